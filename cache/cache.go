@@ -0,0 +1,210 @@
+// Package cache records the inputs each generated package was generated from, so packages
+// whose inputs have not changed are skipped on the next generation.
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/gokit/mgokit/header"
+	"github.com/influx6/moz/ast"
+	"github.com/influx6/moz/gen"
+)
+
+// FileName contains the name of the cache file written into the destination directory.
+const FileName = ".mgokit-cache.json"
+
+// Target defines the record of a generated package within the cache.
+type Target struct {
+	// Hash sets the hash of the inputs the package was generated from.
+	Hash string `json:"hash"`
+
+	// Files sets the paths of all generated files, relative to the destination.
+	Files []string `json:"files"`
+}
+
+// Cache defines the content of a cache file, keyed by the header.Key of each target, e.g
+// "@mongoapi github.com/example/models.User".
+type Cache struct {
+	Version string            `json:"version"`
+	Targets map[string]Target `json:"targets"`
+}
+
+// Read returns the Cache read from the giving path. It returns an empty Cache if the file
+// does not exist or was written by a different version of mgokit.
+func Read(path string, version string) (Cache, error) {
+	empty := Cache{Version: version, Targets: make(map[string]Target)}
+
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return empty, nil
+	}
+
+	if err != nil {
+		return empty, err
+	}
+
+	var c Cache
+	if err := json.Unmarshal(content, &c); err != nil {
+		return empty, fmt.Errorf("Failed to read cache file %+q: %+q", path, err)
+	}
+
+	if c.Version != version || c.Targets == nil {
+		return empty, nil
+	}
+
+	return c, nil
+}
+
+// Write writes the Cache into the giving path.
+func (c Cache) Write(path string) error {
+	content, err := json.MarshalIndent(c, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(content, '\n'), 0644)
+}
+
+// Fresh returns true/false if the target with the giving key was generated from inputs with
+// the giving hash and all its files still exist within dest.
+func (c Cache) Fresh(key string, hash string, dest string) bool {
+	target, ok := c.Targets[key]
+	if !ok || target.Hash != hash {
+		return false
+	}
+
+	for _, file := range target.Files {
+		if _, err := os.Stat(filepath.Join(dest, file)); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Summary defines the outcome of a generation.
+type Summary struct {
+	Generated int
+	Skipped   int
+	Failed    int
+	Errors    []error
+}
+
+// String returns the Summary as a single line.
+func (s Summary) String() string {
+	return fmt.Sprintf("%d generated, %d skipped, %d failed", s.Generated, s.Skipped, s.Failed)
+}
+
+// Runner wraps annotation generators to skip targets whose inputs are unchanged according
+// to its Cache, writing the files of all others into Dest as soon as they are generated.
+// A failing target is recorded within the Summary and does not stop other targets.
+type Runner struct {
+	Dest  string
+	Force bool
+	Cache Cache
+
+	mu      sync.Mutex
+	summary Summary
+}
+
+// Summary returns the Summary of all targets run so far.
+func (r *Runner) Summary() Summary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.summary
+}
+
+// Struct returns a ast.StructAnnotationGenerator running fn through the Runner, where hash
+// returns the hash of the inputs of a struct's target.
+func (r *Runner) Struct(hash func(ast.AnnotationDeclaration, ast.StructDeclaration, ast.PackageDeclaration, ast.Package) (string, error), fn ast.StructAnnotationGenerator) ast.StructAnnotationGenerator {
+	return func(toPackage string, an ast.AnnotationDeclaration, str ast.StructDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
+		key := header.Key(an.Name, fmt.Sprintf("%s.%s", str.Path, str.Object.Name.Name))
+
+		r.run(key, func() (string, error) {
+			return hash(an, str, pkgDeclr, pkg)
+		}, func() ([]gen.WriteDirective, error) {
+			return fn(toPackage, an, str, pkgDeclr, pkg)
+		})
+
+		return nil, nil
+	}
+}
+
+// Package returns a ast.PackageAnnotationGenerator running fn through the Runner, where hash
+// returns the hash of the inputs of a package's target.
+func (r *Runner) Package(hash func(ast.AnnotationDeclaration, ast.PackageDeclaration) (string, error), fn ast.PackageAnnotationGenerator) ast.PackageAnnotationGenerator {
+	return func(toPackage string, an ast.AnnotationDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
+		key := header.Key(an.Name, pkgDeclr.Path)
+
+		r.run(key, func() (string, error) {
+			return hash(an, pkgDeclr)
+		}, func() ([]gen.WriteDirective, error) {
+			return fn(toPackage, an, pkgDeclr, pkg)
+		})
+
+		return nil, nil
+	}
+}
+
+// run generates the target with the giving key unless it is fresh, writing its files and
+// recording the outcome.
+func (r *Runner) run(key string, hash func() (string, error), generate func() ([]gen.WriteDirective, error)) {
+	sum, err := hash()
+	if err != nil {
+		r.fail(key, err)
+		return
+	}
+
+	r.mu.Lock()
+	fresh := !r.Force && r.Cache.Fresh(key, sum, r.Dest)
+	if fresh {
+		r.summary.Skipped++
+	}
+	r.mu.Unlock()
+
+	if fresh {
+		return
+	}
+
+	directives, err := generate()
+	if err != nil {
+		r.fail(key, err)
+		return
+	}
+
+	files := make([]string, 0, len(directives))
+	for _, directive := range directives {
+		if directive.Writer == nil {
+			continue
+		}
+
+		if err := ast.SimpleWriteDirective(r.Dest, r.Force, directive); err != nil {
+			r.fail(key, err)
+			return
+		}
+
+		files = append(files, filepath.Join(directive.Dir, directive.FileName))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.summary.Generated++
+	r.Cache.Targets[key] = Target{Hash: sum, Files: files}
+}
+
+func (r *Runner) fail(key string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.summary.Failed++
+	r.summary.Errors = append(r.summary.Errors, fmt.Errorf("%s: %s", key, err))
+
+	// Drop the record, so the target is generated again on the next run.
+	delete(r.Cache.Targets, key)
+}
@@ -0,0 +1,92 @@
+package cache_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gokit/mgokit/cache"
+	"github.com/influx6/moz/ast"
+	"github.com/influx6/moz/gen"
+)
+
+func TestRunner(t *testing.T) {
+	dest, err := ioutil.TempDir("", "mgokit-cache")
+	if err != nil {
+		t.Fatalf("Should have created temporary directory: %+q", err)
+	}
+	defer os.RemoveAll(dest)
+
+	path := filepath.Join(dest, cache.FileName)
+
+	var hash string
+	var generated int
+
+	run := func(fail bool) cache.Summary {
+		cached, err := cache.Read(path, "1")
+		if err != nil {
+			t.Fatalf("Should have read cache: %+q", err)
+		}
+
+		runner := &cache.Runner{Dest: dest, Cache: cached}
+		fn := runner.Package(func(ast.AnnotationDeclaration, ast.PackageDeclaration) (string, error) {
+			return hash, nil
+		}, func(string, ast.AnnotationDeclaration, ast.PackageDeclaration, ast.Package) ([]gen.WriteDirective, error) {
+			generated++
+			if fail {
+				return nil, errors.New("bad struct")
+			}
+
+			return []gen.WriteDirective{
+				{Writer: strings.NewReader("package mdb\n"), FileName: "mdb.go", Dir: "mdb"},
+			}, nil
+		})
+
+		if _, err := fn("", ast.AnnotationDeclaration{Name: "@mongo"}, ast.PackageDeclaration{Path: "example/models"}, ast.Package{}); err != nil {
+			t.Fatalf("Should have recorded errors within summary: %+q", err)
+		}
+
+		if err := runner.Cache.Write(path); err != nil {
+			t.Fatalf("Should have written cache: %+q", err)
+		}
+
+		return runner.Summary()
+	}
+
+	hash = "a"
+	if summary := run(false); summary.Generated != 1 || generated != 1 {
+		t.Fatalf("Should have generated target: %s", summary)
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "mdb", "mdb.go")); err != nil {
+		t.Fatalf("Should have written generated file: %+q", err)
+	}
+
+	if summary := run(false); summary.Skipped != 1 || generated != 1 {
+		t.Fatalf("Should have skipped unchanged target: %s", summary)
+	}
+
+	hash = "b"
+	if summary := run(true); summary.Failed != 1 || len(summary.Errors) != 1 {
+		t.Fatalf("Should have failed changed target: %s", summary)
+	}
+
+	if summary := run(false); summary.Generated != 1 || generated != 3 {
+		t.Fatalf("Should have generated target after failure: %s", summary)
+	}
+
+	if err := os.Remove(filepath.Join(dest, "mdb", "mdb.go")); err != nil {
+		t.Fatalf("Should have removed generated file: %+q", err)
+	}
+
+	if summary := run(false); summary.Generated != 1 || generated != 4 {
+		t.Fatalf("Should have generated target with missing files: %s", summary)
+	}
+
+	if cached, err := cache.Read(path, "2"); err != nil || len(cached.Targets) != 0 {
+		t.Fatalf("Should have discarded cache of other version: %+q", err)
+	}
+}
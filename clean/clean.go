@@ -0,0 +1,162 @@
+// Package clean finds generated files whose source annotation no longer exists, such as
+// the packages of structs which were renamed or lost their annotation.
+package clean
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gokit/mgokit/cache"
+	"github.com/gokit/mgokit/header"
+	"github.com/influx6/moz/ast"
+)
+
+// Live returns the header.Key of every annotation found on the giving packages and their
+// structs, e.g "@mongoapi github.com/example/models.User".
+func Live(pkgs ...ast.Package) map[string]bool {
+	live := make(map[string]bool)
+
+	for _, pkg := range pkgs {
+		for _, declr := range pkg.Packages {
+			for _, an := range declr.Annotations {
+				live[header.Key(an.Name, declr.Path)] = true
+			}
+
+			for _, str := range declr.Structs {
+				for _, an := range str.Annotations {
+					live[header.Key(an.Name, str.Path+"."+str.Object.Name.Name)] = true
+				}
+			}
+		}
+	}
+
+	return live
+}
+
+// Orphans returns the sorted paths, relative to dest, of all generated files within dest
+// whose target is not within live. Only targets of packages within the import path scope
+// are considered, so files generated from packages outside of it are kept. Generated files
+// are found by their header and through the files recorded for each target in cached,
+// which include files without a header such as README.md.
+func Orphans(dest string, scope string, live map[string]bool, cached cache.Cache) ([]string, error) {
+	found := make(map[string]bool)
+
+	err := filepath.Walk(dest, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			name := info.Name()
+			if path != dest && (name == "vendor" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		h, _, ok := header.Read(content)
+		if !ok || !within(scope, h.Source) || live[h.Key()] {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dest, path)
+		if err != nil {
+			return err
+		}
+
+		found[rel] = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for key, target := range cached.Targets {
+		if live[key] || !within(scope, source(key)) {
+			continue
+		}
+
+		for _, file := range target.Files {
+			if _, err := os.Stat(filepath.Join(dest, file)); err == nil {
+				found[file] = true
+			}
+		}
+	}
+
+	orphans := make([]string, 0, len(found))
+	for file := range found {
+		orphans = append(orphans, file)
+	}
+
+	sort.Strings(orphans)
+	return orphans, nil
+}
+
+// Remove deletes the giving files, relative to dest, along with all directories left empty
+// by their removal.
+func Remove(dest string, files []string) error {
+	dest = filepath.Clean(dest)
+
+	for _, file := range files {
+		path := filepath.Join(dest, file)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		for dir := filepath.Dir(path); dir != dest && strings.HasPrefix(dir, dest); dir = filepath.Dir(dir) {
+			entries, err := ioutil.ReadDir(dir)
+			if err != nil || len(entries) != 0 {
+				break
+			}
+
+			if err := os.Remove(dir); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Prune drops all targets of packages within the import path scope which are not within
+// live from cached.
+func Prune(cached cache.Cache, scope string, live map[string]bool) {
+	for key := range cached.Targets {
+		if !live[key] && within(scope, source(key)) {
+			delete(cached.Targets, key)
+		}
+	}
+}
+
+// within returns true/false if the giving header source, a package import path optionally
+// followed by a struct name, is within the import path scope. Sources of sibling packages
+// sharing the scope as prefix, e.g gopkg.in/acme.v2 for gopkg.in/acme, are not.
+func within(scope string, source string) bool {
+	if source == scope || strings.HasPrefix(source, scope+"/") {
+		return true
+	}
+
+	if !strings.HasPrefix(source, scope+".") {
+		return false
+	}
+
+	name := strings.TrimPrefix(source, scope+".")
+	return !strings.ContainsAny(name, "/.")
+}
+
+// source returns the source of the giving header.Key.
+func source(key string) string {
+	return key[strings.Index(key, " ")+1:]
+}
@@ -0,0 +1,85 @@
+package clean_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gokit/mgokit/cache"
+	"github.com/gokit/mgokit/clean"
+	"github.com/gokit/mgokit/header"
+)
+
+func TestOrphans(t *testing.T) {
+	dest, err := ioutil.TempDir("", "mgokit-clean")
+	if err != nil {
+		t.Fatalf("Should have created temporary directory: %+q", err)
+	}
+	defer os.RemoveAll(dest)
+
+	generated := func(rel string, source string) {
+		content := header.Header{Source: source, Annotation: "@mongoapi(KeyField => ID)"}.String() + "\npackage store\n"
+		writeFile(t, filepath.Join(dest, rel), content)
+	}
+
+	generated("usermgo/usermgo.go", "example/models.User")
+	generated("notemgo/notemgo.go", "example/models.Note")
+	generated("other/othermgo.go", "example/others.Other")
+	generated("sibling/itemmgo.go", "example/models.v2/store.Item")
+	generated("version/recordmgo.go", "example/models.v2.Record")
+	writeFile(t, filepath.Join(dest, "notemgo/README.md"), "# notemgo\n")
+	writeFile(t, filepath.Join(dest, "notemgo/note.go"), "package notemgo\n")
+
+	live := map[string]bool{header.Key("@mongoapi", "example/models.User"): true}
+
+	cached := cache.Cache{Targets: map[string]cache.Target{
+		header.Key("@mongoapi", "example/models.Note"):          {Files: []string{"notemgo/notemgo.go", "notemgo/README.md"}},
+		header.Key("@mongoapi", "example/others.Other"):         {Files: []string{"other/othermgo.go"}},
+		header.Key("@mongoapi", "example/models.v2/store.Item"): {Files: []string{"sibling/itemmgo.go"}},
+		header.Key("@mongoapi", "example/models.v2.Record"):     {Files: []string{"version/recordmgo.go"}},
+	}}
+
+	orphans, err := clean.Orphans(dest, "example/models", live, cached)
+	if err != nil {
+		t.Fatalf("Should have found orphans: %+q", err)
+	}
+
+	expected := []string{filepath.Join("notemgo", "README.md"), filepath.Join("notemgo", "notemgo.go")}
+	if !reflect.DeepEqual(orphans, expected) {
+		t.Fatalf("Should have found orphans of removed struct within scope only: %q", orphans)
+	}
+
+	if err := clean.Remove(dest, orphans); err != nil {
+		t.Fatalf("Should have removed orphans: %+q", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "notemgo", "note.go")); err != nil {
+		t.Fatalf("Should have kept files without header: %+q", err)
+	}
+
+	clean.Prune(cached, "example/models", live)
+	if len(cached.Targets) != 3 {
+		t.Fatalf("Should have pruned targets within scope only: %#v", cached.Targets)
+	}
+
+	os.Remove(filepath.Join(dest, "notemgo", "note.go"))
+	if err := clean.Remove(dest, []string{filepath.Join("usermgo", "usermgo.go")}); err != nil {
+		t.Fatalf("Should have removed file: %+q", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "usermgo")); !os.IsNotExist(err) {
+		t.Fatalf("Should have removed empty directory: %+q", err)
+	}
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Should have created directory: %+q", err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Should have written file: %+q", err)
+	}
+}
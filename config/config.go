@@ -0,0 +1,280 @@
+// Package config loads mgokit project files which set the default generation options
+// for all packages and structs of a project.
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// FileNames contains the names of the project files searched for, in order of preference.
+var FileNames = []string{"mgokit.toml", "mgokit.yaml", "mgokit.yml"}
+
+// Options defines the generation options for a struct, set either as defaults, for a
+// package or for a single struct.
+type Options struct {
+	// PackageName sets the name of generated packages, where {struct} is replaced with
+	// the lowercased struct name and {package} with the name of its package.
+	// Defaults to "{struct}mgo".
+	PackageName string `toml:"package_name" yaml:"package_name"`
+
+	// Dir sets the directory of generated packages relative to the destination, with the
+	// same replacements as PackageName. Defaults to the package name.
+	Dir string `toml:"dir" yaml:"dir"`
+
+	// Types, Fixtures, Readme, Makefile and Dockerfile set whether the backend interface,
+	// the fixtures package, README.md, makefile and test.dockerfile are generated. All
+	// but Dockerfile default to true. Generated tests use the fixtures package, hence are
+	// only generated along with it.
+	Types      *bool `toml:"types" yaml:"types"`
+	Fixtures   *bool `toml:"fixtures" yaml:"fixtures"`
+	Readme     *bool `toml:"readme" yaml:"readme"`
+	Makefile   *bool `toml:"makefile" yaml:"makefile"`
+	Dockerfile *bool `toml:"dockerfile" yaml:"dockerfile"`
+
+	// HTTP sets whether a REST http.Handler serving records through the backend interface
+	// is generated into the httpapi package. Defaults to false.
+	HTTP *bool `toml:"http" yaml:"http"`
+
+	// GRPC sets whether a protobuf service definition with a gRPC server adapter serving
+	// records through the backend interface is generated into the grpcapi package.
+	// Defaults to false.
+	GRPC *bool `toml:"grpc" yaml:"grpc"`
+
+	// Cache sets whether a read-through cache decorating the backend interface is generated
+	// along with it. Defaults to false.
+	Cache *bool `toml:"cache" yaml:"cache"`
+
+	// Outbox sets whether the writes of the generated DB also write change events into an
+	// outbox collection, delivered by a generated OutboxRelay. Defaults to false.
+	Outbox *bool `toml:"outbox" yaml:"outbox"`
+
+	// BackendInSource sets the backend interface to be generated into the package of the
+	// struct instead of the types package, which must then be within the destination.
+	BackendInSource *bool `toml:"backend_in_source" yaml:"backend_in_source"`
+
+	// Driver sets the mongodb driver used by generated code. Only "mgo" is supported.
+	Driver string `toml:"driver" yaml:"driver"`
+
+	// KeyField sets the string field which identifies a record. Defaults to "PublicID".
+	KeyField string `toml:"key_field" yaml:"key_field"`
+
+	// CreatedField sets the time.Time field set to the current time on Create.
+	CreatedField string `toml:"created_field" yaml:"created_field"`
+
+	// UpdatedField sets the time.Time field set to the current time on Create and Update.
+	UpdatedField string `toml:"updated_field" yaml:"updated_field"`
+
+	// ENVName sets the prefix of environment variables used by generated code.
+	ENVName string `toml:"env_name" yaml:"env_name"`
+
+	// Templates maps names of bundled templates, e.g "mongo-api.tml", to files used instead.
+	Templates map[string]string `toml:"templates" yaml:"templates"`
+}
+
+// Merge returns a copy of o with all options set in other taking precedence.
+func (o Options) Merge(other Options) Options {
+	if other.PackageName != "" {
+		o.PackageName = other.PackageName
+	}
+	if other.Dir != "" {
+		o.Dir = other.Dir
+	}
+	if other.Types != nil {
+		o.Types = other.Types
+	}
+	if other.Fixtures != nil {
+		o.Fixtures = other.Fixtures
+	}
+	if other.Readme != nil {
+		o.Readme = other.Readme
+	}
+	if other.Makefile != nil {
+		o.Makefile = other.Makefile
+	}
+	if other.Dockerfile != nil {
+		o.Dockerfile = other.Dockerfile
+	}
+	if other.HTTP != nil {
+		o.HTTP = other.HTTP
+	}
+	if other.GRPC != nil {
+		o.GRPC = other.GRPC
+	}
+	if other.Cache != nil {
+		o.Cache = other.Cache
+	}
+	if other.Outbox != nil {
+		o.Outbox = other.Outbox
+	}
+	if other.BackendInSource != nil {
+		o.BackendInSource = other.BackendInSource
+	}
+	if other.Driver != "" {
+		o.Driver = other.Driver
+	}
+	if other.KeyField != "" {
+		o.KeyField = other.KeyField
+	}
+	if other.CreatedField != "" {
+		o.CreatedField = other.CreatedField
+	}
+	if other.UpdatedField != "" {
+		o.UpdatedField = other.UpdatedField
+	}
+	if other.ENVName != "" {
+		o.ENVName = other.ENVName
+	}
+
+	if len(other.Templates) != 0 {
+		templates := make(map[string]string, len(o.Templates)+len(other.Templates))
+		for name, path := range o.Templates {
+			templates[name] = path
+		}
+		for name, path := range other.Templates {
+			templates[name] = path
+		}
+		o.Templates = templates
+	}
+
+	return o
+}
+
+// Config defines the content of a mgokit project file.
+type Config struct {
+	// Path sets the path of the file the Config was loaded from.
+	Path string `toml:"-" yaml:"-"`
+
+	// Dest sets the destination directory of generated packages, relative to the file.
+	Dest string `toml:"dest" yaml:"dest"`
+
+	// Target sets the directory of annotated packages, relative to the file.
+	Target string `toml:"target" yaml:"target"`
+
+	// TemplatesDir sets a directory of files named like the bundled templates, e.g
+	// "mongo-api.tml", used instead of them.
+	TemplatesDir string `toml:"templates_dir" yaml:"templates_dir"`
+
+	// Force sets generation to override files which are only generated once.
+	Force bool `toml:"force" yaml:"force"`
+
+	// Defaults sets the options for all structs.
+	Defaults Options `toml:"defaults" yaml:"defaults"`
+
+	// Packages sets the options for structs of a package, keyed by package import path.
+	Packages map[string]Options `toml:"packages" yaml:"packages"`
+
+	// Structs sets the options for a struct, keyed by package import path and struct
+	// name, e.g "github.com/example/models.User".
+	Structs map[string]Options `toml:"structs" yaml:"structs"`
+}
+
+// Options returns the options for the giving struct of the package with the giving import
+// path, merging the defaults with the package and struct options.
+func (c Config) Options(pkgPath string, structName string) Options {
+	return c.Defaults.Merge(c.Packages[pkgPath]).Merge(c.Structs[pkgPath+"."+structName])
+}
+
+// Find returns the path of the first project file found within dir or any of its parent
+// directories, else an empty string if none exists.
+func Find(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		for _, name := range FileNames {
+			path := filepath.Join(dir, name)
+			if _, err := os.Stat(path); err == nil {
+				return path, nil
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+
+		dir = parent
+	}
+}
+
+// Load returns the Config found within dir or any of its parent directories, else an
+// empty Config if none exists.
+func Load(dir string) (Config, error) {
+	path, err := Find(dir)
+	if err != nil || path == "" {
+		return Config{}, err
+	}
+
+	return Read(path)
+}
+
+// Read returns the Config read from the giving toml or yaml file. Relative paths within
+// the file are resolved against its directory.
+func Read(path string) (Config, error) {
+	var conf Config
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return conf, err
+	}
+
+	switch filepath.Ext(path) {
+	case ".toml":
+		var meta toml.MetaData
+		if meta, err = toml.Decode(string(content), &conf); err == nil && len(meta.Undecoded()) != 0 {
+			err = fmt.Errorf("unknown key %+q", meta.Undecoded()[0].String())
+		}
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(content, &conf)
+	default:
+		return conf, fmt.Errorf("Config file %+q must be a toml or yaml file", path)
+	}
+
+	if err != nil {
+		return conf, fmt.Errorf("Failed to read config file %+q: %+q", path, err)
+	}
+
+	conf.Path = path
+
+	dir := filepath.Dir(path)
+	conf.Dest = resolve(dir, conf.Dest)
+	conf.Target = resolve(dir, conf.Target)
+	conf.TemplatesDir = resolve(dir, conf.TemplatesDir)
+	conf.Defaults.Templates = resolveAll(dir, conf.Defaults.Templates)
+
+	for key, ops := range conf.Packages {
+		ops.Templates = resolveAll(dir, ops.Templates)
+		conf.Packages[key] = ops
+	}
+
+	for key, ops := range conf.Structs {
+		ops.Templates = resolveAll(dir, ops.Templates)
+		conf.Structs[key] = ops
+	}
+
+	return conf, nil
+}
+
+func resolve(dir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(dir, path)
+}
+
+func resolveAll(dir string, paths map[string]string) map[string]string {
+	for name, path := range paths {
+		paths[name] = resolve(dir, path)
+	}
+
+	return paths
+}
@@ -0,0 +1,122 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gokit/mgokit/config"
+)
+
+const tomlConfig = `
+dest = "gen"
+
+[defaults]
+package_name = "{struct}db"
+key_field = "Key"
+
+[defaults.templates]
+"mongo-api.tml" = "templates/api.tml"
+
+[packages."example.com/models"]
+env_name = "MODELS"
+
+[structs."example.com/models.User"]
+key_field = "ID"
+created_field = "Created"
+`
+
+const yamlConfig = `
+dest: gen
+defaults:
+  package_name: "{struct}db"
+  key_field: Key
+  templates:
+    mongo-api.tml: templates/api.tml
+packages:
+  example.com/models:
+    env_name: MODELS
+structs:
+  example.com/models.User:
+    key_field: ID
+    created_field: Created
+`
+
+func TestRead(t *testing.T) {
+	for name, content := range map[string]string{"mgokit.toml": tomlConfig, "mgokit.yaml": yamlConfig} {
+		dir := tempDir(t)
+		defer os.RemoveAll(dir)
+
+		writeFile(t, filepath.Join(dir, name), content)
+
+		nested := filepath.Join(dir, "models", "user")
+		if err := os.MkdirAll(nested, 0755); err != nil {
+			t.Fatalf("failed to create dir: %+q", err)
+		}
+
+		conf, err := config.Load(nested)
+		if err != nil {
+			t.Fatalf("%s: failed to load config: %+q", name, err)
+		}
+
+		if conf.Path != filepath.Join(dir, name) {
+			t.Fatalf("%s: expected config to be found in %q, got %q", name, dir, conf.Path)
+		}
+
+		if conf.Dest != filepath.Join(dir, "gen") {
+			t.Fatalf("%s: expected dest to be resolved against config dir, got %q", name, conf.Dest)
+		}
+
+		user := conf.Options("example.com/models", "User")
+		if user.PackageName != "{struct}db" || user.KeyField != "ID" || user.CreatedField != "Created" || user.ENVName != "MODELS" {
+			t.Fatalf("%s: unexpected options for User: %#v", name, user)
+		}
+
+		if user.Templates["mongo-api.tml"] != filepath.Join(dir, "templates", "api.tml") {
+			t.Fatalf("%s: expected template path to be resolved against config dir, got %q", name, user.Templates["mongo-api.tml"])
+		}
+
+		admin := conf.Options("example.com/models", "Admin")
+		if admin.KeyField != "Key" || admin.CreatedField != "" || admin.ENVName != "MODELS" {
+			t.Fatalf("%s: unexpected options for Admin: %#v", name, admin)
+		}
+
+		other := conf.Options("example.com/other", "User")
+		if other.KeyField != "Key" || other.ENVName != "" {
+			t.Fatalf("%s: unexpected options for other package: %#v", name, other)
+		}
+	}
+}
+
+func TestReadUnknownKey(t *testing.T) {
+	for name, content := range map[string]string{"mgokit.toml": "destination = \"gen\"\n", "mgokit.yaml": "destination: gen\n"} {
+		dir := tempDir(t)
+		defer os.RemoveAll(dir)
+
+		writeFile(t, filepath.Join(dir, name), content)
+
+		if _, err := config.Read(filepath.Join(dir, name)); err == nil {
+			t.Fatalf("%s: expected unknown key to fail", name)
+		}
+	}
+}
+
+func tempDir(t *testing.T) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "mgokit-config")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %+q", err)
+	}
+
+	return dir
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write file: %+q", err)
+	}
+}
@@ -1,3 +1,8 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/api.User
+// Annotation: @mongoapi(Cache => true, HTTP => true)
+// Hash: sha256:7c8cf2458536963f7bdead989557e23e8030c5b2389e3f66ade2f96ff26ac410
+
 package types
 
 import (
@@ -1,8 +1,11 @@
 package api
 
+import "gopkg.in/mgo.v2/bson"
+
 // User contains user data.
-// @mongoapi
+// @mongoapi(HTTP => true, Cache => true)
 type User struct {
-	PublicID string `json:"public_id"`
-	Name     string `json:"name"`
+	ID       bson.ObjectId `bson:"_id" json:"id"`
+	PublicID string        `json:"public_id" schema:"required"`
+	Name     string        `json:"name"`
 }
@@ -0,0 +1,92 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/api.User
+// Annotation: @mongoapi(Cache => true, HTTP => true)
+// Hash: sha256:1b8c174591fa25d2b5c8fa4440f6412e6e764ee3b468def3a6b020783b6fc806
+
+package fixtures
+
+import (
+	"context"
+
+	"math/rand"
+
+	"time"
+
+	"github.com/gokit/mgokit/example/api"
+)
+
+// DefaultSeed defines the seed used by RandomUsers, so the same
+// records are produced on every run.
+var DefaultSeed int64 = 1
+
+// Creator defines an interface which exposes a method to store a api.User.
+type Creator interface {
+	Create(ctx context.Context, elem api.User) error
+}
+
+// CreatorFunc defines a function type which implements the Creator interface.
+type CreatorFunc func(ctx context.Context, elem api.User) error
+
+// Create calls the underline function with the provided arguments.
+func (fn CreatorFunc) Create(ctx context.Context, elem api.User) error {
+	return fn(ctx, elem)
+}
+
+// RandomUser returns a new instance of a api.User with
+// its fields set to random values drawn from the provided rand.Rand.
+func RandomUser(r *rand.Rand) api.User {
+	var elem api.User
+	elem.PublicID = randomString(r, 30)
+	elem.Name = randomString(r, 20)
+
+	return elem
+}
+
+// RandomUsers returns n instances of api.User with
+// random values drawn from a rand.Rand seeded with DefaultSeed.
+func RandomUsers(n int) []api.User {
+	r := rand.New(rand.NewSource(DefaultSeed))
+
+	elems := make([]api.User, 0, n)
+	for i := 0; i < n; i++ {
+		elems = append(elems, RandomUser(r))
+	}
+
+	return elems
+}
+
+// Seed stores n random instances of api.User through the
+// provided Creator, returning the stored records.
+func Seed(ctx context.Context, db Creator, n int) ([]api.User, error) {
+	elems := RandomUsers(n)
+
+	for _, elem := range elems {
+		if err := db.Create(ctx, elem); err != nil {
+			return nil, err
+		}
+	}
+
+	return elems, nil
+}
+
+const randomLetters = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// randomString returns a string of n random characters drawn from r.
+func randomString(r *rand.Rand, n int) string {
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = randomLetters[r.Intn(len(randomLetters))]
+	}
+	return string(buf)
+}
+
+// randomTime returns a random time within the year 2000 drawn from r.
+func randomTime(r *rand.Rand) time.Time {
+	return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(r.Int63n(365*24)) * time.Hour)
+}
+
+// randomTimePtr returns a pointer to a random time drawn from r.
+func randomTimePtr(r *rand.Rand) *time.Time {
+	tm := randomTime(r)
+	return &tm
+}
@@ -1,14 +1,17 @@
-// Package usermgo provides a auto-generated package which contains a sql CRUD API for the specific User struct in package api.
-//
-//
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/api.User
+// Annotation: @mongoapi(Cache => true, HTTP => true)
+// Hash: sha256:36c65e7dd051d193a79408dec1178f6e58fa6951b6b9e3a1be0ee24d1f32d4cf
+
 package usermgo
 
 import (
 	"errors"
-	"time"
 
 	"runtime"
 
+	"time"
+
 	"sync"
 
 	"context"
@@ -22,6 +25,12 @@ import (
 	"github.com/influx6/faux/metrics"
 
 	"github.com/gokit/mgokit/example/api"
+
+	"encoding/base64"
+
+	"fmt"
+
+	"math"
 )
 
 // errors ...
@@ -80,28 +89,28 @@ type MongoDB interface {
 }
 
 // NewMongoDB returns a new instance of a MongoDB.
-func NewMongoDB(conf Config) MongoDB {
-	mg := &mongoDB{
+func NewMongoDB(conf Config) *MongoDBImpl {
+	mg := &MongoDBImpl{
 		Config: conf,
 	}
 
 	// Add finalizer to ensure closure of master session.
-	runtime.SetFinalizer(mg, func() {
-		mg.ml.Lock()
-		defer mg.ml.Unlock()
-		if mg.master != nil {
-			mg.master.Close()
-			mg.master = nil
+	runtime.SetFinalizer(mg, func(target *MongoDBImpl) {
+		target.ml.Lock()
+		defer target.ml.Unlock()
+		if target.master != nil {
+			target.master.Close()
+			target.master = nil
 		}
 	})
 
 	return mg
 }
 
-// mongoDB defines a mongo connection manager that builds
+// MongoDBImpl defines a mongo connection manager that builds
 // allows usage of a giving configuration to generate new mongo
 // sessions and database instances.
-type mongoDB struct {
+type MongoDBImpl struct {
 	Config
 	ml     sync.Mutex
 	master *mgo.Session
@@ -119,13 +128,15 @@ type mongoDB struct {
 // 2. If `isread` is true, then session is copied which creates a new unique session which you
 // should close after use, this lets you handle large reads that may contain complicated queries.
 //
-func (m *mongoDB) New(isread bool) (*mgo.Database, *mgo.Session, error) {
+func (m *MongoDBImpl) New(isread bool) (*mgo.Database, *mgo.Session, error) {
 	m.ml.Lock()
 	defer m.ml.Unlock()
 
 	// if m.master is alive then continue else, reset as empty.
-	if err := m.master.Ping(); err != nil {
-		m.master = nil
+	if m.master != nil {
+		if err := m.master.Ping(); err != nil {
+			m.master = nil
+		}
 	}
 
 	ses, err := getSession(m.Config)
@@ -189,6 +200,35 @@ type Validation interface {
 	Validate() error
 }
 
+// FieldError defines a field of a record failing a rule of its validate tag, with Field
+// set to its path within stored documents, e.g "items.0.sku".
+type FieldError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+// Error returns the FieldError as a message, e.g "name must be at least 3 characters long".
+func (f FieldError) Error() string {
+	return f.Field + " " + f.Message
+}
+
+// ValidationError defines the error returned for a record failing the rules of the validate
+// tags of its fields, listing every failing field.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error returns the failures of all fields separated by "; ".
+func (v ValidationError) Error() string {
+	messages := make([]string, len(v.Fields))
+	for index, field := range v.Fields {
+		messages[index] = field.Error()
+	}
+
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
 //**********************************************************
 // DB API
 //**********************************************************
@@ -202,8 +242,14 @@ type UserDB struct {
 	ensuredIndex    bool
 	incompleteIndex bool
 	indexes         []mgo.Index
+	hl              sync.RWMutex
+	hooks           map[HookStage][]Hook
 }
 
+// Hook defines a function run by UserDB on a record at the stage it was
+// added for. Hooks run before a write may change the record written.
+type Hook func(ctx context.Context, elem *api.User) error
+
 // New returns a new instance of UserDB.
 func New(col string, m metrics.Metrics, mo MongoDB, indexes ...mgo.Index) *UserDB {
 	return &UserDB{
@@ -211,7 +257,53 @@ func New(col string, m metrics.Metrics, mo MongoDB, indexes ...mgo.Index) *UserD
 		col:     col,
 		metrics: m,
 		indexes: indexes,
+		hooks:   make(map[HookStage][]Hook),
+	}
+}
+
+// AddHook adds the giving hook to run at the giving stage, after the method of the struct
+// named after the stage and the hooks added before it.
+func (mdb *UserDB) AddHook(stage HookStage, hook Hook) {
+	mdb.hl.Lock()
+	defer mdb.hl.Unlock()
+
+	if mdb.hooks == nil {
+		mdb.hooks = make(map[HookStage][]Hook)
+	}
+
+	mdb.hooks[stage] = append(mdb.hooks[stage], hook)
+}
+
+// hook runs the method of the giving record named after the giving stage, then the hooks
+// added for the stage, stopping at the first to fail.
+func (mdb *UserDB) hook(ctx context.Context, stage HookStage, elem *api.User) error {
+	if err := structHook(ctx, stage, elem); err != nil {
+		return err
 	}
+
+	mdb.hl.RLock()
+	added := mdb.hooks[stage]
+	mdb.hl.RUnlock()
+
+	for _, hook := range added {
+		if err := hook(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hooked returns true if any method or hook runs at the giving stage.
+func (mdb *UserDB) hooked(stage HookStage) bool {
+	if structHooked(stage) {
+		return true
+	}
+
+	mdb.hl.RLock()
+	defer mdb.hl.RUnlock()
+
+	return len(mdb.hooks[stage]) != 0
 }
 
 // ensureIndex attempts to ensure all provided indexes into the specific collection.
@@ -315,6 +407,23 @@ func (mdb *UserDB) Delete(ctx context.Context, publicID string) error {
 		return err
 	}
 
+	// The record is only loaded for the methods and hooks run around its deletion.
+	var elem api.User
+	hooked := mdb.hooked(BeforeDelete) || mdb.hooked(AfterDelete)
+
+	if hooked {
+		found, err := mdb.Get(ctx, publicID)
+		if err != nil {
+			return err
+		}
+
+		elem = found
+		if err := mdb.hook(ctx, BeforeDelete, &elem); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to run BeforeDelete hooks"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			return err
+		}
+	}
+
 	database, session, err := mdb.db.New(false)
 	if err != nil {
 		mdb.metrics.Emit(metrics.Errorf("Failed to delete record"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
@@ -324,7 +433,7 @@ func (mdb *UserDB) Delete(ctx context.Context, publicID string) error {
 	defer session.Close()
 
 	query := bson.M{
-		"publicID": publicID,
+		"public_id": publicID,
 	}
 
 	if err := database.C(mdb.col).Remove(query); err != nil {
@@ -337,6 +446,13 @@ func (mdb *UserDB) Delete(ctx context.Context, publicID string) error {
 
 	mdb.metrics.Emit(metrics.Info("Deleted record"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("publicID", publicID))
 
+	if hooked {
+		if err := mdb.hook(ctx, AfterDelete, &elem); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to run AfterDelete hooks"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -353,9 +469,21 @@ func (mdb *UserDB) Create(ctx context.Context, elem api.User) error {
 		return err
 	}
 
+	if err := mdb.hook(ctx, BeforeCreate, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run BeforeCreate hooks"), metrics.With("publicID", elem.PublicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := ValidateUser(elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to validate record"),
+			metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
 	if validator, ok := interface{}(elem).(Validation); ok {
 		if err := validator.Validate(); err != nil {
-			mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			mdb.metrics.Emit(metrics.Errorf("Failed to validate record"),
+				metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
 			return err
 		}
 	}
@@ -373,12 +501,7 @@ func (mdb *UserDB) Create(ctx context.Context, elem api.User) error {
 
 	defer session.Close()
 
-	query := bson.M(map[string]interface{}{
-
-		"name": elem.Name,
-
-		"public_id": elem.PublicID,
-	})
+	query := userDocument(elem)
 
 	if err := database.C(mdb.col).Insert(query); err != nil {
 		mdb.metrics.Emit(metrics.Errorf("Failed to create User record"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
@@ -387,6 +510,11 @@ func (mdb *UserDB) Create(ctx context.Context, elem api.User) error {
 
 	mdb.metrics.Emit(metrics.Info("Create record"), metrics.With("collection", mdb.col), metrics.With("query", query))
 
+	if err := mdb.hook(ctx, AfterCreate, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run AfterCreate hooks"), metrics.With("publicID", elem.PublicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
 	return nil
 }
 
@@ -461,7 +589,8 @@ func (mdb *UserDB) GetAll(ctx context.Context, order string, orderBy string, pag
 
 	var ritems []api.User
 
-	if err := database.C(mdb.col).Find(query).Skip(indexToStart).Limit(totalWanted).Sort(orderBy).All(&ritems); err != nil {
+	var ditems []map[string]interface{}
+	if err := database.C(mdb.col).Find(query).Skip(indexToStart).Limit(totalWanted).Sort(orderBy).All(&ditems); err != nil {
 		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
 		if err == mgo.ErrNotFound {
 			return nil, -1, ErrNotFound
@@ -469,6 +598,21 @@ func (mdb *UserDB) GetAll(ctx context.Context, order string, orderBy string, pag
 		return nil, -1, err
 	}
 
+	for _, item := range ditems {
+		var elem api.User
+		if err := userFromDocument(item, &elem); err != nil {
+			return nil, -1, err
+		}
+		ritems = append(ritems, elem)
+	}
+
+	for index := range ritems {
+		if err := mdb.hook(ctx, AfterLoad, &ritems[index]); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			return nil, -1, err
+		}
+	}
+
 	return ritems, totalRecords, nil
 }
 
@@ -505,16 +649,37 @@ func (mdb *UserDB) GetAllByOrder(ctx context.Context, order, orderBy string) ([]
 
 	query := bson.M{}
 
-	var items []api.User
-	if err := database.C(mdb.col).Find(query).Sort(orderBy).All(&items); err != nil {
-		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
+	var ditems []map[string]interface{}
+	if err := database.C(mdb.col).Find(query).Sort(orderBy).All(&ditems); err != nil {
+		mdb.metrics.Emit(
+			metrics.Errorf("Failed to retrieve all records of User type from db"),
+			metrics.With("collection", mdb.col),
+			metrics.With("query", query),
+			metrics.With("error", err.Error()),
+		)
 		if err == mgo.ErrNotFound {
 			return nil, ErrNotFound
 		}
 		return nil, err
 	}
 
-	return items, nil
+	var ritems []api.User
+	for _, item := range ditems {
+		var elem api.User
+		if err := userFromDocument(item, &elem); err != nil {
+			return nil, err
+		}
+		ritems = append(ritems, elem)
+	}
+
+	for index := range ritems {
+		if err := mdb.hook(ctx, AfterLoad, &ritems[index]); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			return nil, err
+		}
+	}
+
+	return ritems, nil
 
 }
 
@@ -549,17 +714,28 @@ func (mdb *UserDB) GetByField(ctx context.Context, key string, value interface{}
 
 	query := bson.M{key: value}
 
-	var item api.User
+	var item map[string]interface{}
 
 	if err := database.C(mdb.col).Find(query).One(&item); err != nil {
-		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("query", query), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
 		if err == mgo.ErrNotFound {
 			return api.User{}, ErrNotFound
 		}
 		return api.User{}, err
 	}
 
-	return item, nil
+	var elem api.User
+
+	if err := userFromDocument(item, &elem); err != nil {
+		return api.User{}, err
+	}
+
+	if err := mdb.hook(ctx, AfterLoad, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return api.User{}, err
+	}
+
+	return elem, nil
 
 }
 
@@ -590,17 +766,28 @@ func (mdb *UserDB) Get(ctx context.Context, publicID string) (api.User, error) {
 
 	query := bson.M{"public_id": publicID}
 
-	var item api.User
+	var item map[string]interface{}
 
 	if err := database.C(mdb.col).Find(query).One(&item); err != nil {
-		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("query", query), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
 		if err == mgo.ErrNotFound {
 			return api.User{}, ErrNotFound
 		}
 		return api.User{}, err
 	}
 
-	return item, nil
+	var elem api.User
+
+	if err := userFromDocument(item, &elem); err != nil {
+		return api.User{}, err
+	}
+
+	if err := mdb.hook(ctx, AfterLoad, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return api.User{}, err
+	}
+
+	return elem, nil
 
 }
 
@@ -616,9 +803,19 @@ func (mdb *UserDB) Update(ctx context.Context, publicID string, elem api.User) e
 		return err
 	}
 
+	if err := mdb.hook(ctx, BeforeUpdate, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run BeforeUpdate hooks"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := ValidateUser(elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to validate record"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
 	if validator, ok := interface{}(elem).(Validation); ok {
 		if err := validator.Validate(); err != nil {
-			mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+			mdb.metrics.Emit(metrics.Errorf("Failed to validate record"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
 			return err
 		}
 	}
@@ -638,12 +835,7 @@ func (mdb *UserDB) Update(ctx context.Context, publicID string, elem api.User) e
 
 	query := bson.M{"public_id": publicID}
 
-	queryData := bson.M(map[string]interface{}{
-
-		"name": elem.Name,
-
-		"public_id": elem.PublicID,
-	})
+	queryData := userDocument(elem)
 	if err := database.C(mdb.col).Update(query, queryData); err != nil {
 		mdb.metrics.Emit(metrics.Errorf("Failed to update User record"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("data", queryData), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
 		if err == mgo.ErrNotFound {
@@ -654,6 +846,11 @@ func (mdb *UserDB) Update(ctx context.Context, publicID string, elem api.User) e
 
 	mdb.metrics.Emit(metrics.Info("Update record"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("query", query))
 
+	if err := mdb.hook(ctx, AfterUpdate, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run AfterUpdate hooks"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
 	return nil
 }
 
@@ -693,6 +890,62 @@ func (mdb *UserDB) Exec(ctx context.Context, isread bool, fx func(col *mgo.Colle
 	return nil
 }
 
+// namespaceNotFound is the code of the error returned by collMod for a missing collection.
+const namespaceNotFound = 26
+
+// Schema returns the validator of collections storing api.User records,
+// a $jsonSchema document built from the fields of the struct and their schema tags.
+func Schema() bson.M {
+	return bson.M{"$jsonSchema": bson.M{
+		"bsonType": "object",
+		"properties": bson.M{
+			"_id": bson.M{
+				"bsonType": "objectId",
+			},
+			"public_id": bson.M{
+				"bsonType": "string",
+			},
+			"name": bson.M{
+				"bsonType": "string",
+			},
+		},
+		"required": []string{"public_id"},
+	}}
+}
+
+// ApplySchema sets the validator returned by Schema on the giving collection, creating the
+// collection if it does not exist yet. The level sets which writes are validated, one of
+// "off", "strict" or "moderate", and the action whether invalid writes fail with "error" or
+// are only logged with "warn".
+func ApplySchema(ctx context.Context, db MongoDB, col string, level string, action string) error {
+	if isContextExpired(ctx) {
+		return ErrExpiredContext
+	}
+
+	database, session, err := db.New(false)
+	if err != nil {
+		return err
+	}
+
+	defer session.Close()
+
+	command := bson.D{
+		{Name: "collMod", Value: col},
+		{Name: "validator", Value: Schema()},
+		{Name: "validationLevel", Value: level},
+		{Name: "validationAction", Value: action},
+	}
+
+	var result bson.M
+	err = database.Run(command, &result)
+	if qerr, ok := err.(*mgo.QueryError); ok && qerr.Code == namespaceNotFound {
+		command[0].Name = "create"
+		err = database.Run(command, &result)
+	}
+
+	return err
+}
+
 func isContextExpired(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -701,3 +954,332 @@ func isContextExpired(ctx context.Context) bool {
 		return false
 	}
 }
+
+// userDocument returns the bson.M document stored for the giving User.
+func userDocument(elem api.User) bson.M {
+	doc := bson.M{}
+	if elem.ID != "" {
+		doc["_id"] = elem.ID
+	}
+	doc["public_id"] = elem.PublicID
+	doc["name"] = elem.Name
+	return doc
+}
+
+// ValidateUser returns a ValidationError listing every field of the giving User failing the
+// rules of its validate tag, else nil.
+func ValidateUser(elem api.User) error {
+	var failed []FieldError
+	if len(failed) != 0 {
+		return ValidationError{Fields: failed}
+	}
+	return nil
+}
+
+// HookStage defines a stage of the operations on api.User records at
+// which hooks run, named after the method of the struct run at it.
+type HookStage int
+
+// HookStage values, in the order of the operations they run within.
+const (
+	BeforeCreate HookStage = iota
+	AfterCreate
+	BeforeUpdate
+	AfterUpdate
+	BeforeDelete
+	AfterDelete
+	AfterLoad
+)
+
+// hookStages contains the names of all HookStage values.
+var hookStages = []string{"BeforeCreate", "AfterCreate", "BeforeUpdate", "AfterUpdate", "BeforeDelete", "AfterDelete", "AfterLoad"}
+
+// String returns the name of the HookStage.
+func (h HookStage) String() string {
+	if h < 0 || int(h) >= len(hookStages) {
+		return "UnknownHookStage"
+	}
+
+	return hookStages[h]
+}
+
+// structHook runs the method of the giving record named after the giving stage, if
+// api.User declares it.
+func structHook(ctx context.Context, stage HookStage, elem *api.User) error {
+	switch stage {
+	}
+
+	return nil
+}
+
+// structHooked returns true if api.User declares the
+// method named after the giving stage.
+func structHooked(stage HookStage) bool {
+	switch stage {
+	}
+
+	return false
+}
+
+// userFromDocument sets the giving User from the giving document, as built by userDocument and
+// read from mongodb. It returns an error if the key of a field without omitempty is missing
+// or a value can not be converted into the type of its field.
+func userFromDocument(data map[string]interface{}, elem *api.User) error {
+	if value1, ok := data["_id"]; ok {
+		value2, err := userObjectID(value1)
+		if err != nil {
+			return fmt.Errorf("Failed to consume %q of User: %+q", "_id", err)
+		}
+		elem.ID = value2
+	}
+	value3, ok := data["public_id"]
+	if !ok {
+		return fmt.Errorf("User is missing required field %q", "public_id")
+	}
+	value4, err := userString(value3)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of User: %+q", "public_id", err)
+	}
+	elem.PublicID = value4
+	value5, ok := data["name"]
+	if !ok {
+		return fmt.Errorf("User is missing required field %q", "name")
+	}
+	value6, err := userString(value5)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of User: %+q", "name", err)
+	}
+	elem.Name = value6
+	return nil
+}
+
+// userString returns the giving value as a string.
+func userString(value interface{}) (string, error) {
+	if v, ok := value.(string); ok {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("expected string, found %T", value)
+}
+
+// userBool returns the giving value as a bool.
+func userBool(value interface{}) (bool, error) {
+	if v, ok := value.(bool); ok {
+		return v, nil
+	}
+
+	return false, fmt.Errorf("expected bool, found %T", value)
+}
+
+// userInt64 returns the giving number as an int64, failing if it does not fit into the
+// giving number of bits. Floating point numbers, as decoded from json, must be integers.
+func userInt64(value interface{}, bits uint) (int64, error) {
+	var n int64
+
+	switch v := value.(type) {
+	case int:
+		n = int64(v)
+	case int8:
+		n = int64(v)
+	case int16:
+		n = int64(v)
+	case int32:
+		n = int64(v)
+	case int64:
+		n = v
+	case uint8:
+		n = int64(v)
+	case uint16:
+		n = int64(v)
+	case uint32:
+		n = int64(v)
+	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", v)
+		}
+		n = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", v)
+		}
+		n = int64(v)
+	case float32:
+		if float32(int64(v)) != v {
+			return 0, fmt.Errorf("expected integer, found %v", v)
+		}
+		n = int64(v)
+	case float64:
+		if float64(int64(v)) != v {
+			return 0, fmt.Errorf("expected integer, found %v", v)
+		}
+		n = int64(v)
+	default:
+		return 0, fmt.Errorf("expected number, found %T", value)
+	}
+
+	if bits < 64 && (n < -1<<(bits-1) || n >= 1<<(bits-1)) {
+		return 0, fmt.Errorf("%d overflows int%d", n, bits)
+	}
+
+	return n, nil
+}
+
+// userUint64 returns the giving number as an uint64, failing if it is negative or does not
+// fit into the giving number of bits.
+func userUint64(value interface{}, bits uint) (uint64, error) {
+	var n uint64
+
+	switch v := value.(type) {
+	case uint:
+		n = uint64(v)
+	case uint8:
+		n = uint64(v)
+	case uint16:
+		n = uint64(v)
+	case uint32:
+		n = uint64(v)
+	case uint64:
+		n = v
+	default:
+		signed, err := userInt64(value, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		if signed < 0 {
+			return 0, fmt.Errorf("expected unsigned number, found %d", signed)
+		}
+
+		n = uint64(signed)
+	}
+
+	if bits < 64 && n >= 1<<bits {
+		return 0, fmt.Errorf("%d overflows uint%d", n, bits)
+	}
+
+	return n, nil
+}
+
+// userFloat64 returns the giving number as a float64.
+func userFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	}
+
+	n, err := userInt64(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected number, found %T", value)
+	}
+
+	return float64(n), nil
+}
+
+// userTime returns the giving value as a time.Time, parsing strings, as decoded from json,
+// in the RFC3339 format.
+func userTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		return time.Parse(time.RFC3339Nano, v)
+	}
+
+	return time.Time{}, fmt.Errorf("expected time, found %T", value)
+}
+
+// userObjectID returns the giving value as a bson.ObjectId, parsing strings, as decoded
+// from json, in their hex representation.
+func userObjectID(value interface{}) (bson.ObjectId, error) {
+	switch v := value.(type) {
+	case bson.ObjectId:
+		return v, nil
+	case string:
+		if bson.IsObjectIdHex(v) {
+			return bson.ObjectIdHex(v), nil
+		}
+	}
+
+	return "", fmt.Errorf("expected object id, found %#v", value)
+}
+
+// userBytes returns the giving value as binary data, decoding strings, as decoded from
+// json, from base64.
+func userBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case bson.Binary:
+		return v.Data, nil
+	case string:
+		return base64.StdEncoding.DecodeString(v)
+	}
+
+	return nil, fmt.Errorf("expected binary data, found %T", value)
+}
+
+// userMap returns the giving value as a map of fields, as decoded for nested documents.
+func userMap(value interface{}) (map[string]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case bson.M:
+		return v, nil
+	case map[string]interface{}:
+		return v, nil
+	case bson.D:
+		return v.Map(), nil
+	}
+
+	return nil, fmt.Errorf("expected document, found %T", value)
+}
+
+// userSlice returns the giving value as a slice, as decoded for lists.
+func userSlice(value interface{}) ([]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("expected list, found %T", value)
+}
+
+// userHasAny returns true/false if data contains any of the giving keys.
+func userHasAny(data map[string]interface{}, keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := data[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// userDecode decodes the giving value into target through bson, for values of types
+// which are stored as they are. A nil value leaves target unchanged.
+func userDecode(value interface{}, target interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	data, err := bson.Marshal(bson.M{"value": value})
+	if err != nil {
+		return err
+	}
+
+	var raw struct {
+		Value bson.Raw `bson:"value"`
+	}
+
+	if err := bson.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return raw.Value.Unmarshal(target)
+}
@@ -0,0 +1,283 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/api.User
+// Annotation: @mongoapi(Cache => true, HTTP => true)
+// Hash: sha256:146c2681e641678d786eca2957e80b7d7998fa8878e725e77ed8f8347262bf76
+
+package usermgo
+
+import (
+	"container/list"
+
+	"context"
+
+	"sync"
+
+	"sync/atomic"
+
+	"time"
+
+	"github.com/influx6/faux/metrics"
+
+	"github.com/gokit/mgokit/example/api"
+
+	"github.com/gokit/mgokit/example/api/types"
+)
+
+// Cache defines a cache of api.User records keyed by their PublicID, used by
+// CachedUserDB. Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(publicID string) (api.User, bool)
+	Set(publicID string, elem api.User)
+	Delete(publicID string)
+}
+
+// LRU implements Cache, holding up to a fixed count of records, evicting the least recently
+// used record when full and expiring records once they are older than its ttl.
+type LRU struct {
+	size    int
+	ttl     time.Duration
+	ml      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// lruEntry defines a record held by a LRU with the time it expires at.
+type lruEntry struct {
+	key     string
+	elem    api.User
+	expires time.Time
+}
+
+// NewLRU returns a new LRU holding up to size records, at least 1, for the giving ttl. Records
+// never expire if ttl is 0.
+func NewLRU(size int, ttl time.Duration) *LRU {
+	if size < 1 {
+		size = 1
+	}
+
+	return &LRU{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+}
+
+// Get returns the record of the giving key, if held and not expired.
+func (l *LRU) Get(publicID string) (api.User, bool) {
+	l.ml.Lock()
+	defer l.ml.Unlock()
+
+	item, ok := l.entries[publicID]
+	if !ok {
+		return api.User{}, false
+	}
+
+	entry := item.Value.(*lruEntry)
+	if l.ttl > 0 && time.Now().After(entry.expires) {
+		l.order.Remove(item)
+		delete(l.entries, publicID)
+		return api.User{}, false
+	}
+
+	l.order.MoveToFront(item)
+	return entry.elem, true
+}
+
+// Set adds the record of the giving key, evicting the least recently used record if full.
+func (l *LRU) Set(publicID string, elem api.User) {
+	l.ml.Lock()
+	defer l.ml.Unlock()
+
+	expires := time.Now().Add(l.ttl)
+
+	if item, ok := l.entries[publicID]; ok {
+		entry := item.Value.(*lruEntry)
+		entry.elem, entry.expires = elem, expires
+		l.order.MoveToFront(item)
+		return
+	}
+
+	l.entries[publicID] = l.order.PushFront(&lruEntry{key: publicID, elem: elem, expires: expires})
+
+	if l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Delete removes the record of the giving key.
+func (l *LRU) Delete(publicID string) {
+	l.ml.Lock()
+	defer l.ml.Unlock()
+
+	if item, ok := l.entries[publicID]; ok {
+		l.order.Remove(item)
+		delete(l.entries, publicID)
+	}
+}
+
+// CacheStats defines the counts of lookups served by a CachedUserDB.
+// Misses include Coalesced, the misses served by the backend lookup of a concurrent miss.
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Coalesced int64
+}
+
+// CachedUserDB implements types.UserDBBackend, serving Get from its
+// cache and all other methods from its backend. Records are cached when read through Get and
+// invalidated by Create, Update and Delete, while concurrent misses of a record share a single
+// lookup of the backend, failing along with it.
+type CachedUserDB struct {
+	hits      int64
+	misses    int64
+	coalesced int64
+
+	Backend types.UserDBBackend
+	Cache   Cache
+	Metrics metrics.Metrics
+
+	ml      sync.Mutex
+	version uint64
+	loads   map[string]*cacheLoad
+}
+
+var _ types.UserDBBackend = (*CachedUserDB)(nil)
+
+// cacheLoad defines a lookup of the backend shared by concurrent misses of a record.
+type cacheLoad struct {
+	done chan struct{}
+	elem api.User
+	err  error
+}
+
+// NewCached returns a new CachedUserDB serving the records of the
+// giving backend through the giving cache.
+func NewCached(backend types.UserDBBackend, cache Cache, m metrics.Metrics) *CachedUserDB {
+	return &CachedUserDB{
+		Backend: backend,
+		Cache:   cache,
+		Metrics: m,
+		loads:   make(map[string]*cacheLoad),
+	}
+}
+
+// Stats returns the counts of lookups served by Get.
+func (c *CachedUserDB) Stats() CacheStats {
+	return CacheStats{
+		Hits:      atomic.LoadInt64(&c.hits),
+		Misses:    atomic.LoadInt64(&c.misses),
+		Coalesced: atomic.LoadInt64(&c.coalesced),
+	}
+}
+
+// Get returns the record of the giving publicID from the cache, else from the backend, caching
+// it. Misses joining the lookup of another miss return ErrExpiredContext if their context
+// expires before it completes.
+func (c *CachedUserDB) Get(ctx context.Context, publicID string) (api.User, error) {
+	defer c.Metrics.CollectMetrics("CachedUserDB.Get")
+
+	if elem, ok := c.Cache.Get(publicID); ok {
+		atomic.AddInt64(&c.hits, 1)
+		return elem, nil
+	}
+
+	atomic.AddInt64(&c.misses, 1)
+
+	c.ml.Lock()
+	if load, ok := c.loads[publicID]; ok {
+		atomic.AddInt64(&c.coalesced, 1)
+		c.ml.Unlock()
+
+		select {
+		case <-load.done:
+			return load.elem, load.err
+		case <-ctx.Done():
+			return api.User{}, ErrExpiredContext
+		}
+	}
+
+	load := &cacheLoad{done: make(chan struct{})}
+	c.loads[publicID] = load
+	version := c.version
+	c.ml.Unlock()
+
+	load.elem, load.err = c.Backend.Get(ctx, publicID)
+
+	// Records are only cached if no write invalidated records since the lookup started, as
+	// it may have read the record before the write.
+	c.ml.Lock()
+	if load.err == nil && c.version == version {
+		c.Cache.Set(publicID, load.elem)
+	}
+
+	if c.loads[publicID] == load {
+		delete(c.loads, publicID)
+	}
+	c.ml.Unlock()
+
+	close(load.done)
+	return load.elem, load.err
+}
+
+// Create creates the giving record through the backend, invalidating its cached record.
+func (c *CachedUserDB) Create(ctx context.Context, elem api.User) error {
+	defer c.Metrics.CollectMetrics("CachedUserDB.Create")
+
+	err := c.Backend.Create(ctx, elem)
+	c.invalidate(elem.PublicID)
+	return err
+}
+
+// Update updates the record of the giving publicID through the backend, invalidating its
+// cached record.
+func (c *CachedUserDB) Update(ctx context.Context, publicID string, elem api.User) error {
+	defer c.Metrics.CollectMetrics("CachedUserDB.Update")
+
+	err := c.Backend.Update(ctx, publicID, elem)
+	c.invalidate(publicID)
+	return err
+}
+
+// Delete deletes the record of the giving publicID through the backend, invalidating its
+// cached record.
+func (c *CachedUserDB) Delete(ctx context.Context, publicID string) error {
+	defer c.Metrics.CollectMetrics("CachedUserDB.Delete")
+
+	err := c.Backend.Delete(ctx, publicID)
+	c.invalidate(publicID)
+	return err
+}
+
+// Count returns the count of records from the backend.
+func (c *CachedUserDB) Count(ctx context.Context) (int, error) {
+	return c.Backend.Count(ctx)
+}
+
+// GetAll returns a page of records from the backend.
+func (c *CachedUserDB) GetAll(ctx context.Context, order string, orderBy string, page int, responsePerPage int) ([]api.User, int, error) {
+	return c.Backend.GetAll(ctx, order, orderBy, page, responsePerPage)
+}
+
+// GetAllByOrder returns all records ordered as giving from the backend.
+func (c *CachedUserDB) GetAllByOrder(ctx context.Context, order string, orderBy string) ([]api.User, error) {
+	return c.Backend.GetAllByOrder(ctx, order, orderBy)
+}
+
+// GetByField returns the record whose field key holds value from the backend.
+func (c *CachedUserDB) GetByField(ctx context.Context, key string, value interface{}) (api.User, error) {
+	return c.Backend.GetByField(ctx, key, value)
+}
+
+// invalidate removes the cached record of the giving publicID, and stops records looked up
+// before from being cached and lookups in progress from being joined.
+func (c *CachedUserDB) invalidate(publicID string) {
+	c.ml.Lock()
+	defer c.ml.Unlock()
+
+	c.version++
+	c.Cache.Delete(publicID)
+	delete(c.loads, publicID)
+}
@@ -0,0 +1,345 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/api.User
+// Annotation: @mongoapi(Cache => true, HTTP => true)
+// Hash: sha256:06c30e6d5ce3b2d82b29bc777752ed86108d3c463d5bbac675c5bdf305d0e1ec
+
+package usermgo_test
+
+import (
+	"context"
+
+	"fmt"
+
+	"reflect"
+
+	"sync"
+
+	"sync/atomic"
+
+	"testing"
+
+	"time"
+
+	"github.com/influx6/faux/metrics"
+
+	"github.com/gokit/mgokit/example/api"
+
+	mdb "github.com/gokit/mgokit/example/api/usermgo"
+
+	fixtures "github.com/gokit/mgokit/example/api/usermgo/fixtures"
+)
+
+// memoryBackend implements types.UserDBBackend, keeping records in memory in order of creation.
+type memoryBackend struct {
+	ml      sync.Mutex
+	ids     []string
+	records map[string]api.User
+}
+
+func newMemoryBackend() *memoryBackend {
+	return &memoryBackend{records: map[string]api.User{}}
+}
+
+func (mb *memoryBackend) Count(ctx context.Context) (int, error) {
+	if ctx.Err() != nil {
+		return -1, mdb.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+	return len(mb.ids), nil
+}
+
+func (mb *memoryBackend) Delete(ctx context.Context, publicID string) error {
+	if ctx.Err() != nil {
+		return mdb.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	if _, ok := mb.records[publicID]; !ok {
+		return mdb.ErrNotFound
+	}
+
+	delete(mb.records, publicID)
+	for index, id := range mb.ids {
+		if id == publicID {
+			mb.ids = append(mb.ids[:index], mb.ids[index+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (mb *memoryBackend) Create(ctx context.Context, elem api.User) error {
+	if ctx.Err() != nil {
+		return mdb.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	if _, ok := mb.records[elem.PublicID]; ok {
+		return fmt.Errorf("record %q already exists", elem.PublicID)
+	}
+
+	mb.ids = append(mb.ids, elem.PublicID)
+	mb.records[elem.PublicID] = elem
+	return nil
+}
+
+func (mb *memoryBackend) Get(ctx context.Context, publicID string) (api.User, error) {
+	if ctx.Err() != nil {
+		return api.User{}, mdb.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	elem, ok := mb.records[publicID]
+	if !ok {
+		return elem, mdb.ErrNotFound
+	}
+
+	return elem, nil
+}
+
+func (mb *memoryBackend) Update(ctx context.Context, publicID string, elem api.User) error {
+	if ctx.Err() != nil {
+		return mdb.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	if _, ok := mb.records[publicID]; !ok {
+		return mdb.ErrNotFound
+	}
+
+	elem.PublicID = publicID
+	mb.records[publicID] = elem
+	return nil
+}
+
+func (mb *memoryBackend) GetAllByOrder(ctx context.Context, order string, orderBy string) ([]api.User, error) {
+	records, _, err := mb.GetAll(ctx, order, orderBy, 0, 0)
+	return records, err
+}
+
+func (mb *memoryBackend) GetByField(ctx context.Context, key string, value interface{}) (api.User, error) {
+	return api.User{}, mdb.ErrNotFound
+}
+
+func (mb *memoryBackend) GetAll(ctx context.Context, order string, orderBy string, page int, responsePerPage int) ([]api.User, int, error) {
+	if ctx.Err() != nil {
+		return nil, -1, mdb.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	ids := mb.ids
+	if page > 0 && responsePerPage > 0 {
+		start := (page - 1) * responsePerPage
+		if start > len(ids) {
+			start = len(ids)
+		}
+
+		end := start + responsePerPage
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		ids = ids[start:end]
+	}
+
+	records := make([]api.User, 0, len(ids))
+	for _, id := range ids {
+		records = append(records, mb.records[id])
+	}
+
+	return records, len(mb.ids), nil
+}
+
+// countingBackend wraps a memoryBackend, counting calls of Get, which wait for gate if set.
+type countingBackend struct {
+	*memoryBackend
+	gets int64
+	gate chan struct{}
+}
+
+func (cb *countingBackend) Get(ctx context.Context, publicID string) (api.User, error) {
+	atomic.AddInt64(&cb.gets, 1)
+	if cb.gate != nil {
+		<-cb.gate
+	}
+
+	return cb.memoryBackend.Get(ctx, publicID)
+}
+
+func TestCachedGet(t *testing.T) {
+	backend := &countingBackend{memoryBackend: newMemoryBackend()}
+	db := mdb.NewCached(backend, mdb.NewLRU(10, 0), metrics.New())
+
+	ctx := context.Background()
+	elem := fixtures.RandomUsers(1)[0]
+
+	if err := db.Create(ctx, elem); err != nil {
+		t.Fatalf("failed to create record: %+q", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		record, err := db.Get(ctx, elem.PublicID)
+		if err != nil {
+			t.Fatalf("failed to get record: %+q", err)
+		}
+
+		if record.PublicID != elem.PublicID {
+			t.Fatalf("expected record %q, got %q", elem.PublicID, record.PublicID)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := db.Get(ctx, "missing"); err != mdb.ErrNotFound {
+			t.Fatalf("expected ErrNotFound for missing record, got %+q", err)
+		}
+	}
+
+	if gets := atomic.LoadInt64(&backend.gets); gets != 3 {
+		t.Fatalf("expected 3 backend lookups, one for the record and two for the missing record, got %d", gets)
+	}
+
+	if stats := db.Stats(); stats.Hits != 1 || stats.Misses != 3 {
+		t.Fatalf("expected 1 hit and 3 misses, got %#v", stats)
+	}
+}
+
+func TestCachedInvalidation(t *testing.T) {
+	backend := &countingBackend{memoryBackend: newMemoryBackend()}
+	db := mdb.NewCached(backend, mdb.NewLRU(10, 0), metrics.New())
+
+	ctx := context.Background()
+	elems := fixtures.RandomUsers(2)
+
+	if err := db.Create(ctx, elems[0]); err != nil {
+		t.Fatalf("failed to create record: %+q", err)
+	}
+
+	if _, err := db.Get(ctx, elems[0].PublicID); err != nil {
+		t.Fatalf("failed to get record: %+q", err)
+	}
+
+	update := elems[1]
+	update.PublicID = elems[0].PublicID
+
+	if err := db.Update(ctx, elems[0].PublicID, elems[1]); err != nil {
+		t.Fatalf("failed to update record: %+q", err)
+	}
+
+	record, err := db.Get(ctx, elems[0].PublicID)
+	if err != nil {
+		t.Fatalf("failed to get updated record: %+q", err)
+	}
+
+	if !reflect.DeepEqual(record, update) {
+		t.Fatalf("expected updated record %#v, got %#v", update, record)
+	}
+
+	if err := db.Delete(ctx, elems[0].PublicID); err != nil {
+		t.Fatalf("failed to delete record: %+q", err)
+	}
+
+	if _, err := db.Get(ctx, elems[0].PublicID); err != mdb.ErrNotFound {
+		t.Fatalf("expected ErrNotFound for deleted record, got %+q", err)
+	}
+
+	if gets := atomic.LoadInt64(&backend.gets); gets != 3 {
+		t.Fatalf("expected a backend lookup after every write, got %d lookups", gets)
+	}
+}
+
+func TestCachedCoalescing(t *testing.T) {
+	backend := &countingBackend{memoryBackend: newMemoryBackend(), gate: make(chan struct{})}
+	db := mdb.NewCached(backend, mdb.NewLRU(10, 0), metrics.New())
+
+	ctx := context.Background()
+	elem := fixtures.RandomUsers(1)[0]
+
+	if err := backend.Create(ctx, elem); err != nil {
+		t.Fatalf("failed to create record: %+q", err)
+	}
+
+	const lookups = 10
+
+	var wg sync.WaitGroup
+	errs := make(chan error, lookups)
+
+	for i := 0; i < lookups; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := db.Get(ctx, elem.PublicID); err != nil {
+				errs <- err
+			}
+		}()
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for db.Stats().Coalesced != lookups-1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d coalesced lookups, got %#v", lookups-1, db.Stats())
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	close(backend.gate)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("failed to get record: %+q", err)
+	}
+
+	if gets := atomic.LoadInt64(&backend.gets); gets != 1 {
+		t.Fatalf("expected a single backend lookup, got %d", gets)
+	}
+}
+
+func TestLRU(t *testing.T) {
+	elems := fixtures.RandomUsers(3)
+
+	lru := mdb.NewLRU(2, 0)
+	lru.Set("a", elems[0])
+	lru.Set("b", elems[1])
+
+	if _, ok := lru.Get("a"); !ok {
+		t.Fatalf("expected record a to be held")
+	}
+
+	lru.Set("c", elems[2])
+
+	if _, ok := lru.Get("b"); ok {
+		t.Fatalf("expected least recently used record b to be evicted")
+	}
+
+	if _, ok := lru.Get("a"); !ok {
+		t.Fatalf("expected recently used record a to be held")
+	}
+
+	lru.Delete("a")
+	if _, ok := lru.Get("a"); ok {
+		t.Fatalf("expected record a to be deleted")
+	}
+
+	expiring := mdb.NewLRU(2, 10*time.Millisecond)
+	expiring.Set("a", elems[0])
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := expiring.Get("a"); ok {
+		t.Fatalf("expected record a to expire")
+	}
+}
@@ -0,0 +1,67 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/api.User
+// Annotation: @mongoapi(Cache => true, HTTP => true)
+// Hash: sha256:c90295b42787d9b23ddba847f623ddd489e579484b6e0c7e4acc1028d9987816
+
+package usermgo
+
+import (
+	"reflect"
+
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/gokit/mgokit/example/api"
+
+	fixtures "github.com/gokit/mgokit/example/api/usermgo/fixtures"
+)
+
+// storeDocument returns the giving document as read back from mongodb, encoding it into bson
+// and decoding it again.
+func storeDocument(t *testing.T, doc bson.M) bson.M {
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		t.Fatalf("failed to encode document: %+q", err)
+	}
+
+	var stored bson.M
+	if err := bson.Unmarshal(data, &stored); err != nil {
+		t.Fatalf("failed to decode document: %+q", err)
+	}
+
+	return stored
+}
+
+func TestDocumentRoundTrip(t *testing.T) {
+	for _, elem := range fixtures.RandomUsers(5) {
+		stored := storeDocument(t, userDocument(elem))
+
+		var decoded api.User
+		if err := userFromDocument(stored, &decoded); err != nil {
+			t.Fatalf("failed to read document: %+q", err)
+		}
+
+		if decoded.PublicID != elem.PublicID {
+			t.Fatalf("expected PublicID %#v, got %#v", elem.PublicID, decoded.PublicID)
+		}
+
+		if restored := storeDocument(t, userDocument(decoded)); !reflect.DeepEqual(restored, stored) {
+			t.Fatalf("expected read record to store document %#v, got %#v", stored, restored)
+		}
+	}
+}
+
+func TestDocumentZero(t *testing.T) {
+	var elem api.User
+	stored := storeDocument(t, userDocument(elem))
+
+	var decoded api.User
+	if err := userFromDocument(stored, &decoded); err != nil {
+		t.Fatalf("failed to read document of zero record: %+q", err)
+	}
+
+	if restored := storeDocument(t, userDocument(decoded)); !reflect.DeepEqual(restored, stored) {
+		t.Fatalf("expected read zero record to store document %#v, got %#v", stored, restored)
+	}
+}
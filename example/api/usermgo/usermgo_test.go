@@ -1,241 +1,430 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/api.User
+// Annotation: @mongoapi(Cache => true, HTTP => true)
+// Hash: sha256:37e831050214f0d8ef80537a622e37c218f5c6d0b21ee16fc2fc03483df3f084
+
 package usermgo_test
 
 import (
 	"os"
 
+	"fmt"
+
 	"time"
 
+	"reflect"
+
+	"strings"
+
 	"context"
 
+	"math/rand"
+
 	"testing"
 
-	"github.com/influx6/faux/tests"
+	mgo "gopkg.in/mgo.v2"
 
 	"github.com/influx6/faux/metrics"
 
 	"github.com/influx6/faux/metrics/custom"
 
+	"github.com/gokit/mgokit/example/api"
+
 	mdb "github.com/gokit/mgokit/example/api/usermgo"
 
 	fixtures "github.com/gokit/mgokit/example/api/usermgo/fixtures"
+
+	testutil "github.com/gokit/mgokit/example/api/usermgo/testutil"
 )
 
 var (
 	config = mdb.Config{
-		DB:       os.Getenv("API_MONGO_TEST_DB"),
-		Host:     os.Getenv("API_MONGO_TEST_HOST"),
-		User:     os.Getenv("API_MONGO_TEST_USER"),
-		AuthDB:   os.Getenv("API_MONGO_TEST_AUTHDB"),
-		Password: os.Getenv("API_MONGO_TEST_PASSWORD"),
+		DB:       os.Getenv("MONGO_TEST_DB"),
+		Host:     os.Getenv("MONGO_TEST_HOST"),
+		User:     os.Getenv("MONGO_TEST_USER"),
+		AuthDB:   os.Getenv("MONGO_TEST_AUTHDB"),
+		Password: os.Getenv("MONGO_TEST_PASSWORD"),
 	}
-
-	testCol = "user_test_collection"
 )
 
-// TestGetUser validates the retrieval of a User
-// record from a mongodb.
-func TestGetUser(t *testing.T) {
-	events := metrics.New()
-	if testing.Verbose() {
-		events = metrics.New(custom.StackDisplay(os.Stdout))
-	}
-
-	api := mdb.New(testCol, events, mdb.NewMongoDB(config))
+// TestMain starts a local mongod through the testutil package when no
+// MONGO_TEST_HOST is set, tearing it down once all tests have run.
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+// runTests runs the package tests against the configured mongodb, starting a
+// local mongod found in PATH if none is configured.
+func runTests(m *testing.M) int {
+	if config.Host != "" {
+		return m.Run()
+	}
 
-	elem, err := fixtures.LoadUserJSON(fixtures.UserJSON)
+	mongod, err := testutil.Start(testutil.Options{
+		ReplicaSet: os.Getenv("MONGO_TEST_REPLSET"),
+	})
 	if err != nil {
-		tests.Failed("Successfully loaded JSON for User record: %+q.", err)
+		if err != testutil.ErrNoMongod {
+			fmt.Fprintf(os.Stderr, "failed to start local mongod: %s\n", err)
+		}
+		return m.Run()
 	}
-	tests.Passed("Successfully loaded JSON for User record")
 
-	defer api.Delete(ctx, elem.PublicID)
+	defer mongod.Stop()
 
-	if err := api.Create(ctx, elem); err != nil {
-		tests.Failed("Successfully added record for User into db: %+q.", err)
+	config.Host = mongod.Host
+	if config.DB == "" {
+		config.DB = "user_test_db"
 	}
-	tests.Passed("Successfully added record for User into db.")
 
-	_, err = api.Get(ctx, elem.PublicID)
-	if err != nil {
-		tests.Failed("Successfully retrieved stored record for User from db: %+q.", err)
-	}
-	tests.Passed("Successfully retrieved stored record for User from db.")
+	return m.Run()
 }
 
-// TestGetAllUser validates the retrieval of all User
-// record from a mongodb.
-func TestGetAllUser(t *testing.T) {
-	events := metrics.New()
-	if testing.Verbose() {
-		events = metrics.New(custom.StackDisplay(os.Stdout))
+// testSession returns a session to the mongodb configured through the MONGO_TEST_*
+// environment variables, skipping the calling test if none is configured or the
+// configured host is unreachable.
+func testSession(t *testing.T) *mgo.Session {
+	if config.Host == "" || config.DB == "" {
+		t.Skip("MONGO_TEST_HOST and MONGO_TEST_DB are not set and no mongod found in PATH, skipping mongodb tests")
 	}
 
-	api := mdb.New(testCol, events, mdb.NewMongoDB(config))
-
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	elem, err := fixtures.LoadUserJSON(fixtures.UserJSON)
+	session, err := mgo.DialWithInfo(&mgo.DialInfo{
+		Addrs:    []string{config.Host},
+		Timeout:  2 * time.Second,
+		Database: config.AuthDB,
+		Username: config.User,
+		Password: config.Password,
+	})
 	if err != nil {
-		tests.Failed("Successfully loaded JSON for User record: %+q.", err)
+		t.Skipf("mongodb at %q is unreachable, skipping mongodb tests: %s", config.Host, err)
 	}
-	tests.Passed("Successfully loaded JSON for User record")
 
-	defer api.Delete(ctx, elem.PublicID)
+	return session
+}
+
+// testCollection returns a collection name unique to the calling test, so
+// parallel runs against the same database never collide.
+func testCollection(t *testing.T) string {
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	return fmt.Sprintf("user_%s_%d", strings.ToLower(name), time.Now().UnixNano())
+}
+
+// fixtureSource draws the records returned by loadFixture. It is seeded with
+// fixtures.DefaultSeed, so runs are reproducible while each call returns a new record.
+var fixtureSource = rand.New(rand.NewSource(fixtures.DefaultSeed))
 
-	if err := api.Create(ctx, elem); err != nil {
-		tests.Failed("Successfully added record for User into db: %+q.", err)
+// loadFixture returns a new User from the fixtures package, whose values
+// satisfy the validate rules of its fields and differ from those of all previous calls.
+func loadFixture(t *testing.T) api.User {
+	return fixtures.RandomUser(fixtureSource)
+}
+
+// sameRecord fails the test if the giving record read back from mongodb does not equal the
+// record stored, ignoring the timestamps set by the db, the _id assigned by mongodb to records
+// stored without one and the precision and location of times, which mongodb stores as UTC
+// milliseconds.
+func sameRecord(t *testing.T, want api.User, got api.User) {
+	if want.ID == "" {
+		got.ID = ""
 	}
-	tests.Passed("Successfully added record for User into db.")
+	normalize(reflect.ValueOf(&want).Elem())
+	normalize(reflect.ValueOf(&got).Elem())
 
-	records, _, err := api.GetAll(ctx, "asc", "public_id", -1, -1)
-	if err != nil {
-		tests.Failed("Successfully retrieved all records for User from db: %+q.", err)
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("expected User record %#v, got %#v", want, got)
 	}
-	tests.Passed("Successfully retrieved all records for User from db.")
+}
 
-	if len(records) == 0 {
-		tests.Failed("Successfully retrieved atleast 1 record for User from db.")
+// normalize sets all times within the giving value to UTC milliseconds and all empty
+// slices and maps to nil, as they are read back from mongodb.
+func normalize(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if !value.IsNil() {
+			normalize(value.Elem())
+		}
+	case reflect.Struct:
+		if tm, ok := value.Interface().(time.Time); ok {
+			value.Set(reflect.ValueOf(tm.UTC().Truncate(time.Millisecond)))
+			return
+		}
+
+		for i := 0; i < value.NumField(); i++ {
+			if field := value.Field(i); field.CanSet() {
+				normalize(field)
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			normalize(value.Index(i))
+		}
+	case reflect.Slice:
+		if value.Len() == 0 {
+			value.Set(reflect.Zero(value.Type()))
+			return
+		}
+
+		for i := 0; i < value.Len(); i++ {
+			normalize(value.Index(i))
+		}
+	case reflect.Map:
+		if value.Len() == 0 {
+			value.Set(reflect.Zero(value.Type()))
+			return
+		}
+
+		for _, key := range value.MapKeys() {
+			item := reflect.New(value.Type().Elem()).Elem()
+			item.Set(value.MapIndex(key))
+			normalize(item)
+			value.SetMapIndex(key, item)
+		}
 	}
-	tests.Passed("Successfully retrieved atleast 1 record for User from db.")
 }
 
-// TestGetAllUserOrderBy validates the retrieval of all User
-// record from a mongodb.
-func TestGetAllUserByOrder(t *testing.T) {
+// TestUserDB validates the CRUD operations of the UserDB
+// against a mongodb, where each subtest runs against its own collection.
+func TestUserDB(t *testing.T) {
+	session := testSession(t)
+	defer session.Close()
+
 	events := metrics.New()
 	if testing.Verbose() {
 		events = metrics.New(custom.StackDisplay(os.Stdout))
 	}
-	api := mdb.New(testCol, events, mdb.NewMongoDB(config))
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	db := mdb.NewMongoDB(config)
 
-	elem, err := fixtures.LoadUserJSON(fixtures.UserJSON)
-	if err != nil {
-		tests.Failed("Successfully loaded JSON for User record: %+q.", err)
-	}
-	tests.Passed("Successfully loaded JSON for User record")
+	t.Run("Get", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
 
-	defer api.Delete(ctx, elem.PublicID)
+		api := mdb.New(col, events, db)
 
-	if err := api.Create(ctx, elem); err != nil {
-		tests.Failed("Successfully added record for User into db: %+q.", err)
-	}
-	tests.Passed("Successfully added record for User into db.")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
 
-	records, err := api.GetAllByOrder(ctx, "asc", "public_id")
-	if err != nil {
-		tests.Failed("Successfully retrieved all records for User from db: %+q.", err)
-	}
-	tests.Passed("Successfully retrieved all records for User from db.")
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add User record into db: %+q", err)
+		}
 
-	if len(records) == 0 {
-		tests.Failed("Successfully retrieved atleast 1 record for User from db.")
-	}
-	tests.Passed("Successfully retrieved atleast 1 record for User from db.")
-}
+		record, err := api.Get(ctx, elem.PublicID)
+		if err != nil {
+			t.Fatalf("failed to retrieve stored User record from db: %+q", err)
+		}
 
-// TestUserCreate validates the creation of a User
-// record with a mongodb.
-func TestUserCreate(t *testing.T) {
-	events := metrics.New()
-	if testing.Verbose() {
-		events = metrics.New(custom.StackDisplay(os.Stdout))
-	}
-	api := mdb.New(testCol, events, mdb.NewMongoDB(config))
+		sameRecord(t, elem, record)
+	})
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	t.Run("GetAll", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
 
-	elem, err := fixtures.LoadUserJSON(fixtures.UserJSON)
-	if err != nil {
-		tests.Failed("Successfully loaded JSON for User record: %+q.", err)
-	}
-	tests.Passed("Successfully loaded JSON for User record")
+		api := mdb.New(col, events, db)
 
-	defer api.Delete(ctx, elem.PublicID)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
 
-	if err := api.Create(ctx, elem); err != nil {
-		tests.Failed("Successfully added record for User into db: %+q.", err)
-	}
-	tests.Passed("Successfully added record for User into db.")
-}
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add User record into db: %+q", err)
+		}
 
-// TestUserUpdate validates the update of a User
-// record with a mongodb.
-func TestUserUpdate(t *testing.T) {
-	events := metrics.New()
-	if testing.Verbose() {
-		events = metrics.New(custom.StackDisplay(os.Stdout))
-	}
+		records, _, err := api.GetAll(ctx, "asc", "public_id", -1, -1)
+		if err != nil {
+			t.Fatalf("failed to retrieve all User records from db: %+q", err)
+		}
 
-	api := mdb.New(testCol, events, mdb.NewMongoDB(config))
+		if len(records) != 1 {
+			t.Fatalf("expected 1 User record from db, got %d", len(records))
+		}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+		sameRecord(t, elem, records[0])
+	})
 
-	elem, err := fixtures.LoadUserJSON(fixtures.UserJSON)
-	if err != nil {
-		tests.Failed("Successfully loaded JSON for User record: %+q.", err)
-	}
-	tests.Passed("Successfully loaded JSON for User record")
+	t.Run("GetAllByOrder", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
 
-	defer api.Delete(ctx, elem.PublicID)
+		api := mdb.New(col, events, db)
 
-	if err := api.Create(ctx, elem); err != nil {
-		tests.Failed("Successfully added record for User into db: %+q.", err)
-	}
-	tests.Passed("Successfully added record for User into db.")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
 
-	elem2, err := fixtures.LoadUserJSON(fixtures.UserJSON)
-	if err != nil {
-		tests.Failed("Successfully loaded JSON for User record: %+q.", err)
-	}
-	tests.Passed("Successfully loaded JSON for User record")
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add User record into db: %+q", err)
+		}
 
-	elem2.PublicID = elem.PublicID
+		records, err := api.GetAllByOrder(ctx, "asc", "public_id")
+		if err != nil {
+			t.Fatalf("failed to retrieve all User records from db: %+q", err)
+		}
 
-	if err := api.Update(ctx, elem2.PublicID, elem2); err != nil {
-		tests.Failed("Successfully updated record for User into db: %+q.", err)
-	}
-	tests.Passed("Successfully updated record for User into db.")
-}
+		if len(records) == 0 {
+			t.Fatalf("expected atleast 1 User record from db")
+		}
+	})
 
-// TestUserDelete validates the removal of a User
-// record from a mongodb.
-func TestUserDelete(t *testing.T) {
-	events := metrics.New()
-	if testing.Verbose() {
-		events = metrics.New(custom.StackDisplay(os.Stdout))
-	}
-	api := mdb.New(testCol, events, mdb.NewMongoDB(config))
+	t.Run("Create", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+		api := mdb.New(col, events, db)
 
-	elem, err := fixtures.LoadUserJSON(fixtures.UserJSON)
-	if err != nil {
-		tests.Failed("Successfully loaded JSON for User record: %+q.", err)
-	}
-	tests.Passed("Successfully loaded JSON for User record")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
 
-	if err := api.Create(ctx, elem); err != nil {
-		tests.Failed("Successfully added record for User into db: %+q.", err)
-	}
-	tests.Passed("Successfully added record for User into db.")
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add User record into db: %+q", err)
+		}
 
-	if err := api.Delete(ctx, elem.PublicID); err != nil {
-		tests.Failed("Successfully removed record for User into db: %+q.", err)
-	}
-	tests.Passed("Successfully removed record for User into db.")
+		total, err := api.Count(ctx)
+		if err != nil {
+			t.Fatalf("failed to count User records in db: %+q", err)
+		}
 
-	if _, err = api.Get(ctx, elem.PublicID); err == nil {
-		tests.Failed("Successfully failed to get deleted record for User into db.")
-	}
-	tests.Passed("Successfully failed to get deleted record for User into db.")
+		if total != 1 {
+			t.Fatalf("expected 1 User record in db, got %d", total)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add User record into db: %+q", err)
+		}
+
+		elem2 := loadFixture(t)
+		elem2.PublicID = elem.PublicID
+
+		if reflect.DeepEqual(elem2, elem) {
+			t.Fatalf("expected updated User record to differ from the stored one")
+		}
+
+		if err := api.Update(ctx, elem2.PublicID, elem2); err != nil {
+			t.Fatalf("failed to update User record in db: %+q", err)
+		}
+
+		record, err := api.Get(ctx, elem.PublicID)
+		if err != nil {
+			t.Fatalf("failed to retrieve updated User record from db: %+q", err)
+		}
+
+		sameRecord(t, elem2, record)
+	})
+
+	t.Run("Seed", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if _, err := fixtures.Seed(ctx, api, 20); err != nil {
+			t.Fatalf("failed to seed User records into db: %+q", err)
+		}
+
+		records, total, err := api.GetAll(ctx, "asc", "public_id", 1, 10)
+		if err != nil {
+			t.Fatalf("failed to retrieve page of User records from db: %+q", err)
+		}
+
+		if total != 20 {
+			t.Fatalf("expected 20 User records in db, got %d", total)
+		}
+
+		if len(records) != 10 {
+			t.Fatalf("expected page of 10 User records from db, got %d", len(records))
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add User record into db: %+q", err)
+		}
+
+		if err := api.Delete(ctx, elem.PublicID); err != nil {
+			t.Fatalf("failed to remove User record from db: %+q", err)
+		}
+
+		if _, err := api.Get(ctx, elem.PublicID); err == nil {
+			t.Fatalf("expected deleted User record to be missing from db")
+		}
+	})
+	t.Run("Hooks", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		store := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var stages []string
+		for stage := mdb.BeforeCreate; stage <= mdb.AfterLoad; stage++ {
+			stage := stage
+			store.AddHook(stage, func(ctx context.Context, elem *api.User) error {
+				stages = append(stages, stage.String())
+				return nil
+			})
+		}
+
+		elem := loadFixture(t)
+		if err := store.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add User record into db: %+q", err)
+		}
+
+		if err := store.Update(ctx, elem.PublicID, elem); err != nil {
+			t.Fatalf("failed to update User record in db: %+q", err)
+		}
+
+		if err := store.Delete(ctx, elem.PublicID); err != nil {
+			t.Fatalf("failed to remove User record from db: %+q", err)
+		}
+
+		expected := "BeforeCreate,AfterCreate,BeforeUpdate,AfterUpdate,AfterLoad,BeforeDelete,AfterDelete"
+		if got := strings.Join(stages, ","); got != expected {
+			t.Fatalf("expected hooks to run at %s, got %s", expected, got)
+		}
+
+		failing := mdb.New(col, events, db)
+		failing.AddHook(mdb.BeforeCreate, func(ctx context.Context, elem *api.User) error {
+			return fmt.Errorf("rejected")
+		})
+
+		if err := failing.Create(ctx, loadFixture(t)); err == nil || err.Error() != "rejected" {
+			t.Fatalf("expected failing BeforeCreate hook to reject record, got %+q", err)
+		}
+
+		total, err := store.Count(ctx)
+		if err != nil {
+			t.Fatalf("failed to count User records in db: %+q", err)
+		}
+
+		if total != 0 {
+			t.Fatalf("expected rejected User record to be missing from db, got %d records", total)
+		}
+	})
 }
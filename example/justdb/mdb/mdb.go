@@ -1,6 +1,9 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/justdb
+// Annotation: @mongo
+// Hash: sha256:1d90ef81a4b38b40c991e78653d4cb15d8f8ce35f81bd7b56aa8b4cafa0a16c3
+
 // Package mongoapi provides a auto-generated package which contains a mongo base pkg for db operations.
-//
-//
 package mdb
 
 import (
@@ -77,28 +80,28 @@ type MongoDB interface {
 }
 
 // NewMongoDB returns a new instance of a MongoDB.
-func NewMongoDB(conf Config) MongoDB {
-	mg := &mongoDB{
+func NewMongoDB(conf Config) *MongoDBImpl {
+	mg := &MongoDBImpl{
 		Config: conf,
 	}
 
 	// Add finalizer to ensure closure of master session.
-	runtime.SetFinalizer(mg, func() {
-		mg.ml.Lock()
-		defer mg.ml.Unlock()
-		if mg.master != nil {
-			mg.master.Close()
-			mg.master = nil
+	runtime.SetFinalizer(mg, func(target *MongoDBImpl) {
+		target.ml.Lock()
+		defer target.ml.Unlock()
+		if target.master != nil {
+			target.master.Close()
+			target.master = nil
 		}
 	})
 
 	return mg
 }
 
-// mongoDB defines a mongo connection manager that builds
+// MongoDBImpl defines a mongo connection manager that builds
 // allows usage of a giving configuration to generate new mongo
 // sessions and database instances.
-type mongoDB struct {
+type MongoDBImpl struct {
 	Config
 	ml     sync.Mutex
 	master *mgo.Session
@@ -107,7 +110,8 @@ type mongoDB struct {
 // New returns a new session and database from the giving configuration.
 //
 // Argument:
-//  isread: bool
+//
+//	isread: bool
 //
 // 1. If `isread` is false, then the mgo.Session is cloned so that we re-use the existing
 // sessiby not closing, so others get use ofn connection, in such case, it lets you optimize writes, so try not
@@ -115,14 +119,15 @@ type mongoDB struct {
 //
 // 2. If `isread` is true, then session is copied which creates a new unique session which you
 // should close after use, this lets you handle large reads that may contain complicated queries.
-//
-func (m *mongoDB) New(isread bool) (*mgo.Database, *mgo.Session, error) {
+func (m *MongoDBImpl) New(isread bool) (*mgo.Database, *mgo.Session, error) {
 	m.ml.Lock()
 	defer m.ml.Unlock()
 
 	// if m.master is alive then continue else, reset as empty.
-	if err := m.master.Ping(); err != nil {
-		m.master = nil
+	if m.master != nil {
+		if err := m.master.Ping(); err != nil {
+			m.master = nil
+		}
 	}
 
 	ses, err := getSession(m.Config)
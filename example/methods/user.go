@@ -3,6 +3,6 @@ package methods
 // User contains user data.
 // @mongo_methods
 type User struct {
-	PublicID string `json:"public_id"`
+	PublicID string `json:"public_id" schema:"required"`
 	Name     string `json:"name"`
 }
@@ -1,8 +1,12 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/methods.User
+// Annotation: @mongo_methods
+// Hash: sha256:de5241986d12c12fac51f54ca4586692ec52e97a0d6fdfe0587197fd423b9fd2
+
 package usermgo
 
 import (
 	"errors"
-	"time"
 
 	"runtime"
 
@@ -10,6 +14,8 @@ import (
 
 	"context"
 
+	"time"
+
 	"strings"
 
 	mgo "gopkg.in/mgo.v2"
@@ -19,6 +25,12 @@ import (
 	"github.com/influx6/faux/metrics"
 
 	"github.com/gokit/mgokit/example/methods"
+
+	"encoding/base64"
+
+	"fmt"
+
+	"math"
 )
 
 // errors ...
@@ -77,28 +89,28 @@ type MongoDB interface {
 }
 
 // NewMongoDB returns a new instance of a MongoDB.
-func NewMongoDB(conf Config) MongoDB {
-	mg := &mongoDB{
+func NewMongoDB(conf Config) *MongoDBImpl {
+	mg := &MongoDBImpl{
 		Config: conf,
 	}
 
 	// Add finalizer to ensure closure of master session.
-	runtime.SetFinalizer(mg, func() {
-		mg.ml.Lock()
-		defer mg.ml.Unlock()
-		if mg.master != nil {
-			mg.master.Close()
-			mg.master = nil
+	runtime.SetFinalizer(mg, func(target *MongoDBImpl) {
+		target.ml.Lock()
+		defer target.ml.Unlock()
+		if target.master != nil {
+			target.master.Close()
+			target.master = nil
 		}
 	})
 
 	return mg
 }
 
-// mongoDB defines a mongo connection manager that builds
+// MongoDBImpl defines a mongo connection manager that builds
 // allows usage of a giving configuration to generate new mongo
 // sessions and database instances.
-type mongoDB struct {
+type MongoDBImpl struct {
 	Config
 	ml     sync.Mutex
 	master *mgo.Session
@@ -107,7 +119,8 @@ type mongoDB struct {
 // New returns a new session and database from the giving configuration.
 //
 // Argument:
-//  isread: bool
+//
+//	isread: bool
 //
 // 1. If `isread` is false, then the mgo.Session is cloned so that we re-use the existing
 // sessiby not closing, so others get use ofn connection, in such case, it lets you optimize writes, so try not
@@ -115,14 +128,15 @@ type mongoDB struct {
 //
 // 2. If `isread` is true, then session is copied which creates a new unique session which you
 // should close after use, this lets you handle large reads that may contain complicated queries.
-//
-func (m *mongoDB) New(isread bool) (*mgo.Database, *mgo.Session, error) {
+func (m *MongoDBImpl) New(isread bool) (*mgo.Database, *mgo.Session, error) {
 	m.ml.Lock()
 	defer m.ml.Unlock()
 
 	// if m.master is alive then continue else, reset as empty.
-	if err := m.master.Ping(); err != nil {
-		m.master = nil
+	if m.master != nil {
+		if err := m.master.Ping(); err != nil {
+			m.master = nil
+		}
 	}
 
 	ses, err := getSession(m.Config)
@@ -186,6 +200,35 @@ type Validation interface {
 	Validate() error
 }
 
+// FieldError defines a field of a record failing a rule of its validate tag, with Field
+// set to its path within stored documents, e.g "items.0.sku".
+type FieldError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+// Error returns the FieldError as a message, e.g "name must be at least 3 characters long".
+func (f FieldError) Error() string {
+	return f.Field + " " + f.Message
+}
+
+// ValidationError defines the error returned for a record failing the rules of the validate
+// tags of its fields, listing every failing field.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error returns the failures of all fields separated by "; ".
+func (v ValidationError) Error() string {
+	messages := make([]string, len(v.Fields))
+	for index, field := range v.Fields {
+		messages[index] = field.Error()
+	}
+
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
 //**********************************************************
 // DB Functions
 //**********************************************************
@@ -267,6 +310,23 @@ func Delete(ctx context.Context, db MongoDB, m metrics.Metrics, col string, publ
 		return err
 	}
 
+	// The record is only loaded for the methods run around its deletion.
+	var elem methods.User
+	hooked := structHooked(BeforeDelete) || structHooked(AfterDelete)
+
+	if hooked {
+		found, err := Get(ctx, db, m, col, publicID)
+		if err != nil {
+			return err
+		}
+
+		elem = found
+		if err := structHook(ctx, BeforeDelete, &elem); err != nil {
+			m.Emit(metrics.Errorf("Failed to run BeforeDelete hooks"), metrics.With("publicID", publicID), metrics.With("collection", col), metrics.With("error", err.Error()))
+			return err
+		}
+	}
+
 	database, session, err := db.New(false)
 	if err != nil {
 		m.Emit(metrics.Errorf("Failed to delete record"), metrics.With("publicID", publicID), metrics.With("collection", col), metrics.With("error", err.Error()))
@@ -276,7 +336,7 @@ func Delete(ctx context.Context, db MongoDB, m metrics.Metrics, col string, publ
 	defer session.Close()
 
 	query := bson.M{
-		"publicID": publicID,
+		"public_id": publicID,
 	}
 
 	if err := database.C(col).Remove(query); err != nil {
@@ -289,6 +349,13 @@ func Delete(ctx context.Context, db MongoDB, m metrics.Metrics, col string, publ
 
 	m.Emit(metrics.Info("Deleted record"), metrics.With("collection", col), metrics.With("query", query), metrics.With("publicID", publicID))
 
+	if hooked {
+		if err := structHook(ctx, AfterDelete, &elem); err != nil {
+			m.Emit(metrics.Errorf("Failed to run AfterDelete hooks"), metrics.With("publicID", publicID), metrics.With("collection", col), metrics.With("error", err.Error()))
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -305,9 +372,19 @@ func Create(ctx context.Context, db MongoDB, m metrics.Metrics, col string, elem
 		return err
 	}
 
+	if err := structHook(ctx, BeforeCreate, &elem); err != nil {
+		m.Emit(metrics.Errorf("Failed to run BeforeCreate hooks"), metrics.With("publicID", elem.PublicID), metrics.With("collection", col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := ValidateUser(elem); err != nil {
+		m.Emit(metrics.Errorf("Failed to validate record"), metrics.With("collection", col), metrics.With("error", err.Error()))
+		return err
+	}
+
 	if validator, ok := interface{}(elem).(Validation); ok {
 		if err := validator.Validate(); err != nil {
-			m.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", col), metrics.With("error", err.Error()))
+			m.Emit(metrics.Errorf("Failed to validate record"), metrics.With("collection", col), metrics.With("error", err.Error()))
 			return err
 		}
 	}
@@ -320,12 +397,7 @@ func Create(ctx context.Context, db MongoDB, m metrics.Metrics, col string, elem
 
 	defer session.Close()
 
-	query := bson.M(map[string]interface{}{
-
-		"name": elem.Name,
-
-		"public_id": elem.PublicID,
-	})
+	query := userDocument(elem)
 
 	if err := database.C(col).Insert(query); err != nil {
 		m.Emit(metrics.Errorf("Failed to create User record"), metrics.With("collection", col), metrics.With("query", query), metrics.With("error", err.Error()))
@@ -334,6 +406,11 @@ func Create(ctx context.Context, db MongoDB, m metrics.Metrics, col string, elem
 
 	m.Emit(metrics.Info("Create record"), metrics.With("collection", col), metrics.With("query", query))
 
+	if err := structHook(ctx, AfterCreate, &elem); err != nil {
+		m.Emit(metrics.Errorf("Failed to run AfterCreate hooks"), metrics.With("publicID", elem.PublicID), metrics.With("collection", col), metrics.With("error", err.Error()))
+		return err
+	}
+
 	return nil
 }
 
@@ -403,7 +480,8 @@ func GetAll(ctx context.Context, db MongoDB, m metrics.Metrics, col string, orde
 
 	var ritems []methods.User
 
-	if err := database.C(col).Find(query).Skip(indexToStart).Limit(totalWanted).Sort(orderBy).All(&ritems); err != nil {
+	var ditems []map[string]interface{}
+	if err := database.C(col).Find(query).Skip(indexToStart).Limit(totalWanted).Sort(orderBy).All(&ditems); err != nil {
 		m.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("collection", col), metrics.With("query", query), metrics.With("error", err.Error()))
 		if err == mgo.ErrNotFound {
 			return nil, -1, ErrNotFound
@@ -411,6 +489,21 @@ func GetAll(ctx context.Context, db MongoDB, m metrics.Metrics, col string, orde
 		return nil, -1, err
 	}
 
+	for _, item := range ditems {
+		var elem methods.User
+		if err := userFromDocument(item, &elem); err != nil {
+			return nil, -1, err
+		}
+		ritems = append(ritems, elem)
+	}
+
+	for index := range ritems {
+		if err := structHook(ctx, AfterLoad, &ritems[index]); err != nil {
+			m.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", col), metrics.With("error", err.Error()))
+			return nil, -1, err
+		}
+	}
+
 	return ritems, totalRecords, nil
 }
 
@@ -441,16 +534,37 @@ func GetAllByOrder(ctx context.Context, db MongoDB, m metrics.Metrics, col strin
 
 	query := bson.M{}
 
-	var items []methods.User
-	if err := database.C(col).Find(query).Sort(orderBy).All(&items); err != nil {
-		m.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("collection", col), metrics.With("query", query), metrics.With("error", err.Error()))
+	var ditems []map[string]interface{}
+	if err := database.C(col).Find(query).Sort(orderBy).All(&ditems); err != nil {
+		m.Emit(
+			metrics.Errorf("Failed to retrieve all records of User type from db"),
+			metrics.With("collection", col),
+			metrics.With("query", query),
+			metrics.With("error", err.Error()),
+		)
 		if err == mgo.ErrNotFound {
 			return nil, ErrNotFound
 		}
 		return nil, err
 	}
 
-	return items, nil
+	var ritems []methods.User
+	for _, item := range ditems {
+		var elem methods.User
+		if err := userFromDocument(item, &elem); err != nil {
+			return nil, err
+		}
+		ritems = append(ritems, elem)
+	}
+
+	for index := range ritems {
+		if err := structHook(ctx, AfterLoad, &ritems[index]); err != nil {
+			m.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", col), metrics.With("error", err.Error()))
+			return nil, err
+		}
+	}
+
+	return ritems, nil
 
 }
 
@@ -479,17 +593,28 @@ func GetByField(ctx context.Context, db MongoDB, m metrics.Metrics, col string,
 
 	query := bson.M{key: value}
 
-	var item methods.User
+	var item map[string]interface{}
 
 	if err := database.C(col).Find(query).One(&item); err != nil {
-		m.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("query", query), metrics.With("collection", col), metrics.With("error", err.Error()))
+		m.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("collection", col), metrics.With("query", query), metrics.With("error", err.Error()))
 		if err == mgo.ErrNotFound {
 			return methods.User{}, ErrNotFound
 		}
 		return methods.User{}, err
 	}
 
-	return item, nil
+	var elem methods.User
+
+	if err := userFromDocument(item, &elem); err != nil {
+		return methods.User{}, err
+	}
+
+	if err := structHook(ctx, AfterLoad, &elem); err != nil {
+		m.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", col), metrics.With("error", err.Error()))
+		return methods.User{}, err
+	}
+
+	return elem, nil
 
 }
 
@@ -515,17 +640,28 @@ func Get(ctx context.Context, db MongoDB, m metrics.Metrics, col string, publicI
 
 	query := bson.M{"public_id": publicID}
 
-	var item methods.User
+	var item map[string]interface{}
 
 	if err := database.C(col).Find(query).One(&item); err != nil {
-		m.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("query", query), metrics.With("collection", col), metrics.With("error", err.Error()))
+		m.Emit(metrics.Errorf("Failed to retrieve all records of User type from db"), metrics.With("collection", col), metrics.With("query", query), metrics.With("error", err.Error()))
 		if err == mgo.ErrNotFound {
 			return methods.User{}, ErrNotFound
 		}
 		return methods.User{}, err
 	}
 
-	return item, nil
+	var elem methods.User
+
+	if err := userFromDocument(item, &elem); err != nil {
+		return methods.User{}, err
+	}
+
+	if err := structHook(ctx, AfterLoad, &elem); err != nil {
+		m.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", col), metrics.With("error", err.Error()))
+		return methods.User{}, err
+	}
+
+	return elem, nil
 
 }
 
@@ -541,9 +677,19 @@ func Update(ctx context.Context, db MongoDB, m metrics.Metrics, col string, publ
 		return err
 	}
 
+	if err := structHook(ctx, BeforeUpdate, &elem); err != nil {
+		m.Emit(metrics.Errorf("Failed to run BeforeUpdate hooks"), metrics.With("collection", col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := ValidateUser(elem); err != nil {
+		m.Emit(metrics.Errorf("Failed to validate record"), metrics.With("collection", col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
 	if validator, ok := interface{}(elem).(Validation); ok {
 		if err := validator.Validate(); err != nil {
-			m.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+			m.Emit(metrics.Errorf("Failed to validate record"), metrics.With("collection", col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
 			return err
 		}
 	}
@@ -558,12 +704,7 @@ func Update(ctx context.Context, db MongoDB, m metrics.Metrics, col string, publ
 
 	query := bson.M{"public_id": publicID}
 
-	queryData := bson.M(map[string]interface{}{
-
-		"name": elem.Name,
-
-		"public_id": elem.PublicID,
-	})
+	queryData := userDocument(elem)
 	if err := database.C(col).Update(query, queryData); err != nil {
 		m.Emit(metrics.Errorf("Failed to update User record"), metrics.With("collection", col), metrics.With("query", query), metrics.With("data", queryData), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
 		if err == mgo.ErrNotFound {
@@ -574,6 +715,11 @@ func Update(ctx context.Context, db MongoDB, m metrics.Metrics, col string, publ
 
 	m.Emit(metrics.Info("Update record"), metrics.With("collection", col), metrics.With("public_id", publicID), metrics.With("query", query))
 
+	if err := structHook(ctx, AfterUpdate, &elem); err != nil {
+		m.Emit(metrics.Errorf("Failed to run AfterUpdate hooks"), metrics.With("collection", col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
 	return nil
 }
 
@@ -608,6 +754,59 @@ func Exec(ctx context.Context, db MongoDB, m metrics.Metrics, col string, isread
 	return nil
 }
 
+// namespaceNotFound is the code of the error returned by collMod for a missing collection.
+const namespaceNotFound = 26
+
+// Schema returns the validator of collections storing methods.User records,
+// a $jsonSchema document built from the fields of the struct and their schema tags.
+func Schema() bson.M {
+	return bson.M{"$jsonSchema": bson.M{
+		"bsonType": "object",
+		"properties": bson.M{
+			"public_id": bson.M{
+				"bsonType": "string",
+			},
+			"name": bson.M{
+				"bsonType": "string",
+			},
+		},
+		"required": []string{"public_id"},
+	}}
+}
+
+// ApplySchema sets the validator returned by Schema on the giving collection, creating the
+// collection if it does not exist yet. The level sets which writes are validated, one of
+// "off", "strict" or "moderate", and the action whether invalid writes fail with "error" or
+// are only logged with "warn".
+func ApplySchema(ctx context.Context, db MongoDB, col string, level string, action string) error {
+	if isContextExpired(ctx) {
+		return ErrExpiredContext
+	}
+
+	database, session, err := db.New(false)
+	if err != nil {
+		return err
+	}
+
+	defer session.Close()
+
+	command := bson.D{
+		{Name: "collMod", Value: col},
+		{Name: "validator", Value: Schema()},
+		{Name: "validationLevel", Value: level},
+		{Name: "validationAction", Value: action},
+	}
+
+	var result bson.M
+	err = database.Run(command, &result)
+	if qerr, ok := err.(*mgo.QueryError); ok && qerr.Code == namespaceNotFound {
+		command[0].Name = "create"
+		err = database.Run(command, &result)
+	}
+
+	return err
+}
+
 func isContextExpired(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -616,3 +815,322 @@ func isContextExpired(ctx context.Context) bool {
 		return false
 	}
 }
+
+// userDocument returns the bson.M document stored for the giving User.
+func userDocument(elem methods.User) bson.M {
+	doc := bson.M{}
+	doc["public_id"] = elem.PublicID
+	doc["name"] = elem.Name
+	return doc
+}
+
+// ValidateUser returns a ValidationError listing every field of the giving User failing the
+// rules of its validate tag, else nil.
+func ValidateUser(elem methods.User) error {
+	var failed []FieldError
+	if len(failed) != 0 {
+		return ValidationError{Fields: failed}
+	}
+	return nil
+}
+
+// HookStage defines a stage of the operations on methods.User records at
+// which hooks run, named after the method of the struct run at it.
+type HookStage int
+
+// HookStage values, in the order of the operations they run within.
+const (
+	BeforeCreate HookStage = iota
+	AfterCreate
+	BeforeUpdate
+	AfterUpdate
+	BeforeDelete
+	AfterDelete
+	AfterLoad
+)
+
+// hookStages contains the names of all HookStage values.
+var hookStages = []string{"BeforeCreate", "AfterCreate", "BeforeUpdate", "AfterUpdate", "BeforeDelete", "AfterDelete", "AfterLoad"}
+
+// String returns the name of the HookStage.
+func (h HookStage) String() string {
+	if h < 0 || int(h) >= len(hookStages) {
+		return "UnknownHookStage"
+	}
+
+	return hookStages[h]
+}
+
+// structHook runs the method of the giving record named after the giving stage, if
+// methods.User declares it.
+func structHook(ctx context.Context, stage HookStage, elem *methods.User) error {
+	switch stage {
+	}
+
+	return nil
+}
+
+// structHooked returns true if methods.User declares the
+// method named after the giving stage.
+func structHooked(stage HookStage) bool {
+	switch stage {
+	}
+
+	return false
+}
+
+// userFromDocument sets the giving User from the giving document, as built by userDocument and
+// read from mongodb. It returns an error if the key of a field without omitempty is missing
+// or a value can not be converted into the type of its field.
+func userFromDocument(data map[string]interface{}, elem *methods.User) error {
+	value1, ok := data["public_id"]
+	if !ok {
+		return fmt.Errorf("User is missing required field %q", "public_id")
+	}
+	value2, err := userString(value1)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of User: %+q", "public_id", err)
+	}
+	elem.PublicID = value2
+	value3, ok := data["name"]
+	if !ok {
+		return fmt.Errorf("User is missing required field %q", "name")
+	}
+	value4, err := userString(value3)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of User: %+q", "name", err)
+	}
+	elem.Name = value4
+	return nil
+}
+
+// userString returns the giving value as a string.
+func userString(value interface{}) (string, error) {
+	if v, ok := value.(string); ok {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("expected string, found %T", value)
+}
+
+// userBool returns the giving value as a bool.
+func userBool(value interface{}) (bool, error) {
+	if v, ok := value.(bool); ok {
+		return v, nil
+	}
+
+	return false, fmt.Errorf("expected bool, found %T", value)
+}
+
+// userInt64 returns the giving number as an int64, failing if it does not fit into the
+// giving number of bits. Floating point numbers, as decoded from json, must be integers.
+func userInt64(value interface{}, bits uint) (int64, error) {
+	var n int64
+
+	switch v := value.(type) {
+	case int:
+		n = int64(v)
+	case int8:
+		n = int64(v)
+	case int16:
+		n = int64(v)
+	case int32:
+		n = int64(v)
+	case int64:
+		n = v
+	case uint8:
+		n = int64(v)
+	case uint16:
+		n = int64(v)
+	case uint32:
+		n = int64(v)
+	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", v)
+		}
+		n = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", v)
+		}
+		n = int64(v)
+	case float32:
+		if float32(int64(v)) != v {
+			return 0, fmt.Errorf("expected integer, found %v", v)
+		}
+		n = int64(v)
+	case float64:
+		if float64(int64(v)) != v {
+			return 0, fmt.Errorf("expected integer, found %v", v)
+		}
+		n = int64(v)
+	default:
+		return 0, fmt.Errorf("expected number, found %T", value)
+	}
+
+	if bits < 64 && (n < -1<<(bits-1) || n >= 1<<(bits-1)) {
+		return 0, fmt.Errorf("%d overflows int%d", n, bits)
+	}
+
+	return n, nil
+}
+
+// userUint64 returns the giving number as an uint64, failing if it is negative or does not
+// fit into the giving number of bits.
+func userUint64(value interface{}, bits uint) (uint64, error) {
+	var n uint64
+
+	switch v := value.(type) {
+	case uint:
+		n = uint64(v)
+	case uint8:
+		n = uint64(v)
+	case uint16:
+		n = uint64(v)
+	case uint32:
+		n = uint64(v)
+	case uint64:
+		n = v
+	default:
+		signed, err := userInt64(value, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		if signed < 0 {
+			return 0, fmt.Errorf("expected unsigned number, found %d", signed)
+		}
+
+		n = uint64(signed)
+	}
+
+	if bits < 64 && n >= 1<<bits {
+		return 0, fmt.Errorf("%d overflows uint%d", n, bits)
+	}
+
+	return n, nil
+}
+
+// userFloat64 returns the giving number as a float64.
+func userFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	}
+
+	n, err := userInt64(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected number, found %T", value)
+	}
+
+	return float64(n), nil
+}
+
+// userTime returns the giving value as a time.Time, parsing strings, as decoded from json,
+// in the RFC3339 format.
+func userTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		return time.Parse(time.RFC3339Nano, v)
+	}
+
+	return time.Time{}, fmt.Errorf("expected time, found %T", value)
+}
+
+// userObjectID returns the giving value as a bson.ObjectId, parsing strings, as decoded
+// from json, in their hex representation.
+func userObjectID(value interface{}) (bson.ObjectId, error) {
+	switch v := value.(type) {
+	case bson.ObjectId:
+		return v, nil
+	case string:
+		if bson.IsObjectIdHex(v) {
+			return bson.ObjectIdHex(v), nil
+		}
+	}
+
+	return "", fmt.Errorf("expected object id, found %#v", value)
+}
+
+// userBytes returns the giving value as binary data, decoding strings, as decoded from
+// json, from base64.
+func userBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case bson.Binary:
+		return v.Data, nil
+	case string:
+		return base64.StdEncoding.DecodeString(v)
+	}
+
+	return nil, fmt.Errorf("expected binary data, found %T", value)
+}
+
+// userMap returns the giving value as a map of fields, as decoded for nested documents.
+func userMap(value interface{}) (map[string]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case bson.M:
+		return v, nil
+	case map[string]interface{}:
+		return v, nil
+	case bson.D:
+		return v.Map(), nil
+	}
+
+	return nil, fmt.Errorf("expected document, found %T", value)
+}
+
+// userSlice returns the giving value as a slice, as decoded for lists.
+func userSlice(value interface{}) ([]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("expected list, found %T", value)
+}
+
+// userHasAny returns true/false if data contains any of the giving keys.
+func userHasAny(data map[string]interface{}, keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := data[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// userDecode decodes the giving value into target through bson, for values of types
+// which are stored as they are. A nil value leaves target unchanged.
+func userDecode(value interface{}, target interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	data, err := bson.Marshal(bson.M{"value": value})
+	if err != nil {
+		return err
+	}
+
+	var raw struct {
+		Value bson.Raw `bson:"value"`
+	}
+
+	if err := bson.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return raw.Value.Unmarshal(target)
+}
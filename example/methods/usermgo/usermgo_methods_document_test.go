@@ -0,0 +1,67 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/methods.User
+// Annotation: @mongo_methods
+// Hash: sha256:877792059e466b05989c7587779318f183b5aace5363035d5031b123ca526ee4
+
+package usermgo
+
+import (
+	"reflect"
+
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/gokit/mgokit/example/methods"
+
+	fixtures "github.com/gokit/mgokit/example/methods/usermgo/fixtures"
+)
+
+// storeDocument returns the giving document as read back from mongodb, encoding it into bson
+// and decoding it again.
+func storeDocument(t *testing.T, doc bson.M) bson.M {
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		t.Fatalf("failed to encode document: %+q", err)
+	}
+
+	var stored bson.M
+	if err := bson.Unmarshal(data, &stored); err != nil {
+		t.Fatalf("failed to decode document: %+q", err)
+	}
+
+	return stored
+}
+
+func TestDocumentRoundTrip(t *testing.T) {
+	for _, elem := range fixtures.RandomUsers(5) {
+		stored := storeDocument(t, userDocument(elem))
+
+		var decoded methods.User
+		if err := userFromDocument(stored, &decoded); err != nil {
+			t.Fatalf("failed to read document: %+q", err)
+		}
+
+		if decoded.PublicID != elem.PublicID {
+			t.Fatalf("expected PublicID %#v, got %#v", elem.PublicID, decoded.PublicID)
+		}
+
+		if restored := storeDocument(t, userDocument(decoded)); !reflect.DeepEqual(restored, stored) {
+			t.Fatalf("expected read record to store document %#v, got %#v", stored, restored)
+		}
+	}
+}
+
+func TestDocumentZero(t *testing.T) {
+	var elem methods.User
+	stored := storeDocument(t, userDocument(elem))
+
+	var decoded methods.User
+	if err := userFromDocument(stored, &decoded); err != nil {
+		t.Fatalf("failed to read document of zero record: %+q", err)
+	}
+
+	if restored := storeDocument(t, userDocument(decoded)); !reflect.DeepEqual(restored, stored) {
+		t.Fatalf("expected read zero record to store document %#v, got %#v", stored, restored)
+	}
+}
@@ -0,0 +1,22 @@
+package shipments
+
+import "time"
+
+// Shipment contains shipment data.
+// @mongoapi(GRPC => true)
+type Shipment struct {
+	PublicID string     `json:"public_id"`
+	Carrier  string     `json:"carrier" validate:"required"`
+	Weight   float64    `json:"weight"`
+	Pieces   int        `json:"pieces"`
+	Shipped  time.Time  `json:"shipped"`
+	Tags     []string   `json:"tags"`
+	Origin   Address    `json:"origin"`
+	Stops    []*Address `json:"stops"`
+}
+
+// Address contains a postal address.
+type Address struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
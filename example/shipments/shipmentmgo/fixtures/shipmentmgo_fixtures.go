@@ -0,0 +1,53 @@
+package fixtures
+
+import (
+     "encoding/json"
+
+
+     "github.com/gokit/mgokit/example/shipments"
+
+)
+
+
+// json fixtures ...
+var (
+ ShipmentJSON = `{
+
+
+    "stops":	null,
+
+    "public_id":	"l4vtslyu3jbv31r0z0iu7aizutszkr",
+
+    "carrier":	"c6lqterle4t8x6v3cdzf",
+
+    "weight":	0.4220,
+
+    "pieces":	4,
+
+    "shipped":	"2026-10-19T11:37:40Z",
+
+    "tags":	null,
+
+    "origin":	{
+	
+	
+	    "city":	"",
+	
+	    "zip":	""
+	
+	}
+
+}`
+)
+
+// LoadShipmentJSON returns a new instance of a shipments.Shipment.
+func LoadShipmentJSON(content string) (shipments.Shipment, error) {
+	var elem shipments.Shipment
+
+	if err := json.Unmarshal([]byte(content), &elem); err != nil {
+		return shipments.Shipment{}, err
+	}
+
+	return elem, nil
+}
+
@@ -0,0 +1,98 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/shipments.Shipment
+// Annotation: @mongoapi(GRPC => true)
+// Hash: sha256:3b3e2a9fb980e3783ce7b8aa03bd49aa6287ec0e69b8b7ef2d2228bf79440460
+
+package fixtures
+
+import (
+	"context"
+
+	"math/rand"
+
+	"time"
+
+	"github.com/gokit/mgokit/example/shipments"
+)
+
+// DefaultSeed defines the seed used by RandomShipments, so the same
+// records are produced on every run.
+var DefaultSeed int64 = 1
+
+// Creator defines an interface which exposes a method to store a shipments.Shipment.
+type Creator interface {
+	Create(ctx context.Context, elem shipments.Shipment) error
+}
+
+// CreatorFunc defines a function type which implements the Creator interface.
+type CreatorFunc func(ctx context.Context, elem shipments.Shipment) error
+
+// Create calls the underline function with the provided arguments.
+func (fn CreatorFunc) Create(ctx context.Context, elem shipments.Shipment) error {
+	return fn(ctx, elem)
+}
+
+// RandomShipment returns a new instance of a shipments.Shipment with
+// its fields set to random values drawn from the provided rand.Rand.
+func RandomShipment(r *rand.Rand) shipments.Shipment {
+	var elem shipments.Shipment
+	elem.PublicID = randomString(r, 30)
+	elem.Carrier = randomString(r, 20)
+	elem.Weight = float64(r.Float64() * 100)
+	elem.Pieces = int(r.Intn(100))
+	elem.Shipped = randomTime(r)
+	elem.Tags = []string{randomString(r, 10), randomString(r, 10)}
+	elem.Origin.City = randomString(r, 20)
+	elem.Origin.Zip = randomString(r, 20)
+
+	return elem
+}
+
+// RandomShipments returns n instances of shipments.Shipment with
+// random values drawn from a rand.Rand seeded with DefaultSeed.
+func RandomShipments(n int) []shipments.Shipment {
+	r := rand.New(rand.NewSource(DefaultSeed))
+
+	elems := make([]shipments.Shipment, 0, n)
+	for i := 0; i < n; i++ {
+		elems = append(elems, RandomShipment(r))
+	}
+
+	return elems
+}
+
+// Seed stores n random instances of shipments.Shipment through the
+// provided Creator, returning the stored records.
+func Seed(ctx context.Context, db Creator, n int) ([]shipments.Shipment, error) {
+	elems := RandomShipments(n)
+
+	for _, elem := range elems {
+		if err := db.Create(ctx, elem); err != nil {
+			return nil, err
+		}
+	}
+
+	return elems, nil
+}
+
+const randomLetters = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// randomString returns a string of n random characters drawn from r.
+func randomString(r *rand.Rand, n int) string {
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = randomLetters[r.Intn(len(randomLetters))]
+	}
+	return string(buf)
+}
+
+// randomTime returns a random time within the year 2000 drawn from r.
+func randomTime(r *rand.Rand) time.Time {
+	return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(r.Int63n(365*24)) * time.Hour)
+}
+
+// randomTimePtr returns a pointer to a random time drawn from r.
+func randomTimePtr(r *rand.Rand) *time.Time {
+	tm := randomTime(r)
+	return &tm
+}
@@ -0,0 +1,435 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/shipments.Shipment
+// Annotation: @mongoapi(GRPC => true)
+// Hash: sha256:dd32c3c72b2de576ca6b11eb6fc1dd9f7fe8d84ea321f4d1e3cb7755cbb71c1e
+
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/influx6/faux/metrics"
+
+	"google.golang.org/grpc/codes"
+
+	"google.golang.org/grpc/status"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"math"
+
+	"time"
+
+	"github.com/gokit/mgokit/example/shipments"
+
+	"github.com/gokit/mgokit/example/shipments/types"
+
+	"github.com/gokit/mgokit/example/shipments/shipmentmgo"
+
+	"github.com/gokit/mgokit/example/shipments/shipmentmgo/grpcapi/pb"
+)
+
+//go:generate protoc -I pb --go_out=pb --go_opt=paths=source_relative --go-grpc_out=pb --go-grpc_opt=paths=source_relative pb/shipment.proto
+
+// Server implements pb.ShipmentServiceServer, serving the shipments.Shipment records
+// of the giving backend.
+type Server struct {
+	pb.UnimplementedShipmentServiceServer
+
+	Backend types.ShipmentDBBackend
+	Metrics metrics.Metrics
+}
+
+// NewServer returns a new Server serving the records of the giving backend.
+func NewServer(backend types.ShipmentDBBackend, m metrics.Metrics) *Server {
+	return &Server{
+		Backend: backend,
+		Metrics: m,
+	}
+}
+
+// Count returns the count of records.
+func (s *Server) Count(ctx context.Context, _ *emptypb.Empty) (*pb.ShipmentCountResponse, error) {
+	defer s.Metrics.CollectMetrics("ShipmentServer.Count")
+
+	count, err := s.Backend.Count(ctx)
+	if err != nil {
+		return nil, s.fail("count", err)
+	}
+
+	return &pb.ShipmentCountResponse{Count: int64(count)}, nil
+}
+
+// Create creates the record of the giving message.
+func (s *Server) Create(ctx context.Context, msg *pb.Shipment) (*emptypb.Empty, error) {
+	defer s.Metrics.CollectMetrics("ShipmentServer.Create")
+
+	elem, err := FromProto(msg)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.Backend.Create(ctx, elem); err != nil {
+		return nil, s.fail("create", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Get returns the record of the giving id.
+func (s *Server) Get(ctx context.Context, req *pb.ShipmentID) (*pb.Shipment, error) {
+	defer s.Metrics.CollectMetrics("ShipmentServer.Get")
+
+	elem, err := s.Backend.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail("get", err)
+	}
+
+	return ToProto(elem), nil
+}
+
+// GetAll returns the page of records selected by the giving request, ordered by
+// public_id unless order_by is set.
+func (s *Server) GetAll(ctx context.Context, req *pb.ShipmentGetAllRequest) (*pb.ShipmentGetAllResponse, error) {
+	defer s.Metrics.CollectMetrics("ShipmentServer.GetAll")
+
+	order, orderBy, err := ordering(req.GetOrder(), req.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPage() < 0 || req.GetResponsePerPage() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page and response_per_page must be non-negative")
+	}
+
+	records, total, err := s.Backend.GetAll(ctx, order, orderBy, int(req.GetPage()), int(req.GetResponsePerPage()))
+	if err != nil {
+		return nil, s.fail("list", err)
+	}
+
+	res := &pb.ShipmentGetAllResponse{Total: int64(total)}
+	for _, elem := range records {
+		res.Records = append(res.Records, ToProto(elem))
+	}
+
+	return res, nil
+}
+
+// GetAllByOrder returns all records ordered as set by the giving request.
+func (s *Server) GetAllByOrder(ctx context.Context, req *pb.ShipmentGetAllByOrderRequest) (*pb.ShipmentList, error) {
+	defer s.Metrics.CollectMetrics("ShipmentServer.GetAllByOrder")
+
+	order, orderBy, err := ordering(req.GetOrder(), req.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.Backend.GetAllByOrder(ctx, order, orderBy)
+	if err != nil {
+		return nil, s.fail("list", err)
+	}
+
+	res := &pb.ShipmentList{}
+	for _, elem := range records {
+		res.Records = append(res.Records, ToProto(elem))
+	}
+
+	return res, nil
+}
+
+// GetByField returns the record whose field key holds the giving value.
+func (s *Server) GetByField(ctx context.Context, req *pb.ShipmentGetByFieldRequest) (*pb.Shipment, error) {
+	defer s.Metrics.CollectMetrics("ShipmentServer.GetByField")
+
+	if req.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "key must be set")
+	}
+
+	value, err := FieldValue(req.GetKey(), req.GetValue().AsInterface())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	elem, err := s.Backend.GetByField(ctx, req.GetKey(), value)
+	if err != nil {
+		return nil, s.fail("get", err)
+	}
+
+	return ToProto(elem), nil
+}
+
+// Update updates the record of the giving id with the record of the request.
+func (s *Server) Update(ctx context.Context, req *pb.ShipmentUpdateRequest) (*emptypb.Empty, error) {
+	defer s.Metrics.CollectMetrics("ShipmentServer.Update")
+
+	elem, err := FromProto(req.GetRecord())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.Backend.Update(ctx, req.GetId(), elem); err != nil {
+		return nil, s.fail("update", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Delete deletes the record of the giving id.
+func (s *Server) Delete(ctx context.Context, req *pb.ShipmentID) (*emptypb.Empty, error) {
+	defer s.Metrics.CollectMetrics("ShipmentServer.Delete")
+
+	if err := s.Backend.Delete(ctx, req.GetId()); err != nil {
+		return nil, s.fail("delete", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// fail returns the status of the giving error returned by the backend for the giving action,
+// emitting it if it is not one of the errors mapped by ToStatus.
+func (s *Server) fail(action string, err error) error {
+	st := ToStatus(err)
+	if status.Code(st) == codes.Internal {
+		s.Metrics.Emit(metrics.Errorf("Failed to %s records", action), metrics.With("error", err.Error()))
+	}
+
+	return st
+}
+
+// ToStatus returns the grpc status error of the giving error returned by the backend: NotFound
+// for ErrNotFound, DeadlineExceeded for ErrExpiredContext, InvalidArgument for a
+// ValidationError and Internal for any other.
+func ToStatus(err error) error {
+	if _, ok := err.(shipmentmgo.ValidationError); ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	switch err {
+	case shipmentmgo.ErrNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case shipmentmgo.ErrExpiredContext:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// ordering returns the order and field records are ordered by, defaulting to ascending
+// order by public_id.
+func ordering(order string, orderBy string) (string, string, error) {
+	if order == "" {
+		order = "asc"
+	}
+
+	if order != "asc" && order != "desc" {
+		return "", "", status.Errorf(codes.InvalidArgument, "order must be asc or desc, found %+q", order)
+	}
+
+	if orderBy == "" {
+		orderBy = "public_id"
+	}
+
+	return order, orderBy, nil
+}
+
+// ToProto returns the protobuf message of the giving shipments.Shipment.
+func ToProto(elem shipments.Shipment) *pb.Shipment {
+	msg := &pb.Shipment{}
+	msg.PublicId = elem.PublicID
+	msg.Carrier = elem.Carrier
+	msg.Weight = elem.Weight
+	msg.Pieces = int64(elem.Pieces)
+	if !elem.Shipped.IsZero() {
+		msg.Shipped = timestamppb.New(elem.Shipped)
+	}
+	for _, item1 := range elem.Tags {
+		msg.Tags = append(msg.Tags, item1)
+	}
+	msg.Origin = toProtoAddress(elem.Origin)
+	for _, item2 := range elem.Stops {
+		var value3 *pb.Address
+		if item2 != nil {
+			value3 = toProtoAddress((*item2))
+		}
+		msg.Stops = append(msg.Stops, value3)
+	}
+	return msg
+}
+
+// FromProto returns the shipments.Shipment of the giving protobuf message, failing on values which
+// are not valid for its fields.
+func FromProto(msg *pb.Shipment) (shipments.Shipment, error) {
+	var elem shipments.Shipment
+	if msg == nil {
+		return elem, nil
+	}
+
+	elem.PublicID = msg.PublicId
+	elem.Carrier = msg.Carrier
+	elem.Weight = msg.Weight
+	elem.Pieces = int(msg.Pieces)
+	if msg.Shipped != nil {
+		elem.Shipped = msg.Shipped.AsTime()
+	}
+	for _, item4 := range msg.Tags {
+		elem.Tags = append(elem.Tags, item4)
+	}
+	value5, err := fromProtoAddress(msg.Origin)
+	if err != nil {
+		return elem, err
+	}
+	elem.Origin = value5
+	for _, item6 := range msg.Stops {
+		var value7 *shipments.Address
+		if item6 != nil {
+			value8, err := fromProtoAddress(item6)
+			if err != nil {
+				return elem, err
+			}
+			value7 = &value8
+		}
+		elem.Stops = append(elem.Stops, value7)
+	}
+	return elem, nil
+}
+
+// toProtoAddress returns the protobuf message of the giving shipments.Address.
+func toProtoAddress(elem shipments.Address) *pb.Address {
+	msg := &pb.Address{}
+	msg.City = elem.City
+	msg.Zip = elem.Zip
+	return msg
+}
+
+// fromProtoAddress returns the shipments.Address of the giving protobuf message, failing on values which
+// are not valid for its fields.
+func fromProtoAddress(msg *pb.Address) (shipments.Address, error) {
+	var elem shipments.Address
+	if msg == nil {
+		return elem, nil
+	}
+
+	elem.City = msg.City
+	elem.Zip = msg.Zip
+	return elem, nil
+}
+
+// FieldValue returns the giving value of a GetByField request, as returned by AsInterface
+// of its google.protobuf.Value, converted to the go type of the field of the giving key,
+// failing on values which are not valid for it. Items of slices are queried by values of
+// their type, times by RFC 3339 strings and ObjectIds by hex strings. Values of other keys
+// are returned as they are.
+func FieldValue(key string, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch key {
+	case "public_id":
+		converted, err := fieldString(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	case "carrier":
+		converted, err := fieldString(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	case "weight":
+		converted, err := fieldNumber(key, value, false)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	case "pieces":
+		converted, err := fieldNumber(key, value, true)
+		if err != nil {
+			return nil, err
+		}
+		return int(converted), nil
+	case "shipped":
+		converted, err := fieldTime(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	case "tags":
+		converted, err := fieldString(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	case "origin.city":
+		converted, err := fieldString(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	case "origin.zip":
+		converted, err := fieldString(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	case "stops.city":
+		converted, err := fieldString(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	case "stops.zip":
+		converted, err := fieldString(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	}
+
+	return value, nil
+}
+
+// fieldString returns the giving value of the field of the giving key as a string.
+func fieldString(key string, value interface{}) (string, error) {
+	converted, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("field %s requires a string, found %T", key, value)
+	}
+
+	return converted, nil
+}
+
+// fieldNumber returns the giving value of the field of the giving key as a number, which
+// must be whole for integer fields.
+func fieldNumber(key string, value interface{}, whole bool) (float64, error) {
+	converted, ok := value.(float64)
+	if !ok {
+		return 0, fmt.Errorf("field %s requires a number, found %T", key, value)
+	}
+
+	if whole && math.Trunc(converted) != converted {
+		return 0, fmt.Errorf("field %s requires a whole number, found %v", key, converted)
+	}
+
+	return converted, nil
+}
+
+// fieldTime returns the giving RFC 3339 value of the field of the giving key as a time.
+func fieldTime(key string, value interface{}) (time.Time, error) {
+	text, err := fieldString(key, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	converted, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("field %s requires an RFC 3339 time, found %q", key, text)
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,365 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/shipments.Shipment
+// Annotation: @mongoapi(GRPC => true)
+// Hash: sha256:e6f28dd8bad356410f65219a4a81d920ccd9ec82ddd3a53a1a8b022bddc587ae
+
+package grpcapi_test
+
+import (
+	"context"
+
+	"fmt"
+
+	"net"
+
+	"sync"
+
+	"testing"
+
+	"github.com/influx6/faux/metrics"
+
+	"google.golang.org/grpc"
+
+	"google.golang.org/grpc/codes"
+
+	"google.golang.org/grpc/credentials/insecure"
+
+	"google.golang.org/grpc/status"
+
+	"google.golang.org/grpc/test/bufconn"
+
+	"google.golang.org/protobuf/proto"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/gokit/mgokit/example/shipments"
+
+	"github.com/gokit/mgokit/example/shipments/types"
+
+	"github.com/gokit/mgokit/example/shipments/shipmentmgo"
+
+	"github.com/gokit/mgokit/example/shipments/shipmentmgo/grpcapi"
+
+	"github.com/gokit/mgokit/example/shipments/shipmentmgo/grpcapi/pb"
+
+	"github.com/gokit/mgokit/example/shipments/shipmentmgo/fixtures"
+)
+
+// memoryBackend implements types.ShipmentDBBackend, keeping records in memory in order of creation.
+type memoryBackend struct {
+	ml      sync.Mutex
+	ids     []string
+	records map[string]shipments.Shipment
+}
+
+func newMemoryBackend() *memoryBackend {
+	return &memoryBackend{records: map[string]shipments.Shipment{}}
+}
+
+func (mb *memoryBackend) Count(ctx context.Context) (int, error) {
+	if ctx.Err() != nil {
+		return -1, shipmentmgo.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+	return len(mb.ids), nil
+}
+
+func (mb *memoryBackend) Delete(ctx context.Context, publicID string) error {
+	if ctx.Err() != nil {
+		return shipmentmgo.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	if _, ok := mb.records[publicID]; !ok {
+		return shipmentmgo.ErrNotFound
+	}
+
+	delete(mb.records, publicID)
+	for index, id := range mb.ids {
+		if id == publicID {
+			mb.ids = append(mb.ids[:index], mb.ids[index+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (mb *memoryBackend) Create(ctx context.Context, elem shipments.Shipment) error {
+	if ctx.Err() != nil {
+		return shipmentmgo.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	if _, ok := mb.records[elem.PublicID]; ok {
+		return fmt.Errorf("record %q already exists", elem.PublicID)
+	}
+
+	mb.ids = append(mb.ids, elem.PublicID)
+	mb.records[elem.PublicID] = elem
+	return nil
+}
+
+func (mb *memoryBackend) Get(ctx context.Context, publicID string) (shipments.Shipment, error) {
+	if ctx.Err() != nil {
+		return shipments.Shipment{}, shipmentmgo.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	elem, ok := mb.records[publicID]
+	if !ok {
+		return elem, shipmentmgo.ErrNotFound
+	}
+
+	return elem, nil
+}
+
+func (mb *memoryBackend) Update(ctx context.Context, publicID string, elem shipments.Shipment) error {
+	if ctx.Err() != nil {
+		return shipmentmgo.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	if _, ok := mb.records[publicID]; !ok {
+		return shipmentmgo.ErrNotFound
+	}
+
+	elem.PublicID = publicID
+	mb.records[publicID] = elem
+	return nil
+}
+
+func (mb *memoryBackend) GetAllByOrder(ctx context.Context, order string, orderBy string) ([]shipments.Shipment, error) {
+	records, _, err := mb.GetAll(ctx, order, orderBy, 0, 0)
+	return records, err
+}
+
+func (mb *memoryBackend) GetByField(ctx context.Context, key string, value interface{}) (shipments.Shipment, error) {
+	return shipments.Shipment{}, shipmentmgo.ErrNotFound
+}
+
+func (mb *memoryBackend) GetAll(ctx context.Context, order string, orderBy string, page int, responsePerPage int) ([]shipments.Shipment, int, error) {
+	if ctx.Err() != nil {
+		return nil, -1, shipmentmgo.ErrExpiredContext
+	}
+
+	mb.ml.Lock()
+	defer mb.ml.Unlock()
+
+	ids := mb.ids
+	if page > 0 && responsePerPage > 0 {
+		start := (page - 1) * responsePerPage
+		if start > len(ids) {
+			start = len(ids)
+		}
+
+		end := start + responsePerPage
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		ids = ids[start:end]
+	}
+
+	records := make([]shipments.Shipment, 0, len(ids))
+	for _, id := range ids {
+		records = append(records, mb.records[id])
+	}
+
+	return records, len(mb.ids), nil
+}
+
+// dial returns a client of a grpc server serving the giving backend through an in-memory
+// connection, closed along with the server by the returned function.
+func dial(t *testing.T, backend types.ShipmentDBBackend) (pb.ShipmentServiceClient, func()) {
+	lis := bufconn.Listen(1 << 20)
+
+	server := grpc.NewServer()
+	pb.RegisterShipmentServiceServer(server, grpcapi.NewServer(backend, metrics.New()))
+	go server.Serve(lis)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial server: %+q", err)
+	}
+
+	return pb.NewShipmentServiceClient(conn), func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+// expectCode fails the test if the giving error does not have the giving status code.
+func expectCode(t *testing.T, err error, code codes.Code) {
+	if status.Code(err) != code {
+		t.Fatalf("expected status code %s, got %+q", code, err)
+	}
+}
+
+func TestConversion(t *testing.T) {
+	for _, elem := range fixtures.RandomShipments(3) {
+		msg := grpcapi.ToProto(elem)
+
+		converted, err := grpcapi.FromProto(msg)
+		if err != nil {
+			t.Fatalf("failed to convert message: %+q", err)
+		}
+
+		if !proto.Equal(grpcapi.ToProto(converted), msg) {
+			t.Fatalf("expected conversion to keep message %v, got %v", msg, grpcapi.ToProto(converted))
+		}
+	}
+}
+
+func TestServerCreateAndGet(t *testing.T) {
+	client, closeClient := dial(t, newMemoryBackend())
+	defer closeClient()
+
+	ctx := context.Background()
+	elem := fixtures.RandomShipments(1)[0]
+
+	if _, err := client.Create(ctx, grpcapi.ToProto(elem)); err != nil {
+		t.Fatalf("failed to create record: %+q", err)
+	}
+
+	msg, err := client.Get(ctx, &pb.ShipmentID{Id: elem.PublicID})
+	if err != nil {
+		t.Fatalf("failed to get record: %+q", err)
+	}
+
+	if !proto.Equal(msg, grpcapi.ToProto(elem)) {
+		t.Fatalf("expected record %v, got %v", grpcapi.ToProto(elem), msg)
+	}
+
+	_, err = client.Get(ctx, &pb.ShipmentID{Id: "missing"})
+	expectCode(t, err, codes.NotFound)
+}
+
+func TestServerList(t *testing.T) {
+	backend := newMemoryBackend()
+	client, closeClient := dial(t, backend)
+	defer closeClient()
+
+	ctx := context.Background()
+	for _, elem := range fixtures.RandomShipments(3) {
+		if err := backend.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to create record: %+q", err)
+		}
+	}
+
+	count, err := client.Count(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("failed to count records: %+q", err)
+	}
+
+	if count.GetCount() != 3 {
+		t.Fatalf("expected 3 records, got %d", count.GetCount())
+	}
+
+	page, err := client.GetAll(ctx, &pb.ShipmentGetAllRequest{Order: "desc", Page: 1, ResponsePerPage: 2})
+	if err != nil {
+		t.Fatalf("failed to list records: %+q", err)
+	}
+
+	if page.GetTotal() != 3 || len(page.GetRecords()) != 2 {
+		t.Fatalf("expected 2 of 3 records, got %d of %d records", len(page.GetRecords()), page.GetTotal())
+	}
+
+	list, err := client.GetAllByOrder(ctx, &pb.ShipmentGetAllByOrderRequest{})
+	if err != nil {
+		t.Fatalf("failed to list records: %+q", err)
+	}
+
+	if len(list.GetRecords()) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(list.GetRecords()))
+	}
+
+	_, err = client.GetAll(ctx, &pb.ShipmentGetAllRequest{Order: "up"})
+	expectCode(t, err, codes.InvalidArgument)
+}
+
+func TestServerUpdateAndDelete(t *testing.T) {
+	backend := newMemoryBackend()
+	client, closeClient := dial(t, backend)
+	defer closeClient()
+
+	ctx := context.Background()
+	elems := fixtures.RandomShipments(2)
+	if err := backend.Create(ctx, elems[0]); err != nil {
+		t.Fatalf("failed to create record: %+q", err)
+	}
+
+	update := &pb.ShipmentUpdateRequest{Id: elems[0].PublicID, Record: grpcapi.ToProto(elems[1])}
+	if _, err := client.Update(ctx, update); err != nil {
+		t.Fatalf("failed to update record: %+q", err)
+	}
+
+	_, err := client.Update(ctx, &pb.ShipmentUpdateRequest{Id: "missing", Record: grpcapi.ToProto(elems[1])})
+	expectCode(t, err, codes.NotFound)
+
+	if _, err := client.Delete(ctx, &pb.ShipmentID{Id: elems[0].PublicID}); err != nil {
+		t.Fatalf("failed to delete record: %+q", err)
+	}
+
+	_, err = client.Get(ctx, &pb.ShipmentID{Id: elems[0].PublicID})
+	expectCode(t, err, codes.NotFound)
+}
+
+func TestFieldValue(t *testing.T) {
+	value, err := grpcapi.FieldValue("public_id", "id")
+	if err != nil {
+		t.Fatalf("failed to convert field value: %+q", err)
+	}
+
+	if value != "id" {
+		t.Fatalf("expected value %#v, got %#v", "id", value)
+	}
+
+	if _, err := grpcapi.FieldValue("public_id", float64(1)); err == nil {
+		t.Fatalf("expected number to be invalid for field public_id")
+	}
+
+	value, err = grpcapi.FieldValue("pieces", float64(3))
+	if err != nil {
+		t.Fatalf("failed to convert field value: %+q", err)
+	}
+
+	if value != int(3) {
+		t.Fatalf("expected value %#v, got %#v", int(3), value)
+	}
+
+	if _, err := grpcapi.FieldValue("pieces", 2.5); err == nil {
+		t.Fatalf("expected fraction to be invalid for field pieces")
+	}
+
+	client, closeClient := dial(t, newMemoryBackend())
+	defer closeClient()
+
+	_, err = client.GetByField(context.Background(), &pb.ShipmentGetByFieldRequest{Key: "public_id", Value: structpb.NewNumberValue(1)})
+	expectCode(t, err, codes.InvalidArgument)
+}
+
+func TestToStatus(t *testing.T) {
+	expectCode(t, grpcapi.ToStatus(shipmentmgo.ErrNotFound), codes.NotFound)
+	expectCode(t, grpcapi.ToStatus(shipmentmgo.ErrExpiredContext), codes.DeadlineExceeded)
+	expectCode(t, grpcapi.ToStatus(shipmentmgo.ValidationError{}), codes.InvalidArgument)
+	expectCode(t, grpcapi.ToStatus(fmt.Errorf("failed")), codes.Internal)
+}
@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: shipment.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Shipment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicId      string                 `protobuf:"bytes,1,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
+	Carrier       string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Pieces        int64                  `protobuf:"varint,4,opt,name=pieces,proto3" json:"pieces,omitempty"`
+	Shipped       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=shipped,proto3" json:"shipped,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Origin        *Address               `protobuf:"bytes,7,opt,name=origin,proto3" json:"origin,omitempty"`
+	Stops         []*Address             `protobuf:"bytes,8,rep,name=stops,proto3" json:"stops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_shipment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Shipment) GetPublicId() string {
+	if x != nil {
+		return x.PublicId
+	}
+	return ""
+}
+
+func (x *Shipment) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *Shipment) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Shipment) GetPieces() int64 {
+	if x != nil {
+		return x.Pieces
+	}
+	return 0
+}
+
+func (x *Shipment) GetShipped() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Shipped
+	}
+	return nil
+}
+
+func (x *Shipment) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Shipment) GetOrigin() *Address {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *Shipment) GetStops() []*Address {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Zip           string                 `protobuf:"bytes,2,opt,name=zip,proto3" json:"zip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_shipment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetZip() string {
+	if x != nil {
+		return x.Zip
+	}
+	return ""
+}
+
+type ShipmentID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentID) Reset() {
+	*x = ShipmentID{}
+	mi := &file_shipment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentID) ProtoMessage() {}
+
+func (x *ShipmentID) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentID.ProtoReflect.Descriptor instead.
+func (*ShipmentID) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShipmentID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ShipmentUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Record        *Shipment              `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentUpdateRequest) Reset() {
+	*x = ShipmentUpdateRequest{}
+	mi := &file_shipment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentUpdateRequest) ProtoMessage() {}
+
+func (x *ShipmentUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShipmentUpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShipmentUpdateRequest) GetRecord() *Shipment {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type ShipmentGetAllRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Order           string                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	OrderBy         string                 `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Page            int64                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	ResponsePerPage int64                  `protobuf:"varint,4,opt,name=response_per_page,json=responsePerPage,proto3" json:"response_per_page,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShipmentGetAllRequest) Reset() {
+	*x = ShipmentGetAllRequest{}
+	mi := &file_shipment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentGetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentGetAllRequest) ProtoMessage() {}
+
+func (x *ShipmentGetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentGetAllRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentGetAllRequest) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShipmentGetAllRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ShipmentGetAllRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ShipmentGetAllRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ShipmentGetAllRequest) GetResponsePerPage() int64 {
+	if x != nil {
+		return x.ResponsePerPage
+	}
+	return 0
+}
+
+type ShipmentGetAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*Shipment            `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentGetAllResponse) Reset() {
+	*x = ShipmentGetAllResponse{}
+	mi := &file_shipment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentGetAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentGetAllResponse) ProtoMessage() {}
+
+func (x *ShipmentGetAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentGetAllResponse.ProtoReflect.Descriptor instead.
+func (*ShipmentGetAllResponse) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ShipmentGetAllResponse) GetRecords() []*Shipment {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ShipmentGetAllResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ShipmentGetAllByOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         string                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentGetAllByOrderRequest) Reset() {
+	*x = ShipmentGetAllByOrderRequest{}
+	mi := &file_shipment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentGetAllByOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentGetAllByOrderRequest) ProtoMessage() {}
+
+func (x *ShipmentGetAllByOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentGetAllByOrderRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentGetAllByOrderRequest) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ShipmentGetAllByOrderRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ShipmentGetAllByOrderRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ShipmentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*Shipment            `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentList) Reset() {
+	*x = ShipmentList{}
+	mi := &file_shipment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentList) ProtoMessage() {}
+
+func (x *ShipmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentList.ProtoReflect.Descriptor instead.
+func (*ShipmentList) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ShipmentList) GetRecords() []*Shipment {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type ShipmentGetByFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentGetByFieldRequest) Reset() {
+	*x = ShipmentGetByFieldRequest{}
+	mi := &file_shipment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentGetByFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentGetByFieldRequest) ProtoMessage() {}
+
+func (x *ShipmentGetByFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentGetByFieldRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentGetByFieldRequest) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ShipmentGetByFieldRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ShipmentGetByFieldRequest) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ShipmentCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentCountResponse) Reset() {
+	*x = ShipmentCountResponse{}
+	mi := &file_shipment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentCountResponse) ProtoMessage() {}
+
+func (x *ShipmentCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentCountResponse.ProtoReflect.Descriptor instead.
+func (*ShipmentCountResponse) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ShipmentCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_shipment_proto protoreflect.FileDescriptor
+
+const file_shipment_proto_rawDesc = "" +
+	"\n" +
+	"\x0eshipment.proto\x12\vshipmentmgo\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x95\x02\n" +
+	"\bShipment\x12\x1b\n" +
+	"\tpublic_id\x18\x01 \x01(\tR\bpublicId\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06pieces\x18\x04 \x01(\x03R\x06pieces\x124\n" +
+	"\ashipped\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ashipped\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12,\n" +
+	"\x06origin\x18\a \x01(\v2\x14.shipmentmgo.AddressR\x06origin\x12*\n" +
+	"\x05stops\x18\b \x03(\v2\x14.shipmentmgo.AddressR\x05stops\"/\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x10\n" +
+	"\x03zip\x18\x02 \x01(\tR\x03zip\"\x1c\n" +
+	"\n" +
+	"ShipmentID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x15ShipmentUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x06record\x18\x02 \x01(\v2\x15.shipmentmgo.ShipmentR\x06record\"\x88\x01\n" +
+	"\x15ShipmentGetAllRequest\x12\x14\n" +
+	"\x05order\x18\x01 \x01(\tR\x05order\x12\x19\n" +
+	"\border_by\x18\x02 \x01(\tR\aorderBy\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x03R\x04page\x12*\n" +
+	"\x11response_per_page\x18\x04 \x01(\x03R\x0fresponsePerPage\"_\n" +
+	"\x16ShipmentGetAllResponse\x12/\n" +
+	"\arecords\x18\x01 \x03(\v2\x15.shipmentmgo.ShipmentR\arecords\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"O\n" +
+	"\x1cShipmentGetAllByOrderRequest\x12\x14\n" +
+	"\x05order\x18\x01 \x01(\tR\x05order\x12\x19\n" +
+	"\border_by\x18\x02 \x01(\tR\aorderBy\"?\n" +
+	"\fShipmentList\x12/\n" +
+	"\arecords\x18\x01 \x03(\v2\x15.shipmentmgo.ShipmentR\arecords\"[\n" +
+	"\x19ShipmentGetByFieldRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value\"-\n" +
+	"\x15ShipmentCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count2\xbe\x04\n" +
+	"\x0fShipmentService\x12C\n" +
+	"\x05Count\x12\x16.google.protobuf.Empty\x1a\".shipmentmgo.ShipmentCountResponse\x127\n" +
+	"\x06Create\x12\x15.shipmentmgo.Shipment\x1a\x16.google.protobuf.Empty\x125\n" +
+	"\x03Get\x12\x17.shipmentmgo.ShipmentID\x1a\x15.shipmentmgo.Shipment\x12Q\n" +
+	"\x06GetAll\x12\".shipmentmgo.ShipmentGetAllRequest\x1a#.shipmentmgo.ShipmentGetAllResponse\x12U\n" +
+	"\rGetAllByOrder\x12).shipmentmgo.ShipmentGetAllByOrderRequest\x1a\x19.shipmentmgo.ShipmentList\x12K\n" +
+	"\n" +
+	"GetByField\x12&.shipmentmgo.ShipmentGetByFieldRequest\x1a\x15.shipmentmgo.Shipment\x12D\n" +
+	"\x06Update\x12\".shipmentmgo.ShipmentUpdateRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\x06Delete\x12\x17.shipmentmgo.ShipmentID\x1a\x16.google.protobuf.EmptyBEZCgithub.com/gokit/mgokit/example/shipments/shipmentmgo/grpcapi/pb;pbb\x06proto3"
+
+var (
+	file_shipment_proto_rawDescOnce sync.Once
+	file_shipment_proto_rawDescData []byte
+)
+
+func file_shipment_proto_rawDescGZIP() []byte {
+	file_shipment_proto_rawDescOnce.Do(func() {
+		file_shipment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_shipment_proto_rawDesc), len(file_shipment_proto_rawDesc)))
+	})
+	return file_shipment_proto_rawDescData
+}
+
+var file_shipment_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_shipment_proto_goTypes = []any{
+	(*Shipment)(nil),                     // 0: shipmentmgo.Shipment
+	(*Address)(nil),                      // 1: shipmentmgo.Address
+	(*ShipmentID)(nil),                   // 2: shipmentmgo.ShipmentID
+	(*ShipmentUpdateRequest)(nil),        // 3: shipmentmgo.ShipmentUpdateRequest
+	(*ShipmentGetAllRequest)(nil),        // 4: shipmentmgo.ShipmentGetAllRequest
+	(*ShipmentGetAllResponse)(nil),       // 5: shipmentmgo.ShipmentGetAllResponse
+	(*ShipmentGetAllByOrderRequest)(nil), // 6: shipmentmgo.ShipmentGetAllByOrderRequest
+	(*ShipmentList)(nil),                 // 7: shipmentmgo.ShipmentList
+	(*ShipmentGetByFieldRequest)(nil),    // 8: shipmentmgo.ShipmentGetByFieldRequest
+	(*ShipmentCountResponse)(nil),        // 9: shipmentmgo.ShipmentCountResponse
+	(*timestamppb.Timestamp)(nil),        // 10: google.protobuf.Timestamp
+	(*structpb.Value)(nil),               // 11: google.protobuf.Value
+	(*emptypb.Empty)(nil),                // 12: google.protobuf.Empty
+}
+var file_shipment_proto_depIdxs = []int32{
+	10, // 0: shipmentmgo.Shipment.shipped:type_name -> google.protobuf.Timestamp
+	1,  // 1: shipmentmgo.Shipment.origin:type_name -> shipmentmgo.Address
+	1,  // 2: shipmentmgo.Shipment.stops:type_name -> shipmentmgo.Address
+	0,  // 3: shipmentmgo.ShipmentUpdateRequest.record:type_name -> shipmentmgo.Shipment
+	0,  // 4: shipmentmgo.ShipmentGetAllResponse.records:type_name -> shipmentmgo.Shipment
+	0,  // 5: shipmentmgo.ShipmentList.records:type_name -> shipmentmgo.Shipment
+	11, // 6: shipmentmgo.ShipmentGetByFieldRequest.value:type_name -> google.protobuf.Value
+	12, // 7: shipmentmgo.ShipmentService.Count:input_type -> google.protobuf.Empty
+	0,  // 8: shipmentmgo.ShipmentService.Create:input_type -> shipmentmgo.Shipment
+	2,  // 9: shipmentmgo.ShipmentService.Get:input_type -> shipmentmgo.ShipmentID
+	4,  // 10: shipmentmgo.ShipmentService.GetAll:input_type -> shipmentmgo.ShipmentGetAllRequest
+	6,  // 11: shipmentmgo.ShipmentService.GetAllByOrder:input_type -> shipmentmgo.ShipmentGetAllByOrderRequest
+	8,  // 12: shipmentmgo.ShipmentService.GetByField:input_type -> shipmentmgo.ShipmentGetByFieldRequest
+	3,  // 13: shipmentmgo.ShipmentService.Update:input_type -> shipmentmgo.ShipmentUpdateRequest
+	2,  // 14: shipmentmgo.ShipmentService.Delete:input_type -> shipmentmgo.ShipmentID
+	9,  // 15: shipmentmgo.ShipmentService.Count:output_type -> shipmentmgo.ShipmentCountResponse
+	12, // 16: shipmentmgo.ShipmentService.Create:output_type -> google.protobuf.Empty
+	0,  // 17: shipmentmgo.ShipmentService.Get:output_type -> shipmentmgo.Shipment
+	5,  // 18: shipmentmgo.ShipmentService.GetAll:output_type -> shipmentmgo.ShipmentGetAllResponse
+	7,  // 19: shipmentmgo.ShipmentService.GetAllByOrder:output_type -> shipmentmgo.ShipmentList
+	0,  // 20: shipmentmgo.ShipmentService.GetByField:output_type -> shipmentmgo.Shipment
+	12, // 21: shipmentmgo.ShipmentService.Update:output_type -> google.protobuf.Empty
+	12, // 22: shipmentmgo.ShipmentService.Delete:output_type -> google.protobuf.Empty
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_shipment_proto_init() }
+func file_shipment_proto_init() {
+	if File_shipment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shipment_proto_rawDesc), len(file_shipment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_shipment_proto_goTypes,
+		DependencyIndexes: file_shipment_proto_depIdxs,
+		MessageInfos:      file_shipment_proto_msgTypes,
+	}.Build()
+	File_shipment_proto = out.File
+	file_shipment_proto_goTypes = nil
+	file_shipment_proto_depIdxs = nil
+}
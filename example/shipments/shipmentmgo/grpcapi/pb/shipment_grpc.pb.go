@@ -0,0 +1,388 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: shipment.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ShipmentService_Count_FullMethodName         = "/shipmentmgo.ShipmentService/Count"
+	ShipmentService_Create_FullMethodName        = "/shipmentmgo.ShipmentService/Create"
+	ShipmentService_Get_FullMethodName           = "/shipmentmgo.ShipmentService/Get"
+	ShipmentService_GetAll_FullMethodName        = "/shipmentmgo.ShipmentService/GetAll"
+	ShipmentService_GetAllByOrder_FullMethodName = "/shipmentmgo.ShipmentService/GetAllByOrder"
+	ShipmentService_GetByField_FullMethodName    = "/shipmentmgo.ShipmentService/GetByField"
+	ShipmentService_Update_FullMethodName        = "/shipmentmgo.ShipmentService/Update"
+	ShipmentService_Delete_FullMethodName        = "/shipmentmgo.ShipmentService/Delete"
+)
+
+// ShipmentServiceClient is the client API for ShipmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShipmentServiceClient interface {
+	Count(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ShipmentCountResponse, error)
+	Create(ctx context.Context, in *Shipment, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Get(ctx context.Context, in *ShipmentID, opts ...grpc.CallOption) (*Shipment, error)
+	GetAll(ctx context.Context, in *ShipmentGetAllRequest, opts ...grpc.CallOption) (*ShipmentGetAllResponse, error)
+	GetAllByOrder(ctx context.Context, in *ShipmentGetAllByOrderRequest, opts ...grpc.CallOption) (*ShipmentList, error)
+	GetByField(ctx context.Context, in *ShipmentGetByFieldRequest, opts ...grpc.CallOption) (*Shipment, error)
+	Update(ctx context.Context, in *ShipmentUpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Delete(ctx context.Context, in *ShipmentID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type shipmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShipmentServiceClient(cc grpc.ClientConnInterface) ShipmentServiceClient {
+	return &shipmentServiceClient{cc}
+}
+
+func (c *shipmentServiceClient) Count(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ShipmentCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentCountResponse)
+	err := c.cc.Invoke(ctx, ShipmentService_Count_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shipmentServiceClient) Create(ctx context.Context, in *Shipment, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShipmentService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shipmentServiceClient) Get(ctx context.Context, in *ShipmentID, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, ShipmentService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shipmentServiceClient) GetAll(ctx context.Context, in *ShipmentGetAllRequest, opts ...grpc.CallOption) (*ShipmentGetAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentGetAllResponse)
+	err := c.cc.Invoke(ctx, ShipmentService_GetAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shipmentServiceClient) GetAllByOrder(ctx context.Context, in *ShipmentGetAllByOrderRequest, opts ...grpc.CallOption) (*ShipmentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentList)
+	err := c.cc.Invoke(ctx, ShipmentService_GetAllByOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shipmentServiceClient) GetByField(ctx context.Context, in *ShipmentGetByFieldRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, ShipmentService_GetByField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shipmentServiceClient) Update(ctx context.Context, in *ShipmentUpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShipmentService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shipmentServiceClient) Delete(ctx context.Context, in *ShipmentID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShipmentService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShipmentServiceServer is the server API for ShipmentService service.
+// All implementations must embed UnimplementedShipmentServiceServer
+// for forward compatibility.
+type ShipmentServiceServer interface {
+	Count(context.Context, *emptypb.Empty) (*ShipmentCountResponse, error)
+	Create(context.Context, *Shipment) (*emptypb.Empty, error)
+	Get(context.Context, *ShipmentID) (*Shipment, error)
+	GetAll(context.Context, *ShipmentGetAllRequest) (*ShipmentGetAllResponse, error)
+	GetAllByOrder(context.Context, *ShipmentGetAllByOrderRequest) (*ShipmentList, error)
+	GetByField(context.Context, *ShipmentGetByFieldRequest) (*Shipment, error)
+	Update(context.Context, *ShipmentUpdateRequest) (*emptypb.Empty, error)
+	Delete(context.Context, *ShipmentID) (*emptypb.Empty, error)
+	mustEmbedUnimplementedShipmentServiceServer()
+}
+
+// UnimplementedShipmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShipmentServiceServer struct{}
+
+func (UnimplementedShipmentServiceServer) Count(context.Context, *emptypb.Empty) (*ShipmentCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedShipmentServiceServer) Create(context.Context, *Shipment) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedShipmentServiceServer) Get(context.Context, *ShipmentID) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedShipmentServiceServer) GetAll(context.Context, *ShipmentGetAllRequest) (*ShipmentGetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+}
+func (UnimplementedShipmentServiceServer) GetAllByOrder(context.Context, *ShipmentGetAllByOrderRequest) (*ShipmentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllByOrder not implemented")
+}
+func (UnimplementedShipmentServiceServer) GetByField(context.Context, *ShipmentGetByFieldRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByField not implemented")
+}
+func (UnimplementedShipmentServiceServer) Update(context.Context, *ShipmentUpdateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedShipmentServiceServer) Delete(context.Context, *ShipmentID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedShipmentServiceServer) mustEmbedUnimplementedShipmentServiceServer() {}
+func (UnimplementedShipmentServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeShipmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShipmentServiceServer will
+// result in compilation errors.
+type UnsafeShipmentServiceServer interface {
+	mustEmbedUnimplementedShipmentServiceServer()
+}
+
+func RegisterShipmentServiceServer(s grpc.ServiceRegistrar, srv ShipmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShipmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShipmentService_ServiceDesc, srv)
+}
+
+func _ShipmentService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShipmentServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShipmentService_Count_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShipmentServiceServer).Count(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShipmentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Shipment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShipmentServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShipmentService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShipmentServiceServer).Create(ctx, req.(*Shipment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShipmentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShipmentServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShipmentService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShipmentServiceServer).Get(ctx, req.(*ShipmentID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShipmentService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentGetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShipmentServiceServer).GetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShipmentService_GetAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShipmentServiceServer).GetAll(ctx, req.(*ShipmentGetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShipmentService_GetAllByOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentGetAllByOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShipmentServiceServer).GetAllByOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShipmentService_GetAllByOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShipmentServiceServer).GetAllByOrder(ctx, req.(*ShipmentGetAllByOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShipmentService_GetByField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentGetByFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShipmentServiceServer).GetByField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShipmentService_GetByField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShipmentServiceServer).GetByField(ctx, req.(*ShipmentGetByFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShipmentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShipmentServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShipmentService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShipmentServiceServer).Update(ctx, req.(*ShipmentUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShipmentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShipmentServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShipmentService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShipmentServiceServer).Delete(ctx, req.(*ShipmentID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShipmentService_ServiceDesc is the grpc.ServiceDesc for ShipmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShipmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "shipmentmgo.ShipmentService",
+	HandlerType: (*ShipmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Count",
+			Handler:    _ShipmentService_Count_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ShipmentService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ShipmentService_Get_Handler,
+		},
+		{
+			MethodName: "GetAll",
+			Handler:    _ShipmentService_GetAll_Handler,
+		},
+		{
+			MethodName: "GetAllByOrder",
+			Handler:    _ShipmentService_GetAllByOrder_Handler,
+		},
+		{
+			MethodName: "GetByField",
+			Handler:    _ShipmentService_GetByField_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ShipmentService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ShipmentService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shipment.proto",
+}
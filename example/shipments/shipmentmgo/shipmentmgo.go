@@ -0,0 +1,1446 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/shipments.Shipment
+// Annotation: @mongoapi(GRPC => true)
+// Hash: sha256:cedfc409b8e1ec168fad8c0e9c40aa5dffda91e2a04c2ba5cda573b48d0ea289
+
+package shipmentmgo
+
+import (
+	"errors"
+
+	"runtime"
+
+	"time"
+
+	"sync"
+
+	"context"
+
+	"strings"
+
+	mgo "gopkg.in/mgo.v2"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/influx6/faux/metrics"
+
+	"github.com/gokit/mgokit/example/shipments"
+
+	"encoding/base64"
+
+	"fmt"
+
+	"math"
+)
+
+// errors ...
+var (
+	ErrNotFound       = errors.New("record not found")
+	ErrExpiredContext = errors.New("context has expired")
+)
+
+//**********************************************************
+// MongoDB Config and Setup
+//**********************************************************
+
+// Config embodies the data used to connect to user's mongo connection.
+type Config struct {
+	DB       string `toml:"db" json:"db"`
+	AuthDB   string `toml:"authdb" json:"authdb"`
+	User     string `toml:"user" json:"user"`
+	Password string `toml:"password" json:"password"`
+	Host     string `toml:"host" json:"host"`
+}
+
+// Empty returns true/false if all Config values are at default/empty/non-set
+// state.
+func (mgc Config) Empty() bool {
+	return mgc.AuthDB == "" &&
+		mgc.DB == "" &&
+		mgc.User == "" &&
+		mgc.Password == "" &&
+		mgc.Host == ""
+}
+
+// Validate returns an error if the config is invalid.
+func (mgc Config) Validate() error {
+	if mgc.User == "" {
+		return errors.New("Config.User is required")
+	}
+	if mgc.Password == "" {
+		return errors.New("Config.Password is required")
+	}
+	if mgc.AuthDB == "" {
+		return errors.New("Config.AuthDB is required")
+	}
+	if mgc.Host == "" {
+		return errors.New("Config.Host is required")
+	}
+	if mgc.DB == "" {
+		return errors.New("Config.DB is required")
+	}
+	return nil
+}
+
+// MongoDB defines a interface which exposes a method for retrieving a
+// mongo.Database and mongo.Session.
+type MongoDB interface {
+	New(isread bool) (*mgo.Database, *mgo.Session, error)
+}
+
+// NewMongoDB returns a new instance of a MongoDB.
+func NewMongoDB(conf Config) *MongoDBImpl {
+	mg := &MongoDBImpl{
+		Config: conf,
+	}
+
+	// Add finalizer to ensure closure of master session.
+	runtime.SetFinalizer(mg, func(target *MongoDBImpl) {
+		target.ml.Lock()
+		defer target.ml.Unlock()
+		if target.master != nil {
+			target.master.Close()
+			target.master = nil
+		}
+	})
+
+	return mg
+}
+
+// MongoDBImpl defines a mongo connection manager that builds
+// allows usage of a giving configuration to generate new mongo
+// sessions and database instances.
+type MongoDBImpl struct {
+	Config
+	ml     sync.Mutex
+	master *mgo.Session
+}
+
+// New returns a new session and database from the giving configuration.
+//
+// Argument:
+//  isread: bool
+//
+// 1. If `isread` is false, then the mgo.Session is cloned so that we re-use the existing
+// sessiby not closing, so others get use ofn connection, in such case, it lets you optimize writes, so try not
+// the session instance connection for other writes.
+//
+// 2. If `isread` is true, then session is copied which creates a new unique session which you
+// should close after use, this lets you handle large reads that may contain complicated queries.
+//
+func (m *MongoDBImpl) New(isread bool) (*mgo.Database, *mgo.Session, error) {
+	m.ml.Lock()
+	defer m.ml.Unlock()
+
+	// if m.master is alive then continue else, reset as empty.
+	if m.master != nil {
+		if err := m.master.Ping(); err != nil {
+			m.master = nil
+		}
+	}
+
+	ses, err := getSession(m.Config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.master = ses
+
+	if isread {
+		copy := m.master.Copy()
+		db := copy.DB(m.Config.DB)
+		return db, copy, nil
+	}
+
+	clone := m.master.Clone()
+	db := clone.DB(m.Config.DB)
+	return db, clone, nil
+}
+
+// getSession attempts to retrieve the giving session for the given config.
+func getSession(config Config) (*mgo.Session, error) {
+	info := mgo.DialInfo{
+		Addrs:    []string{config.Host},
+		Timeout:  60 * time.Second,
+		Database: config.AuthDB,
+		Username: config.User,
+		Password: config.Password,
+	}
+
+	// Create a session which maintains a pool of socket connections
+	// to our MongoDB.
+	ses, err := mgo.DialWithInfo(&info)
+	if err != nil {
+		return nil, err
+	}
+
+	ses.SetMode(mgo.Monotonic, true)
+
+	return ses, nil
+}
+
+//**********************************************************
+// DB Types
+//**********************************************************
+
+// ShipmentFields defines an interface which exposes method to return a map of all
+// attributes associated with the defined structure as decided by the structure.
+type ShipmentFields interface {
+	Fields() (map[string]interface{}, error)
+}
+
+// ShipmentConsumer defines an interface which accepts a map of data which will be consumed
+// into the giving implementing structure as decided by the structure.
+type ShipmentConsumer interface {
+	Consume(map[string]interface{}) error
+}
+
+// Validation defines an interface which expose a method to validate a giving type.
+type Validation interface {
+	Validate() error
+}
+
+// FieldError defines a field of a record failing a rule of its validate tag, with Field
+// set to its path within stored documents, e.g "items.0.sku".
+type FieldError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+// Error returns the FieldError as a message, e.g "name must be at least 3 characters long".
+func (f FieldError) Error() string {
+	return f.Field + " " + f.Message
+}
+
+// ValidationError defines the error returned for a record failing the rules of the validate
+// tags of its fields, listing every failing field.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error returns the failures of all fields separated by "; ".
+func (v ValidationError) Error() string {
+	messages := make([]string, len(v.Fields))
+	for index, field := range v.Fields {
+		messages[index] = field.Error()
+	}
+
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+//**********************************************************
+// DB API
+//**********************************************************
+
+// ShipmentDB defines a structure which provide DB CRUD operations
+// using mongo as the underline db.
+type ShipmentDB struct {
+	col             string
+	db              MongoDB
+	metrics         metrics.Metrics
+	ensuredIndex    bool
+	incompleteIndex bool
+	indexes         []mgo.Index
+	hl              sync.RWMutex
+	hooks           map[HookStage][]Hook
+}
+
+// Hook defines a function run by ShipmentDB on a record at the stage it was
+// added for. Hooks run before a write may change the record written.
+type Hook func(ctx context.Context, elem *shipments.Shipment) error
+
+// New returns a new instance of ShipmentDB.
+func New(col string, m metrics.Metrics, mo MongoDB, indexes ...mgo.Index) *ShipmentDB {
+	return &ShipmentDB{
+		db:      mo,
+		col:     col,
+		metrics: m,
+		indexes: indexes,
+		hooks:   make(map[HookStage][]Hook),
+	}
+}
+
+// AddHook adds the giving hook to run at the giving stage, after the method of the struct
+// named after the stage and the hooks added before it.
+func (mdb *ShipmentDB) AddHook(stage HookStage, hook Hook) {
+	mdb.hl.Lock()
+	defer mdb.hl.Unlock()
+
+	if mdb.hooks == nil {
+		mdb.hooks = make(map[HookStage][]Hook)
+	}
+
+	mdb.hooks[stage] = append(mdb.hooks[stage], hook)
+}
+
+// hook runs the method of the giving record named after the giving stage, then the hooks
+// added for the stage, stopping at the first to fail.
+func (mdb *ShipmentDB) hook(ctx context.Context, stage HookStage, elem *shipments.Shipment) error {
+	if err := structHook(ctx, stage, elem); err != nil {
+		return err
+	}
+
+	mdb.hl.RLock()
+	added := mdb.hooks[stage]
+	mdb.hl.RUnlock()
+
+	for _, hook := range added {
+		if err := hook(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hooked returns true if any method or hook runs at the giving stage.
+func (mdb *ShipmentDB) hooked(stage HookStage) bool {
+	if structHooked(stage) {
+		return true
+	}
+
+	mdb.hl.RLock()
+	defer mdb.hl.RUnlock()
+
+	return len(mdb.hooks[stage]) != 0
+}
+
+// ensureIndex attempts to ensure all provided indexes into the specific collection.
+func (mdb *ShipmentDB) ensureIndex() error {
+	if mdb.ensuredIndex {
+		return nil
+	}
+
+	defer mdb.metrics.CollectMetrics("ShipmentDB.ensureIndex")
+
+	if len(mdb.indexes) == 0 {
+		return nil
+	}
+
+	// If we had an error before index was complete, then skip, we cant not
+	// stop all ops because of failed index.
+	if !mdb.ensuredIndex && mdb.incompleteIndex {
+		return nil
+	}
+
+	database, session, err := mdb.db.New(false)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to create session for index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	defer session.Close()
+
+	collection := database.C(mdb.col)
+
+	for _, index := range mdb.indexes {
+		if err := collection.EnsureIndex(index); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to ensure session index"), metrics.With("collection", mdb.col), metrics.With("index", index), metrics.With("error", err.Error()))
+
+			mdb.incompleteIndex = true
+			return err
+		}
+
+		mdb.metrics.Emit(metrics.Info("Succeeded in ensuring collection index"), metrics.With("collection", mdb.col), metrics.With("index", index))
+	}
+
+	mdb.ensuredIndex = true
+
+	mdb.metrics.Emit(metrics.Info("Finished adding index"), metrics.With("collection", mdb.col))
+	return nil
+}
+
+// Count attempts to return the total number of record from the db.
+func (mdb *ShipmentDB) Count(ctx context.Context) (int, error) {
+	defer mdb.metrics.CollectMetrics("ShipmentDB.Count")
+
+	if isContextExpired(ctx) {
+		err := ErrExpiredContext
+
+		mdb.metrics.Emit(metrics.Errorf("Failed to get record count"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return -1, err
+	}
+
+	if err := mdb.ensureIndex(); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+
+		return -1, err
+	}
+
+	database, session, err := mdb.db.New(true)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to get record count"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+
+		return -1, err
+	}
+
+	defer session.Close()
+
+	query := bson.M{}
+	total, err := database.C(mdb.col).Find(query).Count()
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to get record count"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
+
+		return -1, err
+	}
+
+	mdb.metrics.Emit(metrics.Info("Deleted record"), metrics.With("collection", mdb.col), metrics.With("query", query))
+
+	return total, err
+}
+
+// Delete attempts to remove the record from the db using the provided publicID.
+// Records using this DB must have a public id value, expressed either by a bson or json tag
+// on the given shipments.Shipment struct.
+func (mdb *ShipmentDB) Delete(ctx context.Context, publicID string) error {
+	defer mdb.metrics.CollectMetrics("ShipmentDB.Delete")
+
+	if isContextExpired(ctx) {
+		err := ErrExpiredContext
+		mdb.metrics.Emit(metrics.Errorf("Failed to delete record"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := mdb.ensureIndex(); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	// The record is only loaded for the methods and hooks run around its deletion.
+	var elem shipments.Shipment
+	hooked := mdb.hooked(BeforeDelete) || mdb.hooked(AfterDelete)
+
+	if hooked {
+		found, err := mdb.Get(ctx, publicID)
+		if err != nil {
+			return err
+		}
+
+		elem = found
+		if err := mdb.hook(ctx, BeforeDelete, &elem); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to run BeforeDelete hooks"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			return err
+		}
+	}
+
+	database, session, err := mdb.db.New(false)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to delete record"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	defer session.Close()
+
+	query := bson.M{
+		"public_id": publicID,
+	}
+
+	if err := database.C(mdb.col).Remove(query); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to delete record"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("publicID", publicID), metrics.With("error", err.Error()))
+		if err == mgo.ErrNotFound {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	mdb.metrics.Emit(metrics.Info("Deleted record"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("publicID", publicID))
+
+	if hooked {
+		if err := mdb.hook(ctx, AfterDelete, &elem); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to run AfterDelete hooks"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create attempts to add the record into the db using the provided instance of the
+// shipments.Shipment.
+// Records using this DB must have a public id value, expressed either by a bson or json tag
+// on the given Shipment struct.
+func (mdb *ShipmentDB) Create(ctx context.Context, elem shipments.Shipment) error {
+	defer mdb.metrics.CollectMetrics("ShipmentDB.Create")
+
+	if isContextExpired(ctx) {
+		err := ErrExpiredContext
+		mdb.metrics.Emit(metrics.Errorf("Failed to create record"), metrics.With("publicID", elem.PublicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := mdb.hook(ctx, BeforeCreate, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run BeforeCreate hooks"), metrics.With("publicID", elem.PublicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := ValidateShipment(elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to validate record"),
+			metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if validator, ok := interface{}(elem).(Validation); ok {
+		if err := validator.Validate(); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to validate record"),
+				metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			return err
+		}
+	}
+
+	if err := mdb.ensureIndex(); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	database, session, err := mdb.db.New(false)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to create session"), metrics.With("publicID", elem.PublicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	defer session.Close()
+
+	query := shipmentDocument(elem)
+
+	if err := database.C(mdb.col).Insert(query); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to create Shipment record"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
+		return err
+	}
+
+	mdb.metrics.Emit(metrics.Info("Create record"), metrics.With("collection", mdb.col), metrics.With("query", query))
+
+	if err := mdb.hook(ctx, AfterCreate, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run AfterCreate hooks"), metrics.With("publicID", elem.PublicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// GetAll retrieves all records from the db and returns a slice of shipments.Shipment type.
+// Records using this DB must have a public id value, expressed either by a bson or json tag
+// on the given Shipment struct.
+func (mdb *ShipmentDB) GetAll(ctx context.Context, order string, orderBy string, page int, responsePerPage int) ([]shipments.Shipment, int, error) {
+	defer mdb.metrics.CollectMetrics("ShipmentDB.GetAll")
+
+	switch strings.ToLower(order) {
+	case "dsc", "desc":
+		orderBy = "-" + orderBy
+	}
+
+	if isContextExpired(ctx) {
+		err := ErrExpiredContext
+		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve record"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return nil, -1, err
+	}
+
+	if err := mdb.ensureIndex(); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return nil, -1, err
+	}
+
+	if page <= 0 && responsePerPage <= 0 {
+		records, err := mdb.GetAllByOrder(ctx, order, orderBy)
+		return records, len(records), err
+	}
+
+	// Get total number of records.
+	totalRecords, err := mdb.Count(ctx)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	var totalWanted, indexToStart int
+
+	if page <= 1 && responsePerPage > 0 {
+		totalWanted = responsePerPage
+		indexToStart = 0
+	} else {
+		totalWanted = responsePerPage * page
+		indexToStart = totalWanted / 2
+
+		if page > 1 {
+			indexToStart++
+		}
+	}
+
+	mdb.metrics.Emit(
+		metrics.Info("DB:Query:GetAllPerPage"),
+		metrics.WithFields(metrics.Field{
+			"starting_index":       indexToStart,
+			"total_records_wanted": totalWanted,
+			"order":                order,
+			"orderBy":              orderBy,
+			"page":                 page,
+			"responsePerPage":      responsePerPage,
+		}),
+	)
+
+	database, session, err := mdb.db.New(true)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to create session"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return nil, -1, err
+	}
+
+	defer session.Close()
+
+	query := bson.M{}
+
+	var ritems []shipments.Shipment
+
+	var ditems []map[string]interface{}
+	if err := database.C(mdb.col).Find(query).Skip(indexToStart).Limit(totalWanted).Sort(orderBy).All(&ditems); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve all records of Shipment type from db"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
+		if err == mgo.ErrNotFound {
+			return nil, -1, ErrNotFound
+		}
+		return nil, -1, err
+	}
+
+	for _, item := range ditems {
+		var elem shipments.Shipment
+		if err := shipmentFromDocument(item, &elem); err != nil {
+			return nil, -1, err
+		}
+		ritems = append(ritems, elem)
+	}
+
+	for index := range ritems {
+		if err := mdb.hook(ctx, AfterLoad, &ritems[index]); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			return nil, -1, err
+		}
+	}
+
+	return ritems, totalRecords, nil
+}
+
+// GetAllByOrder retrieves all records from the db and returns a slice of shipments.Shipment type.
+// Records using this DB must have a public id value, expressed either by a bson or json tag
+// on the given Shipment struct.
+func (mdb *ShipmentDB) GetAllByOrder(ctx context.Context, order, orderBy string) ([]shipments.Shipment, error) {
+	defer mdb.metrics.CollectMetrics("ShipmentDB.GetAllByOrder")
+
+	switch strings.ToLower(order) {
+	case "dsc", "desc":
+		orderBy = "-" + orderBy
+	}
+
+	if isContextExpired(ctx) {
+		err := ErrExpiredContext
+
+		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve record"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return nil, err
+	}
+
+	if err := mdb.ensureIndex(); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return nil, err
+	}
+
+	database, session, err := mdb.db.New(true)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to create session"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return nil, err
+	}
+
+	defer session.Close()
+
+	query := bson.M{}
+
+	var ditems []map[string]interface{}
+	if err := database.C(mdb.col).Find(query).Sort(orderBy).All(&ditems); err != nil {
+		mdb.metrics.Emit(
+			metrics.Errorf("Failed to retrieve all records of Shipment type from db"),
+			metrics.With("collection", mdb.col),
+			metrics.With("query", query),
+			metrics.With("error", err.Error()),
+		)
+		if err == mgo.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	var ritems []shipments.Shipment
+	for _, item := range ditems {
+		var elem shipments.Shipment
+		if err := shipmentFromDocument(item, &elem); err != nil {
+			return nil, err
+		}
+		ritems = append(ritems, elem)
+	}
+
+	for index := range ritems {
+		if err := mdb.hook(ctx, AfterLoad, &ritems[index]); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+			return nil, err
+		}
+	}
+
+	return ritems, nil
+
+}
+
+// GetByField retrieves a record from the db using the provided field key and value
+// returns the shipments.Shipment type.
+// Records using this DB must have a public id value, expressed either by a bson or json tag
+// on the given Shipment struct.
+func (mdb *ShipmentDB) GetByField(ctx context.Context, key string, value interface{}) (shipments.Shipment, error) {
+	defer mdb.metrics.CollectMetrics("ShipmentDB.GetByFiled")
+
+	if isContextExpired(ctx) {
+		err := ErrExpiredContext
+		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve record"), metrics.With(key, value), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+
+		return shipments.Shipment{}, err
+	}
+
+	if err := mdb.ensureIndex(); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+
+		return shipments.Shipment{}, err
+	}
+
+	database, session, err := mdb.db.New(true)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to create session"), metrics.With(key, value), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+
+		return shipments.Shipment{}, err
+	}
+
+	defer session.Close()
+
+	query := bson.M{key: value}
+
+	var item map[string]interface{}
+
+	if err := database.C(mdb.col).Find(query).One(&item); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve all records of Shipment type from db"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
+		if err == mgo.ErrNotFound {
+			return shipments.Shipment{}, ErrNotFound
+		}
+		return shipments.Shipment{}, err
+	}
+
+	var elem shipments.Shipment
+
+	if err := shipmentFromDocument(item, &elem); err != nil {
+		return shipments.Shipment{}, err
+	}
+
+	if err := mdb.hook(ctx, AfterLoad, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return shipments.Shipment{}, err
+	}
+
+	return elem, nil
+
+}
+
+// Get retrieves a record from the db using the publicID and returns the shipments.Shipment type.
+// Records using this DB must have a public id value, expressed either by a bson or json tag
+// on the given Shipment struct.
+func (mdb *ShipmentDB) Get(ctx context.Context, publicID string) (shipments.Shipment, error) {
+	defer mdb.metrics.CollectMetrics("ShipmentDB.Get")
+
+	if isContextExpired(ctx) {
+		err := ErrExpiredContext
+		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve record"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return shipments.Shipment{}, err
+	}
+
+	if err := mdb.ensureIndex(); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return shipments.Shipment{}, err
+	}
+
+	database, session, err := mdb.db.New(true)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to create session"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return shipments.Shipment{}, err
+	}
+
+	defer session.Close()
+
+	query := bson.M{"public_id": publicID}
+
+	var item map[string]interface{}
+
+	if err := database.C(mdb.col).Find(query).One(&item); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to retrieve all records of Shipment type from db"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("error", err.Error()))
+		if err == mgo.ErrNotFound {
+			return shipments.Shipment{}, ErrNotFound
+		}
+		return shipments.Shipment{}, err
+	}
+
+	var elem shipments.Shipment
+
+	if err := shipmentFromDocument(item, &elem); err != nil {
+		return shipments.Shipment{}, err
+	}
+
+	if err := mdb.hook(ctx, AfterLoad, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run AfterLoad hooks"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return shipments.Shipment{}, err
+	}
+
+	return elem, nil
+
+}
+
+// Update uses a record from the db using the publicID and returns the shipments.Shipment type.
+// Records using this DB must have a public id value, expressed either by a bson or json tag
+// on the given Shipment struct.
+func (mdb *ShipmentDB) Update(ctx context.Context, publicID string, elem shipments.Shipment) error {
+	defer mdb.metrics.CollectMetrics("ShipmentDB.Update")
+
+	if isContextExpired(ctx) {
+		err := ErrExpiredContext
+		mdb.metrics.Emit(metrics.Errorf("Failed to finish, context has expired"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := mdb.hook(ctx, BeforeUpdate, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run BeforeUpdate hooks"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := ValidateShipment(elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to validate record"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if validator, ok := interface{}(elem).(Validation); ok {
+		if err := validator.Validate(); err != nil {
+			mdb.metrics.Emit(metrics.Errorf("Failed to validate record"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+			return err
+		}
+	}
+
+	if err := mdb.ensureIndex(); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
+	database, session, err := mdb.db.New(false)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to create session"), metrics.With("publicID", publicID), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
+	defer session.Close()
+
+	query := bson.M{"public_id": publicID}
+
+	queryData := shipmentDocument(elem)
+	if err := database.C(mdb.col).Update(query, queryData); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to update Shipment record"), metrics.With("collection", mdb.col), metrics.With("query", query), metrics.With("data", queryData), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		if err == mgo.ErrNotFound {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	mdb.metrics.Emit(metrics.Info("Update record"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("query", query))
+
+	if err := mdb.hook(ctx, AfterUpdate, &elem); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to run AfterUpdate hooks"), metrics.With("collection", mdb.col), metrics.With("public_id", publicID), metrics.With("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// Exec provides a function which allows the execution of a custom function against the collection.
+func (mdb *ShipmentDB) Exec(ctx context.Context, isread bool, fx func(col *mgo.Collection) error) error {
+	defer mdb.metrics.CollectMetrics("ShipmentDB.Exec")
+
+	if isContextExpired(ctx) {
+		err := ErrExpiredContext
+		mdb.metrics.Emit(metrics.Errorf("Failed to execute operation"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	if err := mdb.ensureIndex(); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to apply index"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	database, session, err := mdb.db.New(isread)
+	if err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to create session"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		return err
+	}
+
+	defer session.Close()
+
+	if err := fx(database.C(mdb.col)); err != nil {
+		mdb.metrics.Emit(metrics.Errorf("Failed to execute operation"), metrics.With("collection", mdb.col), metrics.With("error", err.Error()))
+		if err == mgo.ErrNotFound {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	mdb.metrics.Emit(metrics.Info("Operation executed"), metrics.With("collection", mdb.col))
+
+	return nil
+}
+
+// namespaceNotFound is the code of the error returned by collMod for a missing collection.
+const namespaceNotFound = 26
+
+// Schema returns the validator of collections storing shipments.Shipment records,
+// a $jsonSchema document built from the fields of the struct and their schema tags.
+func Schema() bson.M {
+	return bson.M{"$jsonSchema": bson.M{
+		"bsonType": "object",
+		"properties": bson.M{
+			"public_id": bson.M{
+				"bsonType": "string",
+			},
+			"carrier": bson.M{
+				"bsonType": "string",
+			},
+			"weight": bson.M{
+				"bsonType": []string{"double", "int", "long"},
+			},
+			"pieces": bson.M{
+				"bsonType": []string{"int", "long"},
+			},
+			"shipped": bson.M{
+				"bsonType": "date",
+			},
+			"tags": bson.M{
+				"bsonType": "array",
+				"items": bson.M{
+					"bsonType": "string",
+				},
+			},
+			"origin": bson.M{
+				"bsonType": "object",
+				"properties": bson.M{
+					"city": bson.M{
+						"bsonType": "string",
+					},
+					"zip": bson.M{
+						"bsonType": "string",
+					},
+				},
+			},
+			"stops": bson.M{
+				"bsonType": "array",
+				"items": bson.M{
+					"bsonType": []string{"object", "null"},
+					"properties": bson.M{
+						"city": bson.M{
+							"bsonType": "string",
+						},
+						"zip": bson.M{
+							"bsonType": "string",
+						},
+					},
+				},
+			},
+		},
+	}}
+}
+
+// ApplySchema sets the validator returned by Schema on the giving collection, creating the
+// collection if it does not exist yet. The level sets which writes are validated, one of
+// "off", "strict" or "moderate", and the action whether invalid writes fail with "error" or
+// are only logged with "warn".
+func ApplySchema(ctx context.Context, db MongoDB, col string, level string, action string) error {
+	if isContextExpired(ctx) {
+		return ErrExpiredContext
+	}
+
+	database, session, err := db.New(false)
+	if err != nil {
+		return err
+	}
+
+	defer session.Close()
+
+	command := bson.D{
+		{Name: "collMod", Value: col},
+		{Name: "validator", Value: Schema()},
+		{Name: "validationLevel", Value: level},
+		{Name: "validationAction", Value: action},
+	}
+
+	var result bson.M
+	err = database.Run(command, &result)
+	if qerr, ok := err.(*mgo.QueryError); ok && qerr.Code == namespaceNotFound {
+		command[0].Name = "create"
+		err = database.Run(command, &result)
+	}
+
+	return err
+}
+
+func isContextExpired(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// shipmentDocument returns the bson.M document stored for the giving Shipment.
+func shipmentDocument(elem shipments.Shipment) bson.M {
+	doc := bson.M{}
+	doc["public_id"] = elem.PublicID
+	doc["carrier"] = elem.Carrier
+	doc["weight"] = elem.Weight
+	doc["pieces"] = elem.Pieces
+	doc["shipped"] = elem.Shipped
+	doc["tags"] = elem.Tags
+	sub1 := bson.M{}
+	sub1["city"] = elem.Origin.City
+	sub1["zip"] = elem.Origin.Zip
+	doc["origin"] = sub1
+	var list2 []interface{}
+	if elem.Stops != nil {
+		list2 = make([]interface{}, 0, len(elem.Stops))
+	}
+	for _, item3 := range elem.Stops {
+		var value4 interface{}
+		if item3 != nil {
+			sub5 := bson.M{}
+			sub5["city"] = item3.City
+			sub5["zip"] = item3.Zip
+			value4 = sub5
+		}
+		list2 = append(list2, value4)
+	}
+	doc["stops"] = list2
+	return doc
+}
+
+// ValidateShipment returns a ValidationError listing every field of the giving Shipment failing the
+// rules of its validate tag, else nil.
+func ValidateShipment(elem shipments.Shipment) error {
+	var failed []FieldError
+	if elem.Carrier == "" {
+		failed = append(failed, FieldError{Field: "carrier", Rule: "required", Message: "is required"})
+	}
+	if len(failed) != 0 {
+		return ValidationError{Fields: failed}
+	}
+	return nil
+}
+
+// HookStage defines a stage of the operations on shipments.Shipment records at
+// which hooks run, named after the method of the struct run at it.
+type HookStage int
+
+// HookStage values, in the order of the operations they run within.
+const (
+	BeforeCreate HookStage = iota
+	AfterCreate
+	BeforeUpdate
+	AfterUpdate
+	BeforeDelete
+	AfterDelete
+	AfterLoad
+)
+
+// hookStages contains the names of all HookStage values.
+var hookStages = []string{"BeforeCreate", "AfterCreate", "BeforeUpdate", "AfterUpdate", "BeforeDelete", "AfterDelete", "AfterLoad"}
+
+// String returns the name of the HookStage.
+func (h HookStage) String() string {
+	if h < 0 || int(h) >= len(hookStages) {
+		return "UnknownHookStage"
+	}
+
+	return hookStages[h]
+}
+
+// structHook runs the method of the giving record named after the giving stage, if
+// shipments.Shipment declares it.
+func structHook(ctx context.Context, stage HookStage, elem *shipments.Shipment) error {
+	switch stage {
+	}
+
+	return nil
+}
+
+// structHooked returns true if shipments.Shipment declares the
+// method named after the giving stage.
+func structHooked(stage HookStage) bool {
+	switch stage {
+	}
+
+	return false
+}
+
+// shipmentFromDocument sets the giving Shipment from the giving document, as built by shipmentDocument and
+// read from mongodb. It returns an error if the key of a field without omitempty is missing
+// or a value can not be converted into the type of its field.
+func shipmentFromDocument(data map[string]interface{}, elem *shipments.Shipment) error {
+	value1, ok := data["public_id"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "public_id")
+	}
+	value2, err := shipmentString(value1)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "public_id", err)
+	}
+	elem.PublicID = value2
+	value3, ok := data["carrier"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "carrier")
+	}
+	value4, err := shipmentString(value3)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "carrier", err)
+	}
+	elem.Carrier = value4
+	value5, ok := data["weight"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "weight")
+	}
+	value6, err := shipmentFloat64(value5)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "weight", err)
+	}
+	elem.Weight = value6
+	value7, ok := data["pieces"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "pieces")
+	}
+	value8, err := shipmentInt64(value7, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "pieces", err)
+	}
+	elem.Pieces = int(value8)
+	value9, ok := data["shipped"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "shipped")
+	}
+	value10, err := shipmentTime(value9)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "shipped", err)
+	}
+	elem.Shipped = value10
+	value11, ok := data["tags"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "tags")
+	}
+	list12, err := shipmentSlice(value11)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "tags", err)
+	}
+	if list12 != nil {
+		elem.Tags = make([]string, len(list12))
+	}
+	for index13, item14 := range list12 {
+		value15, err := shipmentString(item14)
+		if err != nil {
+			return fmt.Errorf("Failed to consume %q of Shipment: %+q", "tags", err)
+		}
+		elem.Tags[index13] = value15
+	}
+	value16, ok := data["origin"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "origin")
+	}
+	doc17, err := shipmentMap(value16)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "origin", err)
+	}
+	value18, ok := doc17["city"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "origin.city")
+	}
+	value19, err := shipmentString(value18)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "origin.city", err)
+	}
+	elem.Origin.City = value19
+	value20, ok := doc17["zip"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "origin.zip")
+	}
+	value21, err := shipmentString(value20)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "origin.zip", err)
+	}
+	elem.Origin.Zip = value21
+	value22, ok := data["stops"]
+	if !ok {
+		return fmt.Errorf("Shipment is missing required field %q", "stops")
+	}
+	list23, err := shipmentSlice(value22)
+	if err != nil {
+		return fmt.Errorf("Failed to consume %q of Shipment: %+q", "stops", err)
+	}
+	if list23 != nil {
+		elem.Stops = make([]*shipments.Address, len(list23))
+	}
+	for index24, item25 := range list23 {
+		if item25 != nil {
+			elem.Stops[index24] = new(shipments.Address)
+			doc26, err := shipmentMap(item25)
+			if err != nil {
+				return fmt.Errorf("Failed to consume %q of Shipment: %+q", "stops", err)
+			}
+			value27, ok := doc26["city"]
+			if !ok {
+				return fmt.Errorf("Shipment is missing required field %q", "stops.city")
+			}
+			value28, err := shipmentString(value27)
+			if err != nil {
+				return fmt.Errorf("Failed to consume %q of Shipment: %+q", "stops.city", err)
+			}
+			elem.Stops[index24].City = value28
+			value29, ok := doc26["zip"]
+			if !ok {
+				return fmt.Errorf("Shipment is missing required field %q", "stops.zip")
+			}
+			value30, err := shipmentString(value29)
+			if err != nil {
+				return fmt.Errorf("Failed to consume %q of Shipment: %+q", "stops.zip", err)
+			}
+			elem.Stops[index24].Zip = value30
+		}
+	}
+	return nil
+}
+
+// shipmentString returns the giving value as a string.
+func shipmentString(value interface{}) (string, error) {
+	if v, ok := value.(string); ok {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("expected string, found %T", value)
+}
+
+// shipmentBool returns the giving value as a bool.
+func shipmentBool(value interface{}) (bool, error) {
+	if v, ok := value.(bool); ok {
+		return v, nil
+	}
+
+	return false, fmt.Errorf("expected bool, found %T", value)
+}
+
+// shipmentInt64 returns the giving number as an int64, failing if it does not fit into the
+// giving number of bits. Floating point numbers, as decoded from json, must be integers.
+func shipmentInt64(value interface{}, bits uint) (int64, error) {
+	var n int64
+
+	switch v := value.(type) {
+	case int:
+		n = int64(v)
+	case int8:
+		n = int64(v)
+	case int16:
+		n = int64(v)
+	case int32:
+		n = int64(v)
+	case int64:
+		n = v
+	case uint8:
+		n = int64(v)
+	case uint16:
+		n = int64(v)
+	case uint32:
+		n = int64(v)
+	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", v)
+		}
+		n = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", v)
+		}
+		n = int64(v)
+	case float32:
+		if float32(int64(v)) != v {
+			return 0, fmt.Errorf("expected integer, found %v", v)
+		}
+		n = int64(v)
+	case float64:
+		if float64(int64(v)) != v {
+			return 0, fmt.Errorf("expected integer, found %v", v)
+		}
+		n = int64(v)
+	default:
+		return 0, fmt.Errorf("expected number, found %T", value)
+	}
+
+	if bits < 64 && (n < -1<<(bits-1) || n >= 1<<(bits-1)) {
+		return 0, fmt.Errorf("%d overflows int%d", n, bits)
+	}
+
+	return n, nil
+}
+
+// shipmentUint64 returns the giving number as an uint64, failing if it is negative or does not
+// fit into the giving number of bits.
+func shipmentUint64(value interface{}, bits uint) (uint64, error) {
+	var n uint64
+
+	switch v := value.(type) {
+	case uint:
+		n = uint64(v)
+	case uint8:
+		n = uint64(v)
+	case uint16:
+		n = uint64(v)
+	case uint32:
+		n = uint64(v)
+	case uint64:
+		n = v
+	default:
+		signed, err := shipmentInt64(value, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		if signed < 0 {
+			return 0, fmt.Errorf("expected unsigned number, found %d", signed)
+		}
+
+		n = uint64(signed)
+	}
+
+	if bits < 64 && n >= 1<<bits {
+		return 0, fmt.Errorf("%d overflows uint%d", n, bits)
+	}
+
+	return n, nil
+}
+
+// shipmentFloat64 returns the giving number as a float64.
+func shipmentFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	}
+
+	n, err := shipmentInt64(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected number, found %T", value)
+	}
+
+	return float64(n), nil
+}
+
+// shipmentTime returns the giving value as a time.Time, parsing strings, as decoded from json,
+// in the RFC3339 format.
+func shipmentTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		return time.Parse(time.RFC3339Nano, v)
+	}
+
+	return time.Time{}, fmt.Errorf("expected time, found %T", value)
+}
+
+// shipmentObjectID returns the giving value as a bson.ObjectId, parsing strings, as decoded
+// from json, in their hex representation.
+func shipmentObjectID(value interface{}) (bson.ObjectId, error) {
+	switch v := value.(type) {
+	case bson.ObjectId:
+		return v, nil
+	case string:
+		if bson.IsObjectIdHex(v) {
+			return bson.ObjectIdHex(v), nil
+		}
+	}
+
+	return "", fmt.Errorf("expected object id, found %#v", value)
+}
+
+// shipmentBytes returns the giving value as binary data, decoding strings, as decoded from
+// json, from base64.
+func shipmentBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case bson.Binary:
+		return v.Data, nil
+	case string:
+		return base64.StdEncoding.DecodeString(v)
+	}
+
+	return nil, fmt.Errorf("expected binary data, found %T", value)
+}
+
+// shipmentMap returns the giving value as a map of fields, as decoded for nested documents.
+func shipmentMap(value interface{}) (map[string]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case bson.M:
+		return v, nil
+	case map[string]interface{}:
+		return v, nil
+	case bson.D:
+		return v.Map(), nil
+	}
+
+	return nil, fmt.Errorf("expected document, found %T", value)
+}
+
+// shipmentSlice returns the giving value as a slice, as decoded for lists.
+func shipmentSlice(value interface{}) ([]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("expected list, found %T", value)
+}
+
+// shipmentHasAny returns true/false if data contains any of the giving keys.
+func shipmentHasAny(data map[string]interface{}, keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := data[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// shipmentDecode decodes the giving value into target through bson, for values of types
+// which are stored as they are. A nil value leaves target unchanged.
+func shipmentDecode(value interface{}, target interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	data, err := bson.Marshal(bson.M{"value": value})
+	if err != nil {
+		return err
+	}
+
+	var raw struct {
+		Value bson.Raw `bson:"value"`
+	}
+
+	if err := bson.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return raw.Value.Unmarshal(target)
+}
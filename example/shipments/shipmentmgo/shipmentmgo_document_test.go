@@ -0,0 +1,67 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/shipments.Shipment
+// Annotation: @mongoapi(GRPC => true)
+// Hash: sha256:7af90051b0d6f786714f2b8004072c55d14a02127d0bcd655872cdb685f7a680
+
+package shipmentmgo
+
+import (
+	"reflect"
+
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/gokit/mgokit/example/shipments"
+
+	fixtures "github.com/gokit/mgokit/example/shipments/shipmentmgo/fixtures"
+)
+
+// storeDocument returns the giving document as read back from mongodb, encoding it into bson
+// and decoding it again.
+func storeDocument(t *testing.T, doc bson.M) bson.M {
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		t.Fatalf("failed to encode document: %+q", err)
+	}
+
+	var stored bson.M
+	if err := bson.Unmarshal(data, &stored); err != nil {
+		t.Fatalf("failed to decode document: %+q", err)
+	}
+
+	return stored
+}
+
+func TestDocumentRoundTrip(t *testing.T) {
+	for _, elem := range fixtures.RandomShipments(5) {
+		stored := storeDocument(t, shipmentDocument(elem))
+
+		var decoded shipments.Shipment
+		if err := shipmentFromDocument(stored, &decoded); err != nil {
+			t.Fatalf("failed to read document: %+q", err)
+		}
+
+		if decoded.PublicID != elem.PublicID {
+			t.Fatalf("expected PublicID %#v, got %#v", elem.PublicID, decoded.PublicID)
+		}
+
+		if restored := storeDocument(t, shipmentDocument(decoded)); !reflect.DeepEqual(restored, stored) {
+			t.Fatalf("expected read record to store document %#v, got %#v", stored, restored)
+		}
+	}
+}
+
+func TestDocumentZero(t *testing.T) {
+	var elem shipments.Shipment
+	stored := storeDocument(t, shipmentDocument(elem))
+
+	var decoded shipments.Shipment
+	if err := shipmentFromDocument(stored, &decoded); err != nil {
+		t.Fatalf("failed to read document of zero record: %+q", err)
+	}
+
+	if restored := storeDocument(t, shipmentDocument(decoded)); !reflect.DeepEqual(restored, stored) {
+		t.Fatalf("expected read zero record to store document %#v, got %#v", stored, restored)
+	}
+}
@@ -0,0 +1,427 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/shipments.Shipment
+// Annotation: @mongoapi(GRPC => true)
+// Hash: sha256:75f775de3eb2c5aa257d1870d4db3ada8282f6778637baaaf8040f58931fc59a
+
+package shipmentmgo_test
+
+import (
+	"os"
+
+	"fmt"
+
+	"time"
+
+	"reflect"
+
+	"strings"
+
+	"context"
+
+	"math/rand"
+
+	"testing"
+
+	mgo "gopkg.in/mgo.v2"
+
+	"github.com/influx6/faux/metrics"
+
+	"github.com/influx6/faux/metrics/custom"
+
+	"github.com/gokit/mgokit/example/shipments"
+
+	mdb "github.com/gokit/mgokit/example/shipments/shipmentmgo"
+
+	fixtures "github.com/gokit/mgokit/example/shipments/shipmentmgo/fixtures"
+
+	testutil "github.com/gokit/mgokit/example/shipments/shipmentmgo/testutil"
+)
+
+var (
+	config = mdb.Config{
+		DB:       os.Getenv("MONGO_TEST_DB"),
+		Host:     os.Getenv("MONGO_TEST_HOST"),
+		User:     os.Getenv("MONGO_TEST_USER"),
+		AuthDB:   os.Getenv("MONGO_TEST_AUTHDB"),
+		Password: os.Getenv("MONGO_TEST_PASSWORD"),
+	}
+)
+
+// TestMain starts a local mongod through the testutil package when no
+// MONGO_TEST_HOST is set, tearing it down once all tests have run.
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+// runTests runs the package tests against the configured mongodb, starting a
+// local mongod found in PATH if none is configured.
+func runTests(m *testing.M) int {
+	if config.Host != "" {
+		return m.Run()
+	}
+
+	mongod, err := testutil.Start(testutil.Options{
+		ReplicaSet: os.Getenv("MONGO_TEST_REPLSET"),
+	})
+	if err != nil {
+		if err != testutil.ErrNoMongod {
+			fmt.Fprintf(os.Stderr, "failed to start local mongod: %s\n", err)
+		}
+		return m.Run()
+	}
+
+	defer mongod.Stop()
+
+	config.Host = mongod.Host
+	if config.DB == "" {
+		config.DB = "shipment_test_db"
+	}
+
+	return m.Run()
+}
+
+// testSession returns a session to the mongodb configured through the MONGO_TEST_*
+// environment variables, skipping the calling test if none is configured or the
+// configured host is unreachable.
+func testSession(t *testing.T) *mgo.Session {
+	if config.Host == "" || config.DB == "" {
+		t.Skip("MONGO_TEST_HOST and MONGO_TEST_DB are not set and no mongod found in PATH, skipping mongodb tests")
+	}
+
+	session, err := mgo.DialWithInfo(&mgo.DialInfo{
+		Addrs:    []string{config.Host},
+		Timeout:  2 * time.Second,
+		Database: config.AuthDB,
+		Username: config.User,
+		Password: config.Password,
+	})
+	if err != nil {
+		t.Skipf("mongodb at %q is unreachable, skipping mongodb tests: %s", config.Host, err)
+	}
+
+	return session
+}
+
+// testCollection returns a collection name unique to the calling test, so
+// parallel runs against the same database never collide.
+func testCollection(t *testing.T) string {
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	return fmt.Sprintf("shipment_%s_%d", strings.ToLower(name), time.Now().UnixNano())
+}
+
+// fixtureSource draws the records returned by loadFixture. It is seeded with
+// fixtures.DefaultSeed, so runs are reproducible while each call returns a new record.
+var fixtureSource = rand.New(rand.NewSource(fixtures.DefaultSeed))
+
+// loadFixture returns a new Shipment from the fixtures package, whose values
+// satisfy the validate rules of its fields and differ from those of all previous calls.
+func loadFixture(t *testing.T) shipments.Shipment {
+	return fixtures.RandomShipment(fixtureSource)
+}
+
+// sameRecord fails the test if the giving record read back from mongodb does not equal the
+// record stored, ignoring the timestamps set by the db, the _id assigned by mongodb to records
+// stored without one and the precision and location of times, which mongodb stores as UTC
+// milliseconds.
+func sameRecord(t *testing.T, want shipments.Shipment, got shipments.Shipment) {
+	normalize(reflect.ValueOf(&want).Elem())
+	normalize(reflect.ValueOf(&got).Elem())
+
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("expected Shipment record %#v, got %#v", want, got)
+	}
+}
+
+// normalize sets all times within the giving value to UTC milliseconds and all empty
+// slices and maps to nil, as they are read back from mongodb.
+func normalize(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if !value.IsNil() {
+			normalize(value.Elem())
+		}
+	case reflect.Struct:
+		if tm, ok := value.Interface().(time.Time); ok {
+			value.Set(reflect.ValueOf(tm.UTC().Truncate(time.Millisecond)))
+			return
+		}
+
+		for i := 0; i < value.NumField(); i++ {
+			if field := value.Field(i); field.CanSet() {
+				normalize(field)
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			normalize(value.Index(i))
+		}
+	case reflect.Slice:
+		if value.Len() == 0 {
+			value.Set(reflect.Zero(value.Type()))
+			return
+		}
+
+		for i := 0; i < value.Len(); i++ {
+			normalize(value.Index(i))
+		}
+	case reflect.Map:
+		if value.Len() == 0 {
+			value.Set(reflect.Zero(value.Type()))
+			return
+		}
+
+		for _, key := range value.MapKeys() {
+			item := reflect.New(value.Type().Elem()).Elem()
+			item.Set(value.MapIndex(key))
+			normalize(item)
+			value.SetMapIndex(key, item)
+		}
+	}
+}
+
+// TestShipmentDB validates the CRUD operations of the ShipmentDB
+// against a mongodb, where each subtest runs against its own collection.
+func TestShipmentDB(t *testing.T) {
+	session := testSession(t)
+	defer session.Close()
+
+	events := metrics.New()
+	if testing.Verbose() {
+		events = metrics.New(custom.StackDisplay(os.Stdout))
+	}
+
+	db := mdb.NewMongoDB(config)
+
+	t.Run("Get", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add Shipment record into db: %+q", err)
+		}
+
+		record, err := api.Get(ctx, elem.PublicID)
+		if err != nil {
+			t.Fatalf("failed to retrieve stored Shipment record from db: %+q", err)
+		}
+
+		sameRecord(t, elem, record)
+	})
+
+	t.Run("GetAll", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add Shipment record into db: %+q", err)
+		}
+
+		records, _, err := api.GetAll(ctx, "asc", "public_id", -1, -1)
+		if err != nil {
+			t.Fatalf("failed to retrieve all Shipment records from db: %+q", err)
+		}
+
+		if len(records) != 1 {
+			t.Fatalf("expected 1 Shipment record from db, got %d", len(records))
+		}
+
+		sameRecord(t, elem, records[0])
+	})
+
+	t.Run("GetAllByOrder", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add Shipment record into db: %+q", err)
+		}
+
+		records, err := api.GetAllByOrder(ctx, "asc", "public_id")
+		if err != nil {
+			t.Fatalf("failed to retrieve all Shipment records from db: %+q", err)
+		}
+
+		if len(records) == 0 {
+			t.Fatalf("expected atleast 1 Shipment record from db")
+		}
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add Shipment record into db: %+q", err)
+		}
+
+		total, err := api.Count(ctx)
+		if err != nil {
+			t.Fatalf("failed to count Shipment records in db: %+q", err)
+		}
+
+		if total != 1 {
+			t.Fatalf("expected 1 Shipment record in db, got %d", total)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add Shipment record into db: %+q", err)
+		}
+
+		elem2 := loadFixture(t)
+		elem2.PublicID = elem.PublicID
+
+		if reflect.DeepEqual(elem2, elem) {
+			t.Fatalf("expected updated Shipment record to differ from the stored one")
+		}
+
+		if err := api.Update(ctx, elem2.PublicID, elem2); err != nil {
+			t.Fatalf("failed to update Shipment record in db: %+q", err)
+		}
+
+		record, err := api.Get(ctx, elem.PublicID)
+		if err != nil {
+			t.Fatalf("failed to retrieve updated Shipment record from db: %+q", err)
+		}
+
+		sameRecord(t, elem2, record)
+	})
+
+	t.Run("Seed", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if _, err := fixtures.Seed(ctx, api, 20); err != nil {
+			t.Fatalf("failed to seed Shipment records into db: %+q", err)
+		}
+
+		records, total, err := api.GetAll(ctx, "asc", "public_id", 1, 10)
+		if err != nil {
+			t.Fatalf("failed to retrieve page of Shipment records from db: %+q", err)
+		}
+
+		if total != 20 {
+			t.Fatalf("expected 20 Shipment records in db, got %d", total)
+		}
+
+		if len(records) != 10 {
+			t.Fatalf("expected page of 10 Shipment records from db, got %d", len(records))
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		api := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		elem := loadFixture(t)
+		if err := api.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add Shipment record into db: %+q", err)
+		}
+
+		if err := api.Delete(ctx, elem.PublicID); err != nil {
+			t.Fatalf("failed to remove Shipment record from db: %+q", err)
+		}
+
+		if _, err := api.Get(ctx, elem.PublicID); err == nil {
+			t.Fatalf("expected deleted Shipment record to be missing from db")
+		}
+	})
+	t.Run("Hooks", func(t *testing.T) {
+		col := testCollection(t)
+		defer session.DB(config.DB).C(col).DropCollection()
+
+		store := mdb.New(col, events, db)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var stages []string
+		for stage := mdb.BeforeCreate; stage <= mdb.AfterLoad; stage++ {
+			stage := stage
+			store.AddHook(stage, func(ctx context.Context, elem *shipments.Shipment) error {
+				stages = append(stages, stage.String())
+				return nil
+			})
+		}
+
+		elem := loadFixture(t)
+		if err := store.Create(ctx, elem); err != nil {
+			t.Fatalf("failed to add Shipment record into db: %+q", err)
+		}
+
+		if err := store.Update(ctx, elem.PublicID, elem); err != nil {
+			t.Fatalf("failed to update Shipment record in db: %+q", err)
+		}
+
+		if err := store.Delete(ctx, elem.PublicID); err != nil {
+			t.Fatalf("failed to remove Shipment record from db: %+q", err)
+		}
+
+		expected := "BeforeCreate,AfterCreate,BeforeUpdate,AfterUpdate,AfterLoad,BeforeDelete,AfterDelete"
+		if got := strings.Join(stages, ","); got != expected {
+			t.Fatalf("expected hooks to run at %s, got %s", expected, got)
+		}
+
+		failing := mdb.New(col, events, db)
+		failing.AddHook(mdb.BeforeCreate, func(ctx context.Context, elem *shipments.Shipment) error {
+			return fmt.Errorf("rejected")
+		})
+
+		if err := failing.Create(ctx, loadFixture(t)); err == nil || err.Error() != "rejected" {
+			t.Fatalf("expected failing BeforeCreate hook to reject record, got %+q", err)
+		}
+
+		total, err := store.Count(ctx)
+		if err != nil {
+			t.Fatalf("failed to count Shipment records in db: %+q", err)
+		}
+
+		if total != 0 {
+			t.Fatalf("expected rejected Shipment record to be missing from db, got %d records", total)
+		}
+	})
+}
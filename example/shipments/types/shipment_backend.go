@@ -0,0 +1,26 @@
+// Code generated by mgokit. DO NOT EDIT.
+// Source: github.com/gokit/mgokit/example/shipments.Shipment
+// Annotation: @mongoapi(GRPC => true)
+// Hash: sha256:83e64f75dc5cfaded6dfec5be8c04bdd0f25baa8ccd588c3a0819927fedeefd7
+
+package types
+
+import (
+	"context"
+
+	"github.com/gokit/mgokit/example/shipments"
+)
+
+// ShipmentDBBackend defines a backend which represents the giving
+// methods exposed by the DB implementation for the giving type Shipment.
+// @implement_mock
+type ShipmentDBBackend interface {
+	Count(ctx context.Context) (int, error)
+	Delete(ctx context.Context, publicID string) error
+	Create(ctx context.Context, elem shipments.Shipment) error
+	Get(ctx context.Context, publicID string) (shipments.Shipment, error)
+	Update(ctx context.Context, publicID string, elem shipments.Shipment) error
+	GetAllByOrder(ctx context.Context, order string, orderBy string) ([]shipments.Shipment, error)
+	GetByField(ctx context.Context, key string, value interface{}) (shipments.Shipment, error)
+	GetAll(ctx context.Context, order string, orderBy string, page int, responsePerPage int) ([]shipments.Shipment, int, error)
+}
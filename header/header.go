@@ -0,0 +1,164 @@
+// Package header writes and reads the header of generated go files, which marks them as
+// generated and records where they were generated from.
+package header
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/influx6/moz/ast"
+)
+
+// Generated contains the line marking go files as generated, as recognised by go tools.
+const Generated = "// Code generated by mgokit. DO NOT EDIT."
+
+// contains the prefixes of the provenance lines following Generated.
+const (
+	sourcePrefix     = "// Source: "
+	annotationPrefix = "// Annotation: "
+	hashPrefix       = "// Hash: "
+)
+
+// Header defines the provenance of a generated file.
+type Header struct {
+	// Source sets the import path of the package, or package and struct name, e.g
+	// "github.com/example/models.User", the file was generated from.
+	Source string
+
+	// Annotation sets the annotation with its params the file was generated for, e.g
+	// "@mongoapi(KeyField => ID)".
+	Annotation string
+
+	// Hash sets the hash of the content of the file following the header.
+	Hash string
+}
+
+// New returns a Header for a file generated from source for the giving annotation.
+func New(source string, an ast.AnnotationDeclaration) Header {
+	return Header{Source: source, Annotation: Annotation(an)}
+}
+
+// Annotation returns the giving annotation with its params sorted by name, e.g
+// "@mongoapi(Dir => store, KeyField => ID)".
+func Annotation(an ast.AnnotationDeclaration) string {
+	if len(an.Params) == 0 {
+		return an.Name
+	}
+
+	params := make([]string, 0, len(an.Params))
+	for name, value := range an.Params {
+		params = append(params, fmt.Sprintf("%s => %s", name, value))
+	}
+
+	sort.Strings(params)
+	return fmt.Sprintf("%s(%s)", an.Name, strings.Join(params, ", "))
+}
+
+// Key returns the key identifying the target of the giving annotation name, e.g "@mongoapi",
+// found on the giving source, e.g "@mongoapi github.com/example/models.User".
+func Key(annotation string, source string) string {
+	return annotation + " " + source
+}
+
+// Key returns the key identifying the target the file was generated for.
+func (h Header) Key() string {
+	name := h.Annotation
+	if index := strings.Index(name, "("); index != -1 {
+		name = name[:index]
+	}
+
+	return Key(name, h.Source)
+}
+
+// Sum returns the hash of the giving content as recorded within a Header.
+func Sum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// String returns the Header as the lines of comments written at the start of a file.
+func (h Header) String() string {
+	var lines bytes.Buffer
+	lines.WriteString(Generated + "\n")
+	lines.WriteString(sourcePrefix + h.Source + "\n")
+	lines.WriteString(annotationPrefix + h.Annotation + "\n")
+	lines.WriteString(hashPrefix + h.Hash + "\n")
+	return lines.String()
+}
+
+// Wrap returns a io.WriterTo which writes the Header, with the hash of the content of w,
+// followed by the content of w.
+func (h Header) Wrap(w io.WriterTo) io.WriterTo {
+	return writerTo{header: h, content: w}
+}
+
+type writerTo struct {
+	header  Header
+	content io.WriterTo
+}
+
+// WriteTo writes the header followed by the content into the giving writer.
+func (w writerTo) WriteTo(dest io.Writer) (int64, error) {
+	var content bytes.Buffer
+	if _, err := w.content.WriteTo(&content); err != nil {
+		return 0, err
+	}
+
+	w.header.Hash = Sum(content.Bytes())
+
+	var out bytes.Buffer
+	out.WriteString(w.header.String())
+	out.WriteString("\n")
+	out.Write(content.Bytes())
+
+	return out.WriteTo(dest)
+}
+
+// Read returns the Header at the start of the giving content with the content following
+// it. It returns false if content does not start with a Header.
+func Read(content []byte) (Header, []byte, bool) {
+	var h Header
+
+	reader := bufio.NewReader(bytes.NewReader(content))
+
+	var read int
+	for index := 0; ; index++ {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return h, content, false
+		}
+
+		read += len(line)
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case index == 0 && line != Generated:
+			return h, content, false
+		case index == 0:
+		case strings.HasPrefix(line, sourcePrefix):
+			h.Source = strings.TrimPrefix(line, sourcePrefix)
+		case strings.HasPrefix(line, annotationPrefix):
+			h.Annotation = strings.TrimPrefix(line, annotationPrefix)
+		case strings.HasPrefix(line, hashPrefix):
+			h.Hash = strings.TrimPrefix(line, hashPrefix)
+		case line == "":
+			return h, content[read:], true
+		default:
+			return h, content, false
+		}
+	}
+}
+
+// Edited returns true/false if the giving generated content was changed since it was
+// generated, i.e. its content no longer matches the hash of its Header. Content without
+// a Header was not generated by mgokit, hence is never reported as edited.
+func Edited(content []byte) bool {
+	h, body, ok := Read(content)
+	return ok && h.Hash != Sum(body)
+}
@@ -0,0 +1,58 @@
+package header_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gokit/mgokit/header"
+	"github.com/influx6/moz/ast"
+)
+
+func TestWrapAndRead(t *testing.T) {
+	an := ast.AnnotationDeclaration{
+		Name:   "@mongoapi",
+		Params: map[string]string{"KeyField": "ID", "Dir": "store"},
+	}
+
+	h := header.New("github.com/example/models.User", an)
+	if h.Annotation != "@mongoapi(Dir => store, KeyField => ID)" {
+		t.Fatalf("Should have sorted annotation params: %q", h.Annotation)
+	}
+
+	content := "package usermgo\n"
+
+	var out bytes.Buffer
+	if _, err := h.Wrap(strings.NewReader(content)).WriteTo(&out); err != nil {
+		t.Fatalf("Should have written content: %+q", err)
+	}
+
+	if !strings.HasPrefix(out.String(), header.Generated+"\n") {
+		t.Fatalf("Should have started with generated line: %q", out.String())
+	}
+
+	read, body, ok := header.Read(out.Bytes())
+	if !ok {
+		t.Fatalf("Should have read header: %q", out.String())
+	}
+
+	if string(body) != content {
+		t.Fatalf("Should have returned content after header: %q", body)
+	}
+
+	if read.Source != h.Source || read.Annotation != h.Annotation || read.Hash != header.Sum([]byte(content)) {
+		t.Fatalf("Should have read provenance: %#v", read)
+	}
+
+	if header.Edited(out.Bytes()) {
+		t.Fatal("Should not have reported unchanged content as edited")
+	}
+
+	if !header.Edited(append(out.Bytes(), "// edit\n"...)) {
+		t.Fatal("Should have reported changed content as edited")
+	}
+
+	if header.Edited([]byte(content)) {
+		t.Fatal("Should not have reported content without header as edited")
+	}
+}
@@ -1,13 +1,30 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/gokit/mgokit/cache"
+	"github.com/gokit/mgokit/clean"
+	"github.com/gokit/mgokit/config"
 	"github.com/gokit/mgokit/mgo"
+	"github.com/gokit/mgokit/migrate"
+	"github.com/gokit/mgokit/plan"
+	"github.com/gokit/mgokit/static"
+	"github.com/gokit/mgokit/watch"
 	"github.com/influx6/faux/flags"
 	"github.com/influx6/faux/metrics"
 	"github.com/influx6/faux/metrics/custom"
+	"github.com/influx6/gobuild/build"
+	"github.com/influx6/gobuild/srcpath"
 	"github.com/influx6/moz/ast"
 )
 
@@ -17,39 +34,114 @@ func main() {
 		ShortDesc: "Generates mongo CRUD packages for structs",
 		Desc:      "Generates from go packages to create CRUD implementations for types using mongodb",
 		Action: func(ctx flags.Context) error {
-			force, _ := ctx.GetBool("force")
-			dest, _ := ctx.GetString("dest")
-			target, _ := ctx.GetString("target")
-			verbose, _ := ctx.GetBool("verbose")
-
-			logs := metrics.New()
+			check, _ := ctx.GetBool("check")
+			diff, _ := ctx.GetBool("diff")
+			dryRun, _ := ctx.GetBool("dry-run")
+			noCache, _ := ctx.GetBool("no-cache")
 
-			if verbose {
-				logs = metrics.New(custom.StackDisplay(os.Stderr))
+			set, err := loadSettings(ctx)
+			if err != nil {
+				return err
 			}
 
-			currentdir, err := os.Getwd()
+			res, err := ast.ParseAnnotations(set.logs, set.target)
 			if err != nil {
 				return err
 			}
 
-			if !filepath.IsAbs(dest) {
-				dest = filepath.Join(currentdir, dest)
+			if err := (mgo.Generator{Config: set.conf}).Validate(res...); err != nil {
+				reportProblems(err)
 			}
 
-			currentdir = filepath.Join(currentdir, target)
+			if check || diff || dryRun {
+				err := review(os.Stdout, os.Stderr, set.dest, registry(set.logs, set.conf, nil), set.force, dryRun, diff, check, res...)
+				if stale, ok := err.(outOfDate); ok {
+					fmt.Fprintln(os.Stderr, stale)
+					os.Exit(1)
+				}
 
-			generators := ast.NewAnnotationRegistryWith(logs)
-			generators.Register("mongo", mgo.MongoSolo)
-			generators.Register("mongoapi", mgo.MongoGen)
-			generators.Register("mongo_methods", mgo.MongoFuncGen)
+				return err
+			}
+
+			if noCache {
+				return ast.SimplyParse(set.dest, set.logs, registry(set.logs, set.conf, nil), set.force, res...)
+			}
+
+			return generate(set, res...)
+		},
+		Flags: []flags.Flag{
+			&flags.BoolFlag{
+				Name: "verbose",
+				Desc: "verbose logs all operations out to console.",
+			},
+			&flags.BoolFlag{
+				Name: "force",
+				Desc: "force regeneration of packages annotation directives.",
+			},
+			&flags.BoolFlag{
+				Name: "check",
+				Desc: "check reports generated files which are out of date without writing them, exiting with a non-zero status if any are found.",
+			},
+			&flags.BoolFlag{
+				Name: "dry-run",
+				Desc: "dry-run prints the files to be generated with their create/overwrite/unchanged/skip status without writing them.",
+			},
+			&flags.BoolFlag{
+				Name: "diff",
+				Desc: "diff prints a unified diff for every generated file to be created or overwritten without writing them.",
+			},
+			&flags.BoolFlag{
+				Name: "no-cache",
+				Desc: "no-cache regenerates all packages without reading or writing the generation cache.",
+			},
+			&flags.StringFlag{
+				Name: "config",
+				Desc: "path of the mgokit.toml or mgokit.yaml project file, else one is searched for from the current directory upwards.",
+			},
+			&flags.StringFlag{
+				Name: "templates",
+				Desc: "directory of files named like the bundled templates, e.g mongo-api.tml, used instead of them.",
+			},
+			&flags.StringFlag{
+				Name:    "dest",
+				Default: "./",
+				Desc:    "relative destination for package",
+			},
+			&flags.StringFlag{
+				Name:    "target",
+				Default: "./",
+				Desc:    "-target=./ defines relative path of target for code gen",
+			},
+		},
+	}, flags.Command{
+		Name:      "watch",
+		ShortDesc: "Regenerates mongo CRUD packages when annotated sources change",
+		Desc:      "Watches the target directory for changes to go files, regenerating the packages of changed directories",
+		Action: func(ctx flags.Context) error {
+			interval, _ := ctx.GetDuration("interval")
 
-			res, err := ast.ParseAnnotations(logs, currentdir)
+			set, err := loadSettings(ctx)
 			if err != nil {
 				return err
 			}
 
-			return ast.SimplyParse(dest, logs, generators, force, res...)
+			generators := registry(set.logs, set.conf, nil)
+
+			fmt.Fprintf(os.Stdout, "Watching %q for changes\n", set.target)
+
+			return watch.Watch(ctx, set.target, interval, func(dirs []string) error {
+				for _, dir := range dirs {
+					if err := regenerate(dir, set.dest, set.logs, set.conf, generators, set.force); err != nil {
+						fmt.Fprintf(os.Stderr, "Failed to regenerate %q: %+s\n", dir, err)
+						continue
+					}
+
+					fmt.Fprintf(os.Stdout, "Regenerated %q\n", dir)
+				}
+				return nil
+			}, func(err error) {
+				fmt.Fprintf(os.Stderr, "Failed to watch %q: %+s\n", set.target, err)
+			})
 		},
 		Flags: []flags.Flag{
 			&flags.BoolFlag{
@@ -60,6 +152,57 @@ func main() {
 				Name: "force",
 				Desc: "force regeneration of packages annotation directives.",
 			},
+			&flags.DurationFlag{
+				Name:    "interval",
+				Default: time.Second,
+				Desc:    "interval between checks of the target directory for changes.",
+			},
+			&flags.StringFlag{
+				Name: "config",
+				Desc: "path of the mgokit.toml or mgokit.yaml project file, else one is searched for from the current directory upwards.",
+			},
+			&flags.StringFlag{
+				Name: "templates",
+				Desc: "directory of files named like the bundled templates, e.g mongo-api.tml, used instead of them.",
+			},
+			&flags.StringFlag{
+				Name:    "dest",
+				Default: "./",
+				Desc:    "relative destination for package",
+			},
+			&flags.StringFlag{
+				Name:    "target",
+				Default: "./",
+				Desc:    "-target=./ defines relative path of target for code gen",
+			},
+		},
+	}, flags.Command{
+		Name:      "clean",
+		ShortDesc: "Removes generated files whose annotated source no longer exists",
+		Desc:      "Removes generated files of structs and packages within the target which were renamed, removed or lost their annotation",
+		Action: func(ctx flags.Context) error {
+			yes, _ := ctx.GetBool("yes")
+
+			set, err := loadSettings(ctx)
+			if err != nil {
+				return err
+			}
+
+			return cleanOrphans(set, yes)
+		},
+		Flags: []flags.Flag{
+			&flags.BoolFlag{
+				Name: "verbose",
+				Desc: "verbose logs all operations out to console.",
+			},
+			&flags.BoolFlag{
+				Name: "yes",
+				Desc: "yes removes the orphaned files without asking for confirmation.",
+			},
+			&flags.StringFlag{
+				Name: "config",
+				Desc: "path of the mgokit.toml or mgokit.yaml project file, else one is searched for from the current directory upwards.",
+			},
 			&flags.StringFlag{
 				Name:    "dest",
 				Default: "./",
@@ -71,5 +214,515 @@ func main() {
 				Desc:    "-target=./ defines relative path of target for code gen",
 			},
 		},
+	}, flags.Command{
+		Name:      "list",
+		ShortDesc: "Lists annotated structs and packages",
+		Desc:      "Lists all structs and packages within the target annotated for generation without generating them",
+		Action: func(ctx flags.Context) error {
+			asJSON, _ := ctx.GetBool("json")
+
+			set, err := loadSettings(ctx)
+			if err != nil {
+				return err
+			}
+
+			return inspect(os.Stdout, set, false, asJSON)
+		},
+		Flags: []flags.Flag{
+			&flags.BoolFlag{
+				Name: "json",
+				Desc: "json prints the annotated structs and packages as a JSON array.",
+			},
+			&flags.StringFlag{
+				Name: "config",
+				Desc: "path of the mgokit.toml or mgokit.yaml project file, else one is searched for from the current directory upwards.",
+			},
+			&flags.StringFlag{
+				Name:    "target",
+				Default: "./",
+				Desc:    "-target=./ defines relative path of target for code gen",
+			},
+		},
+	}, flags.Command{
+		Name:      "inspect",
+		ShortDesc: "Shows the resolved settings of annotated structs",
+		Desc:      "Shows the package, key field, environment name, field to bson name mapping and detected hooks resolved for every annotated struct within the target without generating them",
+		Action: func(ctx flags.Context) error {
+			asJSON, _ := ctx.GetBool("json")
+
+			set, err := loadSettings(ctx)
+			if err != nil {
+				return err
+			}
+
+			return inspect(os.Stdout, set, true, asJSON)
+		},
+		Flags: []flags.Flag{
+			&flags.BoolFlag{
+				Name: "json",
+				Desc: "json prints the annotated structs and packages as a JSON array.",
+			},
+			&flags.StringFlag{
+				Name: "config",
+				Desc: "path of the mgokit.toml or mgokit.yaml project file, else one is searched for from the current directory upwards.",
+			},
+			&flags.StringFlag{
+				Name:    "target",
+				Default: "./",
+				Desc:    "-target=./ defines relative path of target for code gen",
+			},
+		},
+	}, flags.Command{
+		Name:      "migrate",
+		ShortDesc: "Creates and runs migrations of stored documents",
+		Desc:      "Creates migration files within the migrations package generated for a package annotated with @mongo with 'migrate new <name>', and applies, reverts or lists them with 'migrate up', 'migrate down [steps]' and 'migrate status'",
+		Usages:    []string{"mgokit migrate new split_name", "mgokit migrate up", "mgokit migrate down 1", "mgokit migrate status"},
+		Action: func(ctx flags.Context) error {
+			dir, _ := ctx.GetString("dir")
+
+			args := ctx.Args()
+			if len(args) == 0 {
+				ctx.PrintHelp()
+				return nil
+			}
+
+			set, err := loadSettings(ctx)
+			if err != nil {
+				return err
+			}
+
+			if dir == "" {
+				dir = migrate.Dir(set.dest)
+			} else if !filepath.IsAbs(dir) {
+				currentdir, err := os.Getwd()
+				if err != nil {
+					return err
+				}
+				dir = filepath.Join(currentdir, dir)
+			}
+
+			switch args[0] {
+			case "new":
+				if len(args) != 2 {
+					return fmt.Errorf("Expected the name of the migration, e.g 'mgokit migrate new split_name'")
+				}
+
+				path, err := migrate.New(dir, args[1], time.Now())
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(os.Stdout, "Created migration %q\n", path)
+				return nil
+			case "up", "down", "status":
+				if err := migrate.Run(dir, args, os.Stdout, os.Stderr); err != nil {
+					os.Exit(1)
+				}
+				return nil
+			}
+
+			return fmt.Errorf("Unknown migrate command %+q, expected new, up, down or status", args[0])
+		},
+		Flags: []flags.Flag{
+			&flags.StringFlag{
+				Name: "config",
+				Desc: "path of the mgokit.toml or mgokit.yaml project file, else one is searched for from the current directory upwards.",
+			},
+			&flags.StringFlag{
+				Name: "dir",
+				Desc: "directory of the generated migrations package, defaults to mdb/migrations within the destination.",
+			},
+			&flags.StringFlag{
+				Name:    "dest",
+				Default: "./",
+				Desc:    "relative destination for package",
+			},
+		},
+	}, flags.Command{
+		Name:      "templates",
+		ShortDesc: "Manages the templates used to generate packages",
+		Desc:      "Exports the bundled templates into a directory with 'templates export', to be changed and used through the templates flag",
+		Usages:    []string{"mgokit -templates.dir=./templates templates export"},
+		Action: func(ctx flags.Context) error {
+			force, _ := ctx.GetBool("force")
+			dir, _ := ctx.GetString("dir")
+
+			args := ctx.Args()
+			if len(args) == 0 || args[0] != "export" {
+				ctx.PrintHelp()
+				return nil
+			}
+
+			return exportTemplates(dir, force)
+		},
+		Flags: []flags.Flag{
+			&flags.BoolFlag{
+				Name: "force",
+				Desc: "force overwriting existing template files.",
+			},
+			&flags.StringFlag{
+				Name:    "dir",
+				Default: "./templates",
+				Desc:    "directory the bundled templates are exported into.",
+			},
+		},
+	})
+}
+
+// exportTemplates writes all bundled templates into dir, skipping existing files unless
+// force is true.
+func exportTemplates(dir string, force bool) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for _, name := range mgo.TemplateNames() {
+		path := filepath.Join(dir, name)
+
+		if _, err := os.Stat(path); err == nil && !force {
+			fmt.Fprintf(os.Stdout, "Skipping existing template %q\n", path)
+			continue
+		}
+
+		if err := ioutil.WriteFile(path, []byte(static.MustReadFile(name, true)), 0644); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stdout, "Exported template %q\n", path)
+	}
+
+	return nil
+}
+
+// cleanOrphans lists the generated files within the destination whose annotated source
+// within the target no longer exists, removing them once confirmed or if yes is true.
+func cleanOrphans(set settings, yes bool) error {
+	scope, err := srcpath.RelativeToSrc(set.target)
+	if err != nil {
+		return fmt.Errorf("Target path is not within current GOPATH: %+q", err.Error())
+	}
+
+	res, err := ast.ParseAnnotations(set.logs, set.target)
+	if err != nil {
+		return err
+	}
+
+	cachePath := filepath.Join(set.dest, cache.FileName)
+
+	cached, err := cache.Read(cachePath, mgo.Version)
+	if err != nil {
+		return err
+	}
+
+	live := clean.Live(res...)
+
+	orphans, err := clean.Orphans(set.dest, filepath.ToSlash(scope), live, cached)
+	if err != nil {
+		return err
+	}
+
+	if len(orphans) == 0 {
+		fmt.Fprintln(os.Stdout, "No orphaned generated files found")
+		return nil
+	}
+
+	for _, file := range orphans {
+		fmt.Fprintf(os.Stdout, "%s\n", file)
+	}
+
+	if !yes {
+		fmt.Fprintf(os.Stdout, "Remove %d orphaned files? [y/N]: ", len(orphans))
+
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+			fmt.Fprintln(os.Stdout, "Nothing removed")
+			return nil
+		}
+	}
+
+	if err := clean.Remove(set.dest, orphans); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(cachePath); err == nil {
+		clean.Prune(cached, filepath.ToSlash(scope), live)
+		if err := cached.Write(cachePath); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(os.Stdout, "Removed %d orphaned files\n", len(orphans))
+	return nil
+}
+
+// inspect prints all annotated structs and packages within the target into out with their
+// resolved settings if full is true, else a single line for each, or as JSON if asJSON is true.
+func inspect(out io.Writer, set settings, full bool, asJSON bool) error {
+	res, err := ast.ParseAnnotations(set.logs, set.target)
+	if err != nil {
+		return err
+	}
+
+	inspections := mgo.Generator{Config: set.conf}.Inspect(res...)
+
+	if asJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "\t")
+		return encoder.Encode(inspections)
+	}
+
+	for _, ins := range inspections {
+		if full {
+			fmt.Fprintln(out, ins)
+			continue
+		}
+
+		name := ins.Path
+		if ins.Struct != "" {
+			name = ins.Path + "." + ins.Struct
+		}
+
+		fmt.Fprintf(out, "%-50s %s\n", name, ins.Annotation)
+	}
+
+	return nil
+}
+
+// settings defines the options shared by all commands.
+type settings struct {
+	dest   string
+	target string
+	force  bool
+	logs   metrics.Metrics
+	conf   config.Config
+}
+
+// loadSettings returns the settings from the flags of the giving context, merged with the
+// project file. Flags left at their defaults take their values from the project file.
+func loadSettings(ctx flags.Context) (settings, error) {
+	var set settings
+
+	force, _ := ctx.GetBool("force")
+	dest, _ := ctx.GetString("dest")
+	target, _ := ctx.GetString("target")
+	verbose, _ := ctx.GetBool("verbose")
+	configPath, _ := ctx.GetString("config")
+	templates, _ := ctx.GetString("templates")
+
+	set.logs = metrics.New()
+
+	if verbose {
+		set.logs = metrics.New(custom.StackDisplay(os.Stderr))
+	}
+
+	currentdir, err := os.Getwd()
+	if err != nil {
+		return set, err
+	}
+
+	if configPath != "" {
+		set.conf, err = config.Read(configPath)
+	} else {
+		set.conf, err = config.Load(currentdir)
+	}
+
+	if err != nil {
+		return set, err
+	}
+
+	set.force = force || set.conf.Force
+	set.dest = filepath.Join(currentdir, dest)
+	set.target = filepath.Join(currentdir, target)
+
+	if filepath.IsAbs(dest) {
+		set.dest = dest
+	} else if dest == "./" && set.conf.Dest != "" {
+		set.dest = set.conf.Dest
+	}
+
+	if target == "./" && set.conf.Target != "" {
+		set.target = set.conf.Target
+	}
+
+	if templates != "" {
+		set.conf.TemplatesDir = templates
+		if !filepath.IsAbs(templates) {
+			set.conf.TemplatesDir = filepath.Join(currentdir, templates)
+		}
+	}
+
+	if set.conf.TemplatesDir != "" {
+		if _, err := os.Stat(set.conf.TemplatesDir); err != nil {
+			return set, fmt.Errorf("Templates directory %+q is not accessible: %+q", set.conf.TemplatesDir, err)
+		}
+
+		if err := mgo.ValidateTemplatesDir(set.conf.TemplatesDir); err != nil {
+			return set, err
+		}
+	}
+
+	return set, nil
+}
+
+// registry returns a new ast.AnnotationRegistry with all mgokit generators registered,
+// using the giving project Config. If runner is not nil, generators are run through it.
+func registry(logs metrics.Metrics, conf config.Config, runner *cache.Runner) *ast.AnnotationRegistry {
+	gens := mgo.Generator{Config: conf}
+
+	generators := ast.NewAnnotationRegistryWith(logs)
+
+	if runner != nil {
+		generators.Register("mongo", runner.Package(gens.PackageHash, gens.MongoSolo))
+		generators.Register("mongoapi", runner.Struct(gens.StructHash, gens.MongoGen))
+		generators.Register("mongo_methods", runner.Struct(gens.StructHash, gens.MongoFuncGen))
+		generators.Register("mongo_fields", runner.Struct(gens.StructHash, gens.MongoFieldsGen))
+		return generators
+	}
+
+	generators.Register("mongo", gens.MongoSolo)
+	generators.Register("mongoapi", gens.MongoGen)
+	generators.Register("mongo_methods", gens.MongoFuncGen)
+	generators.Register("mongo_fields", gens.MongoFieldsGen)
+	return generators
+}
+
+// generate generates all annotated packages into the destination, skipping those whose
+// inputs did not change since they were last generated according to the cache file within
+// the destination. It prints a summary, exiting with a non-zero status if any failed.
+func generate(set settings, pkgs ...ast.Package) error {
+	cachePath := filepath.Join(set.dest, cache.FileName)
+
+	cached, err := cache.Read(cachePath, mgo.Version)
+	if err != nil {
+		return err
+	}
+
+	runner := &cache.Runner{Dest: set.dest, Force: set.force, Cache: cached}
+	if err := ast.SimplyParse(set.dest, set.logs, registry(set.logs, set.conf, runner), set.force, pkgs...); err != nil {
+		return err
+	}
+
+	if err := runner.Cache.Write(cachePath); err != nil {
+		return err
+	}
+
+	summary := runner.Summary()
+	for _, err := range summary.Errors {
+		fmt.Fprintf(os.Stderr, "Failed to generate %s\n", err)
+	}
+
+	fmt.Fprintf(os.Stdout, "Packages: %s\n", summary)
+
+	if summary.Failed != 0 {
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+// regenerate parses the package within dir afresh, generating its annotated outputs into dest.
+// ast.ParseAnnotations caches every directory it parses, hence the package is read through
+// ast.FilteredPackageWithBuildCtx, which always parses the directory again.
+func regenerate(dir string, dest string, logs metrics.Metrics, conf config.Config, generators *ast.AnnotationRegistry, force bool) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+
+	res, err := ast.FilteredPackageWithBuildCtx(logs, dir, build.Default)
+	if err != nil {
+		if _, ok := err.(*build.NoGoError); ok {
+			return nil
+		}
+		return err
+	}
+
+	if err := (mgo.Generator{Config: conf}).Validate(res...); err != nil {
+		return err
+	}
+
+	return ast.SimplyParse(dest, logs, generators, force, res...)
+}
+
+// reportProblems prints the giving problems found with annotated structs, one per line,
+// and exits with a non-zero status.
+func reportProblems(err error) {
+	problems, ok := err.(mgo.Problems)
+	if !ok {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	for _, problem := range problems {
+		fmt.Fprintln(os.Stderr, problem)
+	}
+
+	fmt.Fprintf(os.Stderr, "%d problems found in annotated structs\n", len(problems))
+	os.Exit(1)
+}
+
+// outOfDate defines the error returned by review when checked files are out of date, holding
+// their count. The generate command exits with a non-zero status on it.
+type outOfDate int
+
+// Error implements the error interface.
+func (o outOfDate) Error() string {
+	return fmt.Sprintf("%d generated files are out of date", int(o))
+}
+
+// review renders all generated files into memory without writing them, printing the planned
+// file tree into out if dryRun is true and a diff of each file to be changed if diff or check
+// is true. If check is true it returns an outOfDate error when any file is out of date, naming
+// the edited ones into errOut.
+func review(out io.Writer, errOut io.Writer, dest string, generators *ast.AnnotationRegistry, force bool, dryRun bool, diff bool, check bool, pkgs ...ast.Package) error {
+	files, err := plan.Build(dest, generators, force, pkgs...)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Rel < files[j].Rel
 	})
+
+	if dryRun {
+		for _, file := range files {
+			if file.Edited {
+				fmt.Fprintf(out, "%-10s %s (edited since generated)\n", file.Status, file.Rel)
+				continue
+			}
+
+			fmt.Fprintf(out, "%-10s %s\n", file.Status, file.Rel)
+		}
+	}
+
+	var stale int
+	for _, file := range files {
+		if !file.Stale() {
+			continue
+		}
+
+		stale++
+
+		if !diff && !check {
+			continue
+		}
+
+		fromName := "a/" + file.Rel
+		if file.Status == plan.Create {
+			fromName = "/dev/null"
+		}
+
+		fmt.Fprint(out, plan.Unified(fromName, "b/"+file.Rel, file.Existing, file.Content))
+	}
+
+	if !check || stale == 0 {
+		return nil
+	}
+
+	for _, file := range files {
+		if file.Edited && file.Stale() {
+			fmt.Fprintf(errOut, "%s was edited since it was generated\n", file.Rel)
+		}
+	}
+
+	return outOfDate(stale)
 }
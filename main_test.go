@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/influx6/faux/metrics"
+	"github.com/influx6/gobuild/srcpath"
+	"github.com/influx6/moz/ast"
+	"github.com/influx6/moz/gen"
+)
+
+func TestReviewCheck(t *testing.T) {
+	if os.Getenv("GOPATH") == "" {
+		t.Skip("destinations are resolved through GOPATH, which is not set")
+	}
+
+	dest, err := ioutil.TempDir(srcpath.SrcPath(), "mgokit-review")
+	if err != nil {
+		t.Fatalf("Should have created temporary directory: %+q", err)
+	}
+	defer os.RemoveAll(dest)
+
+	registry := ast.NewAnnotationRegistry()
+	registry.RegisterPackage("@mongo", func(string, ast.AnnotationDeclaration, ast.PackageDeclaration, ast.Package) ([]gen.WriteDirective, error) {
+		return []gen.WriteDirective{
+			{Writer: strings.NewReader("package mdb\n"), FileName: "mdb.go", Dir: "mdb"},
+		}, nil
+	})
+
+	pkg := ast.Package{
+		Packages: []ast.PackageDeclaration{
+			{Package: "models", Annotations: []ast.AnnotationDeclaration{{Name: "@mongo"}}},
+		},
+	}
+
+	var out, errOut bytes.Buffer
+	err = review(&out, &errOut, dest, registry, false, false, false, true, pkg)
+	if stale, ok := err.(outOfDate); !ok || stale != 1 {
+		t.Fatalf("Should have failed check with 1 out of date file, got %+q", err)
+	}
+
+	if err.Error() != "1 generated files are out of date" {
+		t.Fatalf("Should have reported count of out of date files, got %q", err.Error())
+	}
+
+	if !strings.Contains(out.String(), "--- /dev/null\n+++ b/mdb/mdb.go\n") {
+		t.Fatalf("Should have printed diff of created file, got %q", out.String())
+	}
+
+	if err := os.MkdirAll(filepath.Join(dest, "mdb"), 0755); err != nil {
+		t.Fatalf("Should have created destination directory: %+q", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dest, "mdb", "mdb.go"), []byte("package mdb\n"), 0644); err != nil {
+		t.Fatalf("Should have written generated file: %+q", err)
+	}
+
+	out.Reset()
+	if err := review(&out, &errOut, dest, registry, false, false, false, true, pkg); err != nil {
+		t.Fatalf("Should have passed check with up to date files: %+q", err)
+	}
+
+	if out.Len() != 0 || errOut.Len() != 0 {
+		t.Fatalf("Should have printed nothing for up to date files, got %q and %q", out.String(), errOut.String())
+	}
+}
+
+func TestInspectEmptyJSON(t *testing.T) {
+	target, err := filepath.Abs(filepath.Join("mgo", "testdata", "nested", "common"))
+	if err != nil {
+		t.Fatalf("Should have resolved target directory: %+q", err)
+	}
+
+	var out bytes.Buffer
+	if err := inspect(&out, settings{target: target, logs: metrics.New()}, false, true); err != nil {
+		t.Fatalf("Should have inspected package without annotations: %+q", err)
+	}
+
+	if out.String() != "[]\n" {
+		t.Fatalf("Should have printed an empty JSON list, got %q", out.String())
+	}
+}
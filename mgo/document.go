@@ -0,0 +1,596 @@
+package mgo
+
+import (
+	"bytes"
+	"fmt"
+	goast "go/ast"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/influx6/moz/ast"
+)
+
+// document defines the generated function which converts a struct into the bson.M document
+// stored for it.
+type document struct {
+	// Name sets the name of the generated function, which takes the struct and returns
+	// its bson.M document.
+	Name string
+
+	// Source sets the source of the generated function and any helper it uses.
+	Source string
+
+	// Reflect is true if Source uses the reflect package.
+	Reflect bool
+}
+
+// fieldTag defines the storage options of a struct field, read from its bson tag, else
+// its json tag.
+type fieldTag struct {
+	Name      string
+	Named     bool
+	OmitEmpty bool
+	Inline    bool
+	Skip      bool
+}
+
+// omitsEmpty returns true/false if the field of the giving tag and type is left out of
+// documents when zero. Besides fields with omitempty, this holds for bson.ObjectId fields, as
+// a zero bson.ObjectId can not be encoded and mongodb assigns the _id of documents without one.
+func omitsEmpty(tag fieldTag, ft fieldType) bool {
+	return tag.OmitEmpty || ft.Basic == "bson.ObjectId"
+}
+
+// parseTag returns the fieldTag of the field with the giving name and tag. The name is read
+// from the bson tag, else the json tag, else is the lowercased field name as used by mgo.
+// Options are read from the bson tag if set, else the json tag.
+func parseTag(name string, tag *goast.BasicLit) fieldTag {
+	ft := fieldTag{Name: strings.ToLower(name)}
+
+	if tag == nil {
+		return ft
+	}
+
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return ft
+	}
+
+	tags := reflect.StructTag(value)
+
+	bsonTag, hasBSON := tags.Lookup("bson")
+	jsonTag, hasJSON := tags.Lookup("json")
+
+	bsonParts := strings.Split(bsonTag, ",")
+	jsonParts := strings.Split(jsonTag, ",")
+
+	switch {
+	case hasBSON && bsonParts[0] == "-":
+		ft.Skip = true
+	case !hasBSON && hasJSON && jsonParts[0] == "-":
+		ft.Skip = true
+	case bsonParts[0] != "":
+		ft.Name, ft.Named = bsonParts[0], true
+	case jsonParts[0] != "" && jsonParts[0] != "-":
+		ft.Name, ft.Named = jsonParts[0], true
+	}
+
+	options := jsonParts[1:]
+	if hasBSON {
+		options = bsonParts[1:]
+	}
+
+	for _, option := range options {
+		switch option {
+		case "omitempty":
+			ft.OmitEmpty = true
+		case "inline":
+			ft.Inline = true
+		}
+	}
+
+	return ft
+}
+
+// kind defines the kinds of field types handled when building documents.
+type kind int
+
+// contains the kinds of field types. Fields of kind rawKind are stored as they are.
+const (
+	rawKind kind = iota
+	stringKind
+	numberKind
+	boolKind
+	timeKind
+	interfaceKind
+	structKind
+	pointerKind
+	sliceKind
+	mapKind
+)
+
+// fieldType defines the resolved type of a struct field.
+type fieldType struct {
+	Kind kind
+	Elem *fieldType
+
+	// Basic sets the predeclared type underlying a type of kind stringKind, numberKind or
+	// boolKind, or "bson.ObjectId". Named is true if the type is declared with it as its
+	// underlying type, with Name set to the name of the declared type and Scope to where
+	// it was declared.
+	Basic string
+	Named bool
+	Name  string
+
+	// Struct and Scope set the declaration of a struct type and where it was declared.
+	Struct *ast.StructDeclaration
+	Scope  scope
+}
+
+// scope defines where a type expression is declared, to resolve the names it uses.
+type scope struct {
+	Pkg   ast.Package
+	Declr ast.PackageDeclaration
+
+	// Path and Name set the import path and name of the package, if it is not the package
+	// of the struct being generated.
+	Path string
+	Name string
+}
+
+var numberTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"uintptr": true, "float32": true, "float64": true, "byte": true, "rune": true,
+}
+
+// resolve returns the fieldType of the giving type expression declared within sc.
+func resolve(expr goast.Expr, sc scope) fieldType {
+	switch t := expr.(type) {
+	case *goast.Ident:
+		switch {
+		case t.Name == "string":
+			return fieldType{Kind: stringKind, Basic: t.Name}
+		case t.Name == "bool":
+			return fieldType{Kind: boolKind, Basic: t.Name}
+		case numberTypes[t.Name]:
+			return fieldType{Kind: numberKind, Basic: t.Name}
+		case t.Name == "error":
+			return fieldType{Kind: interfaceKind}
+		}
+
+		if str, ok := sc.Pkg.StructFor(t.Name); ok {
+			ft := structType(str, sc.Pkg)
+			ft.Scope.Path, ft.Scope.Name = sc.Path, sc.Name
+			return ft
+		}
+
+		if ft, ok := resolveNamed(t.Name, sc); ok {
+			ft.Scope.Path, ft.Scope.Name = sc.Path, sc.Name
+			return ft
+		}
+	case *goast.SelectorExpr:
+		pkgName, ok := t.X.(*goast.Ident)
+		if !ok {
+			break
+		}
+
+		switch pkgName.Name + "." + t.Sel.Name {
+		case "time.Time":
+			return fieldType{Kind: timeKind}
+		case "bson.ObjectId":
+			return fieldType{Kind: stringKind, Basic: "bson.ObjectId"}
+		}
+
+		imp, ok := importFor(sc.Declr, pkgName.Name)
+		if !ok {
+			break
+		}
+
+		imported, ok := sc.Declr.ImportedPackageFor(pkgName.Name)
+		if !ok {
+			break
+		}
+
+		if str, ok := imported.StructFor(t.Sel.Name); ok {
+			ft := structType(str, imported)
+			ft.Scope.Path, ft.Scope.Name = imp.Path, str.Package
+			return ft
+		}
+
+		if ft, ok := resolveNamed(t.Sel.Name, scope{Pkg: imported}); ok {
+			ft.Scope.Path, ft.Scope.Name = imp.Path, imported.Name
+			return ft
+		}
+	case *goast.StarExpr:
+		elem := resolve(t.X, sc)
+		return fieldType{Kind: pointerKind, Elem: &elem}
+	case *goast.ArrayType:
+		if t.Len == nil {
+			elem := resolve(t.Elt, sc)
+			return fieldType{Kind: sliceKind, Elem: &elem}
+		}
+	case *goast.MapType:
+		if key, ok := t.Key.(*goast.Ident); ok && key.Name == "string" {
+			elem := resolve(t.Value, sc)
+			return fieldType{Kind: mapKind, Elem: &elem}
+		}
+	case *goast.InterfaceType:
+		return fieldType{Kind: interfaceKind}
+	case *goast.StructType:
+		return fieldType{Kind: rawKind}
+	}
+
+	return fieldType{Kind: rawKind}
+}
+
+// resolveNamed returns the fieldType of the named type with the giving name declared within
+// sc, if its underlying type is a string, number or bool.
+func resolveNamed(name string, sc scope) (fieldType, bool) {
+	named, ok := sc.Pkg.TypeFor(name)
+	if !ok || named.Object == nil {
+		return fieldType{}, false
+	}
+
+	switch underlying := named.Object.Type.(type) {
+	case *goast.Ident:
+		if underlying.Name == name {
+			return fieldType{}, false
+		}
+	case *goast.SelectorExpr:
+	default:
+		return fieldType{}, false
+	}
+
+	switch ft := resolve(named.Object.Type, sc); ft.Kind {
+	case stringKind, numberKind, boolKind:
+		ft.Named, ft.Name = true, name
+		return ft, true
+	}
+
+	return fieldType{}, false
+}
+
+// importFor returns the import of the giving file declaration with the giving name.
+func importFor(declr ast.PackageDeclaration, name string) (ast.ImportDeclaration, bool) {
+	if imp, ok := declr.Imports[name]; ok {
+		return imp, true
+	}
+
+	for _, imp := range declr.Imports {
+		if imp.Name == name {
+			return imp, true
+		}
+	}
+
+	return ast.ImportDeclaration{}, false
+}
+
+func structType(str ast.StructDeclaration, pkg ast.Package) fieldType {
+	sc := scope{Pkg: pkg}
+	if str.Declr != nil {
+		sc.Declr = *str.Declr
+	}
+
+	return fieldType{Kind: structKind, Struct: &str, Scope: sc}
+}
+
+// converts returns true/false if values of the giving fieldType are converted before being
+// stored, i.e. they are or contain structs. Fields methods return values as decoded from
+// mongodb for Consume, hence they also convert named types, pointers, slices other than
+// []byte and maps.
+func (b *documentBuilder) converts(ft fieldType) bool {
+	switch ft.Kind {
+	case structKind:
+		return true
+	case pointerKind, mapKind:
+		return b.self != "" || b.converts(*ft.Elem)
+	case sliceKind:
+		if b.self != "" && !ft.Elem.isByte() {
+			return true
+		}
+		return b.converts(*ft.Elem)
+	}
+
+	return b.self != "" && ft.Named
+}
+
+// isByte returns true/false if the fieldType is byte or uint8, whose slices are stored as
+// binary data.
+func (ft fieldType) isByte() bool {
+	return !ft.Named && (ft.Basic == "byte" || ft.Basic == "uint8")
+}
+
+// documentBuilder writes the statements which build the bson.M document of a struct.
+type documentBuilder struct {
+	out      bytes.Buffer
+	vars     int
+	prefix   string
+	reflect  bool
+	visiting map[string]bool
+
+	// self sets the name of the document function, if values of the struct nested within
+	// itself are converted by calling it, rather than stored as they are.
+	self string
+	root string
+}
+
+// buildDocument returns the document function converting values of the giving struct,
+// declared within the giving package, into their bson.M document.
+func buildDocument(str ast.StructDeclaration, pkg ast.Package) document {
+	prefix := lowerFirst(str.Object.Name.Name)
+	return writeDocument(str, pkg, prefix+"Document", fmt.Sprintf("%s.%s", str.Package, str.Object.Name.Name), false)
+}
+
+// writeDocument returns the document function with the giving name converting values of
+// the giving struct, referenced as typeName, into their bson.M document. If self is true,
+// the function converts values of the struct nested within itself by calling itself.
+func writeDocument(str ast.StructDeclaration, pkg ast.Package, name string, typeName string, self bool) document {
+	prefix := lowerFirst(str.Object.Name.Name)
+
+	b := &documentBuilder{prefix: prefix, visiting: make(map[string]bool)}
+	st := structType(str, pkg)
+	b.root = structKey(st)
+	b.visiting[b.root] = true
+
+	if self {
+		b.self = name
+	}
+
+	b.fields("doc", "elem", st)
+
+	doc := document{Name: name, Reflect: b.reflect}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// %s returns the bson.M document stored for the giving %s.\n", doc.Name, str.Object.Name.Name)
+	fmt.Fprintf(&source, "func %s(elem %s) bson.M {\n", doc.Name, typeName)
+	fmt.Fprintf(&source, "doc := bson.M{}\n")
+	source.Write(b.out.Bytes())
+	fmt.Fprintf(&source, "return doc\n}\n")
+
+	if b.reflect {
+		fmt.Fprintf(&source, "\n// %sIsZero returns true/false if the giving value is the zero value of its type.\n", prefix)
+		fmt.Fprintf(&source, "func %sIsZero(value interface{}) bool {\n", prefix)
+		fmt.Fprintf(&source, "rv := reflect.ValueOf(value)\n")
+		fmt.Fprintf(&source, "return !rv.IsValid() || reflect.DeepEqual(value, reflect.Zero(rv.Type()).Interface())\n}\n")
+	}
+
+	doc.Source = source.String()
+	return doc
+}
+
+// lowerFirst returns name with its first letter lowercased, e.g "userItem" for "UserItem".
+func lowerFirst(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+func structKey(ft fieldType) string {
+	return ft.Struct.Path + "." + ft.Struct.Object.Name.Name
+}
+
+// fields writes the statements setting all stored fields of the struct value into the
+// bson.M named doc.
+func (b *documentBuilder) fields(doc string, value string, st fieldType) {
+	for _, field := range st.Struct.Struct.Fields.List {
+		ft := resolve(field.Type, st.Scope)
+
+		if len(field.Names) == 0 {
+			b.embedded(doc, value, field, ft)
+			continue
+		}
+
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			tag := parseTag(ident.Name, field.Tag)
+			if tag.Skip {
+				continue
+			}
+
+			b.field(doc, value+"."+ident.Name, tag, ft)
+		}
+	}
+}
+
+// embedded writes the statements for an embedded field, whose fields are inlined into the
+// document unless its tag sets a name for it, just like encoding/json does.
+func (b *documentBuilder) embedded(doc string, value string, field *goast.Field, ft fieldType) {
+	typeName := embeddedName(field.Type)
+	if typeName == "" || !goast.IsExported(typeName) {
+		return
+	}
+
+	tag := parseTag(typeName, field.Tag)
+	if tag.Skip {
+		return
+	}
+
+	value = value + "." + typeName
+
+	switch {
+	case !tag.Named && ft.Kind == structKind:
+		b.inline(doc, value, ft)
+	case !tag.Named && ft.Kind == pointerKind && ft.Elem.Kind == structKind:
+		fmt.Fprintf(&b.out, "if %s != nil {\n", value)
+		b.inline(doc, value, *ft.Elem)
+		fmt.Fprintf(&b.out, "}\n")
+	default:
+		b.field(doc, value, tag, ft)
+	}
+}
+
+// inline writes the fields of the struct value into doc, unless the struct is being
+// expanded already, in which case it is stored as it is.
+func (b *documentBuilder) inline(doc string, value string, ft fieldType) {
+	key := structKey(ft)
+	if b.visiting[key] {
+		fmt.Fprintf(&b.out, "doc[%q] = %s\n", strings.ToLower(ft.Struct.Object.Name.Name), value)
+		return
+	}
+
+	b.visiting[key] = true
+	b.fields(doc, value, ft)
+	delete(b.visiting, key)
+}
+
+// field writes the statements setting the giving field value into doc.
+func (b *documentBuilder) field(doc string, value string, tag fieldTag, ft fieldType) {
+	if tag.Inline {
+		switch {
+		case ft.Kind == structKind:
+			b.inline(doc, value, ft)
+			return
+		case ft.Kind == mapKind:
+			item := b.newVar("item")
+			fmt.Fprintf(&b.out, "for key, %s := range %s {\n", item, value)
+			fmt.Fprintf(&b.out, "%s[key] = %s\n", doc, b.convert(item, *ft.Elem))
+			fmt.Fprintf(&b.out, "}\n")
+			return
+		}
+	}
+
+	if omitsEmpty(tag, ft) {
+		fmt.Fprintf(&b.out, "if %s {\n", b.nonZero(value, ft))
+
+		// The pointer is known not to be nil within the condition.
+		if ft.Kind == pointerKind && b.converts(ft) {
+			value, ft = b.deref(value, ft), *ft.Elem
+		}
+
+		fmt.Fprintf(&b.out, "%s[%q] = %s\n", doc, tag.Name, assignable(b.convert(value, ft)))
+		fmt.Fprintf(&b.out, "}\n")
+		return
+	}
+
+	fmt.Fprintf(&b.out, "%s[%q] = %s\n", doc, tag.Name, b.convert(value, ft))
+}
+
+// convert writes any statements needed to convert the giving value for storage, returning
+// the expression of the converted value.
+func (b *documentBuilder) convert(value string, ft fieldType) string {
+	if !b.converts(ft) {
+		return value
+	}
+
+	switch ft.Kind {
+	case stringKind, numberKind, boolKind:
+		// Fields methods store named types as their basic type, which Consume reads back.
+		return fmt.Sprintf("%s(%s)", ft.Basic, value)
+	case structKind:
+		key := structKey(ft)
+		if b.isSelf(ft) {
+			return fmt.Sprintf("%s(%s)", b.self, value)
+		}
+
+		if b.visiting[key] {
+			return value
+		}
+
+		sub := b.newVar("sub")
+		fmt.Fprintf(&b.out, "%s := bson.M{}\n", sub)
+
+		b.visiting[key] = true
+		b.fields(sub, value, ft)
+		delete(b.visiting, key)
+
+		return sub
+	case pointerKind:
+		converted := b.newVar("value")
+		fmt.Fprintf(&b.out, "var %s interface{}\n", converted)
+		fmt.Fprintf(&b.out, "if %s != nil {\n", value)
+		fmt.Fprintf(&b.out, "%s = %s\n", converted, assignable(b.convert(b.deref(value, ft), *ft.Elem)))
+		fmt.Fprintf(&b.out, "}\n")
+		return converted
+	case sliceKind:
+		list := b.newVar("list")
+		item := b.newVar("item")
+		fmt.Fprintf(&b.out, "var %s []interface{}\n", list)
+		fmt.Fprintf(&b.out, "if %s != nil {\n", value)
+		fmt.Fprintf(&b.out, "%s = make([]interface{}, 0, len(%s))\n", list, value)
+		fmt.Fprintf(&b.out, "}\n")
+		fmt.Fprintf(&b.out, "for _, %s := range %s {\n", item, value)
+		fmt.Fprintf(&b.out, "%s = append(%s, %s)\n", list, list, b.convert(item, *ft.Elem))
+		fmt.Fprintf(&b.out, "}\n")
+		return list
+	case mapKind:
+		items := b.newVar("items")
+		item := b.newVar("item")
+		fmt.Fprintf(&b.out, "var %s bson.M\n", items)
+		fmt.Fprintf(&b.out, "if %s != nil {\n", value)
+		fmt.Fprintf(&b.out, "%s = make(bson.M, len(%s))\n", items, value)
+		fmt.Fprintf(&b.out, "}\n")
+		fmt.Fprintf(&b.out, "for key, %s := range %s {\n", item, value)
+		fmt.Fprintf(&b.out, "%s[key] = %s\n", items, b.convert(item, *ft.Elem))
+		fmt.Fprintf(&b.out, "}\n")
+		return items
+	}
+
+	return value
+}
+
+// deref returns the expression of the element of the giving pointer value. Fields of
+// pointers to structs are accessed through the pointer, anything else is dereferenced.
+func (b *documentBuilder) deref(value string, ft fieldType) string {
+	switch {
+	case b.isSelf(*ft.Elem):
+		return "*" + value
+	case ft.Elem.Kind == structKind:
+		return value
+	}
+
+	return "(*" + value + ")"
+}
+
+// isSelf returns true/false if values of the giving fieldType are converted by calling the
+// document function itself.
+func (b *documentBuilder) isSelf(ft fieldType) bool {
+	return b.self != "" && ft.Kind == structKind && structKey(ft) == b.root
+}
+
+// nonZero returns the condition which is true if the giving value is not the zero value of
+// its type, as used by bson for omitempty.
+func (b *documentBuilder) nonZero(value string, ft fieldType) string {
+	switch ft.Kind {
+	case stringKind:
+		return value + ` != ""`
+	case numberKind:
+		return value + " != 0"
+	case boolKind:
+		return value
+	case timeKind:
+		return "!" + value + ".IsZero()"
+	case interfaceKind, pointerKind:
+		return value + " != nil"
+	case sliceKind, mapKind:
+		return "len(" + value + ") != 0"
+	}
+
+	b.reflect = true
+	return fmt.Sprintf("!%sIsZero(%s)", b.prefix, value)
+}
+
+func (b *documentBuilder) newVar(name string) string {
+	b.vars++
+	return fmt.Sprintf("%s%d", name, b.vars)
+}
+
+// embeddedName returns the name of the field of an embedded type, e.g "Base" for
+// "*models.Base".
+func embeddedName(expr goast.Expr) string {
+	switch t := expr.(type) {
+	case *goast.Ident:
+		return t.Name
+	case *goast.SelectorExpr:
+		return t.Sel.Name
+	case *goast.StarExpr:
+		return embeddedName(t.X)
+	}
+
+	return ""
+}
@@ -0,0 +1,792 @@
+package mgo
+
+import (
+	"bytes"
+	"fmt"
+	goast "go/ast"
+	"go/types"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gokit/mgokit/header"
+	"github.com/influx6/faux/fmtwriter"
+	"github.com/influx6/moz/ast"
+	"github.com/influx6/moz/gen"
+)
+
+// MongoFieldsGen generates the Fields and Consume methods of a struct declaration into its
+// own package.
+func MongoFieldsGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
+	return Generator{}.MongoFieldsGen(toPackage, an, str, pkgDeclr, pkg)
+}
+
+// MongoFieldsGen generates the Fields and Consume methods of a struct declaration into its
+// own package using the Generator's Config. The package of the struct must be within the
+// destination.
+func (g Generator) MongoFieldsGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
+	ops, err := g.options(an, str)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, ok := relativeImport(toPackage, str.Path)
+	if !ok {
+		return nil, fmt.Errorf("Struct %q must be within the destination %+q to generate its Fields and Consume methods into its package", str.Object.Name.Name, toPackage)
+	}
+
+	file, err := buildFields(str, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := loadTemplates(g.Config.TemplatesDir, ops, "mongo-fields.tml", "mongo-consume.tml")
+	if err != nil {
+		return nil, err
+	}
+
+	fieldsGen := gen.Block(
+		gen.Package(
+			gen.Name(str.Package),
+			gen.Imports(file.Imports...),
+			gen.Block(
+				templates.source(
+					"mongo:fields",
+					"mongo-fields.tml",
+					nil,
+					struct {
+						Struct   ast.StructDeclaration
+						Prefix   string
+						Document document
+						Consume  string
+					}{
+						Struct:   str,
+						Prefix:   file.Prefix,
+						Document: file.Document,
+						Consume:  file.Consume,
+					},
+				),
+				templates.source(
+					"mongo:consume",
+					"mongo-consume.tml",
+					nil,
+					decoder{Prefix: file.Prefix},
+				),
+			),
+		),
+	)
+
+	if err := templates.Err(); err != nil {
+		return nil, err
+	}
+
+	prov := header.New(fmt.Sprintf("%s.%s", str.Path, str.Object.Name.Name), an)
+
+	return []gen.WriteDirective{
+		{
+			Writer:   prov.Wrap(fmtwriter.New(fieldsGen, true, true)),
+			FileName: fieldsFileName(str),
+			Dir:      dir,
+		},
+	}, nil
+}
+
+// fieldsFileName returns the name of the file @mongo_fields generates for the giving struct.
+func fieldsFileName(str ast.StructDeclaration) string {
+	return fmt.Sprintf("%s_fields.go", strings.ToLower(str.Object.Name.Name))
+}
+
+// hasFunc returns the function used by templates to check if a struct declared within the
+// giving file has a method, which is true if the file declares it or the struct is
+// annotated with @mongo_fields, which generates its Fields and Consume methods.
+func hasFunc(pkgDeclr ast.PackageDeclaration) func(ast.StructDeclaration, string) bool {
+	return func(str ast.StructDeclaration, name string) bool {
+		if name == "Fields" || name == "Consume" {
+			for _, an := range str.Annotations {
+				if an.Name == "@mongo_fields" {
+					return true
+				}
+			}
+		}
+
+		return pkgDeclr.HasFunctionFor(str, name)
+	}
+}
+
+// fieldsFile defines the Fields and Consume methods generated for a struct.
+type fieldsFile struct {
+	// Prefix sets the prefix of the helpers used by the generated methods.
+	Prefix string
+
+	// Document sets the function building the map returned by Fields.
+	Document document
+
+	// Consume sets the statements of the Consume method.
+	Consume string
+
+	// Imports sets all imports of the generated file.
+	Imports []gen.ImportItemDeclr
+}
+
+// fieldsImports contains the imports used by the helpers of the generated methods.
+var fieldsImports = map[string]string{
+	"encoding/base64":      "base64",
+	"fmt":                  "fmt",
+	"math":                 "math",
+	"time":                 "time",
+	"gopkg.in/mgo.v2/bson": "bson",
+}
+
+// buildFields returns the fieldsFile of the giving struct, declared within the giving
+// package.
+func buildFields(str ast.StructDeclaration, pkg ast.Package) (fieldsFile, error) {
+	prefix := lowerFirst(str.Object.Name.Name)
+
+	file := fieldsFile{
+		Prefix:   prefix,
+		Document: writeDocument(str, pkg, prefix+"Fields", str.Object.Name.Name, true),
+	}
+
+	c := newConsumer(prefix, str.Object.Name.Name)
+	if file.Document.Reflect {
+		c.use("reflect", "reflect")
+	}
+
+	var err error
+	file.Consume, file.Imports, err = c.consume(structType(str, pkg))
+	return file, err
+}
+
+// decoder defines the generated function which sets a struct from the bson.M document built
+// for it, the inverse of its document function, with which generated packages read records
+// of structs without a Consume method.
+type decoder struct {
+	// Name sets the name of the generated function, which takes the document and a pointer
+	// to the struct to set.
+	Name string
+
+	// Prefix sets the prefix of the helpers used by the generated function.
+	Prefix string
+
+	// Source sets the source of the generated function.
+	Source string
+
+	// Imports sets the imports of the generated function and its helpers.
+	Imports []gen.ImportItemDeclr
+}
+
+// buildDecoder returns the decoder of the giving struct, declared within the giving package,
+// written into the generated package.
+func buildDecoder(str ast.StructDeclaration, pkg ast.Package, doc document) (decoder, error) {
+	prefix := lowerFirst(str.Object.Name.Name)
+	dec := decoder{Name: prefix + "FromDocument", Prefix: prefix}
+
+	c := newConsumer(prefix, str.Object.Name.Name)
+	c.self, c.rootPath, c.rootName = dec.Name, str.Path, str.Package
+
+	typeName := c.use(str.Path, str.Package) + "." + str.Object.Name.Name
+
+	body, imports, err := c.consume(structType(str, pkg))
+	if err != nil {
+		return dec, err
+	}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// %s sets the giving %s from the giving document, as built by %s and\n", dec.Name, str.Object.Name.Name, doc.Name)
+	fmt.Fprintf(&source, "// read from mongodb. It returns an error if the key of a field without omitempty is missing\n")
+	fmt.Fprintf(&source, "// or a value can not be converted into the type of its field.\n")
+	fmt.Fprintf(&source, "func %s(data map[string]interface{}, elem *%s) error {\n", dec.Name, typeName)
+	source.WriteString(body)
+	fmt.Fprintf(&source, "return nil\n}\n")
+
+	dec.Source = source.String()
+	dec.Imports = imports
+	return dec, nil
+}
+
+// readDocument returns the decoder with which the generated package reads records of the
+// giving struct, which it only has if the struct has no Consume method and its document is
+// not built by its Fields method.
+func readDocument(str ast.StructDeclaration, pkg ast.Package, pkgDeclr ast.PackageDeclaration, doc document) (decoder, error) {
+	if doc.Name == "" || hasFunc(pkgDeclr)(str, "Consume") {
+		return decoder{}, nil
+	}
+
+	return buildDecoder(str, pkg, doc)
+}
+
+// newConsumer returns a consumer writing statements for the struct with the giving name,
+// whose helpers are named with the giving prefix.
+func newConsumer(prefix string, name string) *consumer {
+	c := &consumer{
+		prefix:   prefix,
+		name:     name,
+		imports:  make(map[string]string),
+		names:    make(map[string]string),
+		visiting: make(map[string]bool),
+	}
+
+	for pkgPath, alias := range fieldsImports {
+		c.use(pkgPath, alias)
+	}
+
+	return c
+}
+
+// consume returns the statements setting all stored fields of elem, of the giving struct
+// type, from the map named data, along with the imports they and the helpers use.
+func (c *consumer) consume(st fieldType) (string, []gen.ImportItemDeclr, error) {
+	c.root = structKey(st)
+	c.visiting[c.root] = true
+	c.fields("elem", "data", st, "", keysOf(st, c.visiting))
+
+	if c.err != nil {
+		return "", nil, c.err
+	}
+
+	paths := make([]string, 0, len(c.imports))
+	for pkgPath := range c.imports {
+		paths = append(paths, pkgPath)
+	}
+
+	sort.Strings(paths)
+
+	var imports []gen.ImportItemDeclr
+	for _, pkgPath := range paths {
+		alias := c.imports[pkgPath]
+		if alias == path.Base(pkgPath) {
+			alias = ""
+		}
+
+		imports = append(imports, gen.Import(pkgPath, alias))
+	}
+
+	return c.out.String(), imports, nil
+}
+
+// consumer writes the statements which set the fields of a struct from a map of its stored
+// fields, the inverse of documentBuilder.
+type consumer struct {
+	out      bytes.Buffer
+	vars     int
+	prefix   string
+	name     string
+	root     string
+	visiting map[string]bool
+	err      error
+
+	// imports and names map the import paths of packages used by the statements to the
+	// names they are used under, and back.
+	imports map[string]string
+	names   map[string]string
+
+	// self sets the name of the function setting values of the struct nested within itself,
+	// if the statements are not written into its Consume method. rootPath and rootName set
+	// the package of the struct, if the statements are written into another package.
+	self     string
+	rootPath string
+	rootName string
+}
+
+// fields writes the statements setting all stored fields of the struct target from the map
+// named data. Keys within errors are prefixed with path, and keys lists all keys of the
+// document, which inlined maps do not receive.
+func (c *consumer) fields(target string, data string, st fieldType, path string, keys []string) {
+	for _, field := range st.Struct.Struct.Fields.List {
+		ft := resolve(field.Type, st.Scope)
+
+		if len(field.Names) == 0 {
+			c.embedded(target, data, field, ft, st.Scope, path, keys)
+			continue
+		}
+
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			tag := parseTag(ident.Name, field.Tag)
+			if tag.Skip {
+				continue
+			}
+
+			c.field(target+"."+ident.Name, data, tag, ft, field.Type, st.Scope, path, keys)
+		}
+	}
+}
+
+// embedded writes the statements for an embedded field, whose fields are inlined into the
+// document unless its tag sets a name for it.
+func (c *consumer) embedded(target string, data string, field *goast.Field, ft fieldType, sc scope, path string, keys []string) {
+	typeName := embeddedName(field.Type)
+	if typeName == "" || !goast.IsExported(typeName) {
+		return
+	}
+
+	tag := parseTag(typeName, field.Tag)
+	if tag.Skip {
+		return
+	}
+
+	target = target + "." + typeName
+
+	switch {
+	case !tag.Named && ft.Kind == structKind:
+		c.inline(target, data, ft, path, keys)
+	case !tag.Named && ft.Kind == pointerKind && ft.Elem.Kind == structKind:
+		if c.visiting[structKey(*ft.Elem)] {
+			c.inline(target, data, *ft.Elem, path, keys)
+			return
+		}
+
+		// Fields of a nil pointer are not stored, hence it is only set if any is found.
+		key := structKey(*ft.Elem)
+		c.visiting[key] = true
+		inner := keysOf(*ft.Elem, c.visiting)
+		delete(c.visiting, key)
+
+		if len(inner) == 0 {
+			return
+		}
+
+		fmt.Fprintf(&c.out, "if %sHasAny(%s, %s) {\n", c.prefix, data, quoteAll(inner))
+		fmt.Fprintf(&c.out, "%s = new(%s)\n", target, c.typeExpr(field.Type.(*goast.StarExpr).X, sc))
+		c.inline(target, data, *ft.Elem, path, keys)
+		fmt.Fprintf(&c.out, "}\n")
+	default:
+		c.field(target, data, tag, ft, field.Type, sc, path, keys)
+	}
+}
+
+// inline writes the statements setting the fields of the struct target from data, unless
+// the struct is being expanded already, in which case it was stored as it is.
+func (c *consumer) inline(target string, data string, ft fieldType, path string, keys []string) {
+	key := structKey(ft)
+	if c.visiting[key] {
+		value := c.newVar("value")
+		fmt.Fprintf(&c.out, "if %s, ok := %s[%q]; ok {\n", value, data, strings.ToLower(ft.Struct.Object.Name.Name))
+		c.raw(target, value, path+strings.ToLower(ft.Struct.Object.Name.Name))
+		fmt.Fprintf(&c.out, "}\n")
+		return
+	}
+
+	c.visiting[key] = true
+	c.fields(target, data, ft, path, keys)
+	delete(c.visiting, key)
+}
+
+// field writes the statements setting the giving field target from its key within data.
+// Fields not omitted when empty are always stored, hence their keys are required.
+func (c *consumer) field(target string, data string, tag fieldTag, ft fieldType, expr goast.Expr, sc scope, path string, keys []string) {
+	name := path + tag.Name
+
+	if tag.Inline {
+		switch ft.Kind {
+		case structKind:
+			c.inline(target, data, ft, path, keys)
+			return
+		case mapKind:
+			c.inlineMap(target, data, ft, expr, sc, name, keys)
+			return
+		}
+	}
+
+	value := c.newVar("value")
+
+	if omitsEmpty(tag, ft) {
+		fmt.Fprintf(&c.out, "if %s, ok := %s[%q]; ok {\n", value, data, tag.Name)
+		c.decode(target, value, ft, expr, sc, name)
+		fmt.Fprintf(&c.out, "}\n")
+		return
+	}
+
+	fmt.Fprintf(&c.out, "%s, ok := %s[%q]\n", value, data, tag.Name)
+	fmt.Fprintf(&c.out, "if !ok {\n")
+	fmt.Fprintf(&c.out, "return fmt.Errorf(\"%s is missing required field %%q\", %q)\n", c.name, name)
+	fmt.Fprintf(&c.out, "}\n")
+	c.decode(target, value, ft, expr, sc, name)
+}
+
+// inlineMap writes the statements setting the inlined map target from all keys of data not
+// within keys.
+func (c *consumer) inlineMap(target string, data string, ft fieldType, expr goast.Expr, sc scope, name string, keys []string) {
+	mapType, ok := expr.(*goast.MapType)
+	if !ok {
+		return
+	}
+
+	item := c.newVar("item")
+	converted := c.newVar("value")
+
+	fmt.Fprintf(&c.out, "for key, %s := range %s {\n", item, data)
+
+	// The _id key is added by mongodb to all stored documents, so it is never part of the map.
+	if !contains(keys, "_id") {
+		keys = append(keys[:len(keys):len(keys)], "_id")
+	}
+
+	fmt.Fprintf(&c.out, "switch key {\ncase %s:\ncontinue\n}\n", quoteAll(keys))
+
+	fmt.Fprintf(&c.out, "if %s == nil {\n", target)
+	fmt.Fprintf(&c.out, "%s = make(%s)\n", target, c.typeExpr(expr, sc))
+	fmt.Fprintf(&c.out, "}\n")
+	fmt.Fprintf(&c.out, "var %s %s\n", converted, c.typeExpr(mapType.Value, sc))
+	c.decode(converted, item, *ft.Elem, mapType.Value, sc, name)
+	fmt.Fprintf(&c.out, "%s[key] = %s\n", target, converted)
+	fmt.Fprintf(&c.out, "}\n")
+}
+
+// decode writes the statements converting value into the type of the giving target and
+// setting it.
+func (c *consumer) decode(target string, value string, ft fieldType, expr goast.Expr, sc scope, name string) {
+	switch ft.Kind {
+	case stringKind, numberKind, boolKind, timeKind:
+		c.basic(target, value, ft, expr, sc, name)
+	case interfaceKind:
+		if iface, ok := expr.(*goast.InterfaceType); ok && len(iface.Methods.List) == 0 {
+			fmt.Fprintf(&c.out, "%s = %s\n", assignable(target), value)
+			return
+		}
+
+		typeName := c.typeExpr(expr, sc)
+		converted := c.newVar("value")
+		fmt.Fprintf(&c.out, "if %s != nil {\n", value)
+		fmt.Fprintf(&c.out, "%s, ok := %s.(%s)\n", converted, value, typeName)
+		fmt.Fprintf(&c.out, "if !ok {\n")
+		fmt.Fprintf(&c.out, "return fmt.Errorf(\"Failed to consume %%q of %s: expected %s, found %%T\", %q, %s)\n", c.name, typeName, name, value)
+		fmt.Fprintf(&c.out, "}\n")
+		fmt.Fprintf(&c.out, "%s = %s\n", assignable(target), converted)
+		fmt.Fprintf(&c.out, "}\n")
+	case structKind:
+		key := structKey(ft)
+		if c.visiting[key] && key != c.root {
+			c.raw(target, value, name)
+			return
+		}
+
+		doc := c.newVar("doc")
+		fmt.Fprintf(&c.out, "%s, err := %sMap(%s)\n", doc, c.prefix, value)
+		c.check(name)
+
+		if key == c.root && c.self != "" {
+			pointer := "&" + target
+			if deref := assignable(target); deref != target {
+				pointer = deref[1:]
+			}
+
+			fmt.Fprintf(&c.out, "if err := %s(%s, %s); err != nil {\n", c.self, doc, pointer)
+			fmt.Fprintf(&c.out, "return fmt.Errorf(\"Failed to consume %%q of %s: %%+q\", %q, err)\n", c.name, name)
+			fmt.Fprintf(&c.out, "}\n")
+			return
+		}
+
+		if key == c.root {
+			fmt.Fprintf(&c.out, "if err := %s.Consume(%s); err != nil {\n", target, doc)
+			fmt.Fprintf(&c.out, "return fmt.Errorf(\"Failed to consume %%q of %s: %%+q\", %q, err)\n", c.name, name)
+			fmt.Fprintf(&c.out, "}\n")
+			return
+		}
+
+		c.visiting[key] = true
+		c.fields(target, doc, ft, name+".", keysOf(ft, c.visiting))
+		delete(c.visiting, key)
+	case pointerKind:
+		elem := *ft.Elem
+		if elem.Kind == rawKind || (elem.Kind == structKind && c.visiting[structKey(elem)] && structKey(elem) != c.root) {
+			c.raw(target, value, name)
+			return
+		}
+
+		elemExpr := expr.(*goast.StarExpr).X
+
+		// Fields of pointers to structs are set through the pointer, anything else is
+		// dereferenced, as are pointers to the struct set by calling self.
+		inner := target
+		if elem.Kind != structKind || (c.self != "" && structKey(elem) == c.root) {
+			inner = "(*" + target + ")"
+		}
+
+		fmt.Fprintf(&c.out, "if %s != nil {\n", value)
+		fmt.Fprintf(&c.out, "%s = new(%s)\n", target, c.typeExpr(elemExpr, sc))
+		c.decode(inner, value, elem, elemExpr, sc, name)
+		fmt.Fprintf(&c.out, "}\n")
+	case sliceKind:
+		arrayType := expr.(*goast.ArrayType)
+		if isBytes(arrayType) {
+			data := c.newVar("data")
+			fmt.Fprintf(&c.out, "%s, err := %sBytes(%s)\n", data, c.prefix, value)
+			c.check(name)
+			fmt.Fprintf(&c.out, "%s = %s\n", assignable(target), data)
+			return
+		}
+
+		list := c.newVar("list")
+		index := c.newVar("index")
+		item := c.newVar("item")
+
+		fmt.Fprintf(&c.out, "%s, err := %sSlice(%s)\n", list, c.prefix, value)
+		c.check(name)
+		fmt.Fprintf(&c.out, "if %s != nil {\n", list)
+		fmt.Fprintf(&c.out, "%s = make(%s, len(%s))\n", target, c.typeExpr(expr, sc), list)
+		fmt.Fprintf(&c.out, "}\n")
+		fmt.Fprintf(&c.out, "for %s, %s := range %s {\n", index, item, list)
+		c.decode(fmt.Sprintf("%s[%s]", target, index), item, *ft.Elem, arrayType.Elt, sc, name)
+		fmt.Fprintf(&c.out, "}\n")
+	case mapKind:
+		mapType := expr.(*goast.MapType)
+
+		items := c.newVar("items")
+		item := c.newVar("item")
+		converted := c.newVar("value")
+
+		fmt.Fprintf(&c.out, "%s, err := %sMap(%s)\n", items, c.prefix, value)
+		c.check(name)
+		fmt.Fprintf(&c.out, "if %s != nil {\n", items)
+		fmt.Fprintf(&c.out, "%s = make(%s, len(%s))\n", target, c.typeExpr(expr, sc), items)
+		fmt.Fprintf(&c.out, "}\n")
+		fmt.Fprintf(&c.out, "for key, %s := range %s {\n", item, items)
+		fmt.Fprintf(&c.out, "var %s %s\n", converted, c.typeExpr(mapType.Value, sc))
+		c.decode(converted, item, *ft.Elem, mapType.Value, sc, name)
+		fmt.Fprintf(&c.out, "%s[key] = %s\n", target, converted)
+		fmt.Fprintf(&c.out, "}\n")
+	default:
+		c.raw(target, value, name)
+	}
+}
+
+// basicHelpers maps predeclared types to the helper converting values into them, with the
+// bit size the helper checks values against, if any.
+var basicHelpers = map[string]struct {
+	Helper string
+	Type   string
+	Bits   int
+}{
+	"string":        {"String", "string", 0},
+	"bson.ObjectId": {"ObjectID", "bson.ObjectId", 0},
+	"bool":          {"Bool", "bool", 0},
+	"int":           {"Int64", "int64", 64},
+	"int8":          {"Int64", "int64", 8},
+	"int16":         {"Int64", "int64", 16},
+	"int32":         {"Int64", "int64", 32},
+	"rune":          {"Int64", "int64", 32},
+	"int64":         {"Int64", "int64", 64},
+	"uint":          {"Uint64", "uint64", 64},
+	"uint8":         {"Uint64", "uint64", 8},
+	"byte":          {"Uint64", "uint64", 8},
+	"uint16":        {"Uint64", "uint64", 16},
+	"uint32":        {"Uint64", "uint64", 32},
+	"uint64":        {"Uint64", "uint64", 64},
+	"uintptr":       {"Uint64", "uint64", 64},
+	"float32":       {"Float64", "float64", 0},
+	"float64":       {"Float64", "float64", 0},
+}
+
+// basic writes the statements converting value into a string, number, bool or time.Time
+// and setting it into target, converted into the type of target if needed.
+func (c *consumer) basic(target string, value string, ft fieldType, expr goast.Expr, sc scope, name string) {
+	converted := c.newVar("value")
+
+	if ft.Kind == timeKind {
+		fmt.Fprintf(&c.out, "%s, err := %sTime(%s)\n", converted, c.prefix, value)
+		c.check(name)
+		fmt.Fprintf(&c.out, "%s = %s\n", assignable(target), converted)
+		return
+	}
+
+	helper := basicHelpers[ft.Basic]
+
+	if helper.Bits != 0 {
+		fmt.Fprintf(&c.out, "%s, err := %s%s(%s, %d)\n", converted, c.prefix, helper.Helper, value, helper.Bits)
+	} else {
+		fmt.Fprintf(&c.out, "%s, err := %s%s(%s)\n", converted, c.prefix, helper.Helper, value)
+	}
+
+	c.check(name)
+
+	if typeName := c.typeExpr(expr, sc); typeName != helper.Type {
+		converted = fmt.Sprintf("%s(%s)", typeName, converted)
+	}
+
+	fmt.Fprintf(&c.out, "%s = %s\n", assignable(target), converted)
+}
+
+// raw writes the statements decoding value into target through bson, for values of types
+// which are stored as they are.
+func (c *consumer) raw(target string, value string, name string) {
+	pointer := "&" + target
+	if deref := assignable(target); deref != target {
+		pointer = deref[1:]
+	}
+
+	fmt.Fprintf(&c.out, "if err := %sDecode(%s, %s); err != nil {\n", c.prefix, value, pointer)
+	fmt.Fprintf(&c.out, "return fmt.Errorf(\"Failed to consume %%q of %s: %%+q\", %q, err)\n", c.name, name)
+	fmt.Fprintf(&c.out, "}\n")
+}
+
+// check writes the statements returning err, if set, for the key with the giving name.
+func (c *consumer) check(name string) {
+	fmt.Fprintf(&c.out, "if err != nil {\n")
+	fmt.Fprintf(&c.out, "return fmt.Errorf(\"Failed to consume %%q of %s: %%+q\", %q, err)\n", c.name, name)
+	fmt.Fprintf(&c.out, "}\n")
+}
+
+// typeExpr returns the giving type expression, declared within sc, as written within the
+// package the statements are written into, recording the imports it needs.
+func (c *consumer) typeExpr(expr goast.Expr, sc scope) string {
+	switch t := expr.(type) {
+	case *goast.Ident:
+		if types.Universe.Lookup(t.Name) != nil {
+			return t.Name
+		}
+
+		if sc.Path == "" && c.rootPath == "" {
+			return t.Name
+		}
+
+		if sc.Path == "" {
+			return c.use(c.rootPath, c.rootName) + "." + t.Name
+		}
+
+		return c.use(sc.Path, sc.Name) + "." + t.Name
+	case *goast.SelectorExpr:
+		if pkgName, ok := t.X.(*goast.Ident); ok {
+			if imp, ok := importFor(sc.Declr, pkgName.Name); ok {
+				return c.use(imp.Path, pkgName.Name) + "." + t.Sel.Name
+			}
+		}
+	case *goast.StarExpr:
+		return "*" + c.typeExpr(t.X, sc)
+	case *goast.ArrayType:
+		if t.Len == nil {
+			return "[]" + c.typeExpr(t.Elt, sc)
+		}
+
+		return "[" + types.ExprString(t.Len) + "]" + c.typeExpr(t.Elt, sc)
+	case *goast.MapType:
+		return "map[" + c.typeExpr(t.Key, sc) + "]" + c.typeExpr(t.Value, sc)
+	}
+
+	return types.ExprString(expr)
+}
+
+// use records the import of the package with the giving import path under the giving name,
+// returning the name it is imported under.
+func (c *consumer) use(pkgPath string, name string) string {
+	if existing, ok := c.imports[pkgPath]; ok {
+		return existing
+	}
+
+	if other, ok := c.names[name]; ok && c.err == nil {
+		c.err = fmt.Errorf("Struct %q uses packages %+q and %+q under the same name %q", c.name, other, pkgPath, name)
+		return name
+	}
+
+	c.imports[pkgPath] = name
+	c.names[name] = pkgPath
+	return name
+}
+
+func (c *consumer) newVar(name string) string {
+	c.vars++
+	return fmt.Sprintf("%s%d", name, c.vars)
+}
+
+// keysOf returns the keys of all fields stored for the giving struct type, including those
+// of inlined structs, except structs within seen, which are stored under their name.
+func keysOf(st fieldType, seen map[string]bool) []string {
+	var keys []string
+
+	inline := func(ft fieldType) {
+		key := structKey(ft)
+		if seen[key] {
+			keys = append(keys, strings.ToLower(ft.Struct.Object.Name.Name))
+			return
+		}
+
+		seen[key] = true
+		keys = append(keys, keysOf(ft, seen)...)
+		delete(seen, key)
+	}
+
+	for _, field := range st.Struct.Struct.Fields.List {
+		ft := resolve(field.Type, st.Scope)
+
+		if len(field.Names) == 0 {
+			typeName := embeddedName(field.Type)
+			if typeName == "" || !goast.IsExported(typeName) {
+				continue
+			}
+
+			tag := parseTag(typeName, field.Tag)
+
+			switch {
+			case tag.Skip:
+			case !tag.Named && ft.Kind == structKind:
+				inline(ft)
+			case !tag.Named && ft.Kind == pointerKind && ft.Elem.Kind == structKind:
+				inline(*ft.Elem)
+			default:
+				keys = append(keys, tag.Name)
+			}
+
+			continue
+		}
+
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			tag := parseTag(ident.Name, field.Tag)
+
+			switch {
+			case tag.Skip:
+			case tag.Inline && ft.Kind == structKind:
+				inline(ft)
+			case tag.Inline && ft.Kind == mapKind:
+			default:
+				keys = append(keys, tag.Name)
+			}
+		}
+	}
+
+	return keys
+}
+
+// assignable returns the giving target without the parentheses around a dereferenced
+// pointer, e.g "*elem.Note" for "(*elem.Note)".
+func assignable(target string) string {
+	if strings.HasPrefix(target, "(*") && strings.HasSuffix(target, ")") && strings.Count(target, "(") == 1 {
+		return target[1 : len(target)-1]
+	}
+
+	return target
+}
+
+// quoteAll returns the giving values quoted and separated by commas.
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for index, value := range values {
+		quoted[index] = fmt.Sprintf("%q", value)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// contains returns true/false if the giving value is within values.
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isBytes returns true/false if the giving array type is a []byte, which is stored as
+// binary data rather than a list.
+func isBytes(arrayType *goast.ArrayType) bool {
+	elem, ok := arrayType.Elt.(*goast.Ident)
+	return ok && arrayType.Len == nil && (elem.Name == "byte" || elem.Name == "uint8")
+}
@@ -0,0 +1,436 @@
+package mgo
+
+import (
+	"bytes"
+	"fmt"
+	goast "go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/influx6/moz/ast"
+)
+
+// randomAssign returns a function returning go source which assigns random values drawn
+// from the rand.Rand named by rng to all exported fields of the giving struct through
+// varName, including the fields of the structs it holds. Values satisfy the validate
+// rules of their fields, hence pass the generated Validate function. Fields whose types
+// have no known random value are left at their zero value.
+func randomAssign(pkg ast.Package) func(ast.StructDeclaration, string, string) (string, error) {
+	return func(str ast.StructDeclaration, varName string, rng string) (string, error) {
+		b := fixtureBuilder{rng: rng, pkg: str.Package, visiting: make(map[string]bool)}
+
+		st := structType(str, pkg)
+		b.visiting[structKey(st)] = true
+		b.fields(varName, st)
+
+		return b.out.String(), nil
+	}
+}
+
+// randomJSON returns the JSON document of the giving struct with random values, using the
+// giving tag names for its keys, else an empty document for structs the ast package can not
+// map, e.g holding embedded pointers. The document only seeds the JSON fixture, which is
+// generated once to be edited, as generated tests use the records of randomAssign.
+func randomJSON(str ast.StructDeclaration, tagName string, fallback string) (string, error) {
+	document, err := ast.MapOutFieldsWithRandomValuesToJSON(str, tagName, fallback)
+	if err != nil {
+		return "{}", nil
+	}
+
+	return document, nil
+}
+
+// bounds defines the constraints of the validate rules of a field on its random value.
+// Min and Max are empty if unset.
+type bounds struct {
+	Ruled    bool
+	Required bool
+	Email    bool
+	Min      string
+	Max      string
+	OneOf    []string
+}
+
+// boundsOf returns the bounds set by the giving rules.
+func boundsOf(rules []rule) bounds {
+	bd := bounds{Ruled: len(rules) != 0}
+
+	for _, r := range rules {
+		switch r.Name {
+		case "required":
+			bd.Required = true
+		case "email":
+			bd.Email = true
+		case "min":
+			bd.Min = r.Param
+		case "max":
+			bd.Max = r.Param
+		case "oneof":
+			bd.OneOf = strings.Split(r.Param, "|")
+		}
+	}
+
+	return bd
+}
+
+// length returns the giving length within the min and max bounds, which bound lengths.
+func (bd bounds) length(n int) int {
+	if min, err := strconv.Atoi(bd.Min); err == nil && n < min {
+		n = min
+	}
+
+	if max, err := strconv.Atoi(bd.Max); err == nil && n > max {
+		n = max
+	}
+
+	return n
+}
+
+// fixtureBuilder writes the statements assigning random values to the fields of a struct,
+// expanding each nested struct once per path to stop at recursive types. Named types
+// declared within pkg, the package of the struct, are referenced through it.
+type fixtureBuilder struct {
+	out      bytes.Buffer
+	rng      string
+	pkg      string
+	vars     int
+	visiting map[string]bool
+}
+
+// fields writes the assignments of all exported fields of the struct value. Embedded
+// structs are filled, while embedded pointers and fields not stored within documents are
+// left nil, so records read back from mongodb equal the records stored.
+func (b *fixtureBuilder) fields(value string, st fieldType) {
+	for _, field := range st.Struct.Struct.Fields.List {
+		if parseTag("", field.Tag).Skip {
+			continue
+		}
+
+		ft := resolve(field.Type, st.Scope)
+
+		if len(field.Names) == 0 {
+			if typeName := embeddedName(field.Type); goast.IsExported(typeName) && ft.Kind == structKind {
+				b.nested(value+"."+typeName, ft)
+			}
+			continue
+		}
+
+		// Invalid validate tags are reported by Validate, hence ignored here.
+		rules, _ := parseRules(field.Tag)
+		bd := boundsOf(rules)
+
+		for _, ident := range field.Names {
+			if ident.IsExported() {
+				b.field(value+"."+ident.Name, ident.Name, field.Type, ft, bd)
+			}
+		}
+	}
+}
+
+// field writes the assignment of the giving field value, of type expr.
+func (b *fixtureBuilder) field(value string, name string, expr goast.Expr, ft fieldType, bd bounds) {
+	typeName := types.ExprString(expr)
+
+	switch ft.Kind {
+	case structKind:
+		b.nested(value, ft)
+		return
+	case sliceKind:
+		b.slice(value, expr, ft, bd)
+		return
+	case pointerKind:
+		star, ok := expr.(*goast.StarExpr)
+		if !bd.Required || typeName == "*time.Time" || !ok {
+			break
+		}
+
+		// Rules other than required apply to the element, which must then be set.
+		elem := b.value(name, types.ExprString(star.X), *ft.Elem, bd)
+		if elem == "" {
+			return
+		}
+
+		tmp := b.newVar("value")
+		fmt.Fprintf(&b.out, "%s := %s\n%s = &%s\n", tmp, elem, value, tmp)
+		return
+	}
+
+	if random := b.value(name, typeName, ft, bd); random != "" {
+		fmt.Fprintf(&b.out, "%s = %s\n", value, random)
+	}
+}
+
+// nested writes the assignments of the fields of the struct value, unless the struct is
+// being expanded already.
+func (b *fixtureBuilder) nested(value string, ft fieldType) {
+	key := structKey(ft)
+	if b.visiting[key] {
+		return
+	}
+
+	b.visiting[key] = true
+	defer delete(b.visiting, key)
+
+	b.fields(value, ft)
+}
+
+// slice writes the assignment of the giving slice value, holding as many items as its
+// rules require.
+func (b *fixtureBuilder) slice(value string, expr goast.Expr, ft fieldType, bd bounds) {
+	typeName := types.ExprString(expr)
+
+	if !bd.Ruled {
+		if random := randomValue(typeName, "", b.rng); random != "" {
+			fmt.Fprintf(&b.out, "%s = %s\n", value, random)
+		}
+		return
+	}
+
+	if typeName == "[]byte" {
+		fmt.Fprintf(&b.out, "%s = []byte(randomString(%s, %d))\n", value, b.rng, bd.length(20))
+		return
+	}
+
+	count := 0
+	if typeName == "[]string" {
+		count = 2
+	}
+
+	if bd.Required && count == 0 {
+		count = 1
+	}
+
+	count = bd.length(count)
+	if count == 0 {
+		return
+	}
+
+	elemExpr := expr.(*goast.ArrayType).Elt
+	elem := *ft.Elem
+
+	if elem.Kind != structKind {
+		random := randomValue(types.ExprString(elemExpr), "", b.rng)
+		if random == "" {
+			return
+		}
+
+		items := make([]string, count)
+		for index := range items {
+			items[index] = random
+		}
+
+		fmt.Fprintf(&b.out, "%s = %s{%s}\n", value, typeName, strings.Join(items, ", "))
+		return
+	}
+
+	elemType, ok := b.typeRef(elemExpr, elem)
+	if !ok {
+		return
+	}
+
+	index := b.newVar("index")
+	item := b.newVar("item")
+
+	fmt.Fprintf(&b.out, "for %s := 0; %s < %d; %s++ {\n", index, index, count, index)
+	fmt.Fprintf(&b.out, "var %s %s\n", item, elemType)
+	b.nested(item, elem)
+	fmt.Fprintf(&b.out, "%s = append(%s, %s)\n}\n", value, value, item)
+}
+
+// value returns a go expression producing a random value of the giving field type within
+// its bounds, else an empty string if the type is not supported. Fields without rules get
+// the values of randomValue.
+func (b *fixtureBuilder) value(name string, typeName string, ft fieldType, bd bounds) string {
+	if !bd.Ruled || ft.Basic == "bson.ObjectId" {
+		return randomValue(typeName, name, b.rng)
+	}
+
+	switch ft.Kind {
+	case stringKind, numberKind:
+	case boolKind:
+		if bd.Required {
+			return "true"
+		}
+		return randomValue(typeName, name, b.rng)
+	default:
+		return randomValue(typeName, name, b.rng)
+	}
+
+	conv, ok := b.typeRef(goast.NewIdent(typeName), ft)
+
+	if len(bd.OneOf) != 0 {
+		values := make([]string, len(bd.OneOf))
+		for index, v := range bd.OneOf {
+			values[index] = v
+			if ft.Kind == stringKind {
+				values[index] = strconv.Quote(v)
+			}
+		}
+
+		// Untyped constants are assignable to named types declared within other packages.
+		if len(values) == 1 || !ok {
+			return values[0]
+		}
+
+		pick := fmt.Sprintf("[]%s{%s}[%s.Intn(%d)]", ft.Basic, strings.Join(values, ", "), b.rng, len(values))
+		if !ft.Named {
+			return pick
+		}
+
+		return fmt.Sprintf("%s(%s)", conv, pick)
+	}
+
+	var random string
+	if ft.Kind == stringKind {
+		random = b.randomString(name, bd)
+	} else {
+		random = b.randomNumber(ft, bd)
+	}
+
+	switch {
+	case random == "" || !ok:
+		return ""
+	case conv == "string":
+		return random
+	}
+
+	return fmt.Sprintf("%s(%s)", conv, random)
+}
+
+// randomString returns a go expression producing a random string within the giving bounds,
+// which is an email address if they require it or the field is named after one.
+func (b *fixtureBuilder) randomString(name string, bd bounds) string {
+	const domain = "@example.com"
+
+	n := 20
+	switch strings.ToLower(name) {
+	case "email", "emailaddress", "email_address":
+		bd.Email = true
+	case "publicid", "privateid", "public_id", "private_id":
+		n = 30
+	}
+
+	if bd.Email {
+		local := bd.length(10+len(domain)) - len(domain)
+		if local < 1 {
+			local = 1
+		}
+
+		return fmt.Sprintf("randomString(%s, %d) + %q", b.rng, local, domain)
+	}
+
+	if n = bd.length(n); n == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("randomString(%s, %d)", b.rng, n)
+}
+
+// randomNumber returns a go expression producing a random number of the giving type within
+// the giving bounds, which default to [0, 100).
+func (b *fixtureBuilder) randomNumber(ft fieldType, bd bounds) string {
+	if ft.Basic == "float32" || ft.Basic == "float64" {
+		low, high := 0.0, 100.0
+		if min, err := strconv.ParseFloat(bd.Min, 64); err == nil {
+			low = min
+			if high < low {
+				high = low + 100
+			}
+		}
+
+		if max, err := strconv.ParseFloat(bd.Max, 64); err == nil {
+			high = max
+			if low > high {
+				low = high
+			}
+		}
+
+		random := fmt.Sprintf("%s.Float64() * %s", b.rng, strconv.FormatFloat(high-low, 'g', -1, 64))
+		if low != 0 {
+			random = strconv.FormatFloat(low, 'g', -1, 64) + " + " + random
+		}
+
+		return random
+	}
+
+	var low, high int64 = 0, 99
+	if bd.Required {
+		low = 1
+	}
+
+	if min, err := strconv.ParseInt(bd.Min, 10, 64); err == nil {
+		low = min
+		if high < low {
+			high = low + 99
+		}
+	}
+
+	if max, err := strconv.ParseInt(bd.Max, 10, 64); err == nil {
+		high = max
+		if low > high {
+			low = high
+		}
+	}
+
+	random := fmt.Sprintf("%s.Intn(%d)", b.rng, high-low+1)
+	if low != 0 {
+		random = fmt.Sprintf("%d + %s", low, random)
+	}
+
+	return random
+}
+
+// typeRef returns the giving type expression as referenced by the fixtures package, which
+// only imports the package of the struct, else false if it can not reference it.
+func (b *fixtureBuilder) typeRef(expr goast.Expr, ft fieldType) (string, bool) {
+	switch {
+	case ft.Kind == structKind && ft.Scope.Path == "":
+		return b.pkg + "." + ft.Struct.Object.Name.Name, true
+	case ft.Kind == structKind:
+		return "", false
+	case ft.Named && ft.Scope.Path == "":
+		return b.pkg + "." + ft.Name, true
+	case ft.Named:
+		return "", false
+	}
+
+	return types.ExprString(expr), true
+}
+
+func (b *fixtureBuilder) newVar(name string) string {
+	b.vars++
+	return fmt.Sprintf("%s%d", name, b.vars)
+}
+
+// randomValue returns a go expression producing a random value of typeName from
+// the rand.Rand named by rng, else an empty string if typeName is not supported.
+func randomValue(typeName string, fieldName string, rng string) string {
+	switch typeName {
+	case "string":
+		switch strings.ToLower(fieldName) {
+		case "email", "emailaddress", "email_address":
+			return fmt.Sprintf("randomString(%s, 10) + \"@example.com\"", rng)
+		case "publicid", "privateid", "public_id", "private_id":
+			return fmt.Sprintf("randomString(%s, 30)", rng)
+		default:
+			return fmt.Sprintf("randomString(%s, 20)", rng)
+		}
+	case "bool":
+		return fmt.Sprintf("%s.Intn(2) == 0", rng)
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return fmt.Sprintf("%s(%s.Intn(100))", typeName, rng)
+	case "float32", "float64":
+		return fmt.Sprintf("%s(%s.Float64() * 100)", typeName, rng)
+	case "time.Time":
+		return fmt.Sprintf("randomTime(%s)", rng)
+	case "*time.Time":
+		return fmt.Sprintf("randomTimePtr(%s)", rng)
+	case "[]string":
+		return fmt.Sprintf("[]string{randomString(%s, 10), randomString(%s, 10)}", rng, rng)
+	case "[]byte":
+		return fmt.Sprintf("[]byte(randomString(%s, 20))", rng)
+	}
+
+	return ""
+}
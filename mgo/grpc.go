@@ -0,0 +1,832 @@
+package mgo
+
+import (
+	"bytes"
+	"fmt"
+	goast "go/ast"
+	"sort"
+	"strings"
+
+	"github.com/influx6/moz/ast"
+)
+
+// protoAPI defines the protobuf messages generated for a struct, with the go functions
+// converting the struct to and from its message.
+type protoAPI struct {
+	// Messages sets the protobuf messages of the struct and the structs it contains, and
+	// Imports the protobuf files they import.
+	Messages string
+	Imports  []string
+
+	// Convert sets the go source of the ToProto, FromProto and FieldValue functions, and
+	// GoImports the import paths it uses.
+	Convert   string
+	GoImports []string
+
+	// NumberKey sets the key of an integer field of the struct which FieldValue converts
+	// to NumberType, a type the tests of the grpcapi package can reference, if any.
+	NumberKey  string
+	NumberType string
+}
+
+// protoReserved contains the names of the messages declared for the rpcs of a service,
+// which messages of structs must not use.
+var protoReserved = []string{"Service", "ID", "UpdateRequest", "GetAllRequest", "GetAllResponse", "GetAllByOrderRequest", "List", "GetByFieldRequest", "CountResponse"}
+
+// buildProto returns the protoAPI of the giving struct, declared within the giving package,
+// whose messages are generated into the go package pbName.
+func buildProto(str ast.StructDeclaration, pkg ast.Package, pbName string) (protoAPI, error) {
+	b := &protoBuilder{
+		pb:        pbName,
+		rootName:  str.Package,
+		rootPath:  str.Path,
+		messages:  make(map[string]string),
+		imports:   make(map[string]bool),
+		goImports: make(map[string]bool),
+		helpers:   make(map[string]bool),
+	}
+
+	for _, name := range protoReserved {
+		b.messages[str.Object.Name.Name+name] = ""
+	}
+
+	root := structType(str, pkg)
+	b.root = structKey(root)
+	b.messages[str.Object.Name.Name] = b.root
+	b.queue = append(b.queue, root)
+
+	for len(b.queue) != 0 {
+		st := b.queue[0]
+		b.queue = b.queue[1:]
+
+		if err := b.message(st); err != nil {
+			return protoAPI{}, err
+		}
+	}
+
+	if err := b.fieldValue(root); err != nil {
+		return protoAPI{}, err
+	}
+
+	api := protoAPI{
+		Messages:   strings.TrimSpace(b.proto.String()),
+		Convert:    b.out.String(),
+		NumberKey:  b.numberKey,
+		NumberType: b.numberType,
+	}
+
+	for path := range b.imports {
+		api.Imports = append(api.Imports, path)
+	}
+
+	for path := range b.goImports {
+		api.GoImports = append(api.GoImports, path)
+	}
+
+	sort.Strings(api.Imports)
+	sort.Strings(api.GoImports)
+	return api, nil
+}
+
+// protoBuilder writes the protobuf message of every struct reached from the generated
+// struct, once each, along with the go functions converting them.
+type protoBuilder struct {
+	pb        string
+	root      string
+	rootName  string
+	rootPath  string
+	messages  map[string]string
+	queue     []fieldType
+	proto     bytes.Buffer
+	out       bytes.Buffer
+	imports   map[string]bool
+	goImports map[string]bool
+	vars      int
+
+	helpers    map[string]bool
+	numberKey  string
+	numberType string
+}
+
+// protoField defines a field of a protobuf message with the go field and value it is
+// converted from, and the key of the field within stored documents.
+type protoField struct {
+	Name  string
+	Key   string
+	Field string
+	Value string
+	Type  fieldType
+}
+
+// message writes the protobuf message of the giving struct type and its conversion functions,
+// named ToProto and FromProto for the generated struct.
+func (b *protoBuilder) message(st fieldType) error {
+	name := st.Struct.Object.Name.Name
+
+	var fields []protoField
+	if err := b.fields(&fields, "elem", st); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(&b.proto, "// %s mirrors %s.\n", name, b.goType(st))
+	fmt.Fprintf(&b.proto, "message %s {\n", name)
+
+	seen := make(map[string]string)
+	for index, field := range fields {
+		if other, ok := seen[field.Name]; ok {
+			return fmt.Errorf("Struct %q has fields %q and %q which map to the same protobuf field %q", name, other, field.Field, field.Name)
+		}
+		seen[field.Name] = field.Field
+
+		kind, err := b.protoType(field.Type)
+		if err != nil {
+			return fmt.Errorf("Struct %q has field %q without protobuf equivalent: %s", name, field.Field, err)
+		}
+
+		fmt.Fprintf(&b.proto, "  %s %s = %d;\n", kind, field.Name, index+1)
+	}
+
+	fmt.Fprintf(&b.proto, "}\n\n")
+
+	toName, fromName := b.funcNames(st)
+	goType := b.goType(st)
+
+	fmt.Fprintf(&b.out, "// %s returns the protobuf message of the giving %s.\n", toName, goType)
+	fmt.Fprintf(&b.out, "func %s(elem %s) *%s.%s {\n", toName, goType, b.pb, name)
+	fmt.Fprintf(&b.out, "msg := &%s.%s{}\n", b.pb, name)
+	for _, field := range fields {
+		b.toProto("msg."+goCamelCase(field.Name), field.Value, field.Type)
+	}
+	fmt.Fprintf(&b.out, "return msg\n}\n\n")
+
+	fmt.Fprintf(&b.out, "// %s returns the %s of the giving protobuf message, failing on values which\n// are not valid for its fields.\n", fromName, goType)
+	fmt.Fprintf(&b.out, "func %s(msg *%s.%s) (%s, error) {\n", fromName, b.pb, name, goType)
+	fmt.Fprintf(&b.out, "var elem %s\nif msg == nil {\nreturn elem, nil\n}\n\n", goType)
+	for _, field := range fields {
+		b.fromProto(field.Value, "msg."+goCamelCase(field.Name), field.Type, field.Name)
+	}
+	fmt.Fprintf(&b.out, "return elem, nil\n}\n\n")
+
+	return nil
+}
+
+// fields adds the fields of all stored fields of the giving struct type, whose value is
+// held by value, inlining embedded structs as documents do.
+func (b *protoBuilder) fields(fields *[]protoField, value string, st fieldType) error {
+	for _, field := range st.Struct.Struct.Fields.List {
+		ft := resolve(field.Type, st.Scope)
+
+		if len(field.Names) == 0 {
+			typeName := embeddedName(field.Type)
+			if typeName == "" || !goast.IsExported(typeName) {
+				continue
+			}
+
+			tag := parseTag(typeName, field.Tag)
+			switch {
+			case tag.Skip:
+				continue
+			case !tag.Named && ft.Kind == structKind:
+				if err := b.fields(fields, value+"."+typeName, ft); err != nil {
+					return err
+				}
+				continue
+			}
+
+			*fields = append(*fields, protoField{Name: protoName(tag.Name), Key: tag.Name, Field: typeName, Value: value + "." + typeName, Type: ft})
+			continue
+		}
+
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			tag := parseTag(ident.Name, field.Tag)
+			if tag.Skip {
+				continue
+			}
+
+			if tag.Inline && ft.Kind == structKind {
+				if err := b.fields(fields, value+"."+ident.Name, ft); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if tag.Inline {
+				return fmt.Errorf("Struct %q has field %q without protobuf equivalent: only structs can be inlined", st.Struct.Object.Name.Name, ident.Name)
+			}
+
+			*fields = append(*fields, protoField{Name: protoName(tag.Name), Key: tag.Name, Field: ident.Name, Value: value + "." + ident.Name, Type: ft})
+		}
+	}
+
+	return nil
+}
+
+// protoType returns the protobuf type of fields of the giving type, queueing the messages of
+// the structs it contains.
+func (b *protoBuilder) protoType(ft fieldType) (string, error) {
+	switch ft.Kind {
+	case pointerKind:
+		switch ft.Elem.Kind {
+		case stringKind, numberKind, boolKind:
+			elem, err := b.protoType(*ft.Elem)
+			return "optional " + elem, err
+		case timeKind, structKind:
+			return b.protoType(*ft.Elem)
+		}
+
+		return "", fmt.Errorf("pointers are only supported to strings, numbers, bools, times and structs")
+	case sliceKind:
+		if ft.Elem.isByte() {
+			return "bytes", nil
+		}
+
+		switch ft.Elem.Kind {
+		case sliceKind, mapKind:
+			if !ft.Elem.Elem.isByte() || ft.Elem.Kind == mapKind {
+				return "", fmt.Errorf("slices of slices and maps are not supported")
+			}
+		case pointerKind:
+			if ft.Elem.Elem.Kind != structKind {
+				return "", fmt.Errorf("slices of pointers are only supported to structs")
+			}
+		}
+
+		elem, err := b.protoType(*ft.Elem)
+		return "repeated " + elem, err
+	case mapKind:
+		switch ft.Elem.Kind {
+		case mapKind:
+			return "", fmt.Errorf("maps of maps are not supported")
+		case sliceKind:
+			if !ft.Elem.Elem.isByte() {
+				return "", fmt.Errorf("maps of slices are not supported")
+			}
+		case pointerKind:
+			if ft.Elem.Elem.Kind != structKind {
+				return "", fmt.Errorf("maps of pointers are only supported to structs")
+			}
+		}
+
+		elem, err := b.protoType(*ft.Elem)
+		return "map<string, " + elem + ">", err
+	case timeKind:
+		b.imports["google/protobuf/timestamp.proto"] = true
+		return "google.protobuf.Timestamp", nil
+	case structKind:
+		return b.queueMessage(ft)
+	case stringKind, numberKind, boolKind:
+		return protoScalar(ft), nil
+	}
+
+	return "", fmt.Errorf("interfaces and types left to the bson package are not supported")
+}
+
+// queueMessage returns the name of the message of the giving struct type, queueing it to be
+// written if it was not yet.
+func (b *protoBuilder) queueMessage(ft fieldType) (string, error) {
+	name := ft.Struct.Object.Name.Name
+	key := structKey(ft)
+
+	if existing, ok := b.messages[name]; ok {
+		if existing != key {
+			return "", fmt.Errorf("struct %s maps to the protobuf message %s, which is already used", b.goType(ft), name)
+		}
+		return name, nil
+	}
+
+	b.messages[name] = key
+	b.queue = append(b.queue, ft)
+	return name, nil
+}
+
+// protoScalar returns the protobuf scalar type of strings, numbers and bools.
+func protoScalar(ft fieldType) string {
+	switch ft.Basic {
+	case "string", "bson.ObjectId":
+		return "string"
+	case "bool":
+		return "bool"
+	case "int", "int64":
+		return "int64"
+	case "int8", "int16", "int32", "rune":
+		return "int32"
+	case "uint8", "uint16", "uint32", "byte":
+		return "uint32"
+	case "float32":
+		return "float"
+	case "float64":
+		return "double"
+	}
+
+	return "uint64"
+}
+
+// protoGoScalar returns the go type protobuf scalars of the giving type are generated with.
+func protoGoScalar(ft fieldType) string {
+	switch kind := protoScalar(ft); kind {
+	case "float":
+		return "float32"
+	case "double":
+		return "float64"
+	default:
+		return kind
+	}
+}
+
+// protoGoType returns the go type of protobuf values of the giving field type, without the
+// pointer of optional scalars.
+func (b *protoBuilder) protoGoType(ft fieldType) string {
+	switch ft.Kind {
+	case pointerKind:
+		return b.protoGoType(*ft.Elem)
+	case sliceKind:
+		if ft.Elem.isByte() {
+			return "[]byte"
+		}
+		return "[]" + b.protoGoType(*ft.Elem)
+	case mapKind:
+		return "map[string]" + b.protoGoType(*ft.Elem)
+	case timeKind:
+		b.goImports["google.golang.org/protobuf/types/known/timestamppb"] = true
+		return "*timestamppb.Timestamp"
+	case structKind:
+		return fmt.Sprintf("*%s.%s", b.pb, ft.Struct.Object.Name.Name)
+	}
+
+	return protoGoScalar(ft)
+}
+
+// goType returns the go type of values of the giving field type, adding the imports it needs.
+func (b *protoBuilder) goType(ft fieldType) string {
+	switch ft.Kind {
+	case pointerKind:
+		return "*" + b.goType(*ft.Elem)
+	case sliceKind:
+		return "[]" + b.goType(*ft.Elem)
+	case mapKind:
+		return "map[string]" + b.goType(*ft.Elem)
+	case timeKind:
+		b.goImports["time"] = true
+		return "time.Time"
+	case structKind:
+		return b.qualify(ft.Scope, ft.Struct.Object.Name.Name)
+	}
+
+	if ft.Named {
+		return b.qualify(ft.Scope, ft.Name)
+	}
+
+	if ft.Basic == "bson.ObjectId" {
+		b.goImports["gopkg.in/mgo.v2/bson"] = true
+	}
+
+	return ft.Basic
+}
+
+// qualify returns the giving type name qualified by the package of the giving scope, or of
+// the generated struct if the scope has none.
+func (b *protoBuilder) qualify(sc scope, name string) string {
+	if sc.Path == "" {
+		b.goImports[b.rootPath] = true
+		return b.rootName + "." + name
+	}
+
+	b.goImports[sc.Path] = true
+	return sc.Name + "." + name
+}
+
+// funcNames returns the names of the functions converting the giving struct type to and from
+// its message, ToProto and FromProto for the generated struct.
+func (b *protoBuilder) funcNames(st fieldType) (string, string) {
+	if structKey(st) == b.root {
+		return "ToProto", "FromProto"
+	}
+
+	name := st.Struct.Object.Name.Name
+	return "toProto" + name, "fromProto" + name
+}
+
+// toProto writes the statements setting the protobuf field target to the giving go value of
+// a field. Nil pointers and zero times are left unset.
+func (b *protoBuilder) toProto(target string, value string, ft fieldType) {
+	switch ft.Kind {
+	case pointerKind:
+		fmt.Fprintf(&b.out, "if %s != nil {\n", value)
+		switch ft.Elem.Kind {
+		case stringKind, numberKind, boolKind:
+			v := b.newVar("value")
+			fmt.Fprintf(&b.out, "%s := %s\n%s = &%s\n", v, b.toValue("(*"+value+")", *ft.Elem), target, v)
+		default:
+			b.toProto(target, "(*"+value+")", *ft.Elem)
+		}
+		fmt.Fprintf(&b.out, "}\n")
+	case timeKind:
+		b.goImports["google.golang.org/protobuf/types/known/timestamppb"] = true
+		fmt.Fprintf(&b.out, "if !%s.IsZero() {\n%s = timestamppb.New(%s)\n}\n", value, target, value)
+	case sliceKind:
+		if ft.Elem.isByte() {
+			fmt.Fprintf(&b.out, "%s = %s\n", target, value)
+			return
+		}
+
+		item := b.newVar("item")
+		fmt.Fprintf(&b.out, "for _, %s := range %s {\n", item, value)
+		fmt.Fprintf(&b.out, "%s = append(%s, %s)\n}\n", target, target, b.toValue(item, *ft.Elem))
+	case mapKind:
+		key, item := b.newVar("key"), b.newVar("item")
+		fmt.Fprintf(&b.out, "if %s != nil {\n%s = make(%s, len(%s))\n", value, target, b.protoGoType(ft), value)
+		fmt.Fprintf(&b.out, "for %s, %s := range %s {\n", key, item, value)
+		fmt.Fprintf(&b.out, "%s[%s] = %s\n}\n}\n", target, key, b.toValue(item, *ft.Elem))
+	default:
+		fmt.Fprintf(&b.out, "%s = %s\n", target, b.toValue(value, ft))
+	}
+}
+
+// toValue returns the expression of the protobuf value of the giving go value of an item of a
+// slice or map, writing the statements it needs. Nil pointers to structs are kept nil, which
+// protobuf encodes as empty messages.
+func (b *protoBuilder) toValue(value string, ft fieldType) string {
+	switch ft.Kind {
+	case pointerKind:
+		v := b.newVar("value")
+		fmt.Fprintf(&b.out, "var %s *%s.%s\nif %s != nil {\n", v, b.pb, ft.Elem.Struct.Object.Name.Name, value)
+		fmt.Fprintf(&b.out, "%s = %s\n}\n", v, b.toValue("(*"+value+")", *ft.Elem))
+		return v
+	case timeKind:
+		b.goImports["google.golang.org/protobuf/types/known/timestamppb"] = true
+		return fmt.Sprintf("timestamppb.New(%s)", value)
+	case structKind:
+		toName, _ := b.funcNames(ft)
+		return fmt.Sprintf("%s(%s)", toName, value)
+	case sliceKind, mapKind:
+		if ft.Kind == sliceKind && ft.Elem.isByte() {
+			return value
+		}
+
+		v := b.newVar("value")
+		fmt.Fprintf(&b.out, "var %s %s\n", v, b.protoGoType(ft))
+		b.toProto(v, value, ft)
+		return v
+	}
+
+	if ft.Basic == "bson.ObjectId" {
+		if ft.Named {
+			return fmt.Sprintf("bson.ObjectId(%s).Hex()", value)
+		}
+		return value + ".Hex()"
+	}
+
+	if kind := protoGoScalar(ft); ft.Named || kind != ft.Basic {
+		return fmt.Sprintf("%s(%s)", kind, value)
+	}
+
+	return value
+}
+
+// fromProto writes the statements setting the go field target to the giving protobuf value of
+// a field, returning an error naming the protobuf field name for invalid values.
+func (b *protoBuilder) fromProto(target string, value string, ft fieldType, name string) {
+	switch ft.Kind {
+	case pointerKind:
+		fmt.Fprintf(&b.out, "if %s != nil {\n", value)
+
+		fmt.Fprintf(&b.out, "%s = &%s\n}\n", target, b.fromPointee(value, *ft.Elem, name))
+	case timeKind:
+		fmt.Fprintf(&b.out, "if %s != nil {\n%s = %s.AsTime()\n}\n", value, target, value)
+	case sliceKind:
+		if ft.Elem.isByte() {
+			fmt.Fprintf(&b.out, "%s = %s\n", target, value)
+			return
+		}
+
+		item := b.newVar("item")
+		fmt.Fprintf(&b.out, "for _, %s := range %s {\n", item, value)
+		fmt.Fprintf(&b.out, "%s = append(%s, %s)\n}\n", target, target, b.fromValue(item, *ft.Elem, name))
+	case mapKind:
+		key, item := b.newVar("key"), b.newVar("item")
+		fmt.Fprintf(&b.out, "if %s != nil {\n%s = make(%s, len(%s))\n", value, target, b.goType(ft), value)
+		fmt.Fprintf(&b.out, "for %s, %s := range %s {\n", key, item, value)
+		fmt.Fprintf(&b.out, "%s[%s] = %s\n}\n}\n", target, key, b.fromValue(item, *ft.Elem, name))
+	default:
+		fmt.Fprintf(&b.out, "%s = %s\n", target, b.fromValue(value, ft, name))
+	}
+}
+
+// fromValue returns the expression of the go value of the giving protobuf value of an item of
+// a slice or map, writing the statements it needs.
+func (b *protoBuilder) fromValue(value string, ft fieldType, name string) string {
+	switch ft.Kind {
+	case pointerKind:
+		v := b.newVar("value")
+		fmt.Fprintf(&b.out, "var %s %s\nif %s != nil {\n", v, b.goType(ft), value)
+		fmt.Fprintf(&b.out, "%s = &%s\n}\n", v, b.fromPointee(value, *ft.Elem, name))
+		return v
+	case timeKind:
+		return value + ".AsTime()"
+	case structKind:
+		_, fromName := b.funcNames(ft)
+		v := b.newVar("value")
+		fmt.Fprintf(&b.out, "%s, err := %s(%s)\nif err != nil {\nreturn elem, err\n}\n", v, fromName, value)
+		return v
+	case sliceKind, mapKind:
+		if ft.Kind == sliceKind && ft.Elem.isByte() {
+			return value
+		}
+
+		v := b.newVar("value")
+		fmt.Fprintf(&b.out, "var %s %s\n", v, b.goType(ft))
+		b.fromProto(v, value, ft, name)
+		return v
+	}
+
+	goType := b.goType(ft)
+
+	// Empty ids are kept empty, as bson.ObjectIdHex panics on them.
+	if ft.Basic == "bson.ObjectId" {
+		b.goImports["fmt"] = true
+
+		id := "bson.ObjectIdHex(" + value + ")"
+		if ft.Named {
+			id = fmt.Sprintf("%s(%s)", goType, id)
+		}
+
+		v := b.newVar("value")
+		fmt.Fprintf(&b.out, "var %s %s\nif %s != \"\" {\n", v, goType, value)
+		fmt.Fprintf(&b.out, "if !bson.IsObjectIdHex(%s) {\nreturn elem, fmt.Errorf(\"field %s holds invalid ObjectId %%q\", %s)\n}\n", value, name, value)
+		fmt.Fprintf(&b.out, "%s = %s\n}\n", v, id)
+		return v
+	}
+
+	if ft.Named || goType != protoGoScalar(ft) {
+		return fmt.Sprintf("%s(%s)", goType, value)
+	}
+
+	return value
+}
+
+// fromPointee returns the variable holding the go value of the giving non-nil protobuf value
+// of a pointer field or item, writing the statements it needs.
+func (b *protoBuilder) fromPointee(value string, ft fieldType, name string) string {
+	switch ft.Kind {
+	case structKind:
+		return b.fromValue(value, ft, name)
+	case timeKind:
+		v := b.newVar("value")
+		fmt.Fprintf(&b.out, "%s := %s.AsTime()\n", v, value)
+		return v
+	}
+
+	v := b.newVar("value")
+	fmt.Fprintf(&b.out, "%s := %s\n", v, b.fromValue("(*"+value+")", ft, name))
+	return v
+}
+
+// fieldValue writes the FieldValue function, converting the values of GetByField requests to
+// the go types of the fields of the giving struct type they query, as protobuf values only
+// hold float64, string, bool, nil, lists and structs.
+func (b *protoBuilder) fieldValue(root fieldType) error {
+	var cases bytes.Buffer
+	if err := b.fieldCases(&cases, "", root, map[string]bool{structKey(root): true}); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(&b.out, "// FieldValue returns the giving value of a GetByField request, as returned by AsInterface\n")
+	fmt.Fprintf(&b.out, "// of its google.protobuf.Value, converted to the go type of the field of the giving key,\n")
+	fmt.Fprintf(&b.out, "// failing on values which are not valid for it. Items of slices are queried by values of\n")
+	fmt.Fprintf(&b.out, "// their type, times by RFC 3339 strings and ObjectIds by hex strings. Values of other keys\n")
+	fmt.Fprintf(&b.out, "// are returned as they are.\n")
+	fmt.Fprintf(&b.out, "func FieldValue(key string, value interface{}) (interface{}, error) {\n")
+	fmt.Fprintf(&b.out, "if value == nil {\nreturn nil, nil\n}\n\n")
+	fmt.Fprintf(&b.out, "switch key {\n%s}\n\nreturn value, nil\n}\n", cases.String())
+
+	for _, helper := range fieldHelpers {
+		if b.helpers[helper.Name] {
+			fmt.Fprintf(&b.out, "\n%s", helper.Source)
+		}
+	}
+
+	return nil
+}
+
+// fieldCases writes the cases of FieldValue for the fields of the giving struct type, whose
+// keys are prefixed by prefix, including the fields of the structs it holds.
+func (b *protoBuilder) fieldCases(out *bytes.Buffer, prefix string, st fieldType, visiting map[string]bool) error {
+	var fields []protoField
+	if err := b.fields(&fields, "elem", st); err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		key := prefix + field.Key
+
+		ft := field.Type
+		if ft.Kind == pointerKind {
+			ft = *ft.Elem
+		}
+
+		if ft.Kind == sliceKind && !ft.Elem.isByte() {
+			ft = *ft.Elem
+			if ft.Kind == pointerKind {
+				ft = *ft.Elem
+			}
+		}
+
+		switch ft.Kind {
+		case structKind:
+			if visiting[structKey(ft)] {
+				continue
+			}
+
+			visiting[structKey(ft)] = true
+			err := b.fieldCases(out, key+".", ft, visiting)
+			delete(visiting, structKey(ft))
+
+			if err != nil {
+				return err
+			}
+			continue
+		case stringKind, numberKind, boolKind, timeKind:
+		default:
+			continue
+		}
+
+		b.goImports["fmt"] = true
+		goType := b.goType(ft)
+
+		var helper, call, converted string
+		switch {
+		case ft.Kind == timeKind:
+			helper, call, converted = "fieldTime", "fieldTime(key, value)", "converted"
+			b.helpers["fieldString"] = true
+		case ft.Basic == "bson.ObjectId":
+			helper, call, converted = "fieldObjectID", "fieldObjectID(key, value)", "converted"
+			b.helpers["fieldString"] = true
+			b.goImports["gopkg.in/mgo.v2/bson"] = true
+		case ft.Kind == stringKind:
+			helper, call, converted = "fieldString", "fieldString(key, value)", "converted"
+		case ft.Kind == boolKind:
+			helper, call, converted = "fieldBool", "fieldBool(key, value)", "converted"
+		default:
+			whole := protoGoScalar(ft) != "float32" && protoGoScalar(ft) != "float64"
+			helper, call, converted = "fieldNumber", fmt.Sprintf("fieldNumber(key, value, %t)", whole), goType+"(converted)"
+			if goType == "float64" {
+				converted = "converted"
+			}
+			b.goImports["math"] = true
+
+			if whole && b.numberKey == "" && (!ft.Named || ft.Scope.Path == "") {
+				b.numberKey, b.numberType = key, goType
+			}
+		}
+
+		if ft.Kind != numberKind && ft.Named {
+			converted = goType + "(converted)"
+		}
+
+		b.helpers[helper] = true
+		fmt.Fprintf(out, "case %q:\nconverted, err := %s\nif err != nil {\nreturn nil, err\n}\nreturn %s, nil\n", key, call, converted)
+	}
+
+	return nil
+}
+
+// fieldHelper defines a function converting values of a kind within FieldValue.
+type fieldHelper struct {
+	Name   string
+	Source string
+}
+
+// fieldHelpers contains the functions FieldValue may use, in the order they are written.
+var fieldHelpers = []fieldHelper{
+	{Name: "fieldString", Source: `// fieldString returns the giving value of the field of the giving key as a string.
+func fieldString(key string, value interface{}) (string, error) {
+	converted, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("field %s requires a string, found %T", key, value)
+	}
+
+	return converted, nil
+}
+`},
+	{Name: "fieldNumber", Source: `// fieldNumber returns the giving value of the field of the giving key as a number, which
+// must be whole for integer fields.
+func fieldNumber(key string, value interface{}, whole bool) (float64, error) {
+	converted, ok := value.(float64)
+	if !ok {
+		return 0, fmt.Errorf("field %s requires a number, found %T", key, value)
+	}
+
+	if whole && math.Trunc(converted) != converted {
+		return 0, fmt.Errorf("field %s requires a whole number, found %v", key, converted)
+	}
+
+	return converted, nil
+}
+`},
+	{Name: "fieldBool", Source: `// fieldBool returns the giving value of the field of the giving key as a bool.
+func fieldBool(key string, value interface{}) (bool, error) {
+	converted, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("field %s requires a bool, found %T", key, value)
+	}
+
+	return converted, nil
+}
+`},
+	{Name: "fieldTime", Source: `// fieldTime returns the giving RFC 3339 value of the field of the giving key as a time.
+func fieldTime(key string, value interface{}) (time.Time, error) {
+	text, err := fieldString(key, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	converted, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("field %s requires an RFC 3339 time, found %q", key, text)
+	}
+
+	return converted, nil
+}
+`},
+	{Name: "fieldObjectID", Source: `// fieldObjectID returns the giving hex value of the field of the giving key as an ObjectId.
+func fieldObjectID(key string, value interface{}) (bson.ObjectId, error) {
+	text, err := fieldString(key, value)
+	if err != nil {
+		return "", err
+	}
+
+	if !bson.IsObjectIdHex(text) {
+		return "", fmt.Errorf("field %s holds invalid ObjectId %q", key, text)
+	}
+
+	return bson.ObjectIdHex(text), nil
+}
+`},
+}
+
+func (b *protoBuilder) newVar(name string) string {
+	b.vars++
+	return fmt.Sprintf("%s%d", name, b.vars)
+}
+
+// protoName returns the protobuf field name of the giving bson name, made of lowercase
+// letters, digits and underscores.
+func protoName(name string) string {
+	var out []byte
+	for _, c := range []byte(strings.ToLower(name)) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			out = append(out, c)
+		default:
+			out = append(out, '_')
+		}
+	}
+
+	name = strings.Trim(string(out), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "field_" + name
+	}
+
+	return name
+}
+
+// goCamelCase returns the name of the go field generated by protoc-gen-go for the giving
+// protobuf field name.
+func goCamelCase(name string) string {
+	var out []byte
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_' && i == 0:
+			out = append(out, 'X')
+		case c == '_' && i+1 < len(name) && isLower(name[i+1]):
+		case c >= '0' && c <= '9':
+			out = append(out, c)
+		default:
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			out = append(out, c)
+
+			for ; i+1 < len(name) && isLower(name[i+1]); i++ {
+				out = append(out, name[i+1])
+			}
+		}
+	}
+
+	// Fields named like the methods of messages are suffixed by protoc-gen-go.
+	switch goName := string(out); goName {
+	case "Reset", "String", "ProtoMessage", "ProtoReflect", "Descriptor":
+		return goName + "_"
+	default:
+		return goName
+	}
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
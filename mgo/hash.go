@@ -0,0 +1,112 @@
+package mgo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	"github.com/gokit/mgokit/config"
+	"github.com/gokit/mgokit/header"
+	"github.com/influx6/moz/ast"
+)
+
+// Version sets the version of mgokit. It is recorded within the generation cache, so
+// upgrading mgokit regenerates all packages.
+const Version = "0.2.0"
+
+// StructHash returns a hash of all inputs the generated package of the giving struct
+// depends on: the source of the struct, of all structs its fields refer to and the names
+// of its methods, the annotation with its params, all other annotations of the struct, as
+// @mongo_fields changes the code of the others, the resolved options and the content of
+// all templates used.
+func (g Generator) StructHash(an ast.AnnotationDeclaration, str ast.StructDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) (string, error) {
+	ops, err := g.options(an, str)
+	if err != nil {
+		return "", err
+	}
+
+	var methods []string
+	for _, fn := range pkgDeclr.FunctionsFor(str.Object.Name.Obj) {
+		methods = append(methods, fn.FuncName)
+	}
+
+	sort.Strings(methods)
+
+	var annotations []string
+	for _, other := range str.Annotations {
+		annotations = append(annotations, header.Annotation(other))
+	}
+
+	sort.Strings(annotations)
+
+	return g.hash(ops, header.Annotation(an), annotations, str.Path, str.Source, dependencies(str, pkg), methods)
+}
+
+// dependencies returns the sources of all structs the fields of the giving struct refer to,
+// directly or through other structs, keyed by their import path and name.
+func dependencies(str ast.StructDeclaration, pkg ast.Package) map[string]string {
+	deps := make(map[string]string)
+
+	var walk func(ft fieldType)
+	walk = func(ft fieldType) {
+		switch ft.Kind {
+		case structKind:
+			key := structKey(ft)
+			if _, ok := deps[key]; ok {
+				return
+			}
+
+			deps[key] = ft.Struct.Source
+			for _, field := range ft.Struct.Struct.Fields.List {
+				walk(resolve(field.Type, ft.Scope))
+			}
+		case pointerKind, sliceKind, mapKind:
+			walk(*ft.Elem)
+		}
+	}
+
+	root := structType(str, pkg)
+	deps[structKey(root)] = ""
+
+	for _, field := range str.Struct.Fields.List {
+		walk(resolve(field.Type, root.Scope))
+	}
+
+	delete(deps, structKey(root))
+	return deps
+}
+
+// PackageHash returns a hash of all inputs the generated package of the giving annotated
+// package depends on: its import path, the annotation with its params, the resolved
+// options and the content of all templates used.
+func (g Generator) PackageHash(an ast.AnnotationDeclaration, pkgDeclr ast.PackageDeclaration) (string, error) {
+	return g.hash(g.Config.Options(pkgDeclr.Path, ""), header.Annotation(an), pkgDeclr.Path)
+}
+
+// hash returns the hash of the giving options, the templates resolved with them and all
+// giving values.
+func (g Generator) hash(ops config.Options, values ...interface{}) (string, error) {
+	templates, err := loadTemplates(g.Config.TemplatesDir, ops, TemplateNames()...)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := json.Marshal(struct {
+		Version   string
+		Options   config.Options
+		Templates map[string]string
+		Values    []interface{}
+	}{
+		Version:   Version,
+		Options:   ops,
+		Templates: templates.contents,
+		Values:    values,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,202 @@
+package mgo
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gokit/mgokit/header"
+	"github.com/influx6/moz/ast"
+)
+
+// Hooks contains the names of methods which generated code calls on a struct when it
+// declares them.
+var Hooks = append([]string{"Fields", "Consume", "Validate"}, lifecycleHooks...)
+
+// lifecycleHooks contains the names of the methods @mongoapi and @mongo_methods call on a
+// record at the stage of its operations they are named after, in the order of the stages.
+var lifecycleHooks = []string{"BeforeCreate", "AfterCreate", "BeforeUpdate", "AfterUpdate", "BeforeDelete", "AfterDelete", "AfterLoad"}
+
+// Inspection defines the settings resolved for an annotated struct or package, as used
+// when generating its package.
+type Inspection struct {
+	Package     string            `json:"package"`
+	Path        string            `json:"path"`
+	Struct      string            `json:"struct,omitempty"`
+	Annotation  string            `json:"annotation"`
+	PackageName string            `json:"package_name,omitempty"`
+	Dir         string            `json:"dir,omitempty"`
+	KeyField    string            `json:"key_field,omitempty"`
+	KeyName     string            `json:"key_name,omitempty"`
+	ENVName     string            `json:"env_name,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"`
+	Hooks       []string          `json:"hooks,omitempty"`
+	Error       string            `json:"error,omitempty"`
+}
+
+// Inspect returns the Inspection of every struct and package within the giving packages
+// annotated with @mongoapi, @mongo_methods, @mongo_fields or @mongo. Problems resolving the
+// settings of a struct are recorded within its Inspection. The returned slice is empty rather
+// than nil if nothing is annotated, so it encodes as an empty JSON list.
+func (g Generator) Inspect(pkgs ...ast.Package) []Inspection {
+	inspections := []Inspection{}
+
+	for _, pkg := range pkgs {
+		for _, declr := range pkg.Packages {
+			for _, an := range declr.Annotations {
+				if an.Name != "@mongo" {
+					continue
+				}
+
+				inspections = append(inspections, Inspection{
+					Package:     declr.Package,
+					Path:        declr.Path,
+					Annotation:  header.Annotation(an),
+					PackageName: "mdb",
+					Dir:         "mdb",
+				})
+			}
+
+			for _, str := range declr.Structs {
+				for _, an := range str.Annotations {
+					if an.Name != "@mongoapi" && an.Name != "@mongo_methods" && an.Name != "@mongo_fields" {
+						continue
+					}
+
+					inspections = append(inspections, g.inspectStruct(an, str, declr))
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(inspections, func(i, j int) bool {
+		if inspections[i].Path != inspections[j].Path {
+			return inspections[i].Path < inspections[j].Path
+		}
+		return inspections[i].Struct < inspections[j].Struct
+	})
+
+	return inspections
+}
+
+// inspectStruct returns the Inspection of the giving struct for the giving annotation.
+func (g Generator) inspectStruct(an ast.AnnotationDeclaration, str ast.StructDeclaration, pkgDeclr ast.PackageDeclaration) Inspection {
+	ins := Inspection{
+		Package:    str.Package,
+		Path:       str.Path,
+		Struct:     str.Object.Name.Name,
+		Annotation: header.Annotation(an),
+	}
+
+	for _, hook := range Hooks {
+		if hasFunc(pkgDeclr)(str, hook) {
+			ins.Hooks = append(ins.Hooks, hook)
+		}
+	}
+
+	// The methods of @mongo_fields are generated into the package of the struct.
+	if an.Name == "@mongo_fields" {
+		ins.PackageName = str.Package
+		ins.Fields, ins.Error = fieldMap(str)
+		return ins
+	}
+
+	ops, err := g.options(an, str)
+	if err != nil {
+		ins.Error = err.Error()
+		return ins
+	}
+
+	ins.ENVName = ops.ENVName
+	ins.KeyField = ops.KeyField
+	ins.PackageName = expand(ops.PackageName, str)
+	ins.Dir = expand(ops.Dir, str)
+
+	if ins.Dir == "" {
+		ins.Dir = ins.PackageName
+	}
+
+	if ins.Fields, ins.Error = fieldMap(str); ins.Error != "" {
+		return ins
+	}
+
+	rec, err := recordFields(ops, str)
+	if err != nil {
+		ins.Error = strings.Join(strings.Fields(err.Error()), " ")
+		return ins
+	}
+
+	ins.KeyName = rec.KeyName
+	return ins
+}
+
+// fieldMap returns the fields of the giving struct keyed by their bson names, else the
+// error mapping them.
+func fieldMap(str ast.StructDeclaration) (map[string]string, string) {
+	fields, err := ast.MapOutFieldsToMap(str, str.Object.Name.Name, "bson", "json")
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	mapped := make(map[string]string, len(fields))
+	for name, value := range fields {
+		var field bytes.Buffer
+		if _, err := value.WriteTo(&field); err != nil {
+			return nil, err.Error()
+		}
+
+		mapped[name] = strings.Join(strings.Fields(strings.TrimPrefix(field.String(), str.Object.Name.Name+".")), " ")
+	}
+
+	return mapped, ""
+}
+
+// String returns the Inspection as an indented block of text.
+func (ins Inspection) String() string {
+	var out bytes.Buffer
+
+	if ins.Struct != "" {
+		fmt.Fprintf(&out, "%s.%s %s\n", ins.Path, ins.Struct, ins.Annotation)
+	} else {
+		fmt.Fprintf(&out, "%s %s\n", ins.Path, ins.Annotation)
+	}
+
+	if ins.Error != "" {
+		fmt.Fprintf(&out, "  error:   %s\n", ins.Error)
+	}
+
+	if ins.Dir != "" {
+		fmt.Fprintf(&out, "  package: %s (%s)\n", ins.PackageName, ins.Dir)
+	} else {
+		fmt.Fprintf(&out, "  package: %s\n", ins.PackageName)
+	}
+
+	if ins.KeyField != "" {
+		fmt.Fprintf(&out, "  key:     %s (%s)\n", ins.KeyField, ins.KeyName)
+	}
+
+	if ins.ENVName != "" {
+		fmt.Fprintf(&out, "  env:     %s\n", ins.ENVName)
+	}
+
+	if len(ins.Hooks) != 0 {
+		fmt.Fprintf(&out, "  hooks:   %s\n", strings.Join(ins.Hooks, ", "))
+	}
+
+	if len(ins.Fields) != 0 {
+		names := make([]string, 0, len(ins.Fields))
+		for name := range ins.Fields {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		fmt.Fprintf(&out, "  fields:\n")
+		for _, name := range names {
+			fmt.Fprintf(&out, "    %-20s %s\n", name, ins.Fields[name])
+		}
+	}
+
+	return out.String()
+}
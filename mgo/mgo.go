@@ -2,12 +2,11 @@ package mgo
 
 import (
 	"fmt"
-	goast "go/ast"
 	"path/filepath"
 	"strings"
 	"text/template"
 
-	"github.com/gokit/mgokit/static"
+	"github.com/gokit/mgokit/header"
 	"github.com/influx6/faux/fmtwriter"
 	"github.com/influx6/moz/ast"
 	"github.com/influx6/moz/gen"
@@ -15,92 +14,145 @@ import (
 
 // MongoGen generates a mongodb based CRUD api for a struct declaration.
 func MongoGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
-	var hasPublicID bool
-
-	// Validate we have a `PublicID` field.
-	{
-	fieldLoop:
-		for _, field := range str.Struct.Fields.List {
-			typeIdent, ok := field.Type.(*goast.Ident)
-			if !ok {
-				continue
-			}
+	return Generator{}.MongoGen(toPackage, an, str, pkgDeclr, pkg)
+}
 
-			// If typeName is not a string, skip.
-			if typeIdent.Name != "string" {
-				continue
-			}
+// MongoGen generates a mongodb based CRUD api for a struct declaration using the Generator's Config.
+func (g Generator) MongoGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
+	ops, err := g.options(an, str)
+	if err != nil {
+		return nil, err
+	}
 
-			for _, indent := range field.Names {
-				if indent.Name == "PublicID" {
-					hasPublicID = true
-					break fieldLoop
-				}
-			}
-		}
+	rec, err := recordFields(ops, str)
+	if err != nil {
+		return nil, err
 	}
 
-	if !hasPublicID {
-		return nil, fmt.Errorf(`Struct has no 'PublicID' field with 'string' type
-		 Add 'PublicID string json:"public_id"' to struct %q
-		`, str.Object.Name.Name)
+	lay, err := resolveLayout(toPackage, ops, str)
+	if err != nil {
+		return nil, err
 	}
 
-	packageName := fmt.Sprintf("%smgo", strings.ToLower(str.Object.Name.Name))
-	packageFinalPath := filepath.Join(toPackage, packageName)
-	packageFinalFixturesPath := filepath.Join(toPackage, packageName, "fixtures")
+	packageName := lay.Name
 
-	configName := an.Param("ENVName")
-	if configName == "" {
-		configName = strings.ToUpper(str.Package)
+	templates, err := loadTemplates(
+		g.Config.TemplatesDir,
+		ops,
+		"mongo-api-test.tml",
+		"mongo-testutil.tml",
+		"makefile.tml",
+		"dockerfile.tml",
+		"mongo-api-readme.tml",
+		"mongo-api-random.tml",
+		"mongo-api-json.tml",
+		"mongo-api-backend.tml",
+		"mongo-api-memory.tml",
+		"mongo-api-cache.tml",
+		"mongo-api-cache-test.tml",
+		"mongo-api-outbox.tml",
+		"mongo-api-outbox-test.tml",
+		"mongo-api-http.tml",
+		"mongo-api-http-test.tml",
+		"mongo-api-grpc-proto.tml",
+		"mongo-api-grpc.tml",
+		"mongo-api-grpc-test.tml",
+		"mongo-api.tml",
+		"mongo-hooks.tml",
+		"mongo-consume.tml",
+		"mongo-api-document-test.tml",
+	)
+	if err != nil {
+		return nil, err
 	}
 
+	packageFinalPath := filepath.Join(toPackage, lay.Dir)
+	packageFinalFixturesPath := filepath.Join(toPackage, lay.Dir, "fixtures")
+	packageFinalTestutilPath := filepath.Join(toPackage, lay.Dir, "testutil")
+
+	configName := ops.ENVName
+
 	mongoTestGen := gen.Block(
 		gen.Package(
 			gen.Name(fmt.Sprintf("%s_test", packageName)),
 			gen.Imports(
 				gen.Import("os", ""),
+				gen.Import("fmt", ""),
 				gen.Import("time", ""),
+				gen.Import("reflect", ""),
+				gen.Import("strings", ""),
 				gen.Import("context", ""),
+				gen.Import("math/rand", ""),
 				gen.Import("testing", ""),
 				gen.Import("gopkg.in/mgo.v2", "mgo"),
-				gen.Import("github.com/influx6/faux/tests", ""),
 				gen.Import("github.com/influx6/faux/metrics", ""),
 				gen.Import("github.com/influx6/faux/metrics/custom", ""),
+				gen.Import(str.Path, ""),
 				gen.Import(packageFinalPath, "mdb"),
 				gen.Import(packageFinalFixturesPath, "fixtures"),
+				gen.Import(packageFinalTestutilPath, "testutil"),
 			),
 			gen.Block(
-				gen.SourceTextWith(
+				templates.source(
 					"mongo:api-test",
-					string(static.MustReadFile("mongo-api-test.tml", true)),
+					"mongo-api-test.tml",
 					gen.ToTemplateFuncs(
 						ast.ASTTemplatFuncs,
 						template.FuncMap{
 							"map":       ast.MapOutFields,
 							"mapValues": ast.MapOutValues,
-							"hasFunc":   pkgDeclr.HasFunctionFor,
+							"hasFunc":   hasFunc(pkgDeclr),
 						},
 					),
 					struct {
 						ENVName string
 						Pkg     *ast.PackageDeclaration
 						Struct  ast.StructDeclaration
+						Record  record
 					}{
 						ENVName: configName,
 						Pkg:     &pkgDeclr,
 						Struct:  str,
+						Record:  rec,
 					},
 				),
 			),
 		),
 	)
 
+	mongoTestUtilGen := gen.Block(
+		gen.Package(
+			gen.Name("testutil"),
+			gen.Imports(
+				gen.Import("errors", ""),
+				gen.Import("fmt", ""),
+				gen.Import("io", ""),
+				gen.Import("io/ioutil", ""),
+				gen.Import("net", ""),
+				gen.Import("os", ""),
+				gen.Import("os/exec", ""),
+				gen.Import("strconv", ""),
+				gen.Import("time", ""),
+				gen.Import("gopkg.in/mgo.v2", "mgo"),
+				gen.Import("gopkg.in/mgo.v2/bson", ""),
+			),
+			gen.Block(
+				templates.source(
+					"mongo:testutil",
+					"mongo-testutil.tml",
+					nil,
+					nil,
+				),
+			),
+		),
+	)
+
 	mongoMakefileGen := gen.Block(
 		gen.Block(
-			gen.SourceText(
+			templates.source(
 				"mongo:makefile",
-				string(static.MustReadFile("makefile.tml", true)),
+				"makefile.tml",
+				nil,
 				struct {
 					Pkg          *ast.PackageDeclaration
 					Struct       ast.StructDeclaration
@@ -109,12 +161,14 @@ func MongoGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDecl
 					PackageName  string
 					PackagePath  string
 					ENVName      string
+					Dockerfile   bool
 				}{
 					ENVName:     configName,
 					PackagePath: packageFinalPath,
 					PackageName: packageName,
 					Pkg:         &pkgDeclr,
 					Struct:      str,
+					Dockerfile:  lay.Dockerfile,
 				},
 			),
 		),
@@ -122,9 +176,10 @@ func MongoGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDecl
 
 	mongoDockerfileGen := gen.Block(
 		gen.Block(
-			gen.SourceText(
+			templates.source(
 				"mongo:dockerfile",
-				string(static.MustReadFile("dockerfile.tml", true)),
+				"dockerfile.tml",
+				nil,
 				struct {
 					Pkg          *ast.PackageDeclaration
 					Struct       ast.StructDeclaration
@@ -146,9 +201,10 @@ func MongoGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDecl
 
 	mongoReadmeGen := gen.Block(
 		gen.Block(
-			gen.SourceText(
+			templates.source(
 				"mongo:readme",
-				string(static.MustReadFile("mongo-api-readme.tml", true)),
+				"mongo-api-readme.tml",
+				nil,
 				struct {
 					Pkg          *ast.PackageDeclaration
 					Struct       ast.StructDeclaration
@@ -166,6 +222,37 @@ func MongoGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDecl
 		),
 	)
 
+	mongoRandomGen := gen.Block(
+		gen.Package(
+			gen.Name("fixtures"),
+			gen.Imports(
+				gen.Import("context", ""),
+				gen.Import("math/rand", ""),
+				gen.Import("time", ""),
+				gen.Import(str.Path, ""),
+			),
+			gen.Block(
+				templates.source(
+					"mongo:random-fixtures",
+					"mongo-api-random.tml",
+					gen.ToTemplateFuncs(
+						ast.ASTTemplatFuncs,
+						template.FuncMap{
+							"randomAssign": randomAssign(pkg),
+						},
+					),
+					struct {
+						Pkg    *ast.PackageDeclaration
+						Struct ast.StructDeclaration
+					}{
+						Pkg:    &pkgDeclr,
+						Struct: str,
+					},
+				),
+			),
+		),
+	)
+
 	mongoJSONGen := gen.Block(
 		gen.Package(
 			gen.Name("fixtures"),
@@ -174,17 +261,17 @@ func MongoGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDecl
 				gen.Import(str.Path, ""),
 			),
 			gen.Block(
-				gen.SourceTextWith(
+				templates.source(
 					"mongo:fixtures",
-					string(static.MustReadFile("mongo-api-json.tml", true)),
+					"mongo-api-json.tml",
 					gen.ToTemplateFuncs(
 						ast.ASTTemplatFuncs,
 						template.FuncMap{
 							"map":           ast.MapOutFields,
-							"mapRandomJSON": ast.MapOutFieldsWithRandomValuesToJSON,
+							"mapRandomJSON": randomJSON,
 							"mapValues":     ast.MapOutValues,
 							"mapJSON":       ast.MapOutFieldsToJSON,
-							"hasFunc":       pkgDeclr.HasFunctionFor,
+							"hasFunc":       hasFunc(pkgDeclr),
 						},
 					),
 					struct {
@@ -199,202 +286,770 @@ func MongoGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDecl
 		),
 	)
 
+	backendImports := []gen.ImportItemDeclr{gen.Import("context", "")}
+	if !lay.InSource {
+		backendImports = append(backendImports, gen.Import(str.Path, ""))
+	}
+
 	mongoBackendGen := gen.Block(
 		gen.Package(
-			gen.Name("types"),
-			gen.Imports(
-				gen.Import("context", ""),
-				gen.Import(str.Path, ""),
-			),
+			gen.Name(lay.BackendPkg),
+			gen.Imports(backendImports...),
 			gen.Block(
-				gen.SourceTextWith(
+				templates.source(
 					"mongo:backend",
-					string(static.MustReadFile("mongo-api-backend.tml", true)),
+					"mongo-api-backend.tml",
 					gen.ToTemplateFuncs(
 						ast.ASTTemplatFuncs,
 						template.FuncMap{
 							"map":     ast.MapOutFields,
-							"hasFunc": pkgDeclr.HasFunctionFor,
+							"hasFunc": hasFunc(pkgDeclr),
 						},
 					),
 					struct {
 						Pkg    *ast.PackageDeclaration
 						Struct ast.StructDeclaration
+						Type   string
 					}{
 						Pkg:    &pkgDeclr,
 						Struct: str,
+						Type:   lay.BackendType,
 					},
 				),
 			),
 		),
 	)
 
-	mongoGen := gen.Block(
+	backendPath := filepath.Join(toPackage, lay.BackendDir)
+	if lay.InSource {
+		backendPath = str.Path
+	}
+
+	packageFinalHTTPPath := filepath.Join(toPackage, lay.Dir, "httpapi")
+
+	httpData := struct {
+		Struct  ast.StructDeclaration
+		Record  record
+		Type    string
+		Backend string
+		Package string
+	}{
+		Struct:  str,
+		Record:  rec,
+		Type:    fmt.Sprintf("%s.%s", str.Package, str.Object.Name.Name),
+		Backend: fmt.Sprintf("%s.%sDBBackend", lay.BackendPkg, str.Object.Name.Name),
+		Package: packageName,
+	}
+
+	httpImports := []gen.ImportItemDeclr{
+		gen.Import("encoding/json", ""),
+		gen.Import("fmt", ""),
+		gen.Import("net/http", ""),
+		gen.Import("net/url", ""),
+		gen.Import("strconv", ""),
+		gen.Import("strings", ""),
+		gen.Import("github.com/influx6/faux/metrics", ""),
+		gen.Import(str.Path, ""),
+	}
+
+	if backendPath != str.Path {
+		httpImports = append(httpImports, gen.Import(backendPath, ""))
+	}
+
+	httpImports = append(httpImports, gen.Import(packageFinalPath, ""))
+
+	mongoHTTPGen := gen.Block(
+		gen.Package(
+			gen.Name("httpapi"),
+			gen.Imports(httpImports...),
+			gen.Block(
+				templates.source(
+					"mongo:http",
+					"mongo-api-http.tml",
+					nil,
+					httpData,
+				),
+			),
+		),
+	)
+
+	mongoHTTPTestGen := gen.Block(
+		gen.Package(
+			gen.Name("httpapi_test"),
+			gen.Imports(
+				gen.Import("bytes", ""),
+				gen.Import("context", ""),
+				gen.Import("encoding/json", ""),
+				gen.Import("fmt", ""),
+				gen.Import("net/http", ""),
+				gen.Import("net/http/httptest", ""),
+				gen.Import("reflect", ""),
+				gen.Import("strings", ""),
+				gen.Import("sync", ""),
+				gen.Import("testing", ""),
+				gen.Import("github.com/influx6/faux/metrics", ""),
+				gen.Import(str.Path, ""),
+				gen.Import(packageFinalPath, ""),
+				gen.Import(packageFinalHTTPPath, ""),
+				gen.Import(packageFinalFixturesPath, ""),
+			),
+			gen.Block(
+				templates.source(
+					"mongo:memory",
+					"mongo-api-memory.tml",
+					nil,
+					httpData,
+				),
+				templates.source(
+					"mongo:http-test",
+					"mongo-api-http-test.tml",
+					nil,
+					httpData,
+				),
+			),
+		),
+	)
+
+	cacheImports := []gen.ImportItemDeclr{
+		gen.Import("container/list", ""),
+		gen.Import("context", ""),
+		gen.Import("sync", ""),
+		gen.Import("sync/atomic", ""),
+		gen.Import("time", ""),
+		gen.Import("github.com/influx6/faux/metrics", ""),
+		gen.Import(str.Path, ""),
+	}
+
+	if backendPath != str.Path {
+		cacheImports = append(cacheImports, gen.Import(backendPath, ""))
+	}
+
+	mongoCacheGen := gen.Block(
 		gen.Package(
 			gen.Name(packageName),
+			gen.Imports(cacheImports...),
+			gen.Block(
+				templates.source(
+					"mongo:cache",
+					"mongo-api-cache.tml",
+					nil,
+					httpData,
+				),
+			),
+		),
+	)
+
+	// Cache tests share the package of the tests of the generated package, which import it
+	// as mdb.
+	cacheTestData := httpData
+	cacheTestData.Package = "mdb"
+
+	mongoCacheTestGen := gen.Block(
+		gen.Package(
+			gen.Name(fmt.Sprintf("%s_test", packageName)),
 			gen.Imports(
-				gen.Import("errors", ""),
-				gen.Import("runtime", ""),
-				gen.Import("time", ""),
+				gen.Import("context", ""),
+				gen.Import("fmt", ""),
+				gen.Import("reflect", ""),
 				gen.Import("sync", ""),
+				gen.Import("sync/atomic", ""),
+				gen.Import("testing", ""),
+				gen.Import("time", ""),
+				gen.Import("github.com/influx6/faux/metrics", ""),
+				gen.Import(str.Path, ""),
+				gen.Import(packageFinalPath, "mdb"),
+				gen.Import(packageFinalFixturesPath, "fixtures"),
+			),
+			gen.Block(
+				templates.source(
+					"mongo:memory",
+					"mongo-api-memory.tml",
+					nil,
+					cacheTestData,
+				),
+				templates.source(
+					"mongo:cache-test",
+					"mongo-api-cache-test.tml",
+					nil,
+					cacheTestData,
+				),
+			),
+		),
+	)
+
+	mongoOutboxGen := gen.Block(
+		gen.Package(
+			gen.Name(packageName),
+			gen.Imports(
 				gen.Import("context", ""),
-				gen.Import("strings", ""),
+				gen.Import("time", ""),
 				gen.Import("gopkg.in/mgo.v2", "mgo"),
 				gen.Import("gopkg.in/mgo.v2/bson", ""),
 				gen.Import("github.com/influx6/faux/metrics", ""),
 				gen.Import(str.Path, ""),
 			),
 			gen.Block(
-				gen.SourceTextWith(
+				templates.source(
+					"mongo:outbox",
+					"mongo-api-outbox.tml",
+					nil,
+					httpData,
+				),
+			),
+		),
+	)
+
+	mongoOutboxTestGen := gen.Block(
+		gen.Package(
+			gen.Name(fmt.Sprintf("%s_test", packageName)),
+			gen.Imports(
+				gen.Import("context", ""),
+				gen.Import("fmt", ""),
+				gen.Import("strings", ""),
+				gen.Import("sync", ""),
+				gen.Import("testing", ""),
+				gen.Import("time", ""),
+				gen.Import("gopkg.in/mgo.v2/bson", ""),
+				gen.Import("github.com/influx6/faux/metrics", ""),
+				gen.Import(packageFinalPath, "mdb"),
+			),
+			gen.Block(
+				templates.source(
+					"mongo:outbox-test",
+					"mongo-api-outbox-test.tml",
+					nil,
+					cacheTestData,
+				),
+			),
+		),
+	)
+
+	// The protobuf messages are only built for structs served through grpc, as not all
+	// field types have a protobuf equivalent.
+	var protos protoAPI
+	if lay.GRPC {
+		protos, err = buildProto(str, pkg, "pb")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	packageFinalGRPCPath := filepath.Join(toPackage, lay.Dir, "grpcapi")
+	protoFile := fmt.Sprintf("%s.proto", strings.ToLower(str.Object.Name.Name))
+
+	grpcData := struct {
+		Struct     ast.StructDeclaration
+		Record     record
+		Type       string
+		Backend    string
+		Package    string
+		Proto      string
+		GoPackage  string
+		Messages   string
+		Imports    []string
+		Convert    string
+		NumberKey  string
+		NumberType string
+	}{
+		Struct:     str,
+		Record:     rec,
+		Type:       httpData.Type,
+		Backend:    httpData.Backend,
+		Package:    packageName,
+		Proto:      protoFile,
+		GoPackage:  filepath.ToSlash(filepath.Join(packageFinalGRPCPath, "pb")),
+		Messages:   protos.Messages,
+		Imports:    protos.Imports,
+		Convert:    protos.Convert,
+		NumberKey:  protos.NumberKey,
+		NumberType: protos.NumberType,
+	}
+
+	grpcImports := []gen.ImportItemDeclr{
+		gen.Import("context", ""),
+		gen.Import("github.com/influx6/faux/metrics", ""),
+		gen.Import("google.golang.org/grpc/codes", ""),
+		gen.Import("google.golang.org/grpc/status", ""),
+		gen.Import("google.golang.org/protobuf/types/known/emptypb", ""),
+	}
+
+	for _, path := range protos.GoImports {
+		if path != str.Path && path != "context" {
+			grpcImports = append(grpcImports, gen.Import(path, ""))
+		}
+	}
+
+	grpcImports = append(grpcImports, gen.Import(str.Path, ""))
+	if backendPath != str.Path {
+		grpcImports = append(grpcImports, gen.Import(backendPath, ""))
+	}
+
+	grpcImports = append(grpcImports,
+		gen.Import(packageFinalPath, ""),
+		gen.Import(filepath.Join(packageFinalGRPCPath, "pb"), ""),
+	)
+
+	mongoProtoGen := gen.Block(
+		templates.source(
+			"mongo:grpc-proto",
+			"mongo-api-grpc-proto.tml",
+			nil,
+			grpcData,
+		),
+	)
+
+	mongoGRPCGen := gen.Block(
+		gen.Package(
+			gen.Name("grpcapi"),
+			gen.Imports(grpcImports...),
+			gen.Block(
+				templates.source(
+					"mongo:grpc",
+					"mongo-api-grpc.tml",
+					nil,
+					grpcData,
+				),
+			),
+		),
+	)
+
+	grpcTestImports := []gen.ImportItemDeclr{
+		gen.Import("context", ""),
+		gen.Import("fmt", ""),
+		gen.Import("net", ""),
+		gen.Import("sync", ""),
+		gen.Import("testing", ""),
+		gen.Import("github.com/influx6/faux/metrics", ""),
+		gen.Import("google.golang.org/grpc", ""),
+		gen.Import("google.golang.org/grpc/codes", ""),
+		gen.Import("google.golang.org/grpc/credentials/insecure", ""),
+		gen.Import("google.golang.org/grpc/status", ""),
+		gen.Import("google.golang.org/grpc/test/bufconn", ""),
+		gen.Import("google.golang.org/protobuf/proto", ""),
+		gen.Import("google.golang.org/protobuf/types/known/emptypb", ""),
+		gen.Import("google.golang.org/protobuf/types/known/structpb", ""),
+		gen.Import(str.Path, ""),
+	}
+
+	if backendPath != str.Path {
+		grpcTestImports = append(grpcTestImports, gen.Import(backendPath, ""))
+	}
+
+	grpcTestImports = append(grpcTestImports,
+		gen.Import(packageFinalPath, ""),
+		gen.Import(packageFinalGRPCPath, ""),
+		gen.Import(filepath.Join(packageFinalGRPCPath, "pb"), ""),
+		gen.Import(packageFinalFixturesPath, ""),
+	)
+
+	mongoGRPCTestGen := gen.Block(
+		gen.Package(
+			gen.Name("grpcapi_test"),
+			gen.Imports(grpcTestImports...),
+			gen.Block(
+				templates.source(
+					"mongo:memory",
+					"mongo-api-memory.tml",
+					nil,
+					httpData,
+				),
+				templates.source(
+					"mongo:grpc-test",
+					"mongo-api-grpc-test.tml",
+					nil,
+					grpcData,
+				),
+			),
+		),
+	)
+
+	// The document is built by the Fields method of the struct, if it has one.
+	var doc document
+	if !hasFunc(pkgDeclr)(str, "Fields") {
+		doc = buildDocument(str, pkg)
+	}
+
+	dec, err := readDocument(str, pkg, pkgDeclr, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := buildSchema(str, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := buildValidation(str, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	mongoImports := []gen.ImportItemDeclr{
+		gen.Import("errors", ""),
+		gen.Import("runtime", ""),
+		gen.Import("time", ""),
+		gen.Import("sync", ""),
+		gen.Import("context", ""),
+		gen.Import("strings", ""),
+		gen.Import("gopkg.in/mgo.v2", "mgo"),
+		gen.Import("gopkg.in/mgo.v2/bson", ""),
+		gen.Import("github.com/influx6/faux/metrics", ""),
+		gen.Import(str.Path, ""),
+	}
+
+	if doc.Reflect {
+		mongoImports = append(mongoImports, gen.Import("reflect", ""))
+	}
+
+	for _, path := range val.Imports {
+		mongoImports = append(mongoImports, gen.Import(path, ""))
+	}
+
+	mongoImports = withImports(mongoImports, dec.Imports...)
+
+	mongoGen := gen.Block(
+		gen.Package(
+			gen.Name(packageName),
+			gen.Imports(mongoImports...),
+			gen.Block(
+				templates.source(
 					"mongo:api",
-					string(static.MustReadFile("mongo-api.tml", true)),
+					"mongo-api.tml",
 					gen.ToTemplateFuncs(
 						ast.ASTTemplatFuncs,
 						template.FuncMap{
 							"map":     ast.MapOutFields,
-							"hasFunc": pkgDeclr.HasFunctionFor,
+							"hasFunc": hasFunc(pkgDeclr),
 						},
 					),
 					struct {
-						Pkg    *ast.PackageDeclaration
+						Pkg        *ast.PackageDeclaration
+						Struct     ast.StructDeclaration
+						Record     record
+						Document   document
+						Decoder    decoder
+						Schema     string
+						Validation validation
+						Outbox     bool
+					}{
+						Pkg:        &pkgDeclr,
+						Struct:     str,
+						Record:     rec,
+						Document:   doc,
+						Decoder:    dec,
+						Schema:     schema,
+						Validation: val,
+						Outbox:     lay.Outbox,
+					},
+				),
+				templates.source(
+					"mongo:hooks",
+					"mongo-hooks.tml",
+					template.FuncMap{
+						"hasFunc": hasFunc(pkgDeclr),
+					},
+					struct {
 						Struct ast.StructDeclaration
+						Hooks  []string
 					}{
-						Pkg:    &pkgDeclr,
 						Struct: str,
+						Hooks:  lifecycleHooks,
 					},
 				),
+				templates.source(
+					"mongo:consume",
+					"mongo-consume.tml",
+					nil,
+					dec,
+				),
 			),
 		),
 	)
 
-	return []gen.WriteDirective{
+	if err := templates.Err(); err != nil {
+		return nil, err
+	}
+
+	prov := header.New(fmt.Sprintf("%s.%s", str.Path, str.Object.Name.Name), an)
+
+	directives := []gen.WriteDirective{
 		{
+			Writer:   prov.Wrap(fmtwriter.New(mongoGen, true, true)),
+			FileName: fmt.Sprintf("%s.go", packageName),
+			Dir:      lay.Dir,
+		},
+	}
+
+	if lay.Readme {
+		directives = append(directives, gen.WriteDirective{
 			Writer:   mongoReadmeGen,
 			FileName: "README.md",
-			Dir:      packageName,
-		},
-		{
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Makefile {
+		directives = append(directives, gen.WriteDirective{
 			Writer:   mongoMakefileGen,
 			FileName: "makefile",
-			Dir:      packageName,
-		},
-		{
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Dockerfile {
+		directives = append(directives, gen.WriteDirective{
 			Writer:   mongoDockerfileGen,
 			FileName: "test.dockerfile",
-			Dir:      packageName,
-		},
-		{
-			Writer:   fmtwriter.New(mongoBackendGen, true, true),
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Types {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(mongoBackendGen, true, true)),
 			FileName: fmt.Sprintf("%s_backend.go", strings.ToLower(str.Object.Name.Name)),
-			Dir:      "types",
-		},
-		{
-			Writer:   fmtwriter.New(mongoTestGen, true, true),
-			FileName: fmt.Sprintf("%s_test.go", packageName),
-			Dir:      packageName,
-		},
-		{
-			Writer:   fmtwriter.New(mongoGen, true, true),
-			FileName: fmt.Sprintf("%s.go", packageName),
-			Dir:      packageName,
-		},
-		{
-			Writer:       mongoJSONGen,
-			FileName:     fmt.Sprintf("%s_fixtures.go", packageName),
-			Dir:          filepath.Join(packageName, "fixtures"),
-			DontOverride: true,
-		},
-	}, nil
+			Dir:      lay.BackendDir,
+		})
+	}
+
+	if lay.Outbox {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(mongoOutboxGen, true, true)),
+			FileName: fmt.Sprintf("%s_outbox.go", packageName),
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Outbox && lay.Fixtures {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(mongoOutboxTestGen, true, true)),
+			FileName: fmt.Sprintf("%s_outbox_test.go", packageName),
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Types && lay.Cache {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(mongoCacheGen, true, true)),
+			FileName: fmt.Sprintf("%s_cache.go", packageName),
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Types && lay.Cache && lay.Fixtures {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(mongoCacheTestGen, true, true)),
+			FileName: fmt.Sprintf("%s_cache_test.go", packageName),
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.HTTP {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(mongoHTTPGen, true, true)),
+			FileName: "httpapi.go",
+			Dir:      filepath.Join(lay.Dir, "httpapi"),
+		})
+	}
+
+	if lay.HTTP && lay.Fixtures {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(mongoHTTPTestGen, true, true)),
+			FileName: "httpapi_test.go",
+			Dir:      filepath.Join(lay.Dir, "httpapi"),
+		})
+	}
+
+	if lay.GRPC {
+		directives = append(directives, []gen.WriteDirective{
+			{
+				Writer:   mongoProtoGen,
+				FileName: protoFile,
+				Dir:      filepath.Join(lay.Dir, "grpcapi", "pb"),
+			},
+			{
+				Writer:   prov.Wrap(fmtwriter.New(mongoGRPCGen, true, true)),
+				FileName: "grpcapi.go",
+				Dir:      filepath.Join(lay.Dir, "grpcapi"),
+			},
+		}...)
+	}
+
+	if lay.GRPC && lay.Fixtures {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(mongoGRPCTestGen, true, true)),
+			FileName: "grpcapi_test.go",
+			Dir:      filepath.Join(lay.Dir, "grpcapi"),
+		})
+	}
+
+	if lay.Fixtures && dec.Name != "" {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(documentTest(templates, packageName, packageFinalFixturesPath, str, rec, doc, dec), true, true)),
+			FileName: fmt.Sprintf("%s_document_test.go", packageName),
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Fixtures {
+		directives = append(directives, []gen.WriteDirective{
+			{
+				Writer:   prov.Wrap(fmtwriter.New(mongoTestGen, true, true)),
+				FileName: fmt.Sprintf("%s_test.go", packageName),
+				Dir:      lay.Dir,
+			},
+			{
+				Writer:   prov.Wrap(fmtwriter.New(mongoTestUtilGen, true, true)),
+				FileName: "testutil.go",
+				Dir:      filepath.Join(lay.Dir, "testutil"),
+			},
+			{
+				Writer:   prov.Wrap(fmtwriter.New(mongoRandomGen, true, true)),
+				FileName: fmt.Sprintf("%s_random.go", packageName),
+				Dir:      filepath.Join(lay.Dir, "fixtures"),
+			},
+			{
+				Writer:       mongoJSONGen,
+				FileName:     fmt.Sprintf("%s_fixtures.go", packageName),
+				Dir:          filepath.Join(lay.Dir, "fixtures"),
+				DontOverride: true,
+			},
+		}...)
+	}
+
+	return directives, nil
 }
 
 // MongoFuncGen generates a mongodb containing CRUDE functions in a package for a struct declaration.
 func MongoFuncGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
-	var hasPublicID bool
-
-	// Validate we have a `PublicID` field.
-	{
-	fieldLoop:
-		for _, field := range str.Struct.Fields.List {
-			typeIdent, ok := field.Type.(*goast.Ident)
-			if !ok {
-				continue
-			}
+	return Generator{}.MongoFuncGen(toPackage, an, str, pkgDeclr, pkg)
+}
 
-			// If typeName is not a string, skip.
-			if typeIdent.Name != "string" {
-				continue
-			}
+// MongoFuncGen generates a mongodb containing CRUDE functions in a package for a struct
+// declaration using the Generator's Config.
+func (g Generator) MongoFuncGen(toPackage string, an ast.AnnotationDeclaration, str ast.StructDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
+	ops, err := g.options(an, str)
+	if err != nil {
+		return nil, err
+	}
 
-			for _, indent := range field.Names {
-				if indent.Name == "PublicID" {
-					hasPublicID = true
-					break fieldLoop
-				}
-			}
-		}
+	rec, err := recordFields(ops, str)
+	if err != nil {
+		return nil, err
 	}
 
-	if !hasPublicID {
-		return nil, fmt.Errorf(`Struct has no 'PublicID' field with 'string' type
-		 Add 'PublicID string json:"public_id"' to struct %q
-		`, str.Object.Name.Name)
+	lay, err := resolveLayout(toPackage, ops, str)
+	if err != nil {
+		return nil, err
 	}
 
-	packageName := fmt.Sprintf("%smgo", strings.ToLower(str.Object.Name.Name))
-	packageFinalPath := filepath.Join(toPackage, packageName)
-	packageFinalFixturesPath := filepath.Join(toPackage, packageName, "fixtures")
+	packageName := lay.Name
 
-	configName := an.Param("ENVName")
-	if configName == "" {
-		configName = strings.ToUpper(str.Package)
+	templates, err := loadTemplates(
+		g.Config.TemplatesDir,
+		ops,
+		"mongo-functions-test.tml",
+		"mongo-testutil.tml",
+		"makefile.tml",
+		"dockerfile.tml",
+		"mongo-api-random.tml",
+		"mongo-api-json.tml",
+		"mongo-functions.tml",
+		"mongo-hooks.tml",
+		"mongo-consume.tml",
+		"mongo-api-document-test.tml",
+	)
+	if err != nil {
+		return nil, err
 	}
 
+	packageFinalPath := filepath.Join(toPackage, lay.Dir)
+	packageFinalFixturesPath := filepath.Join(toPackage, lay.Dir, "fixtures")
+	packageFinalTestutilPath := filepath.Join(toPackage, lay.Dir, "testutil")
+
+	configName := ops.ENVName
+
 	mongoTestGen := gen.Block(
 		gen.Package(
 			gen.Name(fmt.Sprintf("%s_test", packageName)),
 			gen.Imports(
 				gen.Import("os", ""),
+				gen.Import("fmt", ""),
 				gen.Import("time", ""),
+				gen.Import("reflect", ""),
+				gen.Import("strings", ""),
 				gen.Import("context", ""),
+				gen.Import("math/rand", ""),
 				gen.Import("testing", ""),
 				gen.Import("gopkg.in/mgo.v2", "mgo"),
-				gen.Import("github.com/influx6/faux/tests", ""),
 				gen.Import("github.com/influx6/faux/metrics", ""),
 				gen.Import("github.com/influx6/faux/metrics/custom", ""),
+				gen.Import(str.Path, ""),
 				gen.Import(packageFinalPath, "mdb"),
 				gen.Import(packageFinalFixturesPath, "fixtures"),
+				gen.Import(packageFinalTestutilPath, "testutil"),
 			),
 			gen.Block(
-				gen.SourceTextWith(
+				templates.source(
 					"mongo:functions",
-					string(static.MustReadFile("mongo-functions-test.tml", true)),
+					"mongo-functions-test.tml",
 					gen.ToTemplateFuncs(
 						ast.ASTTemplatFuncs,
 						template.FuncMap{
 							"map":       ast.MapOutFields,
 							"mapValues": ast.MapOutValues,
-							"hasFunc":   pkgDeclr.HasFunctionFor,
+							"hasFunc":   hasFunc(pkgDeclr),
 						},
 					),
 					struct {
 						ENVName string
 						Pkg     *ast.PackageDeclaration
 						Struct  ast.StructDeclaration
+						Record  record
 					}{
 						ENVName: configName,
 						Pkg:     &pkgDeclr,
 						Struct:  str,
+						Record:  rec,
 					},
 				),
 			),
 		),
 	)
 
+	mongoTestUtilGen := gen.Block(
+		gen.Package(
+			gen.Name("testutil"),
+			gen.Imports(
+				gen.Import("errors", ""),
+				gen.Import("fmt", ""),
+				gen.Import("io", ""),
+				gen.Import("io/ioutil", ""),
+				gen.Import("net", ""),
+				gen.Import("os", ""),
+				gen.Import("os/exec", ""),
+				gen.Import("strconv", ""),
+				gen.Import("time", ""),
+				gen.Import("gopkg.in/mgo.v2", "mgo"),
+				gen.Import("gopkg.in/mgo.v2/bson", ""),
+			),
+			gen.Block(
+				templates.source(
+					"mongo:testutil",
+					"mongo-testutil.tml",
+					nil,
+					nil,
+				),
+			),
+		),
+	)
+
 	mongoMakefileGen := gen.Block(
 		gen.Block(
-			gen.SourceText(
+			templates.source(
 				"mongo:make-file",
-				string(static.MustReadFile("makefile.tml", true)),
+				"makefile.tml",
+				nil,
 				struct {
 					Pkg          *ast.PackageDeclaration
 					Struct       ast.StructDeclaration
@@ -403,12 +1058,14 @@ func MongoFuncGen(toPackage string, an ast.AnnotationDeclaration, str ast.Struct
 					PackageName  string
 					PackagePath  string
 					ENVName      string
+					Dockerfile   bool
 				}{
 					ENVName:     configName,
 					PackagePath: packageFinalPath,
 					PackageName: packageName,
 					Pkg:         &pkgDeclr,
 					Struct:      str,
+					Dockerfile:  lay.Dockerfile,
 				},
 			),
 		),
@@ -416,9 +1073,10 @@ func MongoFuncGen(toPackage string, an ast.AnnotationDeclaration, str ast.Struct
 
 	mongoDockerfileGen := gen.Block(
 		gen.Block(
-			gen.SourceText(
+			templates.source(
 				"mongo:dockerfile",
-				string(static.MustReadFile("dockerfile.tml", true)),
+				"dockerfile.tml",
+				nil,
 				struct {
 					Pkg          *ast.PackageDeclaration
 					Struct       ast.StructDeclaration
@@ -438,6 +1096,37 @@ func MongoFuncGen(toPackage string, an ast.AnnotationDeclaration, str ast.Struct
 		),
 	)
 
+	mongoRandomGen := gen.Block(
+		gen.Package(
+			gen.Name("fixtures"),
+			gen.Imports(
+				gen.Import("context", ""),
+				gen.Import("math/rand", ""),
+				gen.Import("time", ""),
+				gen.Import(str.Path, ""),
+			),
+			gen.Block(
+				templates.source(
+					"mongo:random-fixtures",
+					"mongo-api-random.tml",
+					gen.ToTemplateFuncs(
+						ast.ASTTemplatFuncs,
+						template.FuncMap{
+							"randomAssign": randomAssign(pkg),
+						},
+					),
+					struct {
+						Pkg    *ast.PackageDeclaration
+						Struct ast.StructDeclaration
+					}{
+						Pkg:    &pkgDeclr,
+						Struct: str,
+					},
+				),
+			),
+		),
+	)
+
 	mongoJSONGen := gen.Block(
 		gen.Package(
 			gen.Name("fixtures"),
@@ -446,106 +1135,220 @@ func MongoFuncGen(toPackage string, an ast.AnnotationDeclaration, str ast.Struct
 				gen.Import(str.Path, ""),
 			),
 			gen.Block(
-				gen.SourceTextWith(
+				templates.source(
 					"mongo:api-json",
-					string(static.MustReadFile("mongo-api-json.tml", true)),
+					"mongo-api-json.tml",
 					gen.ToTemplateFuncs(
 						ast.ASTTemplatFuncs,
 						template.FuncMap{
 							"map":           ast.MapOutFields,
-							"mapRandomJSON": ast.MapOutFieldsWithRandomValuesToJSON,
+							"mapRandomJSON": randomJSON,
 							"mapValues":     ast.MapOutValues,
 							"mapJSON":       ast.MapOutFieldsToJSON,
-							"hasFunc":       pkgDeclr.HasFunctionFor,
+							"hasFunc":       hasFunc(pkgDeclr),
 						},
 					),
 					struct {
 						Pkg    *ast.PackageDeclaration
 						Struct ast.StructDeclaration
+						Type   string
 					}{
 						Pkg:    &pkgDeclr,
 						Struct: str,
+						Type:   lay.BackendType,
 					},
 				),
 			),
 		),
 	)
 
+	// The document is built by the Fields method of the struct, if it has one.
+	var doc document
+	if !hasFunc(pkgDeclr)(str, "Fields") {
+		doc = buildDocument(str, pkg)
+	}
+
+	dec, err := readDocument(str, pkg, pkgDeclr, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := buildSchema(str, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := buildValidation(str, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	mongoImports := []gen.ImportItemDeclr{
+		gen.Import("errors", ""),
+		gen.Import("runtime", ""),
+		gen.Import("sync", ""),
+		gen.Import("context", ""),
+		gen.Import("time", ""),
+		gen.Import("strings", ""),
+		gen.Import("gopkg.in/mgo.v2", "mgo"),
+		gen.Import("gopkg.in/mgo.v2/bson", ""),
+		gen.Import("github.com/influx6/faux/metrics", ""),
+		gen.Import(str.Path, ""),
+	}
+
+	if doc.Reflect {
+		mongoImports = append(mongoImports, gen.Import("reflect", ""))
+	}
+
+	for _, path := range val.Imports {
+		mongoImports = append(mongoImports, gen.Import(path, ""))
+	}
+
+	mongoImports = withImports(mongoImports, dec.Imports...)
+
 	mongoGen := gen.Block(
 		gen.Package(
 			gen.Name(packageName),
-			gen.Imports(
-				gen.Import("errors", ""),
-				gen.Import("runtime", ""),
-				gen.Import("sync", ""),
-				gen.Import("context", ""),
-				gen.Import("time", ""),
-				gen.Import("strings", ""),
-				gen.Import("gopkg.in/mgo.v2", "mgo"),
-				gen.Import("gopkg.in/mgo.v2/bson", ""),
-				gen.Import("github.com/influx6/faux/metrics", ""),
-				gen.Import(str.Path, ""),
-			),
+			gen.Imports(mongoImports...),
 			gen.Block(
-				gen.SourceTextWith(
+				templates.source(
 					"mongo:functions",
-					string(static.MustReadFile("mongo-functions.tml", true)),
+					"mongo-functions.tml",
 					gen.ToTemplateFuncs(
 						ast.ASTTemplatFuncs,
 						template.FuncMap{
 							"map":     ast.MapOutFields,
-							"hasFunc": pkgDeclr.HasFunctionFor,
+							"hasFunc": hasFunc(pkgDeclr),
 						},
 					),
 					struct {
-						Pkg    *ast.PackageDeclaration
+						Pkg        *ast.PackageDeclaration
+						Struct     ast.StructDeclaration
+						Record     record
+						Document   document
+						Decoder    decoder
+						Schema     string
+						Validation validation
+					}{
+						Pkg:        &pkgDeclr,
+						Struct:     str,
+						Record:     rec,
+						Document:   doc,
+						Decoder:    dec,
+						Schema:     schema,
+						Validation: val,
+					},
+				),
+				templates.source(
+					"mongo:hooks",
+					"mongo-hooks.tml",
+					template.FuncMap{
+						"hasFunc": hasFunc(pkgDeclr),
+					},
+					struct {
 						Struct ast.StructDeclaration
+						Hooks  []string
 					}{
-						Pkg:    &pkgDeclr,
 						Struct: str,
+						Hooks:  lifecycleHooks,
 					},
 				),
+				templates.source(
+					"mongo:consume",
+					"mongo-consume.tml",
+					nil,
+					dec,
+				),
 			),
 		),
 	)
 
-	return []gen.WriteDirective{
+	if err := templates.Err(); err != nil {
+		return nil, err
+	}
+
+	prov := header.New(fmt.Sprintf("%s.%s", str.Path, str.Object.Name.Name), an)
+
+	directives := []gen.WriteDirective{
 		{
+			Writer:   prov.Wrap(fmtwriter.New(mongoGen, true, true)),
+			FileName: fmt.Sprintf("%s_methods.go", packageName),
+			Dir:      lay.Dir,
+		},
+	}
+
+	if lay.Makefile {
+		directives = append(directives, gen.WriteDirective{
 			Writer:   mongoMakefileGen,
 			FileName: "makefile",
-			Dir:      packageName,
-		},
-		{
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Dockerfile {
+		directives = append(directives, gen.WriteDirective{
 			Writer:   mongoDockerfileGen,
 			FileName: "test.dockerfile",
-			Dir:      packageName,
-		},
-		{
-			Writer:   fmtwriter.New(mongoGen, true, true),
-			FileName: fmt.Sprintf("%s_methods.go", packageName),
-			Dir:      packageName,
-		},
-		{
-			Writer:   fmtwriter.New(mongoTestGen, true, true),
-			FileName: fmt.Sprintf("%s_methods_test.go", packageName),
-			Dir:      packageName,
-		},
-		{
-			Writer:       mongoJSONGen,
-			FileName:     fmt.Sprintf("%s_methods_fixtures.go", packageName),
-			Dir:          filepath.Join(packageName, "fixtures"),
-			DontOverride: true,
-		},
-	}, nil
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Fixtures && dec.Name != "" {
+		directives = append(directives, gen.WriteDirective{
+			Writer:   prov.Wrap(fmtwriter.New(documentTest(templates, packageName, packageFinalFixturesPath, str, rec, doc, dec), true, true)),
+			FileName: fmt.Sprintf("%s_methods_document_test.go", packageName),
+			Dir:      lay.Dir,
+		})
+	}
+
+	if lay.Fixtures {
+		directives = append(directives, []gen.WriteDirective{
+			{
+				Writer:   prov.Wrap(fmtwriter.New(mongoTestGen, true, true)),
+				FileName: fmt.Sprintf("%s_methods_test.go", packageName),
+				Dir:      lay.Dir,
+			},
+			{
+				Writer:   prov.Wrap(fmtwriter.New(mongoTestUtilGen, true, true)),
+				FileName: "testutil.go",
+				Dir:      filepath.Join(lay.Dir, "testutil"),
+			},
+			{
+				Writer:   prov.Wrap(fmtwriter.New(mongoRandomGen, true, true)),
+				FileName: fmt.Sprintf("%s_methods_random.go", packageName),
+				Dir:      filepath.Join(lay.Dir, "fixtures"),
+			},
+			{
+				Writer:       mongoJSONGen,
+				FileName:     fmt.Sprintf("%s_methods_fixtures.go", packageName),
+				Dir:          filepath.Join(lay.Dir, "fixtures"),
+				DontOverride: true,
+			},
+		}...)
+	}
+
+	return directives, nil
 }
 
 // MongoSolo generates a simple mongo implementation for executing code on mongodb.
 func MongoSolo(toDir string, an ast.AnnotationDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
+	return Generator{}.MongoSolo(toDir, an, pkgDeclr, pkg)
+}
+
+// MongoSolo generates a simple mongo implementation for executing code on mongodb using
+// the Generator's Config.
+func (g Generator) MongoSolo(toDir string, an ast.AnnotationDeclaration, pkgDeclr ast.PackageDeclaration, pkg ast.Package) ([]gen.WriteDirective, error) {
+	templates, err := loadTemplates(g.Config.TemplatesDir, g.Config.Options(pkgDeclr.Path, ""), "mongo-solo-readme.tml", "mongo-solo.tml", "mongo-migrations.tml")
+	if err != nil {
+		return nil, err
+	}
+
 	mongoReadmeGen := gen.Block(
 		gen.Block(
-			gen.SourceText(
+			templates.source(
 				"mongo:readme",
-				string(static.MustReadFile("mongo-solo-readme.tml", true)),
+				"mongo-solo-readme.tml",
+				nil,
 				struct {
 					Pkg     *ast.PackageDeclaration
 					Package ast.Package
@@ -574,9 +1377,9 @@ func MongoSolo(toDir string, an ast.AnnotationDeclaration, pkgDeclr ast.PackageD
 				gen.Import("github.com/influx6/faux/metrics", ""),
 			),
 			gen.Block(
-				gen.SourceTextWith(
+				templates.source(
 					"mongo:solo",
-					string(static.MustReadFile("mongo-solo.tml", true)),
+					"mongo-solo.tml",
 					template.FuncMap{
 						"map":     ast.MapOutFields,
 						"hasFunc": pkgDeclr.HasFunctionFor,
@@ -593,6 +1396,43 @@ func MongoSolo(toDir string, an ast.AnnotationDeclaration, pkgDeclr ast.PackageD
 		),
 	)
 
+	migrationsGen := gen.Block(
+		gen.Commentary(
+			gen.Text(`Package migrations provides a auto-generated package which applies and reverts versioned migrations of the documents stored through package mdb.`),
+		),
+		gen.Package(
+			gen.Name("migrations"),
+			gen.Imports(
+				gen.Import("context", ""),
+				gen.Import("errors", ""),
+				gen.Import("fmt", ""),
+				gen.Import("io", ""),
+				gen.Import("sort", ""),
+				gen.Import("strconv", ""),
+				gen.Import("sync", ""),
+				gen.Import("time", ""),
+				gen.Import("gopkg.in/mgo.v2", "mgo"),
+				gen.Import("gopkg.in/mgo.v2/bson", ""),
+				gen.Import("github.com/influx6/faux/metrics", ""),
+				gen.Import(filepath.Join(toDir, "mdb"), ""),
+			),
+			gen.Block(
+				templates.source(
+					"mongo:migrations",
+					"mongo-migrations.tml",
+					nil,
+					nil,
+				),
+			),
+		),
+	)
+
+	if err := templates.Err(); err != nil {
+		return nil, err
+	}
+
+	prov := header.New(pkgDeclr.Path, an)
+
 	return []gen.WriteDirective{
 		{
 			Writer:   mongoReadmeGen,
@@ -601,10 +1441,70 @@ func MongoSolo(toDir string, an ast.AnnotationDeclaration, pkgDeclr ast.PackageD
 			// DontOverride: true,
 		},
 		{
-			Writer:   fmtwriter.New(mongoGen, true, true),
+			Writer:   prov.Wrap(fmtwriter.New(mongoGen, true, true)),
 			FileName: "mdb.go",
 			Dir:      "mdb",
 			// DontOverride: true,
 		},
+		{
+			Writer:   prov.Wrap(fmtwriter.New(migrationsGen, true, true)),
+			FileName: "migrations.go",
+			Dir:      filepath.Join("mdb", "migrations"),
+		},
 	}, nil
 }
+
+// documentTest returns the test of the package at packageName reading back the records it
+// stores, through the document and decoder built for the giving struct.
+func documentTest(templates *templateSet, packageName string, fixturesPath string, str ast.StructDeclaration, rec record, doc document, dec decoder) gen.BlockDeclr {
+	return gen.Block(
+		gen.Package(
+			gen.Name(packageName),
+			gen.Imports(
+				gen.Import("reflect", ""),
+				gen.Import("testing", ""),
+				gen.Import("gopkg.in/mgo.v2/bson", ""),
+				gen.Import(str.Path, ""),
+				gen.Import(fixturesPath, "fixtures"),
+			),
+			gen.Block(
+				templates.source(
+					"mongo:document-test",
+					"mongo-api-document-test.tml",
+					nil,
+					struct {
+						Struct   ast.StructDeclaration
+						Record   record
+						Document document
+						Decoder  decoder
+					}{
+						Struct:   str,
+						Record:   rec,
+						Document: doc,
+						Decoder:  dec,
+					},
+				),
+			),
+		),
+	)
+}
+
+// withImports returns imports along with all giving extra imports whose path it does not
+// contain yet.
+func withImports(imports []gen.ImportItemDeclr, extra ...gen.ImportItemDeclr) []gen.ImportItemDeclr {
+	for _, imp := range extra {
+		found := false
+		for _, existing := range imports {
+			if existing.Path == imp.Path {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			imports = append(imports, imp)
+		}
+	}
+
+	return imports
+}
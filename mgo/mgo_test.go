@@ -0,0 +1,543 @@
+package mgo_test
+
+import (
+	"bytes"
+	"flag"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	goast "go/ast"
+
+	"github.com/gokit/mgokit/cache"
+	"github.com/gokit/mgokit/config"
+	"github.com/gokit/mgokit/mgo"
+	"github.com/influx6/faux/metrics"
+	"github.com/influx6/moz/ast"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata/golden")
+
+// testdataPath defines the import path under which the packages in testdata
+// are generated, regardless of where the repository is checked out.
+const testdataPath = "github.com/gokit/mgokit/mgo/testdata"
+
+func TestMongoGen(t *testing.T) {
+	checkGenerated(t, "api", generate(t, "api"))
+}
+
+func TestMongoFuncGen(t *testing.T) {
+	checkGenerated(t, "methods", generate(t, "methods"))
+}
+
+func TestMongoGenOptions(t *testing.T) {
+	checkGenerated(t, "options", generate(t, "options"))
+}
+
+func TestMongoGenLayout(t *testing.T) {
+	checkGenerated(t, "layout", generate(t, "layout"))
+}
+
+func TestMongoGenNested(t *testing.T) {
+	checkGenerated(t, "nested", generate(t, "nested"))
+}
+
+func TestMongoGenSchema(t *testing.T) {
+	checkGenerated(t, "schema", generate(t, "schema"))
+}
+
+func TestMongoGenRules(t *testing.T) {
+	checkGenerated(t, "rules", generate(t, "rules"))
+}
+
+func TestMongoGenHTTP(t *testing.T) {
+	checkGenerated(t, "tickets", generate(t, "tickets"))
+}
+
+func TestMongoGenGRPC(t *testing.T) {
+	checkGenerated(t, "shipments", generate(t, "shipments"))
+}
+
+func TestMongoGenHooks(t *testing.T) {
+	checkGenerated(t, "hooks", generate(t, "hooks"))
+}
+
+func TestMongoGenOutbox(t *testing.T) {
+	checkGenerated(t, "outbox", generate(t, "outbox"))
+}
+
+func TestMongoFieldsGen(t *testing.T) {
+	checkGenerated(t, "fields", generate(t, "fields"))
+}
+
+func TestMongoSolo(t *testing.T) {
+	checkGenerated(t, "justdb", generate(t, "justdb"))
+}
+
+func TestInspect(t *testing.T) {
+	pkgs, err := ast.ParseAnnotations(metrics.New(), filepath.Join("testdata", "options"))
+	if err != nil {
+		t.Fatalf("failed to parse testdata package %q: %+q", "options", err)
+	}
+
+	inspections := mgo.Generator{}.Inspect(pkgs...)
+	if len(inspections) != 1 {
+		t.Fatalf("expected a single annotated struct, got %d", len(inspections))
+	}
+
+	ins := inspections[0]
+	if ins.Error != "" {
+		t.Fatalf("expected struct to resolve without error, got %q", ins.Error)
+	}
+
+	if ins.Struct != "Account" || ins.PackageName != "accountstore" || ins.KeyField != "ID" || ins.KeyName != "id" || ins.ENVName != "OPTIONS" {
+		t.Fatalf("expected resolved settings of Account, got %#v", ins)
+	}
+
+	if len(ins.Fields) != 1 || ins.Fields["id"] != "ID" {
+		t.Fatalf("expected bson tagged fields of Account, got %#v", ins.Fields)
+	}
+}
+
+func TestInspectHooks(t *testing.T) {
+	pkgs, err := ast.ParseAnnotations(metrics.New(), filepath.Join("testdata", "hooks"))
+	if err != nil {
+		t.Fatalf("failed to parse testdata package %q: %+q", "hooks", err)
+	}
+
+	hooks := make(map[string]string)
+	for _, ins := range (mgo.Generator{}).Inspect(pkgs...) {
+		hooks[ins.Struct] = strings.Join(ins.Hooks, ",")
+	}
+
+	if hooks["Member"] != "BeforeCreate,BeforeUpdate,AfterLoad" || hooks["Visit"] != "AfterCreate,BeforeDelete" {
+		t.Fatalf("expected lifecycle methods of Member and Visit as hooks, got %#v", hooks)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	pkgs, err := ast.ParseAnnotations(metrics.New(), filepath.Join("testdata", "invalid"))
+	if err != nil {
+		t.Fatalf("failed to parse testdata package %q: %+q", "invalid", err)
+	}
+
+	err = mgo.Generator{}.Validate(pkgs...)
+	problems, ok := err.(mgo.Problems)
+	if !ok {
+		t.Fatalf("expected problems, got %+q", err)
+	}
+
+	expected := []string{
+		`invalid.go:9:1: unknown param "KeyFiled" for @mongoapi on struct User`,
+		`invalid.go:10:6: struct User has no PublicID field`,
+		`invalid.go:12:2: field Alias of struct User has bson name "name" already used by field Name`,
+		`invalid.go:13:2: field secret of struct User is unexported`,
+		`invalid.go:19:2: field ID of struct Note must be a string to be used as key, found int`,
+		`invalid.go:30:1: struct Tag declares Fields, which @mongo_fields generates`,
+		`invalid.go:37:2: field PublicID of struct Event has invalid schema tag: unknown schema option "requird"`,
+		`invalid.go:38:2: field Created of struct Event has invalid schema tag: enum is only supported for strings, numbers and bools`,
+		`invalid.go:39:2: field Count of struct Event has invalid schema tag: enum value "one" is not an integer`,
+		`invalid.go:45:2: field PublicID of struct Invite has invalid validate tag: validate rule "min" requires a value`,
+		`invalid.go:46:2: field Count of struct Invite has invalid validate tag: validate rule "email" is only supported for strings`,
+		`invalid.go:47:2: field Level of struct Invite has invalid validate tag: "low" is not a valid int`,
+		`invalid.go:51:1: Struct "Call" has field "Payload" without protobuf equivalent: interfaces and types left to the bson package are not supported`,
+		`invalid.go:58:1: param "HTTP" for @mongo_methods on struct Visit is only supported by @mongoapi`,
+		`invalid.go:58:1: param "Outbox" for @mongo_methods on struct Visit is only supported by @mongoapi`,
+		`invalid.go:70:1: method BeforeCreate of struct Entry must be func(context.Context) error to be used as hook, found func() error`,
+		`invalid.go:75:1: method AfterLoad of struct Entry must be func(context.Context) error to be used as hook, found func(ctx context.Context)`,
+	}
+
+	if len(problems) != len(expected) {
+		t.Fatalf("expected %d problems, got:\n%s", len(expected), problems)
+	}
+
+	for index, problem := range problems {
+		if message := filepath.Base(problem.Error()); !strings.HasPrefix(message, expected[index]) {
+			t.Errorf("expected problem %q, got %q", expected[index], message)
+		}
+	}
+
+	pkgs, err = ast.ParseAnnotations(metrics.New(), filepath.Join("testdata", "options"))
+	if err != nil {
+		t.Fatalf("failed to parse testdata package %q: %+q", "options", err)
+	}
+
+	if err := (mgo.Generator{}).Validate(pkgs...); err != nil {
+		t.Fatalf("expected no problems, got %+q", err)
+	}
+}
+
+func TestTemplateOverrides(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mgokit-templates")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %+q", err)
+	}
+	defer os.RemoveAll(dir)
+
+	override := filepath.Join(dir, "mongo-api-readme.tml")
+	if err := ioutil.WriteFile(override, []byte("# {{.PackageName}} stores {{.Struct.Object.Name}}\n"), 0644); err != nil {
+		t.Fatalf("failed to write template: %+q", err)
+	}
+
+	files, err := render("api", mgo.Generator{Config: config.Config{TemplatesDir: dir}})
+	if err != nil {
+		t.Fatalf("failed to generate with template override: %+q", err)
+	}
+
+	if readme := string(files["usermgo/README.md"].Content); readme != "# usermgo stores User\n" {
+		t.Fatalf("expected README.md from template override, got %q", readme)
+	}
+
+	if err := ioutil.WriteFile(override, []byte("{{.Missing}}"), 0644); err != nil {
+		t.Fatalf("failed to write template: %+q", err)
+	}
+
+	if _, err := render("api", mgo.Generator{Config: config.Config{TemplatesDir: dir}}); err == nil || !strings.Contains(err.Error(), override) {
+		t.Fatalf("expected invalid template override to fail naming %q, got %+q", override, err)
+	}
+
+	if err := mgo.ValidateTemplatesDir(dir); err != nil {
+		t.Fatalf("expected templates dir to be valid: %+q", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "mongo-apis.tml"), nil, 0644); err != nil {
+		t.Fatalf("failed to write template: %+q", err)
+	}
+
+	if err := mgo.ValidateTemplatesDir(dir); err == nil {
+		t.Fatal("expected unknown template in templates dir to fail")
+	}
+}
+
+func TestStructHashAnnotations(t *testing.T) {
+	dest, err := ioutil.TempDir("", "mgokit-hash")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %+q", err)
+	}
+	defer os.RemoveAll(dest)
+
+	pkgs, err := ast.ParseAnnotations(metrics.New(), filepath.Join("testdata", "api"))
+	if err != nil {
+		t.Fatalf("failed to parse testdata package %q: %+q", "api", err)
+	}
+
+	pkg := pkgs[0]
+	declr := pkg.Packages[0]
+	declr.Path = path.Join(testdataPath, "api")
+
+	var str ast.StructDeclaration
+	var an ast.AnnotationDeclaration
+	for _, elem := range declr.Structs {
+		for _, annotation := range elem.Annotations {
+			if annotation.Name == "@mongoapi" {
+				str, an = elem, annotation
+			}
+		}
+	}
+	str.Path = declr.Path
+
+	gens := mgo.Generator{}
+	runner := &cache.Runner{Dest: dest, Cache: cache.Cache{Targets: make(map[string]cache.Target)}}
+	fn := runner.Struct(gens.StructHash, gens.MongoGen)
+
+	for index, expected := range []int{1, 1, 2} {
+		if index == 2 {
+			str.Annotations = append(str.Annotations, ast.AnnotationDeclaration{Name: "@mongo_fields"})
+		}
+
+		if _, err := fn(declr.Path, an, str, declr, pkg); err != nil {
+			t.Fatalf("failed to run generator: %+q", err)
+		}
+
+		if summary := runner.Summary(); summary.Generated != expected || summary.Failed != 0 {
+			t.Fatalf("expected %d generated targets after run %d, got %s", expected, index+1, summary)
+		}
+	}
+}
+
+// generatedFile defines a single file produced by a generator.
+type generatedFile struct {
+	Path         string
+	Content      []byte
+	DontOverride bool
+}
+
+// generate runs all mgokit generators over the testdata package with the giving
+// name, returning the produced files keyed by their path relative to the package.
+func generate(t *testing.T, name string) map[string]generatedFile {
+	t.Helper()
+
+	files, err := render(name, mgo.Generator{})
+	if err != nil {
+		t.Fatalf("failed to generate testdata package %q: %+q", name, err)
+	}
+
+	if len(files) == 0 {
+		t.Fatalf("expected generated files for testdata package %q", name)
+	}
+
+	return files
+}
+
+// render runs the generators of the giving Generator over the testdata package with
+// the giving name, rendering all produced files into memory.
+func render(name string, gens mgo.Generator) (map[string]generatedFile, error) {
+	logs := metrics.New()
+
+	generators := ast.NewAnnotationRegistryWith(logs)
+	generators.Register("mongo", gens.MongoSolo)
+	generators.Register("mongoapi", gens.MongoGen)
+	generators.Register("mongo_methods", gens.MongoFuncGen)
+	generators.Register("mongo_fields", gens.MongoFieldsGen)
+
+	pkgs, err := ast.ParseAnnotations(logs, filepath.Join("testdata", name))
+	if err != nil {
+		return nil, err
+	}
+
+	pkgPath := path.Join(testdataPath, name)
+	files := make(map[string]generatedFile)
+
+	for _, pkg := range pkgs {
+		for _, declr := range pkg.Packages {
+			declr.Path = pkgPath
+			for index := range declr.Structs {
+				declr.Structs[index].Path = pkgPath
+			}
+
+			directives, err := generators.ParseDeclr(pkg, declr, pkgPath)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, directive := range directives {
+				if directive.Writer == nil {
+					continue
+				}
+
+				var content bytes.Buffer
+				if _, err := directive.Writer.WriteTo(&content); err != nil {
+					return nil, err
+				}
+
+				filePath := filepath.ToSlash(filepath.Join(directive.Dir, directive.FileName))
+				files[filePath] = generatedFile{
+					Path:         filePath,
+					Content:      content.Bytes(),
+					DontOverride: directive.DontOverride,
+				}
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// checkGenerated compares the giving files against the golden files of the testdata
+// package, then type checks all generated go packages.
+func checkGenerated(t *testing.T, name string, files map[string]generatedFile) {
+	t.Helper()
+
+	goldenDir := filepath.Join("testdata", "golden", name)
+
+	if *update {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			t.Fatalf("failed to clear golden files: %+q", err)
+		}
+	}
+
+	for _, file := range files {
+		// Files which are never overridden hold random fixture values, so
+		// only their compilation is checked.
+		if file.DontOverride {
+			continue
+		}
+
+		golden := filepath.Join(goldenDir, filepath.FromSlash(file.Path)+".golden")
+
+		if *update {
+			if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+				t.Fatalf("failed to create golden directory: %+q", err)
+			}
+
+			if err := ioutil.WriteFile(golden, file.Content, 0644); err != nil {
+				t.Fatalf("failed to write golden file: %+q", err)
+			}
+			continue
+		}
+
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatalf("failed to read golden file for %q, run with -update to create: %+q", file.Path, err)
+		}
+
+		if !bytes.Equal(expected, file.Content) {
+			t.Errorf("generated %q does not match %q, run with -update if the change is expected", file.Path, golden)
+		}
+	}
+
+	typeCheck(t, name, files)
+}
+
+// typeCheck type checks all go packages among the giving files along with the
+// testdata package they were generated from.
+func typeCheck(t *testing.T, name string, files map[string]generatedFile) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	imp := &memImporter{
+		fset:     fset,
+		sources:  make(map[string][]*goast.File),
+		tests:    make(map[string][]*goast.File),
+		internal: make(map[string][]*goast.File),
+		packages: make(map[string]*types.Package),
+		fallback: importer.ForCompiler(fset, "source", nil).(types.ImporterFrom),
+	}
+
+	sourceDir := filepath.Join("testdata", name)
+	sources, err := parser.ParseDir(fset, sourceDir, nil, 0)
+	if err != nil {
+		t.Fatalf("failed to parse testdata package %q: %+q", name, err)
+	}
+
+	for _, pkg := range sources {
+		for _, file := range pkg.Files {
+			imp.add(path.Join(testdataPath, name), file, false)
+		}
+	}
+
+	for _, file := range files {
+		if strings.HasSuffix(file.Path, ".proto") {
+			addProtoc(t, imp, name, file)
+			continue
+		}
+
+		if !strings.HasSuffix(file.Path, ".go") {
+			continue
+		}
+
+		parsed, err := parser.ParseFile(fset, file.Path, file.Content, 0)
+		if err != nil {
+			t.Fatalf("generated %q failed to parse: %+q", file.Path, err)
+		}
+
+		pkgPath := path.Join(testdataPath, name, path.Dir(file.Path))
+		imp.add(pkgPath, parsed, strings.HasSuffix(file.Path, "_test.go"))
+	}
+
+	var paths []string
+	for pkgPath := range imp.sources {
+		paths = append(paths, pkgPath)
+	}
+	sort.Strings(paths)
+
+	for _, pkgPath := range paths {
+		if _, err := imp.Import(pkgPath); err != nil {
+			t.Errorf("generated package %q failed to type check: %+q", pkgPath, err)
+		}
+	}
+
+	for pkgPath, tests := range imp.tests {
+		conf := types.Config{Importer: imp}
+		if _, err := conf.Check(pkgPath+"_test", fset, tests, nil); err != nil {
+			t.Errorf("generated tests for %q failed to type check: %+q", pkgPath, err)
+		}
+	}
+
+	for pkgPath, tests := range imp.internal {
+		files := append(append([]*goast.File(nil), imp.sources[pkgPath]...), tests...)
+
+		conf := types.Config{Importer: imp}
+		if _, err := conf.Check(pkgPath, fset, files, nil); err != nil {
+			t.Errorf("generated internal tests for %q failed to type check: %+q", pkgPath, err)
+		}
+	}
+}
+
+// addProtoc adds the go code protoc generated from the giving .proto file, checked in
+// under testdata/protoc along with the .proto file it was generated from, which must match
+// the giving file. It is regenerated by running protoc with protoc-gen-go and
+// protoc-gen-go-grpc over the .proto file, with paths=source_relative.
+func addProtoc(t *testing.T, imp *memImporter, name string, file generatedFile) {
+	t.Helper()
+
+	dir := filepath.Join("testdata", "protoc", name, filepath.FromSlash(path.Dir(file.Path)))
+	checked, err := ioutil.ReadFile(filepath.Join(dir, path.Base(file.Path)))
+	if err != nil {
+		t.Fatalf("failed to read protoc input for %q: %+q", file.Path, err)
+	}
+
+	if !bytes.Equal(checked, file.Content) {
+		t.Fatalf("generated %q does not match the input of the protoc output in %q, regenerate it", file.Path, dir)
+	}
+
+	sources, err := parser.ParseDir(imp.fset, dir, nil, 0)
+	if err != nil {
+		t.Fatalf("failed to parse protoc output for %q: %+q", file.Path, err)
+	}
+
+	for _, pkg := range sources {
+		for _, parsed := range pkg.Files {
+			imp.add(path.Join(testdataPath, name, path.Dir(file.Path)), parsed, false)
+		}
+	}
+}
+
+// memImporter implements types.Importer for packages held in memory, falling
+// back to importing from source for all others.
+type memImporter struct {
+	fset     *token.FileSet
+	fallback types.ImporterFrom
+	sources  map[string][]*goast.File
+	tests    map[string][]*goast.File
+	internal map[string][]*goast.File
+	packages map[string]*types.Package
+}
+
+func (m *memImporter) add(pkgPath string, file *goast.File, isTest bool) {
+	if isTest && !strings.HasSuffix(file.Name.Name, "_test") {
+		m.internal[pkgPath] = append(m.internal[pkgPath], file)
+		return
+	}
+
+	if isTest {
+		m.tests[pkgPath] = append(m.tests[pkgPath], file)
+		return
+	}
+
+	m.sources[pkgPath] = append(m.sources[pkgPath], file)
+}
+
+// Import implements the types.Importer interface.
+func (m *memImporter) Import(pkgPath string) (*types.Package, error) {
+	if pkg, ok := m.packages[pkgPath]; ok {
+		return pkg, nil
+	}
+
+	files, ok := m.sources[pkgPath]
+	if !ok {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+
+		return m.fallback.ImportFrom(pkgPath, wd, 0)
+	}
+
+	conf := types.Config{Importer: m}
+	pkg, err := conf.Check(pkgPath, m.fset, files, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m.packages[pkgPath] = pkg
+	return pkg, nil
+}
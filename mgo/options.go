@@ -0,0 +1,263 @@
+package mgo
+
+import (
+	"fmt"
+	goast "go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gokit/mgokit/config"
+	"github.com/influx6/moz/ast"
+)
+
+// Generator generates mongodb packages using the options set in a project Config. Params
+// of an annotation, e.g @mongoapi(KeyField => ID), take precedence over the Config.
+type Generator struct {
+	Config config.Config
+}
+
+// defaultOptions contains the options used when neither the Config nor the annotation sets them.
+var defaultOptions = config.Options{
+	PackageName: "{struct}mgo",
+	Driver:      "mgo",
+	KeyField:    "PublicID",
+}
+
+// options returns the options for the giving struct, merging the defaults, the Config and
+// the params of the annotation in order of precedence.
+func (g Generator) options(an ast.AnnotationDeclaration, str ast.StructDeclaration) (config.Options, error) {
+	ops := defaultOptions.Merge(config.Options{ENVName: strings.ToUpper(str.Package)})
+	ops = ops.Merge(g.Config.Options(str.Path, str.Object.Name.Name))
+	ops = ops.Merge(config.Options{
+		PackageName:  an.Param("PackageName"),
+		Dir:          an.Param("Dir"),
+		Driver:       an.Param("Driver"),
+		KeyField:     an.Param("KeyField"),
+		CreatedField: an.Param("CreatedField"),
+		UpdatedField: an.Param("UpdatedField"),
+		ENVName:      an.Param("ENVName"),
+	})
+
+	flags := map[string]**bool{
+		"Types":           &ops.Types,
+		"Fixtures":        &ops.Fixtures,
+		"Readme":          &ops.Readme,
+		"Makefile":        &ops.Makefile,
+		"Dockerfile":      &ops.Dockerfile,
+		"HTTP":            &ops.HTTP,
+		"GRPC":            &ops.GRPC,
+		"Cache":           &ops.Cache,
+		"Outbox":          &ops.Outbox,
+		"BackendInSource": &ops.BackendInSource,
+	}
+
+	for name, flag := range flags {
+		param, ok := an.Params[name]
+		if !ok {
+			continue
+		}
+
+		value, err := strconv.ParseBool(param)
+		if err != nil {
+			return ops, fmt.Errorf("Struct %q has invalid %s param %+q, expected true or false", str.Object.Name.Name, name, param)
+		}
+
+		*flag = &value
+	}
+
+	if ops.Driver != "mgo" {
+		return ops, fmt.Errorf("Struct %q uses unsupported driver %+q, only %+q is supported", str.Object.Name.Name, ops.Driver, "mgo")
+	}
+
+	return ops, nil
+}
+
+// layout defines the names and directories of the files generated for a struct, relative
+// to the destination, and which of them are generated.
+type layout struct {
+	// Name and Dir set the name and directory of the generated package.
+	Name string
+	Dir  string
+
+	// BackendDir and BackendPkg set the directory and package name of the backend
+	// interface, with BackendType set to the struct type as referenced within it.
+	BackendDir  string
+	BackendPkg  string
+	BackendType string
+	InSource    bool
+
+	Types      bool
+	Fixtures   bool
+	Readme     bool
+	Makefile   bool
+	Dockerfile bool
+	HTTP       bool
+	GRPC       bool
+	Cache      bool
+	Outbox     bool
+}
+
+// resolveLayout returns the layout for the giving struct, generated into the package with
+// the import path toPackage.
+func resolveLayout(toPackage string, ops config.Options, str ast.StructDeclaration) (layout, error) {
+	lay := layout{
+		Name:        expand(ops.PackageName, str),
+		Dir:         filepath.FromSlash(expand(ops.Dir, str)),
+		BackendDir:  "types",
+		BackendPkg:  "types",
+		BackendType: fmt.Sprintf("%s.%s", str.Package, str.Object.Name.Name),
+		InSource:    enabled(ops.BackendInSource, false),
+		Types:       enabled(ops.Types, true),
+		Fixtures:    enabled(ops.Fixtures, true),
+		Readme:      enabled(ops.Readme, true),
+		Makefile:    enabled(ops.Makefile, true),
+		Dockerfile:  enabled(ops.Dockerfile, false),
+		HTTP:        enabled(ops.HTTP, false),
+		GRPC:        enabled(ops.GRPC, false),
+		Cache:       enabled(ops.Cache, false),
+		Outbox:      enabled(ops.Outbox, false),
+	}
+
+	if !token.IsIdentifier(lay.Name) {
+		return lay, fmt.Errorf("Package name %+q for struct %q is not a valid go identifier", lay.Name, str.Object.Name.Name)
+	}
+
+	if lay.Dir == "" {
+		lay.Dir = lay.Name
+	}
+
+	if filepath.IsAbs(lay.Dir) || strings.HasPrefix(filepath.Clean(lay.Dir), "..") {
+		return lay, fmt.Errorf("Directory %+q for struct %q must be relative to and within the destination", lay.Dir, str.Object.Name.Name)
+	}
+
+	if lay.HTTP && !lay.Types {
+		return lay, fmt.Errorf("Struct %q sets HTTP, which serves records through the backend interface disabled by Types", str.Object.Name.Name)
+	}
+
+	if lay.GRPC && !lay.Types {
+		return lay, fmt.Errorf("Struct %q sets GRPC, which serves records through the backend interface disabled by Types", str.Object.Name.Name)
+	}
+
+	if lay.InSource {
+		rel, ok := relativeImport(toPackage, str.Path)
+		if !ok {
+			return lay, fmt.Errorf("Struct %q must be within the destination %+q to generate its backend into its package", str.Object.Name.Name, toPackage)
+		}
+
+		lay.BackendDir = rel
+		lay.BackendPkg = str.Package
+		lay.BackendType = str.Object.Name.Name
+	}
+
+	return lay, nil
+}
+
+// expand returns pattern with {struct} replaced with the lowercased name of the struct and
+// {package} with the name of its package.
+func expand(pattern string, str ast.StructDeclaration) string {
+	pattern = strings.Replace(pattern, "{struct}", strings.ToLower(str.Object.Name.Name), -1)
+	return strings.Replace(pattern, "{package}", str.Package, -1)
+}
+
+// enabled returns the value of the giving option, else def if it is not set.
+func enabled(option *bool, def bool) bool {
+	if option == nil {
+		return def
+	}
+
+	return *option
+}
+
+// relativeImport returns the directory of the package with import path pkgPath relative to
+// the package with import path base, if it is within it.
+func relativeImport(base string, pkgPath string) (string, bool) {
+	base, pkgPath = filepath.ToSlash(base), filepath.ToSlash(pkgPath)
+
+	if pkgPath == base {
+		return "", true
+	}
+
+	if !strings.HasPrefix(pkgPath, base+"/") {
+		return "", false
+	}
+
+	return filepath.FromSlash(strings.TrimPrefix(pkgPath, base+"/")), true
+}
+
+// record defines the fields of a struct used by generated code to identify and
+// timestamp stored records.
+type record struct {
+	// Key and KeyName set the field identifying records and its name within mongodb.
+	Key     string
+	KeyName string
+
+	// Created and Updated set the time.Time fields updated on Create and Update, if any.
+	Created string
+	Updated string
+
+	// ObjectID sets the bson.ObjectId field stored as _id, if any, which mongodb assigns
+	// when zero.
+	ObjectID string
+}
+
+// recordFields returns the record for the giving struct, validating that the key
+// field is a string and all timestamp fields are time.Time.
+func recordFields(ops config.Options, str ast.StructDeclaration) (record, error) {
+	rec := record{Key: ops.KeyField, Created: ops.CreatedField, Updated: ops.UpdatedField}
+
+	keyField, ok := structField(str, ops.KeyField)
+	if !ok || types.ExprString(keyField.Type) != "string" {
+		return rec, fmt.Errorf(`Struct has no '%s' field with 'string' type
+		 Add '%s string' with a bson or json tag to struct %q
+		`, ops.KeyField, ops.KeyField, str.Object.Name.Name)
+	}
+
+	rec.KeyName = fieldName(ops.KeyField, keyField.Tag)
+
+	for _, field := range str.Struct.Fields.List {
+		if types.ExprString(field.Type) != "bson.ObjectId" {
+			continue
+		}
+
+		for _, ident := range field.Names {
+			if fieldName(ident.Name, field.Tag) == "_id" {
+				rec.ObjectID = ident.Name
+			}
+		}
+	}
+
+	for _, name := range []string{ops.CreatedField, ops.UpdatedField} {
+		if name == "" {
+			continue
+		}
+
+		field, ok := structField(str, name)
+		if !ok || types.ExprString(field.Type) != "time.Time" {
+			return rec, fmt.Errorf("Struct %q has no %q field with 'time.Time' type", str.Object.Name.Name, name)
+		}
+	}
+
+	return rec, nil
+}
+
+// structField returns the field of the giving struct with the giving name.
+func structField(str ast.StructDeclaration, name string) (*goast.Field, bool) {
+	for _, field := range str.Struct.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return field, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// fieldName returns the name of a field within mongodb from its bson or json tag, else
+// its lowercased name as mgo does.
+func fieldName(name string, tag *goast.BasicLit) string {
+	return parseTag(name, tag).Name
+}
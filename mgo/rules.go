@@ -0,0 +1,595 @@
+package mgo
+
+import (
+	"bytes"
+	"fmt"
+	goast "go/ast"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/influx6/moz/ast"
+)
+
+// rule defines a rule of the validate tag of a struct field, e.g "min=3".
+type rule struct {
+	Name  string
+	Param string
+}
+
+// parseRules returns the rules of the validate tag of the giving field tag, e.g
+// `validate:"required,min=3,max=64,email,oneof=a|b"`, else an error naming the rule it does
+// not know. The omitempty rule skips all others for empty values.
+func parseRules(tag *goast.BasicLit) ([]rule, error) {
+	if tag == nil {
+		return nil, nil
+	}
+
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return nil, nil
+	}
+
+	options, ok := reflect.StructTag(value).Lookup("validate")
+	if !ok || options == "" {
+		return nil, nil
+	}
+
+	var rules []rule
+	for _, option := range strings.Split(options, ",") {
+		parts := strings.SplitN(option, "=", 2)
+
+		r := rule{Name: parts[0]}
+		if len(parts) == 2 {
+			r.Param = parts[1]
+		}
+
+		switch r.Name {
+		case "required", "omitempty", "email":
+			if len(parts) == 2 {
+				return nil, fmt.Errorf("validate rule %+q takes no value", r.Name)
+			}
+		case "min", "max", "oneof":
+			if r.Param == "" {
+				return nil, fmt.Errorf("validate rule %+q requires a value, e.g %s=1", r.Name, r.Name)
+			}
+		default:
+			return nil, fmt.Errorf("unknown validate rule %+q, expected required, omitempty, min, max, email or oneof", r.Name)
+		}
+
+		rules = append(rules, r)
+	}
+
+	return rules, nil
+}
+
+// validation defines the generated function checking the rules of a struct.
+type validation struct {
+	// Name sets the name of the generated function, which takes the struct and returns a
+	// ValidationError listing the fields failing their rules, else nil.
+	Name string
+
+	// Source sets the source of the generated function.
+	Source string
+
+	// Imports sets the import paths of the packages Source uses.
+	Imports []string
+}
+
+// buildValidation returns the validation function checking the rules of the giving struct,
+// declared within the giving package.
+func buildValidation(str ast.StructDeclaration, pkg ast.Package) (validation, error) {
+	b := &ruleBuilder{visiting: make(map[string]bool), imports: make(map[string]bool)}
+
+	st := structType(str, pkg)
+	b.visiting[structKey(st)] = true
+
+	if err := b.fields("elem", nil, st); err != nil {
+		return validation{}, err
+	}
+
+	val := validation{Name: "Validate" + str.Object.Name.Name}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// %s returns a ValidationError listing every field of the giving %s failing the\n", val.Name, str.Object.Name.Name)
+	fmt.Fprintf(&source, "// rules of its validate tag, else nil.\n")
+	fmt.Fprintf(&source, "func %s(elem %s.%s) error {\n", val.Name, str.Package, str.Object.Name.Name)
+	fmt.Fprintf(&source, "var failed []FieldError\n")
+	source.Write(b.out.Bytes())
+	fmt.Fprintf(&source, "if len(failed) != 0 {\n")
+	fmt.Fprintf(&source, "return ValidationError{Fields: failed}\n")
+	fmt.Fprintf(&source, "}\n")
+	fmt.Fprintf(&source, "return nil\n}\n")
+
+	if b.imports["net/mail"] {
+		fmt.Fprintf(&source, "\n// isEmail returns true/false if the giving value is a valid email address.\n")
+		fmt.Fprintf(&source, "func isEmail(value string) bool {\n")
+		fmt.Fprintf(&source, "addr, err := mail.ParseAddress(value)\n")
+		fmt.Fprintf(&source, "return err == nil && addr.Address == value\n}\n")
+	}
+
+	val.Source = source.String()
+
+	for path := range b.imports {
+		val.Imports = append(val.Imports, path)
+	}
+
+	sort.Strings(val.Imports)
+	return val, nil
+}
+
+// pathPart defines a part of the path of a field, either a literal or a go expression.
+type pathPart struct {
+	Text    string
+	Literal bool
+}
+
+// fieldPath defines the path of a field within stored documents, e.g "items.0.sku".
+type fieldPath []pathPart
+
+// join returns the path of the field with the giving name nested within p.
+func (p fieldPath) join(name string) fieldPath {
+	joined := append(fieldPath{}, p...)
+	if len(joined) != 0 {
+		joined = append(joined, pathPart{Text: ".", Literal: true})
+	}
+
+	return append(joined, pathPart{Text: name, Literal: true})
+}
+
+// append returns p followed by the giving literal and go expression, e.g "." and the index
+// of an item.
+func (p fieldPath) append(literal string, expr string) fieldPath {
+	return append(append(fieldPath{}, p...), pathPart{Text: literal, Literal: true}, pathPart{Text: expr})
+}
+
+// expr returns the go expression of the path, merging adjacent literals.
+func (p fieldPath) expr() string {
+	var exprs []string
+	var literal bytes.Buffer
+
+	for _, part := range p {
+		if part.Literal {
+			literal.WriteString(part.Text)
+			continue
+		}
+
+		if literal.Len() != 0 {
+			exprs = append(exprs, strconv.Quote(literal.String()))
+			literal.Reset()
+		}
+
+		exprs = append(exprs, part.Text)
+	}
+
+	if literal.Len() != 0 || len(exprs) == 0 {
+		exprs = append(exprs, strconv.Quote(literal.String()))
+	}
+
+	return strings.Join(exprs, " + ")
+}
+
+// ruleBuilder writes the statements checking the rules of the fields of a struct, including
+// the fields of nested structs, expanding each struct once per path to stop at recursive
+// types. If shallow is true, nested structs are not expanded, as used to check the
+// validate tag of a single field.
+type ruleBuilder struct {
+	out      bytes.Buffer
+	vars     int
+	visiting map[string]bool
+	imports  map[string]bool
+	shallow  bool
+}
+
+// fields writes the checks of all stored fields of the struct value.
+func (b *ruleBuilder) fields(value string, path fieldPath, st fieldType) error {
+	for _, field := range st.Struct.Struct.Fields.List {
+		ft := resolve(field.Type, st.Scope)
+
+		if len(field.Names) == 0 {
+			if err := b.embedded(value, path, st, field, ft); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			tag := parseTag(ident.Name, field.Tag)
+			if tag.Skip {
+				continue
+			}
+
+			if err := b.field(value+"."+ident.Name, path, st, ident.Name, tag, field, ft); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// embedded writes the checks of an embedded field, whose fields are inlined unless its tag
+// sets a name for it.
+func (b *ruleBuilder) embedded(value string, path fieldPath, st fieldType, field *goast.Field, ft fieldType) error {
+	typeName := embeddedName(field.Type)
+	if typeName == "" || !goast.IsExported(typeName) {
+		return nil
+	}
+
+	tag := parseTag(typeName, field.Tag)
+	if tag.Skip {
+		return nil
+	}
+
+	value = value + "." + typeName
+
+	switch {
+	case !tag.Named && ft.Kind == structKind:
+		return b.nested(value, path, ft)
+	case !tag.Named && ft.Kind == pointerKind && ft.Elem.Kind == structKind:
+		body, err := b.capture(func() error {
+			return b.nested(value, path, *ft.Elem)
+		})
+		if body != "" {
+			fmt.Fprintf(&b.out, "if %s != nil {\n%s}\n", value, body)
+		}
+		return err
+	}
+
+	return b.field(value, path, st, typeName, tag, field, ft)
+}
+
+// field writes the checks of the rules of the giving field value, and of the fields of
+// the structs it holds.
+func (b *ruleBuilder) field(value string, path fieldPath, st fieldType, name string, tag fieldTag, field *goast.Field, ft fieldType) error {
+	if tag.Inline && ft.Kind == structKind {
+		return b.nested(value, path, ft)
+	}
+
+	rules, err := parseRules(field.Tag)
+	if err != nil {
+		return fmt.Errorf("Struct %q has invalid validate tag on field %q: %s", st.Struct.Object.Name.Name, name, err)
+	}
+
+	checks, err := b.checks(value, rules, ft)
+	if err != nil {
+		return fmt.Errorf("Struct %q has invalid validate tag on field %q: %s", st.Struct.Object.Name.Name, name, err)
+	}
+
+	nestedPath := path.join(tag.Name)
+
+	// Only the first rule failed by a field is reported.
+	for index, c := range checks {
+		if index == 0 {
+			fmt.Fprintf(&b.out, "if %s {\n", c.Cond)
+		} else {
+			fmt.Fprintf(&b.out, "} else if %s {\n", c.Cond)
+		}
+
+		b.appendFailure(nestedPath, c)
+	}
+
+	if len(checks) != 0 {
+		fmt.Fprintf(&b.out, "}\n")
+	}
+
+	return b.contained(value, nestedPath, ft)
+}
+
+// checks returns the checks of the giving rules against the giving field value. If the
+// rules contain omitempty, the checks only fail if the value is not empty.
+func (b *ruleBuilder) checks(value string, rules []rule, ft fieldType) ([]check, error) {
+	var checks []check
+	var omitEmpty, required bool
+
+	for _, r := range rules {
+		switch r.Name {
+		case "omitempty":
+			omitEmpty = true
+			continue
+		case "required":
+			required = true
+		}
+
+		c, err := b.rule(value, r, ft)
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, c)
+	}
+
+	if !omitEmpty {
+		return checks, nil
+	}
+
+	if required {
+		return nil, fmt.Errorf("validate rules omitempty and required exclude each other")
+	}
+
+	_, present, err := emptyCheck(value, ft)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range checks {
+		checks[index].Cond = present + " && " + checks[index].Cond
+	}
+
+	return checks, nil
+}
+
+// contained writes the checks of the fields of the structs held by the giving value.
+// Loops and nil checks are only written if they hold any check.
+func (b *ruleBuilder) contained(value string, path fieldPath, ft fieldType) error {
+	if b.shallow || !b.holdsStruct(ft) {
+		return nil
+	}
+
+	switch ft.Kind {
+	case structKind:
+		return b.nested(value, path, ft)
+	case pointerKind:
+		body, err := b.capture(func() error {
+			return b.contained(value, path, *ft.Elem)
+		})
+		if body != "" {
+			fmt.Fprintf(&b.out, "if %s != nil {\n%s}\n", value, body)
+		}
+		return err
+	case sliceKind:
+		index := b.newVar("index")
+		item := b.newVar("item")
+
+		body, err := b.capture(func() error {
+			return b.contained(item, path.append(".", "strconv.Itoa("+index+")"), *ft.Elem)
+		})
+		if body != "" {
+			b.imports["strconv"] = true
+			fmt.Fprintf(&b.out, "for %s, %s := range %s {\n%s}\n", index, item, value, body)
+		}
+		return err
+	case mapKind:
+		key := b.newVar("key")
+		item := b.newVar("item")
+
+		body, err := b.capture(func() error {
+			return b.contained(item, path.append(".", key), *ft.Elem)
+		})
+		if body != "" {
+			fmt.Fprintf(&b.out, "for %s, %s := range %s {\n%s}\n", key, item, value, body)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// capture returns the statements written by fn.
+func (b *ruleBuilder) capture(fn func() error) (string, error) {
+	out := b.out
+	b.out = bytes.Buffer{}
+
+	err := fn()
+
+	body := b.out.String()
+	b.out = out
+	return body, err
+}
+
+// holdsStruct returns true/false if values of the giving fieldType are or contain structs
+// whose fields may have rules.
+func (b *ruleBuilder) holdsStruct(ft fieldType) bool {
+	switch ft.Kind {
+	case structKind:
+		return !b.visiting[structKey(ft)]
+	case pointerKind, sliceKind, mapKind:
+		return b.holdsStruct(*ft.Elem)
+	}
+
+	return false
+}
+
+// nested writes the checks of the fields of the struct value, unless the struct is being
+// expanded already.
+func (b *ruleBuilder) nested(value string, path fieldPath, ft fieldType) error {
+	key := structKey(ft)
+	if b.shallow || b.visiting[key] {
+		return nil
+	}
+
+	b.visiting[key] = true
+	defer delete(b.visiting, key)
+
+	return b.fields(value, path, ft)
+}
+
+// check defines the condition under which a field fails a rule, and the message of the
+// failure.
+type check struct {
+	Rule    string
+	Cond    string
+	Message string
+}
+
+// rule returns the check of the giving rule against the giving field value. Rules other
+// than required apply to the elements of pointers, when not nil.
+func (b *ruleBuilder) rule(value string, r rule, ft fieldType) (check, error) {
+	c := check{Rule: r.Name}
+
+	if r.Name == "required" {
+		cond, _, err := emptyCheck(value, ft)
+		c.Cond, c.Message = cond, "is required"
+		return c, err
+	}
+
+	if ft.Kind == pointerKind {
+		c, err := b.rule(assignable("(*"+value+")"), r, *ft.Elem)
+		c.Cond = fmt.Sprintf("%s != nil && %s", value, c.Cond)
+		return c, err
+	}
+
+	switch r.Name {
+	case "min", "max":
+		return b.bound(value, r, ft)
+	case "email":
+		if ft.Kind != stringKind || ft.Basic == "bson.ObjectId" {
+			return c, fmt.Errorf("validate rule %+q is only supported for strings", r.Name)
+		}
+
+		b.imports["net/mail"] = true
+		c.Cond, c.Message = fmt.Sprintf("!isEmail(string(%s))", value), "must be a valid email address"
+	case "oneof":
+		return oneOf(value, r, ft)
+	}
+
+	return c, nil
+}
+
+// bound returns the check of a min or max rule, bounding the length of strings, slices and
+// maps, else the value of numbers.
+func (b *ruleBuilder) bound(value string, r rule, ft fieldType) (check, error) {
+	c := check{Rule: r.Name}
+
+	op, bound := "<", "least"
+	if r.Name == "max" {
+		op, bound = ">", "most"
+	}
+
+	switch {
+	case ft.Kind == stringKind && ft.Basic != "bson.ObjectId":
+		n, err := strconv.Atoi(r.Param)
+		if err != nil || n < 0 {
+			return c, fmt.Errorf("validate rule %+q requires a length, found %+q", r.Name, r.Param)
+		}
+
+		b.imports["unicode/utf8"] = true
+		c.Cond = fmt.Sprintf("utf8.RuneCountInString(string(%s)) %s %d", value, op, n)
+		c.Message = fmt.Sprintf("must be at %s %d %s long", bound, n, plural(n, "character"))
+	case ft.Kind == sliceKind || ft.Kind == mapKind:
+		n, err := strconv.Atoi(r.Param)
+		if err != nil || n < 0 {
+			return c, fmt.Errorf("validate rule %+q requires a length, found %+q", r.Name, r.Param)
+		}
+
+		c.Cond = fmt.Sprintf("len(%s) %s %d", value, op, n)
+		c.Message = fmt.Sprintf("must contain at %s %d %s", bound, n, plural(n, "item"))
+	case ft.Kind == numberKind:
+		if _, err := numberLiteral(r.Param, ft); err != nil {
+			return c, err
+		}
+
+		c.Cond = fmt.Sprintf("%s %s %s", value, op, r.Param)
+		c.Message = fmt.Sprintf("must be at %s %s", bound, r.Param)
+	default:
+		return c, fmt.Errorf("validate rule %+q is only supported for strings, numbers, slices and maps", r.Name)
+	}
+
+	return c, nil
+}
+
+// oneOf returns the check of a oneof rule, whose values are separated by "|".
+func oneOf(value string, r rule, ft fieldType) (check, error) {
+	c := check{Rule: r.Name}
+	values := strings.Split(r.Param, "|")
+
+	var conds []string
+	for _, v := range values {
+		switch {
+		case ft.Kind == stringKind && ft.Basic != "bson.ObjectId":
+			conds = append(conds, fmt.Sprintf("%s != %s", value, strconv.Quote(v)))
+		case ft.Kind == numberKind:
+			literal, err := numberLiteral(v, ft)
+			if err != nil {
+				return c, err
+			}
+
+			conds = append(conds, fmt.Sprintf("%s != %s", value, literal))
+		default:
+			return c, fmt.Errorf("validate rule %+q is only supported for strings and numbers", r.Name)
+		}
+	}
+
+	c.Cond = strings.Join(conds, " && ")
+	c.Message = "must be one of " + strings.Join(values, ", ")
+	return c, nil
+}
+
+// plural returns noun, suffixed with "s" unless n is 1.
+func plural(n int, noun string) string {
+	if n == 1 {
+		return noun
+	}
+
+	return noun + "s"
+}
+
+// appendFailure writes the statement appending a FieldError for the giving check to failed.
+func (b *ruleBuilder) appendFailure(path fieldPath, c check) {
+	fmt.Fprintf(&b.out, "failed = append(failed, FieldError{Field: %s, Rule: %q, Message: %q})\n", path.expr(), c.Rule, c.Message)
+}
+
+func (b *ruleBuilder) newVar(name string) string {
+	b.vars++
+	return fmt.Sprintf("%s%d", name, b.vars)
+}
+
+// emptyCheck returns the conditions which are true if the giving value is empty, i.e the
+// zero value or, for slices and maps, without items, and if it is not.
+func emptyCheck(value string, ft fieldType) (string, string, error) {
+	switch ft.Kind {
+	case stringKind:
+		return value + ` == ""`, value + ` != ""`, nil
+	case numberKind:
+		return value + " == 0", value + " != 0", nil
+	case boolKind:
+		return "!" + value, value, nil
+	case timeKind:
+		return value + ".IsZero()", "!" + value + ".IsZero()", nil
+	case interfaceKind, pointerKind:
+		return value + " == nil", value + " != nil", nil
+	case sliceKind, mapKind:
+		return "len(" + value + ") == 0", "len(" + value + ") != 0", nil
+	}
+
+	return "", "", fmt.Errorf("validate rules required and omitempty are not supported for structs and arrays")
+}
+
+// numberLiteral returns the giving value as a literal for a number of the giving type, else
+// an error if it is not one.
+func numberLiteral(value string, ft fieldType) (string, error) {
+	var err error
+
+	switch {
+	case ft.Basic == "float32" || ft.Basic == "float64":
+		_, err = strconv.ParseFloat(value, 64)
+	case strings.HasPrefix(ft.Basic, "uint") || ft.Basic == "byte":
+		_, err = strconv.ParseUint(value, 10, 64)
+	default:
+		_, err = strconv.ParseInt(value, 10, 64)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("%+q is not a valid %s", value, ft.Basic)
+	}
+
+	return value, nil
+}
+
+// checkRules returns an error if the validate tag of the giving field, of type ft, sets
+// unknown rules or rules which do not suit its type.
+func checkRules(field *goast.Field, ft fieldType) error {
+	rules, err := parseRules(field.Tag)
+	if err != nil {
+		return err
+	}
+
+	b := ruleBuilder{shallow: true, imports: make(map[string]bool)}
+	_, err = b.checks("value", rules, ft)
+	return err
+}
@@ -0,0 +1,377 @@
+package mgo
+
+import (
+	"bytes"
+	"fmt"
+	goast "go/ast"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/influx6/moz/ast"
+)
+
+// schemaTag defines the options of the schema tag of a struct field, e.g
+// `schema:"required,enum=admin|user"`.
+type schemaTag struct {
+	Required bool
+	Enum     []string
+}
+
+// parseSchemaTag returns the schemaTag of the giving field tag, else an error naming the
+// option it does not know.
+func parseSchemaTag(tag *goast.BasicLit) (schemaTag, error) {
+	var st schemaTag
+
+	if tag == nil {
+		return st, nil
+	}
+
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return st, nil
+	}
+
+	options, ok := reflect.StructTag(value).Lookup("schema")
+	if !ok || options == "" {
+		return st, nil
+	}
+
+	for _, option := range strings.Split(options, ",") {
+		switch {
+		case option == "required":
+			st.Required = true
+		case strings.HasPrefix(option, "enum="):
+			st.Enum = strings.Split(strings.TrimPrefix(option, "enum="), "|")
+		default:
+			return st, fmt.Errorf("unknown schema option %+q, expected required or enum=a|b", option)
+		}
+	}
+
+	return st, nil
+}
+
+// jsonSchema defines a $jsonSchema document, with Enum holding go literals of its values.
+type jsonSchema struct {
+	Types      []string
+	Enum       []string
+	Properties []property
+	Required   []string
+	Items      *jsonSchema
+	Values     *jsonSchema
+}
+
+// property defines the schema of a field stored under Name.
+type property struct {
+	Name   string
+	Schema jsonSchema
+}
+
+// literal returns the go expression of the schema as a bson.M.
+func (s jsonSchema) literal() string {
+	var out bytes.Buffer
+
+	out.WriteString("bson.M{")
+	if s.empty() {
+		out.WriteString("}")
+		return out.String()
+	}
+
+	out.WriteString("\n")
+
+	switch len(s.Types) {
+	case 0:
+	case 1:
+		fmt.Fprintf(&out, "\"bsonType\": %q,\n", s.Types[0])
+	default:
+		fmt.Fprintf(&out, "\"bsonType\": []string{%s},\n", quoteAll(s.Types))
+	}
+
+	if len(s.Enum) != 0 {
+		fmt.Fprintf(&out, "\"enum\": []interface{}{%s},\n", strings.Join(s.Enum, ", "))
+	}
+
+	if len(s.Properties) != 0 {
+		out.WriteString("\"properties\": bson.M{\n")
+		for _, prop := range s.Properties {
+			fmt.Fprintf(&out, "%q: %s,\n", prop.Name, prop.Schema.literal())
+		}
+		out.WriteString("},\n")
+	}
+
+	if len(s.Required) != 0 {
+		fmt.Fprintf(&out, "\"required\": []string{%s},\n", quoteAll(s.Required))
+	}
+
+	if s.Items != nil {
+		fmt.Fprintf(&out, "\"items\": %s,\n", s.Items.literal())
+	}
+
+	if s.Values != nil && !s.Values.empty() {
+		fmt.Fprintf(&out, "\"additionalProperties\": %s,\n", s.Values.literal())
+	}
+
+	out.WriteString("}")
+	return out.String()
+}
+
+// empty returns true/false if the schema accepts any value.
+func (s jsonSchema) empty() bool {
+	return len(s.Types) == 0 && len(s.Enum) == 0 && len(s.Properties) == 0 && len(s.Required) == 0 && s.Items == nil && (s.Values == nil || s.Values.empty())
+}
+
+// buildSchema returns the go expression of the $jsonSchema document of the giving struct,
+// declared within the giving package, matching the documents stored for it.
+func buildSchema(str ast.StructDeclaration, pkg ast.Package) (string, error) {
+	b := &schemaBuilder{visiting: make(map[string]bool)}
+
+	schema, err := b.object(structType(str, pkg))
+	if err != nil {
+		return "", err
+	}
+
+	return schema.literal(), nil
+}
+
+// schemaBuilder builds the jsonSchema of structs, expanding each struct once per path to
+// stop at recursive types. If shallow is true, nested structs are not expanded at all, as
+// used to check the schema tag of a single field.
+type schemaBuilder struct {
+	visiting map[string]bool
+	shallow  bool
+}
+
+// object returns the schema of the giving struct type.
+func (b *schemaBuilder) object(st fieldType) (jsonSchema, error) {
+	schema := jsonSchema{Types: []string{"object"}}
+
+	key := structKey(st)
+	if b.shallow || b.visiting[key] {
+		return schema, nil
+	}
+
+	b.visiting[key] = true
+	defer delete(b.visiting, key)
+
+	err := b.properties(&schema, st, true)
+	return schema, err
+}
+
+// properties adds the properties of all stored fields of the giving struct type to schema.
+// Fields are only added as required if required is true, as fields inlined from embedded
+// pointers are missing when the pointer is nil.
+func (b *schemaBuilder) properties(schema *jsonSchema, st fieldType, required bool) error {
+	for _, field := range st.Struct.Struct.Fields.List {
+		ft := resolve(field.Type, st.Scope)
+
+		if len(field.Names) == 0 {
+			if err := b.embedded(schema, st, field, ft, required); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			if err := b.property(schema, st, ident.Name, field, ft, required); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// embedded adds the properties of an embedded field, inlined as documents store them.
+func (b *schemaBuilder) embedded(schema *jsonSchema, st fieldType, field *goast.Field, ft fieldType, required bool) error {
+	typeName := embeddedName(field.Type)
+	if typeName == "" || !goast.IsExported(typeName) {
+		return nil
+	}
+
+	tag := parseTag(typeName, field.Tag)
+
+	switch {
+	case tag.Skip:
+		return nil
+	case !tag.Named && ft.Kind == structKind:
+		return b.inline(schema, ft, required)
+	case !tag.Named && ft.Kind == pointerKind && ft.Elem.Kind == structKind:
+		return b.inline(schema, *ft.Elem, false)
+	}
+
+	return b.property(schema, st, typeName, field, ft, required)
+}
+
+// inline adds the properties of the giving struct type to schema, unless the struct is
+// being expanded already, in which case it is stored as it is.
+func (b *schemaBuilder) inline(schema *jsonSchema, ft fieldType, required bool) error {
+	key := structKey(ft)
+	if b.visiting[key] {
+		return nil
+	}
+
+	b.visiting[key] = true
+	defer delete(b.visiting, key)
+
+	return b.properties(schema, ft, required)
+}
+
+// property adds the property of the field with the giving name, declared within st, to
+// schema.
+func (b *schemaBuilder) property(schema *jsonSchema, st fieldType, name string, field *goast.Field, ft fieldType, required bool) error {
+	tag := parseTag(name, field.Tag)
+	if tag.Skip {
+		return nil
+	}
+
+	if tag.Inline {
+		switch ft.Kind {
+		case structKind:
+			return b.inline(schema, ft, required)
+		case mapKind:
+			return nil
+		}
+	}
+
+	options, err := parseSchemaTag(field.Tag)
+	if err != nil {
+		return fmt.Errorf("Struct %q has invalid schema tag on field %q: %s", st.Struct.Object.Name.Name, name, err)
+	}
+
+	prop, err := b.schema(ft, options.Enum)
+	if err != nil {
+		return fmt.Errorf("Struct %q has invalid schema tag on field %q: %s", st.Struct.Object.Name.Name, name, err)
+	}
+
+	schema.Properties = append(schema.Properties, property{Name: tag.Name, Schema: prop})
+
+	if options.Required && required {
+		schema.Required = append(schema.Required, tag.Name)
+	}
+
+	return nil
+}
+
+// schema returns the schema of values of the giving fieldType, restricted to the giving
+// enum values, which apply to the elements of pointers, slices and maps.
+func (b *schemaBuilder) schema(ft fieldType, enum []string) (jsonSchema, error) {
+	switch ft.Kind {
+	case stringKind, numberKind, boolKind:
+		schema := jsonSchema{Types: bsonTypes(ft)}
+		for _, value := range enum {
+			literal, err := enumLiteral(value, ft)
+			if err != nil {
+				return schema, err
+			}
+
+			schema.Enum = append(schema.Enum, literal)
+		}
+
+		return schema, nil
+	case pointerKind:
+		schema, err := b.schema(*ft.Elem, enum)
+		if err != nil {
+			return schema, err
+		}
+
+		// Nil pointers are stored as null, unless omitted.
+		if len(schema.Types) != 0 {
+			schema.Types = append(schema.Types, "null")
+		}
+
+		if len(schema.Enum) != 0 {
+			schema.Enum = append(schema.Enum, "nil")
+		}
+
+		return schema, nil
+	case sliceKind:
+		if ft.Elem.isByte() {
+			return jsonSchema{Types: []string{"binData"}}, checkEnum(enum)
+		}
+
+		items, err := b.schema(*ft.Elem, enum)
+		return jsonSchema{Types: []string{"array"}, Items: &items}, err
+	case mapKind:
+		values, err := b.schema(*ft.Elem, enum)
+		return jsonSchema{Types: []string{"object"}, Values: &values}, err
+	case timeKind:
+		return jsonSchema{Types: []string{"date"}}, checkEnum(enum)
+	case structKind:
+		if err := checkEnum(enum); err != nil {
+			return jsonSchema{}, err
+		}
+
+		return b.object(ft)
+	}
+
+	// Interfaces and types left to the bson package may be stored as anything.
+	return jsonSchema{}, checkEnum(enum)
+}
+
+// checkSchemaTag returns an error if the schema tag of the giving field, of type ft, sets
+// unknown options or enum values which do not suit its type.
+func checkSchemaTag(field *goast.Field, ft fieldType) error {
+	options, err := parseSchemaTag(field.Tag)
+	if err != nil {
+		return err
+	}
+
+	b := schemaBuilder{shallow: true}
+	_, err = b.schema(ft, options.Enum)
+	return err
+}
+
+// checkEnum returns an error if enum values are set for a type which does not support them.
+func checkEnum(enum []string) error {
+	if len(enum) != 0 {
+		return fmt.Errorf("enum is only supported for strings, numbers and bools")
+	}
+
+	return nil
+}
+
+// bsonTypes returns the bson types values of the giving string, number or bool type are
+// stored as. Integers are stored as int or long depending on their value, floats as double,
+// though other clients may store integral floats as integers.
+func bsonTypes(ft fieldType) []string {
+	switch {
+	case ft.Basic == "bson.ObjectId":
+		return []string{"objectId"}
+	case ft.Kind == stringKind:
+		return []string{"string"}
+	case ft.Kind == boolKind:
+		return []string{"bool"}
+	case ft.Basic == "float32" || ft.Basic == "float64":
+		return []string{"double", "int", "long"}
+	}
+
+	return []string{"int", "long"}
+}
+
+// enumLiteral returns the go literal of the enum value of a field of the giving type.
+func enumLiteral(value string, ft fieldType) (string, error) {
+	switch {
+	case ft.Basic == "bson.ObjectId":
+		return "", fmt.Errorf("enum is not supported for bson.ObjectId")
+	case ft.Kind == stringKind:
+		return strconv.Quote(value), nil
+	case ft.Kind == boolKind:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return "", fmt.Errorf("enum value %+q is not a bool", value)
+		}
+	case ft.Basic == "float32" || ft.Basic == "float64":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "", fmt.Errorf("enum value %+q is not a number", value)
+		}
+	default:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return "", fmt.Errorf("enum value %+q is not an integer", value)
+		}
+	}
+
+	return value, nil
+}
@@ -0,0 +1,103 @@
+package mgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/gokit/mgokit/config"
+	"github.com/gokit/mgokit/static"
+	"github.com/influx6/moz/gen"
+)
+
+// TemplateNames returns the names of all bundled templates, which are also the file names
+// of overrides within a templates directory.
+func TemplateNames() []string {
+	return static.FilesFor(".tml")
+}
+
+// ValidateTemplatesDir returns an error if the giving templates directory contains a
+// template file which does not override any bundled template.
+func ValidateTemplatesDir(dir string) error {
+	known := make(map[string]bool)
+	for _, name := range TemplateNames() {
+		known[name] = true
+	}
+
+	overrides, err := filepath.Glob(filepath.Join(dir, "*.tml"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range overrides {
+		if !known[filepath.Base(path)] {
+			return fmt.Errorf("Template %+q does not override any bundled template", path)
+		}
+	}
+
+	return nil
+}
+
+// templateSet holds the templates used by a generator, where overridden templates are
+// validated by rendering them against the same data as the bundled ones.
+type templateSet struct {
+	contents  map[string]string
+	overrides map[string]string
+	err       error
+}
+
+// loadTemplates returns the templateSet of the giving template names. Each is read from the
+// override set in ops if any, else from dir if it contains a file of the same name, else
+// from the bundled templates.
+func loadTemplates(dir string, ops config.Options, names ...string) (*templateSet, error) {
+	set := &templateSet{
+		contents:  make(map[string]string, len(names)),
+		overrides: make(map[string]string),
+	}
+
+	for _, name := range names {
+		path, ok := ops.Templates[name]
+		if !ok && dir != "" {
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				path, ok = filepath.Join(dir, name), true
+			}
+		}
+
+		if !ok {
+			set.contents[name] = static.MustReadFile(name, true)
+			continue
+		}
+
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read template override %+q for %q: %+q", path, name, err)
+		}
+
+		set.contents[name] = string(content)
+		set.overrides[name] = path
+	}
+
+	return set, nil
+}
+
+// source returns a gen.TextDeclr rendering the template with the giving file name. An
+// overridden template is rendered once against binding to validate it, with the first
+// failure returned by Err.
+func (ts *templateSet) source(name string, file string, funcs template.FuncMap, binding interface{}) gen.TextDeclr {
+	declr := gen.SourceTextWith(name, ts.contents[file], funcs, binding)
+
+	if path, ok := ts.overrides[file]; ok && ts.err == nil {
+		if _, err := declr.WriteTo(ioutil.Discard); err != nil {
+			ts.err = fmt.Errorf("Template override %+q for %q is invalid: %+q", path, file, err)
+		}
+	}
+
+	return declr
+}
+
+// Err returns the first failure of an overridden template, if any.
+func (ts *templateSet) Err() error {
+	return ts.err
+}
@@ -0,0 +1,12 @@
+package common
+
+import "time"
+
+// Level defines the level of a record.
+type Level int
+
+// Base contains fields shared by records.
+type Base struct {
+	Revision int       `json:"revision"`
+	Updated  time.Time `json:"updated"`
+}
@@ -0,0 +1,60 @@
+package fields
+
+import (
+	"time"
+
+	"github.com/gokit/mgokit/mgo/testdata/fields/common"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Kind defines the kind of a record.
+type Kind string
+
+// Record contains fields of every type converted by generated Fields and Consume methods.
+// @mongo_fields
+// @mongoapi(Fixtures => false, Readme => false, Makefile => false, Dockerfile => false)
+type Record struct {
+	common.Base
+	*Origin
+
+	PublicID string                 `json:"public_id"`
+	ObjectID bson.ObjectId          `bson:"_id"`
+	Kind     Kind                   `json:"kind"`
+	Level    common.Level           `json:"level"`
+	Count    int                    `json:"count"`
+	Small    int8                   `json:"small"`
+	Total    int32                  `json:"total"`
+	Large    int64                  `json:"large"`
+	Size     uint16                 `json:"size"`
+	Ratio    float32                `json:"ratio"`
+	Score    float64                `json:"score,omitempty"`
+	Active   bool                   `json:"active"`
+	Created  time.Time              `json:"created"`
+	Expires  *time.Time             `json:"expires,omitempty"`
+	Limit    *int                   `json:"limit"`
+	Data     []byte                 `json:"data"`
+	Grid     [2]int                 `json:"grid"`
+	Tags     []string               `json:"tags"`
+	Scores   map[string]float64     `json:"scores"`
+	Owner    Owner                  `json:"owner"`
+	Backup   *Owner                 `json:"backup,omitempty"`
+	Members  []Owner                `json:"members"`
+	Groups   map[string][]Owner     `json:"groups"`
+	Parent   *Record                `json:"parent"`
+	Children []Record               `json:"children"`
+	Value    interface{}            `json:"value"`
+	Extra    map[string]interface{} `bson:",inline"`
+	Hidden   string                 `json:"-"`
+	internal string
+}
+
+// Origin contains where a record came from, inlined into records.
+type Origin struct {
+	Source string `json:"source"`
+}
+
+// Owner contains the owner of a record.
+type Owner struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
@@ -0,0 +1,55 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Member normalizes its email before it is written and derives its display name when loaded.
+// @mongoapi(Readme => false, Makefile => false, Dockerfile => false)
+type Member struct {
+	PublicID string    `json:"public_id"`
+	Email    string    `json:"email"`
+	Name     string    `json:"name"`
+	Display  string    `json:"-" bson:"-"`
+	Joined   time.Time `json:"joined"`
+}
+
+// BeforeCreate normalizes the email of the Member.
+func (m *Member) BeforeCreate(ctx context.Context) error {
+	m.Email = strings.ToLower(strings.TrimSpace(m.Email))
+	return nil
+}
+
+// BeforeUpdate normalizes the email of the Member.
+func (m *Member) BeforeUpdate(ctx context.Context) error {
+	return m.BeforeCreate(ctx)
+}
+
+// AfterLoad sets the display name of the Member.
+func (m *Member) AfterLoad(ctx context.Context) error {
+	m.Display = m.Name + " <" + m.Email + ">"
+	return nil
+}
+
+// Visit refuses to be deleted once recorded.
+// @mongo_methods(Makefile => false, Dockerfile => false)
+type Visit struct {
+	PublicID string `json:"public_id"`
+	Page     string `json:"page"`
+}
+
+// AfterCreate checks the Visit names its page.
+func (v Visit) AfterCreate(ctx context.Context) error {
+	if v.Page == "" {
+		return errors.New("visit has no page")
+	}
+	return nil
+}
+
+// BeforeDelete refuses to delete the Visit.
+func (v Visit) BeforeDelete(ctx context.Context) error {
+	return errors.New("visits are kept")
+}
@@ -0,0 +1,80 @@
+package invalid
+
+import (
+	"context"
+	"time"
+)
+
+// User lacks its key field, names two fields alike and has a tagged unexported field.
+// @mongoapi(KeyFiled => ID)
+type User struct {
+	Name   string `json:"name"`
+	Alias  string `bson:"name"`
+	secret string `json:"secret"`
+}
+
+// Note has a key field which is not a string.
+// @mongo_methods(KeyField => ID)
+type Note struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+}
+
+// Tag declares the Fields method which @mongo_fields generates.
+// @mongo_fields
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// Fields returns the fields of the Tag.
+func (t Tag) Fields() (map[string]interface{}, error) {
+	return map[string]interface{}{"name": t.Name}, nil
+}
+
+// Event restricts fields by schema tags which do not suit them.
+// @mongo_methods
+type Event struct {
+	PublicID string    `json:"public_id" schema:"requird"`
+	Created  time.Time `json:"created" schema:"enum=today"`
+	Count    int       `json:"count" schema:"enum=one|two"`
+}
+
+// Invite sets validate rules which do not suit its fields.
+// @mongo_methods
+type Invite struct {
+	PublicID string `json:"public_id" validate:"min"`
+	Count    int    `json:"count" validate:"email"`
+	Level    int    `json:"level" validate:"oneof=low|high"`
+}
+
+// Call is served through grpc but holds a field without protobuf equivalent.
+// @mongoapi(GRPC => true)
+type Call struct {
+	PublicID string      `json:"public_id"`
+	Payload  interface{} `json:"payload"`
+}
+
+// Visit sets params which only the DB struct of @mongoapi supports.
+// @mongo_methods(HTTP => true, Outbox => true)
+type Visit struct {
+	PublicID string `json:"public_id"`
+}
+
+// Entry declares lifecycle methods which do not match the hooks generated code calls.
+// @mongoapi
+type Entry struct {
+	PublicID string `json:"public_id"`
+}
+
+// BeforeCreate takes no context.
+func (e *Entry) BeforeCreate() error {
+	return nil
+}
+
+// AfterLoad returns no error.
+func (e Entry) AfterLoad(ctx context.Context) {}
+
+// BeforeUpdate matches the hook called before updates.
+func (e *Entry) BeforeUpdate(ctx context.Context) error {
+	return nil
+}
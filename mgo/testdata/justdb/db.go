@@ -0,0 +1,4 @@
+// Package justdb contains sample of solo generated mongo packages.
+//
+// @mongo
+package justdb
@@ -0,0 +1,16 @@
+package layout
+
+// Profile contains profile data, stored by a package within stores/layout/profile with its
+// backend interface generated into this package.
+// @mongoapi(PackageName => {struct}store, Dir => stores/{package}/{struct}, BackendInSource => true, Readme => false, Makefile => false, Dockerfile => false)
+type Profile struct {
+	PublicID string `json:"public_id"`
+	Name     string `json:"name"`
+}
+
+// Note contains note data, stored by a package without fixtures or tests.
+// @mongo_methods(Dir => stores/{package}/{struct}, Fixtures => false)
+type Note struct {
+	PublicID string `json:"public_id"`
+	Text     string `json:"text"`
+}
@@ -0,0 +1,15 @@
+package methods
+
+import "time"
+
+// User contains user data.
+// @mongo_methods
+type User struct {
+	PublicID string    `json:"public_id"`
+	Name     string    `json:"name"`
+	Email    string    `json:"email"`
+	Age      int       `json:"age"`
+	Active   bool      `json:"active"`
+	Tags     []string  `json:"tags"`
+	Created  time.Time `json:"created_at"`
+}
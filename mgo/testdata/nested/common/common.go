@@ -0,0 +1,9 @@
+package common
+
+import "time"
+
+// Audit contains audit data shared by stored structs.
+type Audit struct {
+	CreatedBy string    `json:"created_by"`
+	At        time.Time `json:"at"`
+}
@@ -0,0 +1,66 @@
+package nested
+
+import (
+	"time"
+
+	"github.com/gokit/mgokit/mgo/testdata/nested/common"
+)
+
+// Status defines the state of an order.
+type Status string
+
+// Order contains fields of every shape supported within stored documents.
+// @mongoapi(Readme => false, Makefile => false, Dockerfile => false)
+type Order struct {
+	common.Audit
+	*Meta
+
+	PublicID  string            `json:"public_id"`
+	Status    Status            `json:"status,omitempty"`
+	Total     float64           `bson:"total,omitempty"`
+	Paid      bool              `json:"paid,omitempty"`
+	Shipped   time.Time         `json:"shipped,omitempty"`
+	Note      *string           `json:"note"`
+	Address   Address           `json:"address"`
+	Billing   *Address          `json:"billing,omitempty"`
+	Items     []Item            `json:"items"`
+	Previous  []*Address        `json:"previous"`
+	Tags      []string          `json:"tags,omitempty"`
+	Labels    map[string]string `json:"labels"`
+	Stock     map[string]Item   `json:"stock"`
+	Extra     map[string]int    `bson:",inline"`
+	Details   Details           `bson:",inline"`
+	Parent    *Order            `json:"parent"`
+	Internal  string            `json:"-"`
+	Ignored   string            `bson:"-" json:"ignored"`
+	Extension Address           `json:"extension,omitempty"`
+	secret    string
+}
+
+// Meta contains metadata inlined into orders.
+type Meta struct {
+	Source string `json:"source"`
+}
+
+// Details contains details inlined into orders.
+type Details struct {
+	Channel string `json:"channel"`
+}
+
+// Address contains a postal address.
+type Address struct {
+	City string `json:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+// Item contains an ordered item.
+type Item struct {
+	SKU      string   `json:"sku"`
+	Quantity int      `json:"quantity"`
+	Options  []Option `json:"options"`
+}
+
+// Option contains an option of an item.
+type Option struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,12 @@
+package options
+
+import "time"
+
+// Account contains account data, identified by its ID and stored in a accountstore package.
+// @mongoapi(PackageName => {struct}store, KeyField => ID, CreatedField => Created, UpdatedField => Updated, Dockerfile => true)
+type Account struct {
+	ID      string    `bson:"id" json:"id"`
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+	Updated time.Time `json:"updated"`
+}
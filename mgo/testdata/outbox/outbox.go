@@ -0,0 +1,21 @@
+package outbox
+
+import "time"
+
+// Order writes change events into the outbox of its collection.
+// @mongoapi(Outbox => true, Readme => false, Makefile => false, Dockerfile => false, UpdatedField => Updated)
+type Order struct {
+	PublicID string    `json:"public_id"`
+	Customer string    `json:"customer"`
+	Total    int       `json:"total"`
+	Updated  time.Time `json:"updated"`
+}
+
+// Invoice writes change events along with the documents built by its Fields method.
+// @mongo_fields
+// @mongoapi(Outbox => true, Readme => false, Makefile => false, Dockerfile => false)
+type Invoice struct {
+	PublicID string `json:"public_id"`
+	Order    string `json:"order"`
+	Amount   int    `json:"amount"`
+}
@@ -0,0 +1,870 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: shipment.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Shipment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedBy     string                 `protobuf:"bytes,1,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	PublicId      string                 `protobuf:"bytes,2,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Carrier       string                 `protobuf:"bytes,4,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	Weight        float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Pieces        int64                  `protobuf:"varint,6,opt,name=pieces,proto3" json:"pieces,omitempty"`
+	Priority      int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Fragile       bool                   `protobuf:"varint,8,opt,name=fragile,proto3" json:"fragile,omitempty"`
+	Note          *string                `protobuf:"bytes,9,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	Shipped       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=shipped,proto3" json:"shipped,omitempty"`
+	Delivered     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Label         []byte                 `protobuf:"bytes,12,opt,name=label,proto3" json:"label,omitempty"`
+	Tags          []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	Origin        *Address               `protobuf:"bytes,14,opt,name=origin,proto3" json:"origin,omitempty"`
+	Return        *Address               `protobuf:"bytes,15,opt,name=return,proto3" json:"return,omitempty"`
+	Stops         []*Address             `protobuf:"bytes,16,rep,name=stops,proto3" json:"stops,omitempty"`
+	Items         map[string]*Item       `protobuf:"bytes,17,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_shipment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Shipment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Shipment) GetPublicId() string {
+	if x != nil {
+		return x.PublicId
+	}
+	return ""
+}
+
+func (x *Shipment) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Shipment) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *Shipment) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Shipment) GetPieces() int64 {
+	if x != nil {
+		return x.Pieces
+	}
+	return 0
+}
+
+func (x *Shipment) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Shipment) GetFragile() bool {
+	if x != nil {
+		return x.Fragile
+	}
+	return false
+}
+
+func (x *Shipment) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *Shipment) GetShipped() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Shipped
+	}
+	return nil
+}
+
+func (x *Shipment) GetDelivered() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Delivered
+	}
+	return nil
+}
+
+func (x *Shipment) GetLabel() []byte {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *Shipment) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Shipment) GetOrigin() *Address {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *Shipment) GetReturn() *Address {
+	if x != nil {
+		return x.Return
+	}
+	return nil
+}
+
+func (x *Shipment) GetStops() []*Address {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *Shipment) GetItems() map[string]*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Zip           string                 `protobuf:"bytes,2,opt,name=zip,proto3" json:"zip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_shipment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetZip() string {
+	if x != nil {
+		return x.Zip
+	}
+	return ""
+}
+
+type Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_shipment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Item) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Item) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ShipmentID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentID) Reset() {
+	*x = ShipmentID{}
+	mi := &file_shipment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentID) ProtoMessage() {}
+
+func (x *ShipmentID) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentID.ProtoReflect.Descriptor instead.
+func (*ShipmentID) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShipmentID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ShipmentUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Record        *Shipment              `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentUpdateRequest) Reset() {
+	*x = ShipmentUpdateRequest{}
+	mi := &file_shipment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentUpdateRequest) ProtoMessage() {}
+
+func (x *ShipmentUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShipmentUpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShipmentUpdateRequest) GetRecord() *Shipment {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type ShipmentGetAllRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Order           string                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	OrderBy         string                 `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Page            int64                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	ResponsePerPage int64                  `protobuf:"varint,4,opt,name=response_per_page,json=responsePerPage,proto3" json:"response_per_page,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShipmentGetAllRequest) Reset() {
+	*x = ShipmentGetAllRequest{}
+	mi := &file_shipment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentGetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentGetAllRequest) ProtoMessage() {}
+
+func (x *ShipmentGetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentGetAllRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentGetAllRequest) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ShipmentGetAllRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ShipmentGetAllRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ShipmentGetAllRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ShipmentGetAllRequest) GetResponsePerPage() int64 {
+	if x != nil {
+		return x.ResponsePerPage
+	}
+	return 0
+}
+
+type ShipmentGetAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*Shipment            `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentGetAllResponse) Reset() {
+	*x = ShipmentGetAllResponse{}
+	mi := &file_shipment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentGetAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentGetAllResponse) ProtoMessage() {}
+
+func (x *ShipmentGetAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentGetAllResponse.ProtoReflect.Descriptor instead.
+func (*ShipmentGetAllResponse) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ShipmentGetAllResponse) GetRecords() []*Shipment {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ShipmentGetAllResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ShipmentGetAllByOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         string                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentGetAllByOrderRequest) Reset() {
+	*x = ShipmentGetAllByOrderRequest{}
+	mi := &file_shipment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentGetAllByOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentGetAllByOrderRequest) ProtoMessage() {}
+
+func (x *ShipmentGetAllByOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentGetAllByOrderRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentGetAllByOrderRequest) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ShipmentGetAllByOrderRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ShipmentGetAllByOrderRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ShipmentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*Shipment            `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentList) Reset() {
+	*x = ShipmentList{}
+	mi := &file_shipment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentList) ProtoMessage() {}
+
+func (x *ShipmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentList.ProtoReflect.Descriptor instead.
+func (*ShipmentList) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ShipmentList) GetRecords() []*Shipment {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type ShipmentGetByFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentGetByFieldRequest) Reset() {
+	*x = ShipmentGetByFieldRequest{}
+	mi := &file_shipment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentGetByFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentGetByFieldRequest) ProtoMessage() {}
+
+func (x *ShipmentGetByFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentGetByFieldRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentGetByFieldRequest) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ShipmentGetByFieldRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ShipmentGetByFieldRequest) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ShipmentCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentCountResponse) Reset() {
+	*x = ShipmentCountResponse{}
+	mi := &file_shipment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentCountResponse) ProtoMessage() {}
+
+func (x *ShipmentCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentCountResponse.ProtoReflect.Descriptor instead.
+func (*ShipmentCountResponse) Descriptor() ([]byte, []int) {
+	return file_shipment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ShipmentCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_shipment_proto protoreflect.FileDescriptor
+
+const file_shipment_proto_rawDesc = "" +
+	"\n" +
+	"\x0eshipment.proto\x12\vshipmentmgo\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xa5\x05\n" +
+	"\bShipment\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x01 \x01(\tR\tcreatedBy\x12\x1b\n" +
+	"\tpublic_id\x18\x02 \x01(\tR\bpublicId\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x18\n" +
+	"\acarrier\x18\x04 \x01(\tR\acarrier\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06pieces\x18\x06 \x01(\x03R\x06pieces\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\x12\x18\n" +
+	"\afragile\x18\b \x01(\bR\afragile\x12\x17\n" +
+	"\x04note\x18\t \x01(\tH\x00R\x04note\x88\x01\x01\x124\n" +
+	"\ashipped\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\ashipped\x128\n" +
+	"\tdelivered\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdelivered\x12\x14\n" +
+	"\x05label\x18\f \x01(\fR\x05label\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tags\x12,\n" +
+	"\x06origin\x18\x0e \x01(\v2\x14.shipmentmgo.AddressR\x06origin\x12,\n" +
+	"\x06return\x18\x0f \x01(\v2\x14.shipmentmgo.AddressR\x06return\x12*\n" +
+	"\x05stops\x18\x10 \x03(\v2\x14.shipmentmgo.AddressR\x05stops\x126\n" +
+	"\x05items\x18\x11 \x03(\v2 .shipmentmgo.Shipment.ItemsEntryR\x05items\x1aK\n" +
+	"\n" +
+	"ItemsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.shipmentmgo.ItemR\x05value:\x028\x01B\a\n" +
+	"\x05_note\"/\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x10\n" +
+	"\x03zip\x18\x02 \x01(\tR\x03zip\"4\n" +
+	"\x04Item\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\"\x1c\n" +
+	"\n" +
+	"ShipmentID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x15ShipmentUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x06record\x18\x02 \x01(\v2\x15.shipmentmgo.ShipmentR\x06record\"\x88\x01\n" +
+	"\x15ShipmentGetAllRequest\x12\x14\n" +
+	"\x05order\x18\x01 \x01(\tR\x05order\x12\x19\n" +
+	"\border_by\x18\x02 \x01(\tR\aorderBy\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x03R\x04page\x12*\n" +
+	"\x11response_per_page\x18\x04 \x01(\x03R\x0fresponsePerPage\"_\n" +
+	"\x16ShipmentGetAllResponse\x12/\n" +
+	"\arecords\x18\x01 \x03(\v2\x15.shipmentmgo.ShipmentR\arecords\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"O\n" +
+	"\x1cShipmentGetAllByOrderRequest\x12\x14\n" +
+	"\x05order\x18\x01 \x01(\tR\x05order\x12\x19\n" +
+	"\border_by\x18\x02 \x01(\tR\aorderBy\"?\n" +
+	"\fShipmentList\x12/\n" +
+	"\arecords\x18\x01 \x03(\v2\x15.shipmentmgo.ShipmentR\arecords\"[\n" +
+	"\x19ShipmentGetByFieldRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value\"-\n" +
+	"\x15ShipmentCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count2\xbe\x04\n" +
+	"\x0fShipmentService\x12C\n" +
+	"\x05Count\x12\x16.google.protobuf.Empty\x1a\".shipmentmgo.ShipmentCountResponse\x127\n" +
+	"\x06Create\x12\x15.shipmentmgo.Shipment\x1a\x16.google.protobuf.Empty\x125\n" +
+	"\x03Get\x12\x17.shipmentmgo.ShipmentID\x1a\x15.shipmentmgo.Shipment\x12Q\n" +
+	"\x06GetAll\x12\".shipmentmgo.ShipmentGetAllRequest\x1a#.shipmentmgo.ShipmentGetAllResponse\x12U\n" +
+	"\rGetAllByOrder\x12).shipmentmgo.ShipmentGetAllByOrderRequest\x1a\x19.shipmentmgo.ShipmentList\x12K\n" +
+	"\n" +
+	"GetByField\x12&.shipmentmgo.ShipmentGetByFieldRequest\x1a\x15.shipmentmgo.Shipment\x12D\n" +
+	"\x06Update\x12\".shipmentmgo.ShipmentUpdateRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\x06Delete\x12\x17.shipmentmgo.ShipmentID\x1a\x16.google.protobuf.EmptyBJZHgithub.com/gokit/mgokit/mgo/testdata/shipments/shipmentmgo/grpcapi/pb;pbb\x06proto3"
+
+var (
+	file_shipment_proto_rawDescOnce sync.Once
+	file_shipment_proto_rawDescData []byte
+)
+
+func file_shipment_proto_rawDescGZIP() []byte {
+	file_shipment_proto_rawDescOnce.Do(func() {
+		file_shipment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_shipment_proto_rawDesc), len(file_shipment_proto_rawDesc)))
+	})
+	return file_shipment_proto_rawDescData
+}
+
+var file_shipment_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_shipment_proto_goTypes = []any{
+	(*Shipment)(nil),                     // 0: shipmentmgo.Shipment
+	(*Address)(nil),                      // 1: shipmentmgo.Address
+	(*Item)(nil),                         // 2: shipmentmgo.Item
+	(*ShipmentID)(nil),                   // 3: shipmentmgo.ShipmentID
+	(*ShipmentUpdateRequest)(nil),        // 4: shipmentmgo.ShipmentUpdateRequest
+	(*ShipmentGetAllRequest)(nil),        // 5: shipmentmgo.ShipmentGetAllRequest
+	(*ShipmentGetAllResponse)(nil),       // 6: shipmentmgo.ShipmentGetAllResponse
+	(*ShipmentGetAllByOrderRequest)(nil), // 7: shipmentmgo.ShipmentGetAllByOrderRequest
+	(*ShipmentList)(nil),                 // 8: shipmentmgo.ShipmentList
+	(*ShipmentGetByFieldRequest)(nil),    // 9: shipmentmgo.ShipmentGetByFieldRequest
+	(*ShipmentCountResponse)(nil),        // 10: shipmentmgo.ShipmentCountResponse
+	nil,                                  // 11: shipmentmgo.Shipment.ItemsEntry
+	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
+	(*structpb.Value)(nil),               // 13: google.protobuf.Value
+	(*emptypb.Empty)(nil),                // 14: google.protobuf.Empty
+}
+var file_shipment_proto_depIdxs = []int32{
+	12, // 0: shipmentmgo.Shipment.shipped:type_name -> google.protobuf.Timestamp
+	12, // 1: shipmentmgo.Shipment.delivered:type_name -> google.protobuf.Timestamp
+	1,  // 2: shipmentmgo.Shipment.origin:type_name -> shipmentmgo.Address
+	1,  // 3: shipmentmgo.Shipment.return:type_name -> shipmentmgo.Address
+	1,  // 4: shipmentmgo.Shipment.stops:type_name -> shipmentmgo.Address
+	11, // 5: shipmentmgo.Shipment.items:type_name -> shipmentmgo.Shipment.ItemsEntry
+	0,  // 6: shipmentmgo.ShipmentUpdateRequest.record:type_name -> shipmentmgo.Shipment
+	0,  // 7: shipmentmgo.ShipmentGetAllResponse.records:type_name -> shipmentmgo.Shipment
+	0,  // 8: shipmentmgo.ShipmentList.records:type_name -> shipmentmgo.Shipment
+	13, // 9: shipmentmgo.ShipmentGetByFieldRequest.value:type_name -> google.protobuf.Value
+	2,  // 10: shipmentmgo.Shipment.ItemsEntry.value:type_name -> shipmentmgo.Item
+	14, // 11: shipmentmgo.ShipmentService.Count:input_type -> google.protobuf.Empty
+	0,  // 12: shipmentmgo.ShipmentService.Create:input_type -> shipmentmgo.Shipment
+	3,  // 13: shipmentmgo.ShipmentService.Get:input_type -> shipmentmgo.ShipmentID
+	5,  // 14: shipmentmgo.ShipmentService.GetAll:input_type -> shipmentmgo.ShipmentGetAllRequest
+	7,  // 15: shipmentmgo.ShipmentService.GetAllByOrder:input_type -> shipmentmgo.ShipmentGetAllByOrderRequest
+	9,  // 16: shipmentmgo.ShipmentService.GetByField:input_type -> shipmentmgo.ShipmentGetByFieldRequest
+	4,  // 17: shipmentmgo.ShipmentService.Update:input_type -> shipmentmgo.ShipmentUpdateRequest
+	3,  // 18: shipmentmgo.ShipmentService.Delete:input_type -> shipmentmgo.ShipmentID
+	10, // 19: shipmentmgo.ShipmentService.Count:output_type -> shipmentmgo.ShipmentCountResponse
+	14, // 20: shipmentmgo.ShipmentService.Create:output_type -> google.protobuf.Empty
+	0,  // 21: shipmentmgo.ShipmentService.Get:output_type -> shipmentmgo.Shipment
+	6,  // 22: shipmentmgo.ShipmentService.GetAll:output_type -> shipmentmgo.ShipmentGetAllResponse
+	8,  // 23: shipmentmgo.ShipmentService.GetAllByOrder:output_type -> shipmentmgo.ShipmentList
+	0,  // 24: shipmentmgo.ShipmentService.GetByField:output_type -> shipmentmgo.Shipment
+	14, // 25: shipmentmgo.ShipmentService.Update:output_type -> google.protobuf.Empty
+	14, // 26: shipmentmgo.ShipmentService.Delete:output_type -> google.protobuf.Empty
+	19, // [19:27] is the sub-list for method output_type
+	11, // [11:19] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_shipment_proto_init() }
+func file_shipment_proto_init() {
+	if File_shipment_proto != nil {
+		return
+	}
+	file_shipment_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shipment_proto_rawDesc), len(file_shipment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_shipment_proto_goTypes,
+		DependencyIndexes: file_shipment_proto_depIdxs,
+		MessageInfos:      file_shipment_proto_msgTypes,
+	}.Build()
+	File_shipment_proto = out.File
+	file_shipment_proto_goTypes = nil
+	file_shipment_proto_depIdxs = nil
+}
@@ -0,0 +1,40 @@
+package rules
+
+import "time"
+
+// Plan defines a subscription plan.
+type Plan string
+
+// Signup contains fields checked by validate tags, including fields of nested structs.
+// @mongoapi(Readme => false, Makefile => false, Dockerfile => false)
+type Signup struct {
+	*Referral
+
+	PublicID string             `json:"public_id" validate:"required"`
+	Name     string             `json:"name" validate:"required,min=3,max=64"`
+	Email    string             `json:"email" validate:"required,email"`
+	Plan     Plan               `json:"plan" validate:"oneof=free|pro"`
+	Seats    int                `json:"seats" validate:"min=1,max=100"`
+	Discount *float64           `json:"discount" validate:"max=0.5"`
+	Tags     []string           `json:"tags" validate:"max=5"`
+	Created  time.Time          `json:"created" validate:"required"`
+	Address  Address            `json:"address"`
+	Contacts []Contact          `json:"contacts" validate:"min=1"`
+	Labels   map[string]Contact `json:"labels"`
+	Parent   *Signup            `json:"parent"`
+}
+
+// Referral is inlined into Signup when set.
+type Referral struct {
+	Code string `json:"code" validate:"min=6"`
+}
+
+// Address contains a postal address.
+type Address struct {
+	City string `json:"city" validate:"required"`
+}
+
+// Contact contains a contact of a signup.
+type Contact struct {
+	Email string `json:"email" validate:"omitempty,email"`
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Role defines the role of an account.
+type Role string
+
+// Account contains fields restricted by schema tags.
+// @mongo_methods(Fixtures => false, Readme => false, Makefile => false, Dockerfile => false)
+type Account struct {
+	*Profile
+
+	PublicID string         `json:"public_id" schema:"required"`
+	ID       bson.ObjectId  `bson:"_id" schema:"required"`
+	Role     Role           `json:"role" schema:"required,enum=admin|member"`
+	Level    int            `json:"level" schema:"enum=1|2|3"`
+	Rating   *float64       `json:"rating" schema:"enum=0.5|1"`
+	Active   bool           `json:"active,omitempty"`
+	Scopes   []string       `json:"scopes" schema:"enum=read|write"`
+	Avatar   []byte         `json:"avatar"`
+	Created  time.Time      `json:"created" schema:"required"`
+	Limits   map[string]int `json:"limits"`
+	Owner    *Account       `json:"owner"`
+	Meta     interface{}    `json:"meta"`
+}
+
+// Profile is inlined into Account when set, hence its required fields may be missing.
+type Profile struct {
+	Email string `json:"email" schema:"required"`
+}
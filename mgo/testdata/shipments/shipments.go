@@ -0,0 +1,48 @@
+package shipments
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Shipment contains shipment data served over grpc through its backend.
+// @mongoapi(GRPC => true, Readme => false, Makefile => false, Dockerfile => false)
+type Shipment struct {
+	Audit
+
+	PublicID  string          `json:"public_id"`
+	Owner     bson.ObjectId   `json:"owner"`
+	Carrier   string          `json:"carrier" validate:"required"`
+	Weight    float64         `json:"weight"`
+	Pieces    int             `json:"pieces"`
+	Priority  int32           `json:"priority"`
+	Fragile   bool            `json:"fragile"`
+	Note      *string         `json:"note"`
+	Shipped   time.Time       `json:"shipped"`
+	Delivered *time.Time      `json:"delivered"`
+	Label     []byte          `json:"label"`
+	Tags      []string        `json:"tags"`
+	Origin    Address         `json:"origin"`
+	Return    *Address        `json:"return"`
+	Stops     []*Address      `json:"stops"`
+	Items     map[string]Item `json:"items"`
+	Internal  string          `json:"-"`
+}
+
+// Audit contains audit data inlined into shipments.
+type Audit struct {
+	CreatedBy string `json:"created_by"`
+}
+
+// Address contains a postal address.
+type Address struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+// Item contains a shipped item.
+type Item struct {
+	SKU      string `json:"sku"`
+	Quantity uint32 `json:"quantity"`
+}
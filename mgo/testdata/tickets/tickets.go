@@ -0,0 +1,11 @@
+package tickets
+
+import "time"
+
+// Ticket contains ticket data served over http through its backend.
+// @mongoapi(HTTP => true, Readme => false, Makefile => false, Dockerfile => false, CreatedField => Opened)
+type Ticket struct {
+	PublicID string    `json:"public_id"`
+	Title    string    `json:"title" validate:"required"`
+	Opened   time.Time `json:"opened"`
+}
@@ -0,0 +1,414 @@
+package mgo
+
+import (
+	"bytes"
+	"fmt"
+	goast "go/ast"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/influx6/moz/ast"
+)
+
+// Params contains the names of all params accepted by the @mongoapi annotation.
+var Params = []string{
+	"PackageName",
+	"Dir",
+	"Driver",
+	"KeyField",
+	"CreatedField",
+	"UpdatedField",
+	"ENVName",
+	"Types",
+	"Fixtures",
+	"Readme",
+	"Makefile",
+	"Dockerfile",
+	"HTTP",
+	"GRPC",
+	"Cache",
+	"Outbox",
+	"BackendInSource",
+}
+
+// MethodParams contains the names of all params accepted by the @mongo_methods annotation,
+// which generates no http handler, grpc server, cache or outbox.
+var MethodParams = []string{
+	"PackageName",
+	"Dir",
+	"Driver",
+	"KeyField",
+	"CreatedField",
+	"UpdatedField",
+	"ENVName",
+	"Types",
+	"Fixtures",
+	"Readme",
+	"Makefile",
+	"Dockerfile",
+	"BackendInSource",
+}
+
+// Problem defines a problem with an annotated struct, positioned at its cause.
+type Problem struct {
+	Pos     token.Position
+	Message string
+}
+
+// Error returns the Problem in the format of compiler errors, e.g
+// "models/user.go:12:2: field ID must be a string to be used as key".
+func (p Problem) Error() string {
+	return fmt.Sprintf("%s: %s", p.Pos, p.Message)
+}
+
+// Problems defines a list of problems, sorted by position.
+type Problems []Problem
+
+// Error returns all problems on separate lines.
+func (p Problems) Error() string {
+	lines := make([]string, len(p))
+	for index, problem := range p {
+		lines[index] = problem.Error()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Validate returns Problems listing every problem found with the structs annotated with
+// @mongoapi, @mongo_methods or @mongo_fields within the giving packages, else nil if there
+// are none. It reports missing and mistyped key and timestamp fields, invalid or unknown
+// annotation params, fields sharing a bson name, unexported fields with a bson or json tag,
+// which generated code can not access, Fields or Consume methods declared on structs
+// whose methods @mongo_fields generates, lifecycle methods which are not
+// func(context.Context) error and fields without protobuf equivalent on structs served
+// through GRPC.
+func (g Generator) Validate(pkgs ...ast.Package) error {
+	v := validator{sources: make(map[string][]byte)}
+
+	for _, pkg := range pkgs {
+		for _, declr := range pkg.Packages {
+			for _, str := range declr.Structs {
+				for _, an := range str.Annotations {
+					switch an.Name {
+					case "@mongoapi", "@mongo_methods":
+						g.validateStruct(&v, an, str, pkg)
+					case "@mongo_fields":
+						v.validateFields(an, str, pkg)
+					}
+				}
+			}
+		}
+	}
+
+	if len(v.problems) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(v.problems, func(i, j int) bool {
+		a, b := v.problems[i].Pos, v.problems[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Offset < b.Offset
+	})
+
+	// Structs with several annotations report problems of their fields once.
+	problems := v.problems[:1]
+	for _, problem := range v.problems[1:] {
+		if problem != problems[len(problems)-1] {
+			problems = append(problems, problem)
+		}
+	}
+
+	return problems
+}
+
+// validateStruct records all problems of the giving struct for the giving annotation.
+func (g Generator) validateStruct(v *validator, an ast.AnnotationDeclaration, str ast.StructDeclaration, pkg ast.Package) {
+	name := str.Object.Name.Name
+	anPos := annotationPos(an, str)
+
+	accepted := Params
+	if an.Name == "@mongo_methods" {
+		accepted = MethodParams
+	}
+
+	known := make(map[string]bool, len(Params))
+	for _, param := range Params {
+		known[param] = false
+	}
+	for _, param := range accepted {
+		known[param] = true
+	}
+
+	var unknown []string
+	for param := range an.Params {
+		if !known[param] {
+			unknown = append(unknown, param)
+		}
+	}
+
+	sort.Strings(unknown)
+	for _, param := range unknown {
+		if _, ok := known[param]; ok {
+			v.add(str, anPos, "param %q for %s on struct %s is only supported by @mongoapi", param, an.Name, name)
+			continue
+		}
+
+		v.add(str, anPos, "unknown param %q for %s on struct %s", param, an.Name, name)
+	}
+
+	ops, err := g.options(an, str)
+	if err != nil {
+		v.add(str, anPos, "%s", err)
+		return
+	}
+
+	keyField, ok := structField(str, ops.KeyField)
+	switch {
+	case !ok:
+		v.add(str, str.Object.Name.Pos(), "struct %s has no %s field, add '%s string' with a bson or json tag or set KeyField", name, ops.KeyField, ops.KeyField)
+	case types.ExprString(keyField.Type) != "string":
+		v.add(str, keyField.Pos(), "field %s of struct %s must be a string to be used as key, found %s", ops.KeyField, name, types.ExprString(keyField.Type))
+	}
+
+	for _, timestamp := range []string{ops.CreatedField, ops.UpdatedField} {
+		if timestamp == "" {
+			continue
+		}
+
+		field, ok := structField(str, timestamp)
+		switch {
+		case !ok:
+			v.add(str, anPos, "struct %s has no %s field to be used as timestamp", name, timestamp)
+		case types.ExprString(field.Type) != "time.Time":
+			v.add(str, field.Pos(), "field %s of struct %s must be a time.Time to be used as timestamp, found %s", timestamp, name, types.ExprString(field.Type))
+		}
+	}
+
+	if an.Name == "@mongoapi" && enabled(ops.GRPC, false) {
+		if _, err := buildProto(str, pkg, "pb"); err != nil {
+			v.add(str, anPos, "%s", err)
+		}
+	}
+
+	v.validateHooks(str, pkg)
+	v.validateTags(str, pkg)
+}
+
+// validateHooks records problems with the lifecycle methods of the giving struct, which
+// generated code calls as func(context.Context) error.
+func (v *validator) validateHooks(str ast.StructDeclaration, pkg ast.Package) {
+	name := str.Object.Name.Name
+
+	hooks := make(map[string]bool, len(lifecycleHooks))
+	for _, hook := range lifecycleHooks {
+		hooks[hook] = true
+	}
+
+	for _, declr := range pkg.Packages {
+		// Methods are only recorded by the objects of their receivers.
+		for _, methods := range declr.ObjectFunc {
+			for _, fn := range methods {
+				if fn.RecieverName != name || !hooks[fn.FuncName] || isHookSignature(fn, declr) {
+					continue
+				}
+
+				v.problems = append(v.problems, Problem{
+					Pos:     v.offset(fn.FilePath, fn.From),
+					Message: fmt.Sprintf("method %s of struct %s must be func(context.Context) error to be used as hook, found %s", fn.FuncName, name, types.ExprString(fn.Type)),
+				})
+			}
+		}
+	}
+}
+
+// isHookSignature returns true/false if the giving method, declared within the giving file,
+// takes a context.Context and returns an error.
+func isHookSignature(fn ast.FuncDeclaration, declr ast.PackageDeclaration) bool {
+	params, results := fn.Type.Params, fn.Type.Results
+	if params == nil || len(params.List) != 1 || len(params.List[0].Names) > 1 {
+		return false
+	}
+
+	if results == nil || len(results.List) != 1 || len(results.List[0].Names) > 1 {
+		return false
+	}
+
+	if types.ExprString(results.List[0].Type) != "error" {
+		return false
+	}
+
+	for handle, imp := range declr.Imports {
+		if imp.Path == "context" && types.ExprString(params.List[0].Type) == handle+".Context" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateFields records all problems of the giving struct annotated with @mongo_fields,
+// which accepts no params.
+func (v *validator) validateFields(an ast.AnnotationDeclaration, str ast.StructDeclaration, pkg ast.Package) {
+	name := str.Object.Name.Name
+	anPos := annotationPos(an, str)
+
+	var params []string
+	for param := range an.Params {
+		params = append(params, param)
+	}
+
+	sort.Strings(params)
+	for _, param := range params {
+		v.add(str, anPos, "unknown param %q for %s on struct %s", param, an.Name, name)
+	}
+
+	for _, declr := range pkg.Packages {
+		if filepath.Base(declr.FilePath) == fieldsFileName(str) {
+			continue
+		}
+
+		// Methods are only recorded by the objects of their receivers.
+		for _, methods := range declr.ObjectFunc {
+			for _, fn := range methods {
+				if fn.RecieverName == name && (fn.FuncName == "Fields" || fn.FuncName == "Consume") {
+					v.problems = append(v.problems, Problem{
+						Pos:     v.offset(fn.FilePath, fn.From),
+						Message: fmt.Sprintf("struct %s declares %s, which %s generates", name, fn.FuncName, an.Name),
+					})
+				}
+			}
+		}
+	}
+
+	v.validateTags(str, pkg)
+}
+
+// validateTags records problems with the bson, json, schema and validate tags of the fields
+// of the giving struct, declared within the giving package.
+func (v *validator) validateTags(str ast.StructDeclaration, pkg ast.Package) {
+	name := str.Object.Name.Name
+	sc := structType(str, pkg).Scope
+
+	seen := make(map[string]string)
+	for _, field := range str.Struct.Fields.List {
+		ft := resolve(field.Type, sc)
+
+		fieldName := embeddedName(field.Type)
+		if len(field.Names) != 0 {
+			fieldName = field.Names[0].Name
+		}
+
+		if err := checkSchemaTag(field, ft); err != nil {
+			v.add(str, field.Pos(), "field %s of struct %s has invalid schema tag: %s", fieldName, name, err)
+		}
+
+		if err := checkRules(field, ft); err != nil {
+			v.add(str, field.Pos(), "field %s of struct %s has invalid validate tag: %s", fieldName, name, err)
+		}
+
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				if hasStorageTag(field.Tag) {
+					v.add(str, ident.Pos(), "field %s of struct %s is unexported, hence can not be stored by generated code", ident.Name, name)
+				}
+				continue
+			}
+
+			tag := parseTag(ident.Name, field.Tag)
+			if tag.Skip || tag.Inline {
+				continue
+			}
+
+			bsonName := tag.Name
+
+			if other, ok := seen[bsonName]; ok {
+				v.add(str, ident.Pos(), "field %s of struct %s has bson name %q already used by field %s", ident.Name, name, bsonName, other)
+				continue
+			}
+
+			seen[bsonName] = ident.Name
+		}
+	}
+}
+
+// hasStorageTag returns true/false if the giving field tag sets a bson or json name.
+func hasStorageTag(tag *goast.BasicLit) bool {
+	if tag == nil {
+		return false
+	}
+
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return false
+	}
+
+	tags := fmt.Sprintf(" %s", value)
+	return strings.Contains(tags, " bson:") || strings.Contains(tags, " json:")
+}
+
+// annotationPos returns the position of the comment carrying the giving annotation on the
+// giving struct, else the position of the struct.
+func annotationPos(an ast.AnnotationDeclaration, str ast.StructDeclaration) token.Pos {
+	for _, group := range []*goast.CommentGroup{str.GenObj.Doc, str.Object.Doc} {
+		if group == nil {
+			continue
+		}
+
+		for _, comment := range group.List {
+			if strings.Contains(comment.Text, an.Name) {
+				return comment.Pos()
+			}
+		}
+	}
+
+	return str.Object.Name.Pos()
+}
+
+// validator collects problems, resolving positions of nodes against the source files of
+// their structs.
+type validator struct {
+	problems Problems
+	sources  map[string][]byte
+}
+
+// add records a problem at the position of a node of the giving struct.
+func (v *validator) add(str ast.StructDeclaration, pos token.Pos, format string, args ...interface{}) {
+	v.problems = append(v.problems, Problem{
+		Pos:     v.position(str, pos),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// position returns the position of pos, a position of a node of the giving struct. The
+// token.FileSet used to parse structs is not exposed, hence the offset of pos is found
+// relative to the declaration of the struct, whose offset within its file is known.
+func (v *validator) position(str ast.StructDeclaration, pos token.Pos) token.Position {
+	return v.offset(str.FilePath, str.From+int(pos-str.GenObj.Pos()))
+}
+
+// offset returns the position of the giving offset within the giving file.
+func (v *validator) offset(file string, offset int) token.Position {
+	position := token.Position{Filename: file, Offset: offset}
+
+	source, ok := v.sources[file]
+	if !ok {
+		source, _ = ioutil.ReadFile(file)
+		v.sources[file] = source
+	}
+
+	if position.Offset < 0 || position.Offset > len(source) {
+		return token.Position{Filename: file}
+	}
+
+	before := source[:position.Offset]
+	position.Line = bytes.Count(before, []byte("\n")) + 1
+	position.Column = position.Offset - (bytes.LastIndexByte(before, '\n') + 1) + 1
+	return position
+}
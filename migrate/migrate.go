@@ -0,0 +1,194 @@
+// Package migrate creates migration files within the migrations package generated for a
+// package annotated with @mongo, and runs its migrations through a temporary program which
+// imports it, as migrations are user code which mgokit can not call itself.
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/influx6/gobuild/srcpath"
+)
+
+// Dir returns the directory of the migrations package generated into dest.
+func Dir(dest string) string {
+	return filepath.Join(dest, "mdb", "migrations")
+}
+
+// runDir defines the directory within the migrations package the runner program is written
+// into, ignored by go tools as it starts with an underscore.
+const runDir = "_run"
+
+var nonWord = regexp.MustCompile(`[^a-z0-9]+`)
+
+// New creates the file of a new migration with the giving name within the migrations package
+// at dir, versioned by the giving time as e.g 20060102150405, returning its path.
+func New(dir string, name string, now time.Time) (string, error) {
+	slug := strings.Trim(nonWord.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if slug == "" {
+		return "", fmt.Errorf("Migration name %+q must contain letters or digits", name)
+	}
+
+	mdbPath, err := importPath(dir)
+	if err != nil {
+		return "", err
+	}
+
+	mdbPath = filepath.ToSlash(filepath.Dir(mdbPath))
+
+	version := now.UTC().Format("20060102150405")
+	path := filepath.Join(dir, version+"_"+slug+".go")
+
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("Migration file %+q already exists", path)
+	}
+
+	content, err := render(migrationTemplate, struct {
+		Version string
+		Name    string
+		MDB     string
+	}{
+		Version: version,
+		Name:    slug,
+		MDB:     mdbPath,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return path, ioutil.WriteFile(path, content, 0644)
+}
+
+// Run runs the migrations command within args, e.g "up", "down 2" or "status", against the
+// migrations package at dir by writing a program importing it into dir and running it with
+// 'go run', connecting to mongodb as set by the MONGO_HOST, MONGO_DB, MONGO_AUTHDB,
+// MONGO_USER and MONGO_PASSWORD environment variables.
+func Run(dir string, args []string, stdout io.Writer, stderr io.Writer) error {
+	migrationsPath, err := importPath(dir)
+	if err != nil {
+		return err
+	}
+
+	content, err := runner(filepath.ToSlash(migrationsPath))
+	if err != nil {
+		return err
+	}
+
+	programDir := filepath.Join(dir, runDir)
+	if err := os.MkdirAll(programDir, 0755); err != nil {
+		return err
+	}
+
+	defer os.RemoveAll(programDir)
+
+	program := filepath.Join(programDir, "main.go")
+	if err := ioutil.WriteFile(program, content, 0644); err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", append([]string{"run", program}, args...)...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	return cmd.Run()
+}
+
+// importPath returns the import path of the migrations package at dir, failing if it was
+// not generated yet.
+func importPath(dir string) (string, error) {
+	if _, err := os.Stat(filepath.Join(dir, "migrations.go")); err != nil {
+		return "", fmt.Errorf("No generated migrations package found at %+q, generate a package annotated with @mongo first", dir)
+	}
+
+	path, err := srcpath.RelativeToSrc(dir)
+	if err != nil {
+		return "", fmt.Errorf("Migrations path is not within current GOPATH: %+q", err.Error())
+	}
+
+	return path, nil
+}
+
+// runner returns the source of the program running the migrations package of the giving
+// import path.
+func runner(migrationsPath string) ([]byte, error) {
+	return render(runnerTemplate, struct {
+		Migrations string
+		MDB        string
+	}{
+		Migrations: migrationsPath,
+		MDB:        filepath.ToSlash(filepath.Dir(migrationsPath)),
+	})
+}
+
+// render returns the gofmt formatted output of the giving template executed with data.
+func render(tml *template.Template, data interface{}) ([]byte, error) {
+	var out bytes.Buffer
+	if err := tml.Execute(&out, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(out.Bytes())
+}
+
+var migrationTemplate = template.Must(template.New("migration").Parse(`package migrations
+
+import (
+	"context"
+
+	"{{.MDB}}"
+)
+
+func init() {
+	Register(Migration{
+		Version: {{.Version}},
+		Name:    "{{.Name}}",
+		Up: func(ctx context.Context, db mdb.MongoDB) error {
+			return nil
+		},
+		Down: func(ctx context.Context, db mdb.MongoDB) error {
+			return nil
+		},
+	})
+}
+`))
+
+var runnerTemplate = template.Must(template.New("runner").Parse(`// Code generated by mgokit migrate. DO NOT EDIT.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/influx6/faux/metrics"
+
+	"{{.MDB}}"
+	"{{.Migrations}}"
+)
+
+func main() {
+	db := mdb.NewMongoDB(mdb.Config{
+		DB:       os.Getenv("MONGO_DB"),
+		Host:     os.Getenv("MONGO_HOST"),
+		User:     os.Getenv("MONGO_USER"),
+		AuthDB:   os.Getenv("MONGO_AUTHDB"),
+		Password: os.Getenv("MONGO_PASSWORD"),
+	})
+
+	if err := migrations.Run(context.Background(), db, metrics.New(), os.Stdout, os.Args[1:]...); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+`))
@@ -0,0 +1,99 @@
+package migrate
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influx6/gobuild/srcpath"
+)
+
+func TestNew(t *testing.T) {
+	if os.Getenv("GOPATH") == "" {
+		t.Skip("migrations are resolved through GOPATH, which is not set")
+	}
+
+	root, err := ioutil.TempDir(srcpath.SrcPath(), "mgokit-migrate")
+	if err != nil {
+		t.Fatalf("Should have created temporary directory: %+q", err)
+	}
+	defer os.RemoveAll(root)
+
+	dir := Dir(root)
+	if _, err := New(dir, "split_name", time.Now()); err == nil {
+		t.Fatalf("Should have failed without a generated migrations package")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("Should have created migrations directory: %+q", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "migrations.go"), []byte("package migrations\n"), 0644); err != nil {
+		t.Fatalf("Should have written migrations package: %+q", err)
+	}
+
+	now := time.Date(2026, 10, 19, 9, 35, 0, 0, time.UTC)
+
+	path, err := New(dir, "Split Name!", now)
+	if err != nil {
+		t.Fatalf("Should have created migration: %+q", err)
+	}
+
+	if filepath.Base(path) != "20261019093500_split_name.go" {
+		t.Fatalf("Should have named migration file by version and name, got %q", filepath.Base(path))
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+	if err != nil {
+		t.Fatalf("Should have created valid go file: %+q", err)
+	}
+
+	mdbPath := filepath.ToSlash(filepath.Join(filepath.Base(root), "mdb"))
+	if len(file.Imports) != 2 || file.Imports[1].Path.Value != strconv.Quote(mdbPath) {
+		t.Fatalf("Should have imported %q", mdbPath)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Should have read migration file: %+q", err)
+	}
+
+	if !strings.Contains(string(content), "Version: 20261019093500,") || !strings.Contains(string(content), `Name:    "split_name",`) {
+		t.Fatalf("Should have registered migration with its version and name, got:\n%s", content)
+	}
+
+	if _, err := New(dir, "split_name", now); err == nil {
+		t.Fatalf("Should have failed to overwrite existing migration")
+	}
+
+	if _, err := New(dir, "!!", now); err == nil {
+		t.Fatalf("Should have failed with name without letters or digits")
+	}
+}
+
+func TestRunner(t *testing.T) {
+	content, err := runner("example.com/app/mdb/migrations")
+	if err != nil {
+		t.Fatalf("Should have rendered runner: %+q", err)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "main.go", content, parser.ImportsOnly)
+	if err != nil {
+		t.Fatalf("Should have rendered valid go file: %+q", err)
+	}
+
+	imports := make(map[string]bool)
+	for _, spec := range file.Imports {
+		imports[spec.Path.Value] = true
+	}
+
+	if !imports[`"example.com/app/mdb"`] || !imports[`"example.com/app/mdb/migrations"`] {
+		t.Fatalf("Should have imported mdb and migrations packages, got:\n%s", content)
+	}
+}
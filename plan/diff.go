@@ -0,0 +1,158 @@
+package plan
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// contextLines sets the number of unchanged lines shown around each change.
+const contextLines = 3
+
+// Unified returns a unified diff turning the content of a into b, using the
+// giving names for the file headers. It returns an empty string if both are equal.
+func Unified(fromName string, toName string, a []byte, b []byte) string {
+	if bytes.Equal(a, b) {
+		return ""
+	}
+
+	from, to := splitLines(a), splitLines(b)
+	edits := diffLines(from, to)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+
+	for start := 0; start < len(edits); {
+		// Find the next change.
+		for start < len(edits) && edits[start].kind == ' ' {
+			start++
+		}
+
+		if start == len(edits) {
+			break
+		}
+
+		// Grow the hunk until the unchanged run between changes exceeds
+		// twice the context.
+		hunkStart := start - contextLines
+		if hunkStart < 0 {
+			hunkStart = 0
+		}
+
+		end := start
+		for end < len(edits) {
+			if edits[end].kind != ' ' {
+				end++
+				continue
+			}
+
+			run := end
+			for run < len(edits) && edits[run].kind == ' ' {
+				run++
+			}
+
+			if run == len(edits) || run-end > 2*contextLines {
+				end += contextLines
+				if end > len(edits) {
+					end = len(edits)
+				}
+				break
+			}
+
+			end = run
+		}
+
+		writeHunk(&out, edits, hunkStart, end)
+		start = end
+	}
+
+	return out.String()
+}
+
+// edit defines a single line of a diff.
+type edit struct {
+	kind byte
+	line string
+	from int
+	to   int
+}
+
+func writeHunk(out *bytes.Buffer, edits []edit, start int, end int) {
+	var fromCount, toCount int
+	fromStart, toStart := edits[start].from, edits[start].to
+
+	for _, e := range edits[start:end] {
+		if e.kind != '+' {
+			fromCount++
+		}
+		if e.kind != '-' {
+			toCount++
+		}
+	}
+
+	if fromCount > 0 {
+		fromStart++
+	}
+
+	if toCount > 0 {
+		toStart++
+	}
+
+	fmt.Fprintf(out, "@@ -%d,%d +%d,%d @@\n", fromStart, fromCount, toStart, toCount)
+
+	for _, e := range edits[start:end] {
+		out.WriteByte(e.kind)
+		out.WriteString(e.line)
+		out.WriteByte('\n')
+	}
+}
+
+// diffLines returns the edits turning from into to, using the longest common
+// subsequence of both.
+func diffLines(from []string, to []string) []edit {
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+				continue
+			}
+
+			lcs[i][j] = lcs[i+1][j]
+			if lcs[i][j+1] > lcs[i][j] {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var edits []edit
+	var i, j int
+	for i < len(from) || j < len(to) {
+		switch {
+		case i < len(from) && j < len(to) && from[i] == to[j]:
+			edits = append(edits, edit{kind: ' ', line: from[i], from: i, to: j})
+			i++
+			j++
+		case i < len(from) && (j == len(to) || lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, edit{kind: '-', line: from[i], from: i, to: j})
+			i++
+		default:
+			edits = append(edits, edit{kind: '+', line: to[j], from: i, to: j})
+			j++
+		}
+	}
+
+	return edits
+}
+
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
@@ -0,0 +1,47 @@
+package plan_test
+
+import (
+	"testing"
+
+	"github.com/gokit/mgokit/plan"
+)
+
+func TestUnified(t *testing.T) {
+	specs := []struct {
+		Name     string
+		From     string
+		To       string
+		Expected string
+	}{
+		{
+			Name:     "equal",
+			From:     "a\nb\n",
+			To:       "a\nb\n",
+			Expected: "",
+		},
+		{
+			Name:     "create",
+			From:     "",
+			To:       "a\nb\n",
+			Expected: "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			Name:     "change",
+			From:     "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			To:       "1\n2\n3\n4\nfive\n6\n7\n8\n9\n",
+			Expected: "--- a\n+++ b\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			Name:     "separate hunks",
+			From:     "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			To:       "one\n2\n3\n4\n5\n6\n7\n8\n9\nten\n",
+			Expected: "--- a\n+++ b\n@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+ten\n",
+		},
+	}
+
+	for _, spec := range specs {
+		if diff := plan.Unified("a", "b", []byte(spec.From), []byte(spec.To)); diff != spec.Expected {
+			t.Errorf("%s: expected diff %q, got %q", spec.Name, spec.Expected, diff)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Package plan renders the output of annotation generators into memory, so it can be
+// checked against, diffed with or written into a destination directory.
+package plan
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/gokit/mgokit/header"
+	"github.com/influx6/gobuild/srcpath"
+	"github.com/influx6/moz/ast"
+)
+
+// Status defines the state of a generated file against the destination directory.
+type Status int
+
+// contains the possible states of a generated file.
+const (
+	// Create marks a file which does not exist yet.
+	Create Status = iota
+
+	// Overwrite marks a file which exists with different content.
+	Overwrite
+
+	// Unchanged marks a file which exists with the same content.
+	Unchanged
+
+	// Skip marks a file which exists and is not to be overridden.
+	Skip
+)
+
+// String returns the name of the Status.
+func (s Status) String() string {
+	switch s {
+	case Create:
+		return "create"
+	case Overwrite:
+		return "overwrite"
+	case Unchanged:
+		return "unchanged"
+	case Skip:
+		return "skip"
+	}
+
+	return "unknown"
+}
+
+// File defines a single file rendered from a generator's directive.
+type File struct {
+	Path       string
+	Rel        string
+	Package    string
+	Annotation string
+	Status     Status
+	Content    []byte
+	Existing   []byte
+
+	// Edited marks an existing generated file which was changed since it was generated.
+	Edited bool
+}
+
+// Stale returns true/false if writing the File would change the destination.
+func (f File) Stale() bool {
+	return f.Status == Create || f.Status == Overwrite
+}
+
+// Build runs the generators within provider for all declarations of the giving packages,
+// rendering all directives into memory and resolving each against what exists in toDir.
+// Provided toDir must be a absolute path within the GOPATH.
+func Build(toDir string, provider *ast.AnnotationRegistry, doFileOverwrite bool, pkgs ...ast.Package) ([]File, error) {
+	if !filepath.IsAbs(toDir) {
+		return nil, errors.New("Destination path must be a absolute path directory")
+	}
+
+	toSrcPath, err := srcpath.RelativeToSrc(toDir)
+	if err != nil {
+		return nil, fmt.Errorf("Destination path is not within current GOPATH: %+q", err.Error())
+	}
+
+	var files []File
+
+	for _, pkg := range pkgs {
+		for _, declr := range pkg.Packages {
+			wdrs, err := provider.ParseDeclr(pkg, declr, toSrcPath)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, wd := range wdrs {
+				if wd.Writer == nil {
+					continue
+				}
+
+				if filepath.IsAbs(wd.Dir) {
+					return nil, fmt.Errorf("gen.WriteDirectiveError: Expected relative Dir path not absolute: %+q", wd.Dir)
+				}
+
+				if wd.FileName == "" {
+					return nil, errors.New("WriteDirective has no filename value attached")
+				}
+
+				var content bytes.Buffer
+				if _, err := wd.Writer.WriteTo(&content); err != nil {
+					return nil, fmt.Errorf("IOError: Unable to render content for file %q: %+q", wd.FileName, err)
+				}
+
+				rel := filepath.Join(wd.Dir, wd.FileName)
+				file := File{
+					Rel:        rel,
+					Path:       filepath.Join(toDir, rel),
+					Package:    declr.Package,
+					Annotation: wd.Annotation,
+					Content:    content.Bytes(),
+				}
+
+				existing, err := ioutil.ReadFile(file.Path)
+				file.Edited = err == nil && header.Edited(existing)
+
+				switch {
+				case os.IsNotExist(err):
+					file.Status = Create
+				case err != nil:
+					return nil, err
+				case wd.DontOverride && !doFileOverwrite:
+					file.Status = Skip
+					file.Existing = existing
+				case bytes.Equal(existing, file.Content):
+					file.Status = Unchanged
+					file.Existing = existing
+				default:
+					file.Status = Overwrite
+					file.Existing = existing
+				}
+
+				files = append(files, file)
+			}
+		}
+	}
+
+	return files, nil
+}
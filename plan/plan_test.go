@@ -0,0 +1,128 @@
+package plan_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gokit/mgokit/plan"
+	"github.com/influx6/gobuild/srcpath"
+	"github.com/influx6/moz/ast"
+	"github.com/influx6/moz/gen"
+)
+
+func TestBuild(t *testing.T) {
+	if os.Getenv("GOPATH") == "" {
+		t.Skip("destinations are resolved through GOPATH, which is not set")
+	}
+
+	specs := []struct {
+		Name         string
+		Existing     string
+		Exists       bool
+		DontOverride bool
+		Force        bool
+		Status       plan.Status
+		Stale        bool
+	}{
+		{
+			Name:   "create",
+			Status: plan.Create,
+			Stale:  true,
+		},
+		{
+			Name:     "overwrite",
+			Existing: "package mdb\n\nvar old = true\n",
+			Exists:   true,
+			Status:   plan.Overwrite,
+			Stale:    true,
+		},
+		{
+			Name:     "unchanged",
+			Existing: "package mdb\n",
+			Exists:   true,
+			Status:   plan.Unchanged,
+		},
+		{
+			Name:         "skip",
+			Existing:     "package mdb\n\nvar edited = true\n",
+			Exists:       true,
+			DontOverride: true,
+			Status:       plan.Skip,
+		},
+		{
+			Name:         "forced overwrite",
+			Existing:     "package mdb\n\nvar edited = true\n",
+			Exists:       true,
+			DontOverride: true,
+			Force:        true,
+			Status:       plan.Overwrite,
+			Stale:        true,
+		},
+	}
+
+	for _, spec := range specs {
+		dest, err := ioutil.TempDir(srcpath.SrcPath(), "mgokit-plan")
+		if err != nil {
+			t.Fatalf("Should have created temporary directory: %+q", err)
+		}
+		defer os.RemoveAll(dest)
+
+		path := filepath.Join(dest, "mdb", "mdb.go")
+		if spec.Exists {
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("%s: Should have created destination directory: %+q", spec.Name, err)
+			}
+
+			if err := ioutil.WriteFile(path, []byte(spec.Existing), 0644); err != nil {
+				t.Fatalf("%s: Should have written existing file: %+q", spec.Name, err)
+			}
+		}
+
+		dontOverride := spec.DontOverride
+		registry := ast.NewAnnotationRegistry()
+		registry.RegisterPackage("@mongo", func(string, ast.AnnotationDeclaration, ast.PackageDeclaration, ast.Package) ([]gen.WriteDirective, error) {
+			return []gen.WriteDirective{
+				{Writer: strings.NewReader("package mdb\n"), FileName: "mdb.go", Dir: "mdb", DontOverride: dontOverride},
+			}, nil
+		})
+
+		pkg := ast.Package{
+			Packages: []ast.PackageDeclaration{
+				{Package: "models", Annotations: []ast.AnnotationDeclaration{{Name: "@mongo"}}},
+			},
+		}
+
+		files, err := plan.Build(dest, registry, spec.Force, pkg)
+		if err != nil {
+			t.Fatalf("%s: Should have built files: %+q", spec.Name, err)
+		}
+
+		if len(files) != 1 {
+			t.Fatalf("%s: Should have built 1 file, got %d", spec.Name, len(files))
+		}
+
+		file := files[0]
+		if file.Status != spec.Status {
+			t.Errorf("%s: Should have resolved status %s, got %s", spec.Name, spec.Status, file.Status)
+		}
+
+		if file.Stale() != spec.Stale {
+			t.Errorf("%s: Should have resolved stale to %t", spec.Name, spec.Stale)
+		}
+
+		if file.Path != path || file.Rel != filepath.Join("mdb", "mdb.go") {
+			t.Errorf("%s: Should have resolved file to %q, got %q", spec.Name, path, file.Path)
+		}
+
+		if string(file.Content) != "package mdb\n" || string(file.Existing) != spec.Existing {
+			t.Errorf("%s: Should have held rendered and existing content, got %q and %q", spec.Name, file.Content, file.Existing)
+		}
+	}
+
+	if _, err := plan.Build("relative", ast.NewAnnotationRegistry(), false); err == nil {
+		t.Fatalf("Should have failed with relative destination")
+	}
+}
@@ -25,22 +25,56 @@ var (
         
           "mongo-api-backend.tml",
         
+          "mongo-api-cache-test.tml",
+        
+          "mongo-api-cache.tml",
+        
+          "mongo-api-document-test.tml",
+        
+          "mongo-api-grpc-proto.tml",
+        
+          "mongo-api-grpc-test.tml",
+        
+          "mongo-api-grpc.tml",
+        
+          "mongo-api-http-test.tml",
+        
+          "mongo-api-http.tml",
+        
           "mongo-api-json.tml",
         
+          "mongo-api-memory.tml",
+        
+          "mongo-api-outbox-test.tml",
+        
+          "mongo-api-outbox.tml",
+        
+          "mongo-api-random.tml",
+        
           "mongo-api-readme.tml",
         
           "mongo-api-test.tml",
         
           "mongo-api.tml",
         
+          "mongo-consume.tml",
+        
+          "mongo-fields.tml",
+        
           "mongo-functions-test.tml",
         
           "mongo-functions.tml",
         
+          "mongo-hooks.tml",
+        
+          "mongo-migrations.tml",
+        
           "mongo-solo-readme.tml",
         
           "mongo-solo.tml",
         
+          "mongo-testutil.tml",
+        
       },
     
   }
@@ -49,71 +83,173 @@ var (
     
       
         "dockerfile.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\x41\x6b\xdc\x30\x10\x85\xef\xfe\x15\x8f\x2c\x84\x16\x22\xd9\xe9\xa1\x81\x52\x4a\xb7\xcd\x36\x31\x65\x6d\xc8\xba\xbd\x94\xb2\x48\x96\x2c\xab\x6b\x69\x16\x69\x1c\x92\x7f\x5f\xec\xf6\x10\x72\x1b\xe6\x1b\xe6\xf1\xbd\x6f\x0f\xed\x1e\x3e\x0e\xd3\xfc\xf4\xbe\x0c\x14\x5d\xb2\x93\xa8\x64\x25\xaf\x8b\xfd\xb6\x6e\xba\x6d\xdd\xec\x1e\x70\xd7\x7e\xaf\xbb\x37\xce\xb3\x9e\xb5\xec\x29\x94\x8e\x4e\x9e\xdf\xe2\x23\x27\x1f\xe9\x69\xf8\xec\x82\xf2\xd3\x42\x3e\x15\xc5\x06\x07\xcb\xc8\x7d\xf2\x67\x06\x13\xd2\x1c\xa1\x18\x99\x55\xe2\xf9\x5c\xec\x9a\x9f\xd8\xb7\xcd\x5d\x7b\xac\x9b\xba\x43\x19\x22\x97\x46\xaf\xd9\x64\x74\x69\xb4\xfc\x93\x97\x27\xdd\xe8\x33\x7c\x86\x02\xdb\xcc\xf0\x41\x39\x7b\x05\x43\x91\x61\x08\xbc\xd0\x81\x12\x54\x7c\xc6\x39\x91\x99\x7b\xf6\x14\x8b\x0d\xf2\x73\x66\x1b\x70\x9e\xac\xca\xf6\x0a\x2a\x40\x5b\xe7\x7c\x74\xff\x57\x12\x75\xcc\x6c\x95\xc1\x44\xca\x20\xdb\x3e\x59\xce\xc5\x06\x3c\x26\x9a\xdd\x08\x1e\x2d\x84\xb0\xf1\x51\x0c\x7e\xb2\x18\x26\xe5\xd6\x24\x43\xfd\xc9\xa6\x55\x47\xbe\xb0\xe8\x76\x87\xee\x78\xdf\x1e\x3a\x2c\xb5\x55\xb2\xfa\xf0\xee\xa6\xba\xbe\x79\x7d\x71\xfb\x65\xf5\x38\x1a\xfd\x9a\x6c\x7f\x74\xf7\x2f\x68\xf1\x75\x7f\x8b\x5f\xa5\xf6\xb1\xcc\x23\x44\x8f\x8b\x75\xd6\x44\x1c\x1c\x41\x88\x48\x42\xcd\x3c\x42\x88\x81\xd2\x09\x97\x97\x70\xf4\xaf\x23\xf1\x08\x59\x4a\x29\x2f\x7e\xff\x0d\x00\x00\xff\xff\xf8\x21\x38\x1f\xd7\x01\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\x51\x6f\xd3\x30\x14\x85\xdf\xf3\x2b\x8e\x5a\x1e\x1b\x97\x4e\xe5\x65\x12\x0f\xb0\x8e\x0d\x41\x97\x6a\x2b\x20\x84\xd0\xe4\xda\x37\x8e\xd5\xc4\x37\xb2\x6f\x37\xaa\x69\xff\x1d\xb9\xe9\x60\x7d\x49\x7c\x8f\xce\x39\xbe\xfe\xc6\xe8\x1c\xab\x87\x33\x70\x68\xf7\x48\x3d\xe9\x6d\x82\x34\x84\x96\x9c\x36\x7b\x3c\xfa\x48\xe8\x23\x0b\x1b\x6e\x27\x78\x6c\xbc\x69\xd0\x71\x70\x6c\x61\x23\xf7\x3d\x59\xe8\x5a\x28\xe2\x9d\x7a\x3b\x41\x43\xc1\x50\xce\x17\x63\xf8\x4e\x3b\x82\x4f\xe8\x7d\x08\x64\x21\x8c\xb9\x9a\xab\xe2\xd3\x6d\xb5\x1c\x2a\xce\xe7\x6a\x5e\x14\x63\xac\x29\x49\x42\x12\x1d\x25\x67\x5f\xfa\xb9\x3e\x4c\x43\x8f\x34\x91\x77\xae\x39\x28\x8e\x02\x45\x2d\xb9\x93\x92\xec\xc4\xb7\xe8\xb5\xd9\x6a\x47\x0a\x77\x24\xc5\x18\xcb\xea\xe6\xaa\xba\x5f\x5f\xde\xad\xef\xaf\xab\xbb\x35\x74\xb0\xaf\xb5\xc5\xc7\x93\xbe\xb2\xa4\xf0\x50\xd6\xbe\x25\xd4\xad\x76\xe0\x1a\x96\xcd\x96\x22\xe2\x2e\xe4\xbd\x85\x92\x40\x3b\xed\x43\xca\xed\x3a\xb0\x34\x14\x8f\x7b\x6e\x90\x65\xd2\x56\x15\x17\xd5\xea\x27\xca\xb2\x8e\xdc\xbd\x77\xdc\xea\xe0\xce\x67\xea\x6c\x86\xe9\x2e\xc5\x69\xcb\x46\xb7\x53\xc7\xa7\x53\x71\x79\xf3\x1d\x57\xd5\xea\xc3\xfa\x1a\xff\xc7\xd9\x6c\xb6\xac\x16\xdf\xbe\x5e\x82\xeb\xfa\x60\x19\x0c\xaf\x93\xd3\x8d\x0f\xe7\x6f\x86\xe8\x70\xce\xa7\x03\xcf\x7f\xd4\x7c\xc2\x66\xe7\x5b\xc1\xa3\x97\x81\x5d\x8a\x06\xd6\x47\x32\xc2\x71\xff\x82\xf8\x78\x7f\xc3\xad\xf5\xc1\x1d\x7c\x47\xa0\xd0\x09\x86\x83\xd0\x1f\x99\x40\xa7\x62\x0c\xcb\x81\xb0\xd9\xa3\xd3\x5b\x3a\x62\x2a\x33\x9f\x09\x12\x9f\x26\x83\x85\x97\x04\x4b\x3d\x05\x4b\xc1\x78\x4a\xd8\x12\xf5\xd9\xe5\x23\x7c\xd7\x73\x14\xf4\x5a\x9a\x74\x44\xa7\xf0\xf2\x9e\x14\xcd\xb4\xf8\x51\xdd\x7e\x59\x7c\xbe\x3d\x11\x9f\x9e\xd4\x6a\xb8\x60\xa5\xa5\x79\x7e\x2e\x8a\x8b\xe5\x02\xbf\x46\x8e\x47\x13\x8c\xf2\x22\xf9\x5f\x3e\xe4\xaf\x9a\x2a\xa5\x46\xbf\x8b\xbf\x03\x00\x50\x95\xf8\x65\xe5\x02\x00\x00"),
           path: "dockerfile.tml",
           root: "dockerfile.tml",
         },
       
         "makefile.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe2\x4a\x2a\xcd\xcc\x49\xb1\x52\x48\xc9\x4f\xce\x4e\x2d\x52\x00\xf3\x14\x74\x4b\x14\xaa\xab\x4b\xf2\x7d\xf2\xcb\x53\x8b\x14\xf4\x02\x12\x93\xb3\x13\xd3\x53\xfd\x12\x73\x53\x6b\x6b\x15\x74\xd3\x14\xf4\xf4\x4b\x52\x8b\x4b\xf4\x20\x5a\xd2\x32\x73\x52\xb9\xb8\x40\x02\x56\x10\xdd\x5c\x9c\x50\xb3\x8a\x4a\xf3\x14\x74\x75\x8b\x72\x71\x9a\x05\x08\x00\x00\xff\xff\xd1\x0a\xfe\x5d\x7c\x00\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8d\xbb\x6a\x85\x40\x14\x45\xeb\xcc\x57\xec\x42\x41\xc1\x39\xf6\x69\x63\x13\x08\x41\x84\x7c\xc0\xe8\x8c\x46\x7c\x4c\x18\xc7\xdc\xe2\x70\xfe\xfd\xe2\x7d\x56\xb7\xdb\xab\x58\x7b\x45\xb7\xc5\x77\xf5\x36\x78\x1c\x0b\xfa\x1f\x54\x12\x91\x62\xc6\xd8\x83\x2a\xdf\x4d\x2e\xf4\xe3\xec\x20\xa2\xec\x85\xf4\xcd\xb9\x12\xda\x7d\x9c\x2d\x74\x04\x73\xf4\x5f\xfe\xe4\x02\xa8\x36\xdd\x64\x06\xf7\x6d\x16\x27\x02\xdd\x83\xca\x43\x22\xfb\xbc\x4b\xb2\x3f\x13\xb7\xbd\xdd\x22\xd2\x92\xf9\xae\xd4\x26\xfe\x8a\x14\x69\x91\x64\x1f\x3f\x4d\xf5\xd9\xe4\xf9\xa3\x14\xf6\x15\x5a\x87\xe5\x65\x49\x31\xc3\xad\x16\x22\xe7\x01\x00\x8b\x25\xcc\x4d\xd7\x00\x00\x00"),
           path: "makefile.tml",
           root: "makefile.tml",
         },
       
         "mongo-api-backend.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x92\x41\x0b\x9b\x40\x10\x85\xef\xfe\x8a\x39\x26\x20\xfa\x17\x5a\x23\x0d\xbd\x34\x81\xd2\x53\x29\x65\x5d\x5f\x74\xeb\xba\xbb\xec\x8e\x69\x24\xf8\xdf\x8b\x31\x24\x52\xb4\x90\xf4\x24\xce\xce\x9b\xf7\xbe\x61\xd2\x94\xae\xd7\xe4\x2b\xfb\x4e\x72\x72\x28\x7e\x41\x72\xf2\x45\xb4\x18\x86\x3c\xcb\x84\x6c\x60\x4a\x2a\x71\x52\x06\x81\x04\x15\xf7\xca\xef\x5a\xc9\x9a\x3c\x9c\x47\x80\xe1\x40\x5c\x83\x2a\x75\x56\xa6\x8a\xd2\x94\x5a\x70\x6d\xcb\x40\xb8\x38\x1b\x50\x52\xd1\xdf\x1a\xf2\x8c\x54\xeb\x34\x5a\x18\x16\xac\xac\xa1\x93\xf5\x33\x29\x71\xef\xb0\x16\x27\x19\x07\x7f\x78\xe8\x7f\xb6\x56\x36\xd1\xbf\x04\xcf\xfc\xca\x30\xfc\x49\x48\x5c\x23\xa2\x9d\xed\x0c\x6f\x24\x5f\x48\x5a\xc3\xb8\x70\xb2\x9b\xbe\x5b\xda\x28\xc3\x31\xc1\x7b\xeb\xb7\x11\x51\x0e\x0d\xc6\x52\x6b\x4c\xae\x2b\xb4\x92\x9f\x73\x0a\xec\x95\xa9\xb6\x93\x6a\x1c\xef\x21\xd6\x44\xd0\x68\x67\x69\x8f\x42\x36\xa2\x1a\xd1\x56\x08\xee\x53\x29\x22\xda\x63\x31\xf3\x42\x10\xda\xbc\xe0\x10\xd3\x13\xf7\x9b\x2b\x57\x93\xff\xe5\xf2\x36\xca\x44\xf2\x51\xeb\xac\x3f\xf8\x12\x7e\xd9\xcd\x8e\x4f\x0f\xab\xdb\x5f\xd6\xcf\x00\xbf\xff\x78\x13\x71\x0f\xce\xfa\x4f\x0a\xba\x5c\x36\x6e\xd0\x3f\x6c\xcf\x42\x77\x98\x9d\xce\xf0\x1f\xab\x9d\x98\x5f\x87\x8d\xc9\x89\xea\x16\x22\x26\x8f\xe0\xac\x09\x38\xc2\x1f\xef\xc5\x77\x76\x31\x3f\xf1\x21\xfa\x13\x00\x00\xff\xff\x29\x26\x1a\x09\xfe\x03\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xc1\x8e\x9b\x30\x10\x86\xef\x3c\xc5\x1c\x13\x09\xc1\x2b\xb4\x04\x35\xea\xa5\x89\xd4\xf6\x54\x55\x95\x31\x7f\xc0\x8d\xb1\x2d\x7b\xc8\x06\x45\xbc\xfb\x8a\xc0\x26\x91\x16\x56\xd9\x13\x9a\x61\xbe\x7f\xbe\x11\xa4\x29\x5d\x2e\xc9\x4f\xf6\xad\xe4\x64\x57\xfc\x87\xe4\xe4\x87\x68\xd0\xf7\x79\x96\x09\x79\x84\x29\xa9\xc4\x41\x19\x04\x12\x54\x4c\x9d\x97\x5a\xc9\x9a\x3c\x9c\x47\x80\xe1\x40\x5c\x83\x2a\x75\x52\xa6\x8a\xd2\x94\x1a\x70\x6d\xcb\x40\x38\x3b\x1b\x50\x52\xd1\x5d\x07\xf2\x8c\x54\xe3\x34\x1a\x18\x16\xac\xac\xa1\x83\xf5\x0f\x28\x71\xe7\xb0\xa4\x93\x0c\xc1\x5f\x6e\xfc\xbf\xc6\xca\x63\xf4\x11\x70\xf7\x57\x86\xe1\x0f\x42\xe2\x12\x11\x6d\x6c\x6b\x78\x25\xf9\x4c\xd2\x1a\xc6\x99\x93\xcd\xf8\x5c\xd3\x4a\x19\x8e\x09\xde\x5b\xbf\x8e\x88\x72\x68\x30\xe6\x46\x63\x72\x6d\xa1\x95\xfc\x9e\x53\x60\xaf\x4c\xb5\x1e\xa9\x21\xde\x43\x2c\x41\xd0\x68\x06\xdb\x5f\x9d\x43\xdf\x4f\x0c\x45\x44\x5b\xf0\x93\x6b\x68\x75\xe3\x63\xba\xab\xfe\x76\xa5\x78\x52\x75\x41\x63\xb4\xf8\xaa\x75\xd6\xed\x7c\x09\x3f\x9f\x65\x87\x57\xb7\xa0\x6b\x95\x75\x53\x3d\xc8\xfd\xf9\x3b\xab\xb7\x05\x67\xdd\x37\x05\x5d\xce\xc7\x1e\xf1\x16\x12\xd3\x49\xe8\x16\x0f\x9f\xac\x5f\x3c\x7a\xf4\xfd\xbc\x68\x4c\x4e\x54\xd7\x15\x31\x79\x04\x67\x4d\xc0\x1e\x7e\x3f\x35\xdf\xdf\xf1\xf8\x5b\xf4\xd1\xeb\x00\x68\x10\x9e\xa8\x32\x03\x00\x00"),
           path: "mongo-api-backend.tml",
           root: "mongo-api-backend.tml",
         },
       
+        "mongo-api-cache-test.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\x51\x73\xdb\xb6\x0f\x7f\xf7\xa7\x40\xdc\x4b\x4f\x4a\x55\xd5\x4e\xfe\xff\x3d\x38\xf5\xc3\x96\xb4\x5d\x6f\x6d\xb7\x6b\xda\xdb\x43\x2f\xb7\xa3\x29\x48\xe6\x22\x8b\x0e\x49\xc5\xf1\xb9\xfa\xee\x3b\x50\x94\x22\xd9\x72\x1c\x67\xd9\x4b\xdb\x5c\x62\x93\x20\x7e\x3f\x00\x04\x08\xbc\x7a\x05\x5c\xe6\x99\x11\x59\xf2\x0b\xe3\x57\x98\x45\xb0\x50\x6c\xae\x81\xc1\x0c\x67\x52\x2d\xdd\x6a\x50\x8b\x01\x67\x69\xaa\x41\xc6\xf0\x0e\x4d\x00\x8b\xa9\xe0\x53\x58\x30\x61\x20\x96\x0a\x12\x66\x10\x44\x0c\x1a\x4d\xd8\x33\xcb\x39\x6e\xa8\xd7\x46\xe5\xdc\xc0\xaa\x07\x00\x70\xd4\x02\xb1\x4b\x09\x1a\x0d\x22\x33\x3f\xfd\xaf\xfc\x4a\x0a\xf9\x94\x65\xee\xe0\xaa\xe8\x15\xbd\x5e\x9c\x67\x1c\x3c\x3e\x81\xa3\x35\xf5\x3e\xb1\xf2\xb8\xb9\x05\x2e\x33\x83\xb7\x26\x3c\x2b\xff\x06\x30\xcf\x27\xa9\xe0\xef\xcf\x49\x91\xc8\x12\x1f\xbc\xd5\x2a\xfc\xb2\x9c\x63\x51\x04\x80\x4a\x49\xe5\x3b\x56\xcc\xc8\x99\xe0\xe1\xcf\x51\xf4\x9e\x78\x78\xcf\xf9\x24\x24\x5a\x01\x0c\x7d\x2b\x20\x62\xa0\x25\xa2\x76\x30\x86\x4c\xa4\xee\x20\xfd\xbc\x7e\xe9\xb6\xec\x4a\xd1\xb3\x7f\x14\x9a\x5c\x65\x74\xa8\x65\x70\xe8\xc8\xde\x91\xf3\x6b\xeb\xbe\xa0\x36\x67\x8c\x4f\x31\x22\x21\x03\x47\x06\x35\x19\x1a\x7e\xa9\x68\x4e\x9c\x47\x47\x63\x78\xbe\xe6\x86\x55\x0b\x66\x04\x19\x2e\x3e\x36\x57\x3c\xbf\xb0\xbc\xa2\x09\x8c\xc6\xb0\x5a\x85\x7f\x30\x7e\xc5\x12\x2c\x8a\xf0\x13\x2e\x4a\x58\xcf\xe9\x0f\x36\xf6\x3f\x7c\xfe\xea\x0d\x07\x01\x0c\xfc\x00\x66\x68\x94\xe0\x9a\x8e\x79\xbe\x5f\x5a\x4b\xee\x1f\x8d\xeb\x08\x10\x8b\x44\xc9\x9c\x60\xed\x3e\xa6\x38\x23\xdc\x58\xdc\x9a\x5c\xa1\x0e\x3f\xb3\x2c\x92\xb3\xd5\x2a\xbc\xb0\x31\x0e\x7f\x9f\xfc\x8d\xdc\x84\x9f\xd8\x0c\xed\xaf\xa2\xd0\xde\xd0\xff\x36\xb8\xec\x55\xee\x47\xa5\x48\x43\x34\x09\xcf\x14\x32\x83\x14\xf2\xc0\x2a\xf6\x4f\x29\x98\x9b\x71\x31\xe1\x5b\x66\x58\x1a\x7b\xfd\x98\x89\x14\x23\x30\x12\xb8\x3d\x0b\x0a\xb9\x54\xd1\x08\x0e\x5f\x5c\xf7\xed\x55\xf0\x9b\xb1\xa3\x6b\x2d\x08\x6c\x70\x0a\x02\x5e\xc3\xf1\x29\x88\x17\x2f\x1a\x9a\xcb\xe3\x41\x83\x53\x1d\x56\x22\x14\xae\x56\xe1\x67\x2b\x12\xfe\x86\xcb\xa2\xf0\xeb\x83\x22\xee\xa6\xba\x8d\x6e\x82\x66\x1b\xd7\x06\x5f\xa7\xb9\x14\x5c\x07\x27\xb0\x2e\x52\x5b\xd1\xf1\x76\x8e\xdc\x60\xe4\x80\xe1\xf0\x3a\x80\x44\x1a\x38\xbc\xee\x77\x9b\x17\x38\xc9\xed\x66\x17\x7b\x79\x57\xc4\xf0\x57\xa7\x6f\xfb\x33\xa1\xb5\xc8\x92\xfe\x5d\xc4\x5b\x17\xf5\x8d\x52\x9f\xa4\x79\x4b\xf7\x6e\xb7\x75\x4d\x61\x0a\xb8\xd3\xed\x6c\x71\x26\x77\xb9\xbc\x61\x8a\x88\x81\xaa\x04\x19\xe3\x2a\xc8\x07\xc9\xaa\x12\xe2\x92\xc9\xd6\x11\xff\xb4\x14\x3c\x18\xc3\x49\x83\x5a\x07\xad\x93\x3a\xc9\x53\x29\xaf\xf2\xb9\x0e\x40\x66\x68\x9d\x66\xa6\xe8\xd8\x01\xcb\x22\x30\x0b\x59\x2f\x77\x92\x8f\xfa\x81\x45\x6d\xdd\x6d\xaa\xd4\x86\x95\x9c\xa3\x49\x78\x41\x9f\x3d\xff\xb4\x5c\x0c\x7f\x15\x25\xc9\x21\x7c\xff\xee\x96\x3e\x0a\xad\xf1\x21\xcc\x87\x30\x15\xc6\x32\x3b\xb1\x7c\x50\x3b\x1e\xcf\x6e\xfa\x41\xa9\xac\x62\xb2\x59\xf1\xde\x67\x37\x2c\x15\x11\x33\x42\x66\x3f\x5e\xe9\xd3\x7b\xd7\xbe\x63\xff\x01\x85\x4f\x7f\x1b\x5c\x3e\x79\xf1\xdb\x9a\x7d\x15\xe2\x46\x9a\xef\xc1\xe0\x9e\x7a\xe6\xe0\xf3\x79\x44\x05\x7a\x34\x76\x16\x0e\x2f\x1b\xcb\xeb\xd0\x30\xde\xca\xaa\xc3\x7d\x5f\xe7\xd1\x86\xfb\xd6\x8f\x55\x3b\xc3\xbd\x1c\xeb\x48\xdf\x6f\x59\x95\x9b\xfb\xb8\xb6\x69\xc4\x83\x1d\x5c\xb2\x89\x76\xd0\x11\x31\x1c\x28\x8c\x53\x7a\x76\xcf\x11\xe7\x6f\xae\x73\x96\x7a\x15\xc9\x52\x87\xdf\x89\x56\x67\x7c\x1b\x08\x0e\x9f\xdd\x34\x33\xbe\xdc\xad\x1e\x87\x75\xec\x3b\x2f\x9c\x63\x8a\xbb\xc2\xb2\x4f\x30\x22\xab\x6f\xb7\xf5\x8f\xbd\xe5\x0f\x78\x72\x1e\xf0\xdc\x94\x2c\x2b\xdf\x39\xbf\x6d\xa3\xfa\xd4\x8f\x0c\x5b\x7b\x64\x80\xc5\x06\x15\xe0\x0d\xaa\x25\x2c\x94\xa0\xb0\x59\x42\xf5\x2b\xb4\xf6\x94\x6c\x16\xf0\x33\xc9\x52\xd4\x5c\x64\xc9\xd3\x97\xef\xc0\xce\x04\x23\x98\xb1\x2b\xf4\x5a\x93\xc1\x0f\xd2\xd4\x3a\x8a\xff\x7d\x67\xcb\x65\xa6\x4d\x15\x53\x18\xc3\x70\x50\xae\xdf\x30\x05\x8b\x04\xf4\x32\xe3\xe1\x9f\x4c\x98\x77\x4a\xe6\x73\xbb\x83\x4a\xd9\xd7\xeb\xce\xf7\x76\x70\x0a\x2a\x25\x7e\x77\x53\xe7\x76\xd7\x5b\xbb\x45\x42\x33\x96\xe7\x86\x2a\xfa\x49\x24\xd0\xf0\xe3\x35\x4b\x0d\xfd\x8f\x30\x46\xe2\x14\x9e\xcb\x0c\x3d\xbf\xd7\xda\xbc\x37\x7b\x1f\x5a\x3b\xaa\x7f\xd6\xc2\xd7\x2f\x49\xa8\xb5\x57\xd4\xdf\x0a\xaf\xe5\xc4\x08\x59\x94\x8a\xcc\x3e\x52\x46\xd0\xc0\x22\x17\x9e\x6f\x0d\xfb\x3f\x1c\x95\x4b\x17\xc8\x65\xe6\x8a\x1e\x35\x69\x77\xad\x56\xe8\x32\x05\x23\xa2\xe4\xfc\xf4\x72\xd8\x20\x26\xe2\x96\x5a\x4a\x4d\xaf\xc2\x5c\x77\x53\x47\x72\x1f\x46\xc0\x6b\x08\xa7\xdf\xa5\xb3\xed\xc4\x6a\xc8\xa0\xc1\xaa\xd9\xe0\xd6\x1f\x2d\x8b\x8b\x14\x71\xee\xd9\x8f\x1f\x45\x9a\x0a\xdd\xb0\xcc\xc9\xf2\x54\x6a\xac\xf2\xcc\x4e\xc2\xa5\xba\x45\x62\x2f\x93\xf3\x5e\x29\x45\xde\x6e\xdc\x19\x17\x43\xc5\xb2\x04\x29\x02\x7a\xc7\x2d\xdf\xdd\x40\x3c\xa6\x5c\x0e\x3b\x51\x1b\xe5\x92\xa6\x8e\x14\xd7\xaa\xe6\x96\x36\xbb\x59\x1b\xa9\x79\xec\x2a\x87\x8f\x6b\x0a\x4f\x9c\xe3\x52\x95\x77\xd5\x3b\x02\x3b\xa6\x46\xb5\x12\x0a\x2f\xd0\x78\x7d\xe6\xc6\x36\x7a\xd5\xd6\xb6\x26\xf5\xd6\xf0\xd2\xe9\x2e\x53\x4b\x5e\x11\x00\xc9\x51\x6a\xf5\x19\x4d\x5c\x07\xf2\xea\x7e\x37\x55\xb3\x09\x3d\xc2\x13\x84\x29\xa6\x51\xbf\x15\x9a\x1a\x97\xd7\xb8\xc7\xf7\xe2\x4e\x08\x77\x17\x6c\x8a\x4c\xdb\x4b\x81\x99\x49\x97\x90\xeb\x3b\x2a\x13\x47\x05\x6f\x04\x05\xb2\xcd\xe6\xdf\x59\xba\x09\x76\xbf\xdd\xae\xcb\x21\x80\x1d\xe8\x7b\xba\xd9\x35\x12\x6d\x44\xbc\x9d\x0b\x45\x03\xe1\xf6\x6b\x32\x1c\x1c\x75\x27\x75\x75\x76\xdb\xed\x69\x54\x85\xe3\x41\x55\xef\x5a\x5a\x36\x0c\xac\x55\x3e\xce\x4a\x7b\x1c\xfb\x7e\x0f\x00\xa0\xe8\x15\xbd\x7f\x06\x00\x9f\x22\x48\x25\xc4\x14\x00\x00"),
+          path: "mongo-api-cache-test.tml",
+          root: "mongo-api-cache-test.tml",
+        },
+      
+        "mongo-api-cache.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5d\x8f\xdb\xb8\xd5\xbe\xf7\xaf\x38\xef\x5e\x0c\xa4\xac\x5e\x65\x03\x14\xbd\x70\x77\x16\xd8\x4c\xb2\xe9\xa2\x93\x6c\x90\x8f\xde\x04\x41\xc1\x91\x8e\xc7\x5c\xd3\xa4\x41\x52\x9e\x71\x0d\xff\xf7\xe2\x90\x87\x94\xe4\x8f\x59\xbb\x48\x8b\x02\xbb\x19\x8b\xa2\xce\xc7\xf3\x1c\x1e\x3e\x94\x9e\x3f\x87\x1b\xd1\xcc\x11\x5a\x9c\x49\x8d\x0e\x04\x34\xe1\xda\xcc\x60\xbb\xad\x3f\x6d\x56\xb8\xdb\x81\xc5\xc6\xd8\xd6\xc1\x02\x37\xd8\xc2\xdd\x06\xfc\x1c\xa5\xa5\x09\x1f\xc2\x9d\xfa\x6f\xb8\xd9\xed\x2a\xe8\x5c\xb8\x3d\x49\x56\xdb\xed\xb6\xfe\xe8\x6d\xd7\xf8\xfa\xb7\xbb\xdf\xb1\xf1\xf5\x3b\xb1\xc4\xf0\xcf\x6e\xf7\xea\x65\x0d\xbf\x2e\x57\x0a\x97\xa8\xbd\xf0\xd2\x68\x07\xcb\xce\x79\xb8\x43\x70\x62\x86\x30\x33\x16\x1a\xa3\x9b\xce\x5a\xd4\x9e\x8c\xd7\x13\xbf\x59\x21\x47\x2c\xb5\x47\x3b\x13\x0d\xc2\x76\x02\x00\xf0\x06\x7d\xb1\xea\xee\x94\x6c\x7e\x7d\x05\xce\x5b\xa9\xef\x4b\x28\x72\x12\x15\xdc\x19\xa3\xca\x30\xf5\xe3\xe1\xd4\x0a\x50\xe1\xb2\xcf\x39\x4e\x7c\x85\x0a\x3d\x1e\x98\x9d\xec\x26\x94\xe3\xed\x87\xcf\x20\x53\x06\x2e\x86\x55\xc1\xdc\xa8\x56\xea\x7b\xe8\x56\xe0\x0d\x08\x98\xc9\x47\x6c\xa1\x31\x9d\xf6\x60\x66\x09\xcb\x0a\x70\x2d\x1b\x4f\x13\xfd\x1c\x41\xa1\x70\x9e\xee\xa1\xf6\x6a\x43\xc6\x03\x96\x71\x32\x3c\xcc\x51\xc3\xac\x53\x0a\x84\x6e\x01\x1f\x57\x92\xc2\xc8\xb4\x18\xdd\x20\x51\xb2\x01\x61\x11\x8c\x6a\xd1\x82\x9f\x0b\x0d\xd2\x3b\xf0\x5e\x31\x6c\x14\xae\x0b\x6c\x30\x62\x4e\xfe\x13\x41\x6a\x1f\x2e\xbc\x57\xe0\xe5\x12\xeb\x57\x9d\x0d\x6c\x84\xd1\xa5\x02\xb7\xd1\x4d\xfd\xb6\xf3\xf8\x18\x46\x8c\x25\xf3\xcf\x94\x74\xbe\xbe\x95\x2e\x3e\x8c\xda\x5b\x89\x0e\x96\x62\xf5\x25\x62\xf4\x35\xce\x78\x1d\xc1\x61\xc0\x94\xed\x5e\x6b\x6f\x37\x83\x6a\xe3\x0c\xe7\xa8\xa8\x72\x40\x84\x28\x1f\xa4\x9f\x53\x42\x21\x20\x90\x3e\xa6\x4c\xf3\x3d\xe7\x92\x2d\x8d\x12\x5a\xe0\x86\x29\x0a\x97\x63\x46\xe3\x10\x1b\x22\xc3\xf5\x27\xb9\x44\x8e\xec\x1d\x3e\x90\x63\x8b\xbe\xb3\x9a\x56\x81\xc6\x87\x10\xca\x98\xcd\x80\x58\x66\x50\x78\xe6\xed\x45\x15\x8a\x95\x22\xbe\x97\x6b\x9a\x4e\xa8\x43\x5c\x1c\x8e\xd8\xd4\xb8\x46\xcb\xde\x41\xce\x88\x15\x90\x0e\x7e\xa8\x27\xb3\x4e\x37\xec\xbe\x48\x84\x54\x87\x6c\x94\xf0\x8c\xc2\x89\xc4\xc9\x19\x84\xa9\x3f\xc2\x0b\x1e\xc9\x74\x5e\xc3\x8b\x30\xb0\x9b\x84\x3f\x31\x21\xb8\xba\xfd\xf0\x79\x3c\x71\x1a\x2c\x54\x79\xcc\x7b\x35\x25\xaf\xfd\x48\x20\x7a\x0a\x81\xc6\x77\xf8\x50\x94\xfd\x2d\xa6\x7b\x0a\x4b\xb1\xc0\xe2\x14\xe9\x55\x70\xc1\x8f\xed\x18\xe8\x37\xe8\x33\xca\x84\x17\xf3\x6f\x66\x43\xf4\x16\xb8\xa9\x08\xa5\x50\x15\x54\xf2\xda\xa4\x1a\x68\x19\xb1\x42\x05\x40\xca\x33\x97\x3d\xc3\xa4\xea\xa5\xaa\x6f\x4d\xb3\x28\xe2\xfa\x6e\x71\x86\x36\x8e\x7e\xd6\x2a\x8e\x87\x1b\xd2\xe3\xb2\x02\xb3\x80\xe9\x35\xa8\x9a\xf3\xfd\x92\xfc\x7c\x4d\x2c\xfc\x9f\x59\x0c\x08\x60\xb0\xb3\xef\xed\xae\x82\x99\x50\x0e\x87\x8c\x90\xad\x0d\x99\x25\x17\xf5\xdf\x85\xea\xb0\x2e\x9e\xa5\x7a\x2e\x93\x65\x55\x53\x09\xfc\x04\x3f\xc0\xd5\x55\xac\x84\x77\xe6\xa1\x28\xeb\x9f\x67\x1e\x6d\x11\x8c\xd4\x5c\xcc\xe5\x20\x04\x55\x07\xda\xea\x0f\xb8\x34\x6b\x2c\xc8\x47\x99\x6f\xb6\xb1\x9b\xe5\x7c\x2a\x48\x09\x95\x97\xe5\x90\xbc\xbc\x35\x6b\xfc\x64\x7e\xb1\x46\xfb\x81\x2b\x36\xc1\x31\x2a\x02\xd2\xdb\x2e\xad\xb4\x8f\xe8\x41\xb4\xed\x1f\xb1\xff\x44\x77\x1c\xb5\x46\x39\x0b\x8d\x71\xbf\x2a\xce\xe9\xf0\x97\xd6\x44\xea\x1d\xd3\xeb\x11\x21\x6d\x5b\x04\xb2\x52\xe5\xcc\xfe\xa8\x78\xfe\x02\xa3\xaa\x39\xa7\x20\xf2\x3c\xc6\x93\x7f\x73\x40\xd7\xc0\xa3\xf1\xfa\xa0\x18\x8e\xd3\xd4\x53\x35\xa6\xf6\x20\x5e\xb8\xce\x96\xde\x77\x6e\x1e\xed\x5c\xa5\x00\xb7\x0b\xdc\x4c\x73\x21\xc5\x6d\x74\x3a\x0e\x68\x9a\x7e\xec\x7a\x8c\x92\xc5\x5b\xd4\x45\x09\x3f\x81\xaa\xa9\x59\x0c\x60\xa1\x6d\xcc\xf9\x08\x61\x9c\xfa\x52\x64\x8e\x8e\x94\x7a\x9c\xff\x54\xb1\xc7\x19\x87\x08\xd7\x0b\xdc\x94\xa3\x26\x15\xb7\x7d\xb0\x61\x11\x3d\x5d\xa9\xfb\x85\x77\x42\x31\x5c\x5a\x6b\x17\x57\xd1\x1e\x1a\x97\x2d\xfc\x94\x77\x50\x30\x1f\xbd\xf0\x2e\xef\xd0\x94\x6d\xd0\x2e\x8e\x72\x57\xc6\x2c\xba\x95\x03\x87\x76\x8d\xbc\x61\x9f\xa5\xf4\xc8\xfa\x5b\xe9\x1c\x3a\x90\xba\x51\x5d\x8b\x70\x63\x84\x42\xd7\x60\x5b\x05\x80\x97\xf1\x6e\x6f\x99\x06\xef\x44\xb3\x40\xdd\xb2\x5f\x8a\x40\x0c\x25\x21\x3d\xc3\x82\x60\x10\xfa\x48\x12\xfc\x95\x34\x90\xd4\xfe\xcf\x7f\x0a\x97\x39\x86\x34\x90\xa3\xe0\xb1\xdd\xe4\x5c\xf5\x3a\x94\x7e\xdb\x6d\xa8\x4e\xd4\x2d\xed\x38\x94\x03\x6d\x63\xb4\xd7\xcd\xac\x59\x92\x0e\x23\xab\x51\x59\xd3\x7e\x26\x94\x02\xe3\xe7\x68\x61\x89\x7e\x6e\x5a\x97\xe7\xa5\x94\xb3\x7a\x08\x9a\x2e\x3c\xc9\x3a\xd0\xa2\x68\xc1\xcf\xad\xe9\xee\xe7\xb4\xfb\x91\x26\x24\xeb\x52\xaf\x85\x92\xad\xf0\x11\xbe\x1b\x8b\xc2\x63\x05\x9f\x57\x34\x44\x93\xb8\x36\x2b\x78\x98\x4b\x85\xfb\x38\xa2\x8b\xf0\x72\xa1\xbb\x39\xf9\x15\xe0\xa4\xbe\x57\x48\xf6\x7b\x0e\x06\xcc\xd0\xe6\x20\x15\x25\x2b\x94\xd1\xf7\x51\xb9\xc9\xa4\xd2\xce\x82\x71\x44\xd7\x7c\x4c\xd7\x72\x9f\xae\x66\x8f\xae\x30\xc8\xd0\x8f\x58\x08\x37\x82\xff\xa8\xcb\xc3\xf5\x5b\xf4\x56\x36\x8e\x40\xa7\xbf\x35\x5f\x4f\x4e\x48\xdd\x35\x5a\x27\x8d\x86\xae\xf7\xaf\x8c\x68\xc7\x32\x37\x50\x73\x6b\x44\x4b\x6b\x68\x2d\x2c\xfc\x63\x14\x07\x5c\x43\xf1\xec\x1c\x1c\xca\x42\x4b\x55\x4e\x72\x9d\x90\xc9\x81\x4e\x3e\x8a\x7e\x64\x29\xd0\xfd\x24\x9b\x4c\x47\x6f\x77\x84\x79\x6b\x34\x42\x43\xe7\x85\x38\xbc\xdd\x9d\x54\xcf\xd6\xd2\xff\xc6\x72\xbf\x78\x87\x0f\x31\xb5\x3d\xe1\x7c\x1e\xef\xbc\x4a\xfa\xee\xea\x38\x3d\xc2\x80\x3b\x6c\x4a\x34\xd5\xfb\xa0\xf9\x86\x6c\xb8\xfd\xe6\x40\x8a\xbb\x23\xb5\x50\xf1\xca\xe3\x03\xda\x72\x9f\xff\x12\xce\x62\x08\xb6\x43\x79\x73\x75\xce\x23\x7d\x7f\xe6\x60\xa6\x79\xe1\xe4\x3b\xc1\xce\x34\x86\xd8\x4b\x6c\x0e\x6d\x0a\xcb\x7e\x2c\x14\xdf\x11\xd1\x9d\x79\xdd\xd3\xda\xb1\x93\x0f\xd5\xf6\x53\x9d\xfc\x0d\xfa\xb4\x99\x35\xe7\x01\x52\x46\x0f\x45\x39\xdc\x38\x46\x20\xf5\xe3\x3d\x12\xd4\x91\xa7\x20\xbc\x59\xca\xa6\xa6\x2a\xff\x95\x56\x57\x71\xd5\xd4\xb4\xf8\x39\x85\xbe\x57\x1f\x9f\x1a\x1b\xc3\x60\x72\xee\xe3\xc7\xe7\xe7\xbe\xb1\x07\xd1\x59\xc7\x91\xb4\x59\xc6\x26\x4d\x37\x02\xe2\xa4\x75\x1c\xf6\x83\x89\xd9\x40\x25\x9d\x41\xa9\x29\xfb\x3a\xed\x7b\xbf\x1b\xa9\xb3\xa0\xcd\xab\x59\x68\xde\x07\xa4\x4b\x54\xc1\x6b\x6b\x5f\x07\xc1\xd4\xde\x18\xed\xf1\xd1\x93\x60\x8a\xaf\x5c\x9a\x38\x40\x2b\x84\x35\x15\xdc\xe1\xcc\xd8\x70\x4a\x6e\x0c\xed\x48\x1e\xdd\xc5\x3c\xd2\x21\xaa\xf1\x8f\xc9\x7c\xcd\x7e\x2b\x38\xd0\x31\xc3\x93\x55\x68\x05\x49\x46\xc7\xa3\x54\x93\xd6\x54\x7d\x63\x94\xc2\xc6\xf3\x65\xf1\xdd\x39\x81\xd4\x6f\xd0\x7f\xd7\x2b\x20\x54\xbd\x02\x6a\xea\x60\xa0\x1e\x1e\xf7\xca\x3d\x0d\xc4\xc4\xff\xdc\xf6\xbc\x53\x49\x55\xf0\xa2\x97\x42\x0c\x71\x34\xad\xa5\xe2\x6a\x98\x9c\x78\x3e\xd6\x59\xb0\x10\xa6\x34\x7b\x02\x8e\x4e\x6a\x46\xb4\x7d\x94\x61\x8d\x9e\x54\x69\x47\x3c\xe4\xca\x1c\x85\xd9\x1c\x4a\x42\xfa\xcf\x21\x61\x3a\x30\xd8\x08\x87\xf0\xe3\xff\x93\xd7\x9a\xfa\xf8\x34\xdf\x19\x24\x1b\xee\xc6\x8c\xe3\x4f\x6b\xf7\x0d\x34\xfe\xb1\x7e\x65\x34\x16\xe5\x51\x0b\x99\x74\x3a\x0e\x1e\x94\x67\x7e\x62\x37\x44\x93\x5c\x11\x26\x57\xb9\x3d\x6d\x43\x84\xb1\x7f\x8d\xf6\x9b\x72\xc7\xe0\xee\xa1\x47\x27\x0f\xda\x59\x87\x9b\x71\x40\x99\x2f\x26\x27\xa0\x3a\x92\x30\xd0\x63\xdc\x84\x6b\x2e\xf7\xa1\x0c\x0e\xa6\x9e\x3f\x1f\xa9\x2e\xa3\xd5\x26\x49\x2f\x39\x03\x6d\xe0\xc1\x4a\x8f\x23\xa5\x95\x76\x2e\x27\xf9\x25\x5c\x5a\xdb\xce\x0b\xeb\x49\xdc\x0a\x97\x6c\x4b\x0f\x4b\xb1\x81\xb9\x58\x63\x52\x71\xb9\xe3\xf0\x2a\x26\x03\xc1\x49\xfd\x54\xb9\x11\x83\x70\x7d\x0d\x5a\x2a\x7a\x3d\x90\xf1\xa0\xb1\xf4\x73\x50\x23\xbc\x74\x86\x67\xe2\x04\x8c\x4a\xc7\x04\x26\x4d\xce\x8e\xb1\x10\x69\x80\xed\xfe\x81\x82\xa7\x0e\x71\xec\xcb\xe0\x08\x2f\x8d\x32\x0e\x8b\x5c\xac\xa3\xd7\x05\x47\x38\xe3\x1e\x1d\x95\x2c\x34\xe1\x8f\x1b\x36\x66\xc6\x6e\x28\x0e\x72\x13\xce\x24\x51\xcf\x25\x55\xc9\x44\x26\x45\x74\x61\x87\x8c\x41\x1c\x6f\x92\xfb\xef\x15\x42\x5f\xfc\x96\x6d\x31\x3a\x4f\x9d\x91\xc8\x9f\x0e\xcb\xb9\x8f\x2d\xc6\x52\x32\xfe\x7d\x9d\x16\x34\x5c\xef\xbd\xaf\x1f\xbf\xad\xc9\x70\xf3\x81\xa1\x0b\x7f\xce\xd9\x15\xcf\x82\x3f\xeb\xda\x7f\x9b\x81\x18\xd7\x59\xdb\xd4\x7f\x81\x92\x18\xcd\x49\x4a\xfa\x60\xfb\xe0\x4e\x92\x93\x26\x9c\x20\x84\xdf\x46\xc4\x43\xfc\xff\x10\x21\xfc\xaa\xe3\x1c\x42\xfe\x03\x04\x44\xef\x27\x09\xe8\x83\xeb\x83\xb9\x1c\xfa\x1b\xd2\xcb\x87\x0a\x7a\xf0\x1d\xe7\x40\xfe\x5d\x0c\x63\xf0\x71\xac\xac\x4b\x28\xa4\xf6\x7b\x32\x8b\x43\xec\x13\xcd\x8f\x97\x1c\xf3\x1b\xf4\x3f\x2b\x95\x83\x16\xb0\x12\xf7\xf8\x4d\x23\x8e\x1e\x8e\xaf\xc4\xf0\x16\x2a\x2f\xc3\x70\xf5\x32\x7d\x94\xa9\x62\x28\x21\x29\x8b\x6e\x65\xb4\xc3\xf7\x68\xdf\xf3\x60\x09\xc5\x97\xaf\x79\xcd\x56\x70\x46\xf2\x7d\x24\xec\x2b\xbb\x8c\xbe\x0e\xfc\x8c\x41\x7a\xb9\xf9\x8d\x02\xec\xb1\x52\xaa\x3f\x8a\xd2\x1d\x6c\x41\xb8\xb4\xbe\xbe\x11\x6e\xec\xf4\x72\xf8\xf6\xf1\x39\x07\x9a\x81\x33\x36\x97\xad\x0e\xa0\x78\xb9\xf9\x45\xd2\xa7\x96\x84\xc3\xa0\xc5\x3c\xcc\x0d\x9d\x71\xc2\x6d\xfa\xbc\x46\xdf\xc3\x1c\xac\xe9\x1d\xea\x37\x01\x84\x5d\x1f\x47\xa3\xff\x9e\x57\xb1\xcb\xfc\xd1\x77\xbb\x7b\xe2\x2c\x72\x0c\x8c\x81\xa3\x60\x98\x2d\x26\x14\xfa\x9e\x30\x7a\xed\x3b\xea\x90\x27\xfa\x6d\x15\x5e\xae\x39\x6f\x56\x8e\x27\xba\x20\x02\xb1\x85\x6e\x45\xb6\x59\xdb\x05\xb8\xee\x30\xbf\xbb\x68\xc3\x73\xe9\x20\x2e\x35\xac\xac\xb9\xb7\xe8\x78\x85\xc6\x99\x74\x6a\xc4\xcb\x91\x3d\xd2\xe2\x72\x15\x6d\x8f\x4a\xcb\xd4\x94\x0f\x45\x5b\x52\x97\xdf\x7f\xcf\xd7\x81\xdc\xd4\x61\x93\xf9\x72\xf2\xb4\x30\xdc\x4d\xfe\x35\x00\x32\x1d\xab\x1d\x6c\x20\x00\x00"),
+          path: "mongo-api-cache.tml",
+          root: "mongo-api-cache.tml",
+        },
+      
+        "mongo-api-document-test.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\x3d\x6f\xdb\x30\x10\xdd\xf5\x2b\x2e\x0e\x1c\x48\xad\x40\x4f\x5d\x1c\x78\x4b\xbb\x14\xfd\x80\xeb\x2e\x5d\x0a\x5a\x3c\x29\x6c\x24\xd2\x39\x51\x46\x5a\x83\xff\xbd\x20\x45\xda\x8e\x6a\x35\xae\x87\x22\x81\x04\xeb\x74\xef\xbd\xbb\xf7\xa8\xd9\x0c\x5a\xa3\x09\xef\x74\xd1\x35\xa8\x0c\x10\x9a\x8e\x54\x0b\xe6\x1e\xa1\x92\x5b\xa9\x2a\x10\xb1\xc6\x5b\x20\xe4\x02\xd6\xbc\x78\x80\x92\x74\x03\x8d\x56\x95\x16\xeb\x1c\x50\x15\x5a\xb8\x97\xa5\x01\xa9\x8c\x86\x75\xab\x55\x32\x9b\x01\x57\x02\x04\x1e\x8a\xbc\xe2\x52\xb1\xa4\xec\x54\xf1\x9c\x39\x35\xf0\xca\x60\x6b\xa4\xaa\xd8\x2a\x77\xa4\x1e\x83\x7d\xc8\xc2\x1d\x76\x09\x00\x80\xe0\x86\xe7\x80\x44\x30\x5f\x84\x0a\xa7\xf6\x9e\xd7\xa9\xd0\x45\xe6\x5f\x91\xa5\xaf\x5f\x2d\x40\xc9\x3a\xb4\xb9\x7f\xc3\xde\x71\xc3\xeb\x32\x9d\x94\x5c\xd6\x28\xc0\xe8\x5e\x38\xee\x67\x9c\xc3\xf4\xf5\xe3\xc4\xe3\xf7\x58\x36\xf1\xb7\x2d\xa7\x5e\xae\x08\x9c\xc7\x44\x51\xc8\x57\xd5\x44\x29\x5e\xe4\x4d\xdf\x91\xdd\x9e\x2f\x47\xe0\x59\x72\x7a\x97\x82\xa2\xc4\x26\xfd\x3e\x57\xd8\x9a\xb8\xce\xa5\xee\x94\x58\x91\xdc\x3c\xdb\x6b\x16\xe8\x4b\x4d\xf0\x3d\x07\xac\xb1\x71\x6b\x24\xae\x2a\x84\x52\x3e\x99\x8e\xb0\x65\x4b\xae\x84\x6e\x76\x3b\xf6\xc5\x50\x57\x18\xf6\x69\xfd\x03\x0b\xc3\x3e\xf2\x06\xfd\xc5\xda\x36\x7d\x13\xa1\xdc\x5f\xd8\xcc\x7c\x31\xb4\x34\x87\xdd\x8e\xc5\x9f\xa1\x37\x75\xac\x59\x96\xec\xbb\xdd\x6e\xfb\xb9\x05\x1c\x48\x3f\xf3\xe2\x81\x57\x68\x2d\xfb\x9b\x90\x3d\xc8\xc1\x0a\xc7\xe8\xd1\x28\x12\x7a\x51\x22\x87\x9b\xc0\x32\x66\xc8\x98\x29\x3e\xf4\xa3\x96\x1c\xd9\x12\x84\x04\x1a\x27\x7c\x89\x85\x26\xc1\xde\xe3\x4f\x6b\x1d\xa1\x9b\xfd\x8f\xe7\x63\x0a\xf0\x69\x83\x85\x41\x01\xc3\x86\xe9\xf5\x36\x87\x4a\x1b\x98\x5e\x6f\x27\xf9\x49\xd0\x3c\xae\x74\x58\x18\x13\x4d\xf8\x6f\x2e\x06\xf8\x2c\xbb\x85\x2b\xc2\xb2\x76\x09\xb9\x43\xdc\xbc\x7d\xec\x78\x9d\x46\xb4\x3c\x64\x23\x7b\x79\x48\xbf\x65\xf2\x23\xb8\xa5\xfb\xbe\xfd\x41\x18\x8e\x1c\xd1\x23\xcf\xf1\x54\xfd\xf5\xd4\xa1\xf8\x86\xa4\x4f\x9e\x07\x17\x41\x67\xcd\xf9\xf9\x0b\xd1\xbb\x3c\xf9\x17\xa5\xde\xda\xc1\x77\xe7\xf2\xb0\xbf\x18\x74\xd0\x25\xfc\x42\x72\x4f\x9d\x27\x63\x9f\xa2\xff\x1a\x9d\xb1\xd8\x1c\xe9\xbc\x34\x3b\x36\xb1\xc9\xef\x01\x00\x8f\xc1\xc7\xf0\x0e\x07\x00\x00"),
+          path: "mongo-api-document-test.tml",
+          root: "mongo-api-document-test.tml",
+        },
+      
+        "mongo-api-grpc-proto.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x0c\x7c\x72\x80\x80\x6a\x9b\xa0\x17\xc3\x87\xdd\x38\x5d\x2c\xda\xc6\x45\xb2\xed\xd5\xa0\xa5\x91\xcc\x5a\x22\x55\x92\xf6\x56\x20\xf4\xdf\x03\x7e\xc8\x91\x9d\x8d\x4d\xed\x65\x41\x73\x87\xef\xbd\x79\x33\x1c\x2a\xcb\xe0\x83\x2c\x10\x2a\x14\xa8\x98\xc1\x02\xb6\x1d\x34\x95\xdc\x73\x43\x61\xb5\x86\x4f\xeb\x27\xf8\xb8\x7a\x7c\xa2\x24\xcb\x48\x96\x81\xb5\xf4\x8b\x51\x87\xdc\xd0\xf5\xf6\x5f\xcc\x0d\xfd\xc4\x1a\xf4\x7f\xfa\xfe\x0b\xaa\x23\xcf\x11\x34\xaa\x23\x6a\x30\x3b\x74\xe1\x4f\x5d\x8b\x7d\x0f\x0a\x73\xa9\x0a\x0d\xb2\x04\x06\x5b\x96\xef\x51\x14\x6f\x41\x23\xfa\xb8\x4a\xb5\x39\x6b\xb9\x63\x68\x59\xbe\x67\x15\x42\x29\x95\xff\x57\x2e\xc5\x11\x95\xe6\x52\x68\xd8\xa2\x79\x46\x14\x6e\xbf\x01\x26\x0a\xb7\xe0\x0a\x1a\xd4\x9a\x55\xa8\x29\x21\xba\x13\x86\xfd\x0f\x4b\x98\xb5\x4a\x1a\xf9\x6e\xb6\x20\x64\x40\xb4\x96\xfe\x15\x96\x7d\xbf\x20\x44\xb6\x86\x4b\x01\x95\xdc\x0c\x01\x4b\x98\x59\x4b\x1f\xe4\xb7\xa8\x76\x3b\x5b\x10\x6b\x15\x13\x15\x02\x7d\x6c\x5a\xa9\x8c\xee\x7b\xc2\xfd\xca\x87\xf7\xfd\x6c\x61\x2d\x8a\x62\xb4\x5d\x49\x59\xd5\x98\x79\x09\xdb\x43\x99\x61\xd3\x9a\x8e\xfa\x9f\xb3\xc5\x0f\xa3\x74\x30\x76\x08\x23\xd6\xd2\x3f\x63\x66\x7d\x4f\x6e\xb9\xff\xb8\x02\x5e\xa0\x30\xbc\xe4\xa8\x81\x45\xc3\x5d\x39\xb9\xd1\xae\x10\x9f\xfd\x06\xfd\x1d\xbb\xbe\xa7\x24\x7a\x76\x0b\xd2\x12\x00\x6d\x14\x17\x15\xf0\x02\x96\xf0\xf3\x82\xdc\x96\xf2\x77\x5b\x30\x83\x9f\xf1\xbf\x03\x6a\x03\x07\xff\x2b\xf4\x43\x14\x75\x12\xea\xdb\x8d\x17\x69\x72\xce\x61\x5f\x50\x06\x57\x8f\x0f\xe4\x4b\xf8\x25\x25\x89\x07\x34\x77\x75\x3d\xb0\x69\xac\x31\x37\xce\xd7\xd6\xc9\x94\x65\x44\xd3\x6f\x41\xaa\x02\x55\xb8\x38\x7e\xb9\x71\x29\x09\x07\xef\x32\xf6\x5b\xc0\x74\x0e\x52\x41\x81\x3a\xa7\x70\x57\xd7\xc3\x69\x60\x0a\x23\x36\x16\xc0\xcb\x88\xae\x40\xa1\x6e\xa5\xd0\xb8\x69\x51\x6d\xfc\x26\xd7\xf0\x53\x9a\x4f\xe7\xca\x47\x3e\x05\x2d\xd1\xaa\xf1\x9e\x93\xec\x6d\x01\xe0\xc2\xfc\xfa\x3e\xc8\x58\xc2\xbb\x6f\x3b\xdf\x0b\x5a\xc2\xfb\x29\x3e\x86\xe3\xb0\x93\x75\xf1\x82\x8d\xf0\xcc\xcd\xce\xb7\x88\x91\x86\xd5\x90\xcb\x83\x30\x20\x4b\x87\x1e\x43\xa6\x25\x1f\xe9\x5c\xf6\x0a\x5b\xf4\xa3\xed\xda\xb9\x93\x90\x68\x4f\xc8\x3a\x88\x99\xd4\x31\xf7\xdd\xda\x59\xfa\x5d\xe3\xd4\xf5\xeb\x5b\x66\x42\xe6\x17\xf4\x93\xaa\x7f\x3b\xc5\x3f\xb8\x36\xb1\x84\x93\xaa\xe2\xcf\xbd\xb2\x16\x49\xc6\xdf\x77\xbf\x71\xac\x8b\x4b\xd7\x47\x33\xe7\x79\x27\x35\x42\xe9\xa2\x60\x8f\x5d\xcc\xe2\xc8\xea\x03\x26\xfb\x7b\xc1\x32\x32\xd7\x21\x46\x6b\xc3\xf0\xa7\xc3\xf0\xa7\xff\x38\x8a\x40\x94\x6a\xf3\x07\xd7\xfd\x17\x57\xc6\xa5\x32\xdc\x8a\x69\xe6\x9f\xa3\xd9\x53\x73\x07\xb4\x44\x8f\x87\xc7\xbd\xe1\x4a\x49\x15\x9c\x6d\xd0\xec\x64\x78\xd3\xad\xa5\xf7\xe1\x51\x77\x8f\x8b\x8e\xc1\x29\x80\x4e\x8f\x6a\x73\xf0\x2a\xe7\x97\xe6\x7d\x74\x2f\xe7\x1b\x50\x68\x0e\x4a\x68\x98\x27\xe7\xf9\x66\x31\xe0\x2a\x77\xf9\xaf\x1e\x1c\xe1\xbf\xcc\x3f\x60\x3d\xa0\xb9\x0a\xf4\xb8\x4a\x94\x3a\x46\xbc\xab\xeb\x79\xca\x44\xf3\x9d\x9d\x88\x7f\x3e\x05\x2f\xd8\xe2\x88\x98\x4f\x1d\x26\x89\xdc\xee\xae\x8f\x19\xe3\xa5\x99\x4f\xba\x5b\x89\x5c\x27\x9e\xf0\x6d\x30\x4f\xfe\x7c\x48\x2e\xf9\x0a\x6b\x34\x98\x5e\xf5\x1f\xa1\xf5\xe4\xeb\x00\x26\x24\xa3\xa1\x6b\x0b\x00\x00"),
+          path: "mongo-api-grpc-proto.tml",
+          root: "mongo-api-grpc-proto.tml",
+        },
+      
+        "mongo-api-grpc-test.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\xdf\x73\xdb\xb8\x11\x7e\xd7\x5f\xb1\xc7\x1b\x77\xc8\x96\xa5\x63\xa7\xed\x83\x7a\x7e\x48\x14\xa7\x93\x49\xeb\xf3\xd8\xee\xf5\x21\x93\xf1\x40\xe4\x92\x46\x43\x01\x0c\x00\x2a\xd2\xe8\xf8\xbf\x77\x16\x00\x69\x4a\xa2\x64\x49\xb9\x76\x7a\x97\xb1\x65\x02\xf8\xf6\xdb\x6f\x7f\x60\xc5\xf3\x73\xc8\x38\x2b\x41\xa1\xa9\x95\xd0\xc0\x20\x2d\x39\x0a\x03\x32\x07\x06\x85\xaa\x52\xd0\xa8\xe6\xa8\xec\x2f\x2e\x0a\x30\x4f\x08\x05\xb7\x1f\xa7\x2c\xfd\x82\x22\x03\xf3\xa4\x64\x5d\x3c\x01\x13\xc0\xc5\x1f\x67\x38\x93\x6a\x39\x3a\x3f\x87\x54\x0a\x81\xa9\xe1\x52\xc4\x90\x96\x52\x63\x06\xac\x94\xa2\x80\x6f\xdc\x3c\x59\x20\x8f\x3d\x5d\xda\xbf\x1c\x09\xcc\x20\xaf\x85\x3d\x96\x8c\xe8\x93\x65\x18\x1a\xf8\xbd\x41\x6d\xb8\x28\x92\x87\xb8\x33\xbd\x5a\x25\x6f\xdd\xc7\xa6\x89\x20\xac\xa6\xc9\x6a\x95\xdc\x1b\x55\xa7\x26\xf9\x79\xfa\x6f\x4c\x4d\x72\xc3\x66\x68\x7f\x34\xcd\x3d\xf9\x90\xe2\xc4\xba\x18\x5b\x33\x61\x14\xc1\x6a\x04\x00\x50\x72\x0d\xe3\x2b\x98\xd6\x39\xf1\x4e\xfe\xce\xb5\x41\x11\x5e\xc0\x4f\x3f\xc1\xe5\xab\x68\x64\xf7\x78\xbe\xe3\x2b\x2b\x4d\x72\x83\xdf\x08\x12\x55\x18\xd9\xe5\x6a\x9a\xdc\x61\x41\x07\xd5\x01\x34\xfc\x51\x87\x19\x5b\x44\x56\xf1\x1e\xa8\x77\x32\x86\x19\x1a\xc5\x53\x4d\x4b\x61\x14\x39\x5b\x85\xf4\x91\x49\xec\xee\xb0\xe4\xda\x93\x24\xfa\x31\xa0\x5a\xe3\xe9\x7c\x0e\xed\x06\xfa\x17\x54\x4c\x6b\x1f\xb8\xf1\xf9\xf9\xf9\xb4\xce\x05\x9a\x20\xee\x36\x10\x9d\xe4\x5f\xdc\x3c\x4d\xa4\x30\xb8\x30\xef\x38\x2b\x51\x85\x56\xb3\xd4\x2c\x28\xb8\xf4\x38\xf1\xcb\x31\x3c\x82\x36\x8a\x8b\x22\x82\x50\xa0\x7d\xee\x58\x48\xd5\x2a\xdc\xfe\xe7\xe2\x4c\x82\x27\x84\xea\x11\xc2\xd4\x2c\xa2\x6e\x5f\x13\x0d\x50\x79\x50\x4c\xe8\x4a\x2a\x33\x51\x98\xa1\x30\x9c\x95\x3a\xe4\x42\x63\x5a\x2b\x24\x75\xfa\xcf\x23\x8f\xe0\x30\x79\x4e\x5c\xe0\x87\x2b\x10\xbc\xec\xf1\x31\xc9\x7b\x66\x58\x99\x87\x41\xce\x78\x89\x19\x18\x69\xf3\xcd\x8b\x3b\x86\xb3\x3f\x7c\x0d\xac\x1f\x0e\xa7\x19\x8d\x7a\x2e\x54\x53\xb2\x7a\x70\xca\x85\x14\x9a\xa8\x4d\xbc\x1e\x0b\x7a\x9e\x4c\x4a\xa9\xd1\x67\xd2\x73\xb2\x25\xf7\x46\x56\xfe\x69\x33\x6a\x46\x54\x58\xb8\xa8\x30\x35\x13\x99\x21\x10\x6b\x6d\xab\x87\xaa\x03\x78\xde\x2f\x50\xab\x3e\x64\x12\x35\x08\x69\xe0\x89\xcd\xb1\xbf\xac\x0d\x33\xb5\x86\x54\x66\xe8\x2b\xed\x19\x78\xa3\xde\x48\x3b\x8b\x16\xdb\xed\xf6\x87\x4e\x68\x63\xeb\x06\xcf\x3d\x9e\x7d\x1a\x92\x60\xa4\x36\x6d\x1c\x94\xdb\x99\xc2\xac\xcf\x02\xce\x74\x0c\x85\x34\x5e\x74\x7a\xb4\x26\x7d\x33\x72\x34\x1f\x50\x9b\x89\x14\x73\x54\x9a\x4b\xb1\x46\xb5\xa5\x93\x4b\x05\x8f\x31\x60\x89\x33\x2a\x03\xc5\x44\x81\x90\xf3\x85\xa9\x15\xea\xe4\x8e\x89\x4c\xce\xf6\xc5\x4d\x87\xaf\x5b\x28\xfa\x7f\xa6\x8b\xb6\x9a\xa8\x46\x1f\xe4\xad\x92\x46\x86\x04\x1f\x8d\xfa\x61\x9c\xa3\x32\x98\xad\x95\x1f\x1d\x78\xaf\xe4\xcc\x1d\x99\xe9\xe2\x39\xc4\xbb\xb2\x72\x57\x66\x7a\x03\x30\x43\xad\x59\x81\x5b\xd9\xd9\xcb\x50\x0f\xff\x43\x45\x56\x93\xeb\xaf\x35\x2b\xc3\x4d\xfa\x1d\xdf\x28\x06\xe2\xb5\x93\x41\x17\xac\xb4\x53\x9d\xe8\x7c\x41\xac\x5a\x2e\x70\x36\xf7\xb1\x9b\x07\x16\x2d\x86\xdd\xe6\xfa\x6c\xb7\x43\x4b\x35\x83\x6a\xa2\x90\x19\x7c\x23\xb2\xbf\xa1\x19\x0c\x71\xea\xdb\xb8\xbd\x5a\x5c\x7f\xa3\x18\x51\xf5\x86\x26\x06\x81\xdf\xfe\x61\xaf\x22\x7f\x41\x84\xde\x6a\x86\x39\xaa\xfe\xa1\xb0\xed\x9b\x66\x41\xe7\xdb\xc6\x46\xc7\x0a\x25\x6b\x91\xf9\xea\x6b\x73\xe9\xa8\x2c\xba\x88\x3e\xbd\xfa\x3c\x6a\x2b\xe4\xb1\x4b\x0c\x47\x3e\x71\x4e\x52\xeb\xdb\x96\x8b\xec\x45\xd1\x5f\x0f\xef\x5b\xa9\x05\x03\x85\xa9\x54\xd9\xae\xce\x65\x23\xb3\x4e\x82\x04\xb6\x0c\x7e\xf7\xc2\xfd\xf9\xe1\xdd\xea\x43\x36\xb6\x45\x45\xfb\xee\xac\xa1\xe4\x23\x2e\x9b\xa6\x39\xbe\xcd\x16\x68\x5e\xe0\xba\x99\xbf\x83\x59\x45\x6c\xa2\x68\xd0\x56\x97\xb6\xce\xcc\x7a\x8a\x0e\xe2\xd8\xcc\x5d\xe3\xe0\x43\x76\xb2\x58\xc1\x8c\x6b\xcd\x45\x11\x78\x85\xfa\x2d\xd6\x42\xc7\xbe\x99\xde\x48\xf3\x9e\xb2\x2d\x1a\xa8\x05\x1a\x46\x06\x6b\xa0\x9d\x83\xc6\x57\x03\xf9\x7e\x48\x95\x78\x80\xef\x2c\x8d\xdf\xb8\xdb\xfa\x2c\xa2\x69\xcc\xd1\x5b\xab\x13\x1b\xa8\x1d\x65\x71\x5a\x69\xf4\x1b\x91\x1f\xa1\x6a\x61\x36\xcb\x64\x22\x6b\xd1\xc6\x1e\x67\x95\x59\x56\xd3\xe4\x9a\x7e\xaf\x4e\x48\xfe\x94\xc0\x3c\x1f\xbd\x27\xff\xed\x3e\x4a\x3a\x67\x3d\xa2\xd2\x7a\xbd\x3f\xd9\x5f\xb7\xb0\x3e\xd9\xb3\x20\xde\x82\x59\x33\x53\xb1\x02\x07\x7a\xc2\x9b\xb2\x3c\x2c\xd3\xdd\xde\x3b\xfc\x5a\xa3\x36\xab\x9f\x55\x86\x6a\x0c\x41\x86\x3a\x0d\x62\xb8\xb5\xf7\xd4\x45\x0c\x77\xa8\x2b\x29\x34\xde\xa2\x72\xcf\x2e\x4f\x90\xad\xe4\xfa\x10\xd5\xc8\x23\xf2\xf6\x41\x1a\x56\xb6\xa2\xfd\xfa\x2b\x94\x28\xc2\x76\xcd\x75\x2f\x9a\x13\x69\xf9\x72\xbf\xa6\x97\xf4\x85\x68\x4b\x58\x7a\x78\xd6\x76\x17\x1d\xc4\x3b\xf0\xe3\x4d\x3e\x6b\x74\xc9\xa5\x61\xf5\xdf\x2e\xad\x96\xc7\x04\xc1\x1f\x69\x63\xf1\xdf\x53\x98\x3c\x25\xe6\xdb\x4a\x9e\x90\x9d\xc3\x60\x2f\xb5\xe1\xef\xcc\xcf\xba\x7a\xb1\x25\x7f\x10\x73\x56\xf2\xec\x8d\x2a\xea\x19\x0a\x33\xd4\x99\xff\x59\x65\x6e\x4a\x79\x87\x25\x1a\xfc\x7f\x6e\xd2\xd4\x37\xf5\xd1\x03\xcc\x65\x34\x3a\xac\x25\xeb\x4f\xaf\x3e\xff\xe6\xd3\x4a\x6d\xe5\x25\xb3\x2f\x05\xd9\x05\xa2\x0d\x72\x3b\xa6\x10\xa9\xcd\x51\x85\x5a\x11\xfd\x35\x1e\x1c\x01\xf4\xa7\x8b\xcf\x51\xd3\xfa\xfc\xb8\x59\x99\xce\x8c\xf3\xda\x91\x3b\xc6\x67\xef\xce\x7e\x9f\xf7\xda\x3c\x5e\x86\x6e\x00\x39\xc4\xef\x83\x67\x94\x9d\x02\xf9\x3a\x38\x66\x44\xda\x15\xa8\xe6\x18\x69\x33\x6b\xf7\x30\x69\x4f\x1e\xe6\x76\x33\x3d\x65\xb6\x7b\xcf\xb1\xcc\x7e\x61\x65\x3d\xdc\x36\xe6\xb4\xd2\xc9\xdb\xc6\xac\x77\x28\x58\x63\xe1\x88\x06\x31\x04\x3c\x0b\x8e\xef\xfb\xfe\xcb\x19\xe4\x84\xef\x6c\xef\x52\x91\xe7\x6e\x9d\x80\xc9\xd8\xfe\x86\xef\xb6\x9e\xfd\xd8\xce\xde\x3f\xce\x3d\xc7\xd8\xa1\x6c\x42\x3f\x9e\xe0\x72\x5e\x4a\x66\xfe\xf2\xa7\xf0\xa2\xfd\xbe\x74\xb5\xdb\xe5\x8e\x98\xa8\x67\x53\x54\xe4\xfb\x14\x81\xbb\x56\x6f\x27\x59\x27\xc1\x90\xa5\x96\xeb\x6a\x45\x4c\x93\x1b\x8b\xf0\x11\x97\xd0\x34\x9b\x21\xdb\x49\xbf\x3b\xb4\xc6\xfc\x75\xf4\x3f\x0b\x59\xc7\xe1\x61\x59\x61\xd3\xac\x8f\xde\x87\x06\x70\x1b\xe4\xd4\x70\xae\xeb\x71\x99\xfc\xf9\x88\x10\xe6\x8a\xd9\x17\xc5\xfb\x82\xd8\xc7\x6f\xc9\xad\x56\x40\x17\x72\xd3\x1c\x72\xfb\x6e\xdf\xd9\x2f\x5c\xc4\x03\x5d\xe6\xed\xd2\x66\x41\x38\x74\x39\xbf\xdc\x7d\x9e\x01\xda\xc6\xfe\x11\x97\x63\xd8\x51\x0c\x56\xda\x31\xbd\x8d\xad\x53\xe3\xde\x50\x3a\x0d\xec\x42\x78\x11\x7d\xd7\xd8\xf3\x20\xef\xed\x0b\xbb\xc1\x96\xb5\x0e\xd9\x06\xbc\x3b\xb2\x5a\x25\xb7\x2c\xfd\xc2\x0a\x6c\x9a\xe4\x5a\xa9\xae\x2d\x6e\xf7\xc9\x13\xd0\xae\x17\x15\x57\x98\xf9\xd7\xc9\x1d\xe6\x3b\x64\x59\xc9\x05\x5e\x2f\x52\xc4\x0c\x4f\xc0\xfe\x85\xd4\x60\x94\x67\xd7\xf4\x0a\x74\xd5\x44\x3b\x95\x3a\x00\x3a\x9f\x19\xf2\x5d\xaa\xae\x96\x83\xa8\x07\x68\x50\x09\x56\x46\xa3\x66\xf4\x9f\x01\x00\xee\x9c\x84\x5a\x9c\x19\x00\x00"),
+          path: "mongo-api-grpc-test.tml",
+          root: "mongo-api-grpc-test.tml",
+        },
+      
+        "mongo-api-grpc.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x4d\x73\xe3\xb8\x11\xbd\xfb\x57\xf4\xb2\x6a\xa7\xc8\x09\x4d\xe7\x90\xca\xc1\x1b\x1d\xfc\x5d\xae\x4d\x66\xa7\x66\xbc\x9b\xa3\x0b\x22\x9b\x14\x62\x12\xe0\x00\x90\x6d\x95\x96\xff\x3d\x85\x06\x40\x51\x32\x65\x4b\x9e\xb5\xe7\x60\x43\xc4\x47\xe3\x75\xbf\xd7\x4d\x80\x47\x47\x95\x3c\xae\x50\xa0\x62\x06\xa1\x55\xd2\xc8\x1c\x0e\xaf\xa1\x9d\xc2\xe1\x61\x25\x6f\xe5\xdc\x4c\xfa\xdf\xad\x99\xb4\xcc\xcc\xf4\x44\xcb\xb9\xca\xf1\x56\x61\xcd\x0c\xbf\x47\x1a\x3e\xac\x54\x9b\x0f\xe7\xfb\x8e\xad\x8b\xda\xe9\xd1\x72\x99\x7d\xb6\x5b\x76\xdd\xc1\xc1\xd1\x11\x7c\x45\x75\x8f\x0a\x78\xd3\xd6\xd8\xa0\x30\x1a\xda\x69\xb6\x5c\x66\x5f\x8d\x9a\xe7\x26\xfb\x6d\xfa\x3f\xcc\x4d\xf6\x89\x35\x48\xff\xba\xce\x2e\xe0\x39\xda\x06\x55\x0a\xda\x3e\x8a\x0a\xcc\x0c\x61\xb9\xcc\x6e\x16\x2d\x76\x1d\x28\xcc\xa5\x2a\xb4\xdd\x40\x96\x34\x56\x71\x9a\x36\x65\xf9\x1d\x8a\x22\x3b\x30\x8b\x16\xc3\xe6\x9a\xf6\x82\xe5\x01\x00\xd8\xed\x7f\x17\x3d\x1c\x2c\x76\xc6\x72\x40\xcb\x4f\xdd\x06\x16\x8b\xff\xd9\x75\x34\xf0\x1f\x34\x8a\xe7\x1a\x1a\xd7\x66\xfe\xf9\xc0\x85\xe1\x13\x3e\x78\x30\x0a\xcd\x5c\x09\x0d\x0c\x04\x3e\xf4\x08\x07\x5e\x7a\xdf\xb6\x39\x56\xce\x45\xbe\x32\x17\x4f\x47\xf0\xa4\xd0\x6c\xc2\x48\xe0\xa3\xdf\xca\x45\xc1\xa1\x80\x0f\xae\xd3\xf5\x0d\xdc\x3b\x0e\xfb\xa5\xfd\x88\x37\x74\x0c\x8d\xeb\xeb\xbc\x67\x67\x72\x2e\x8c\xb7\xa7\xc9\x81\x9c\x7a\x64\x19\x58\xf2\x98\x63\x1d\x30\x24\x6e\x51\x9c\x9b\x47\xc8\xa5\x30\xf8\x68\xb2\x33\xd7\xa6\x70\x0b\x1f\xb1\x69\xcd\xa2\x9d\x66\x17\xb6\x4d\x20\xfe\xf8\x82\x64\xc8\xda\x17\xd4\xad\x14\x1a\x53\x40\xa5\xa4\x4a\x3c\xdd\x05\x96\xa8\xa0\x8f\x43\x76\x26\xeb\x1a\x73\xe3\x1f\xe3\xe8\x25\xfa\x51\x65\x64\x3e\x4a\x1c\xff\xe4\x1c\xed\x01\xc7\x13\xd0\x21\xe8\x59\xef\x51\x42\xd3\x78\x49\x53\x7e\x9a\x80\xe0\xb5\x87\x32\x88\xbb\xe0\x75\x0a\x3a\x2b\x19\xaf\xe3\x88\x4c\x46\x64\x33\xf1\xa1\x5d\xe3\x68\x1f\xef\x97\x84\xe3\x18\xb8\x30\xff\xfc\x47\x4c\x96\x93\x2e\xb5\x20\x02\x5d\x0a\x6d\x55\xc8\xa9\xd1\x03\xc1\x6d\xe8\xad\x41\xad\x59\x85\x63\xdc\xd1\xd2\x71\xf2\x1a\x5d\xc1\x4b\x6c\x59\x42\xd7\x18\x7e\x03\xc6\x08\x62\xa0\x0c\x6b\x6c\x7a\xc6\x2e\x95\x6c\xa8\x3e\xc5\x8d\xae\xf6\xe0\xca\x30\x33\xd7\xd9\x85\x95\x56\x9c\xcb\x02\x75\x76\x2d\xee\x59\xcd\x8b\x13\x55\xcd\x6d\x61\xa3\x1d\xfc\x84\x64\x8d\x47\x5e\x8e\xc8\xa5\x0f\x62\x4a\xf8\x92\x5f\xf6\xd0\x0b\xad\x7d\x46\x30\x6b\xd1\x5d\xae\xd1\x7f\x85\xeb\xb9\x3a\xca\x3d\x2f\x46\x68\xbf\x42\x33\xce\xb9\xc2\x6f\x2f\x72\x7e\x7d\xbe\x43\x1a\xff\xf5\x3a\xb8\x42\x33\x2a\x82\x15\x0f\xde\x2b\xf2\x22\xbb\x42\x73\x5d\xc4\x49\x32\x64\x6d\x27\x46\x2a\x34\xdb\xe9\xb8\x91\x4e\x6f\x16\x41\xb2\xc1\xc5\x49\x5d\xfb\x69\x8e\x8e\x96\x55\x38\xa8\x9c\xa0\xd1\xba\x8c\x05\x4c\x17\x43\x82\x14\x7e\x9b\xa3\x36\x29\x48\x55\xa0\xa2\x61\x6b\x70\xb9\xcc\xbe\x10\x9f\xd9\xaf\xb8\xb0\xd1\xe8\x3a\x98\x8b\x1a\xb5\x76\x13\x6f\xa7\x0b\xe0\x1a\x34\x9a\x71\x7e\x4f\xea\xfa\xf5\x14\x3b\x6f\xbe\x38\x64\x3b\xb0\x1d\xe6\xbf\x55\xd5\x76\xf6\x03\xfd\xe4\xbf\x8f\xd7\xe9\xa2\x17\x02\x3d\x73\x51\xc5\x9e\xfe\xdf\xec\x73\x9c\xf4\x72\xa0\xe7\xd3\xc5\x3e\x9a\x40\xa5\x86\x22\xe0\x65\xb0\xf5\x99\x55\x18\x27\xf0\x2f\xf8\x3b\xfc\xf9\x67\xe8\x0c\xfe\x7f\x46\x35\x18\x7f\x7d\x0d\x8a\x48\x41\x4c\x14\xa0\xbc\xe5\xdb\x16\xd5\x2d\xf5\x36\x73\x6d\x60\x8a\x20\xa4\x38\x14\x58\xd1\x31\x2f\xda\x50\xac\x55\x8f\x4e\xc1\x48\xc3\xea\xf1\x74\xf1\x22\x49\x9f\x84\x94\x0b\x13\xc2\xe8\x5c\x49\xd6\xfa\x9e\x78\xfa\x8a\x3c\xab\xb9\x1e\x4f\x34\x6d\xab\xeb\x87\xbd\x14\xb7\xbc\xb1\x3e\x86\x37\x25\x39\x9c\xb8\x93\x5c\x29\x15\xdc\xba\xb2\x6c\xcd\x2a\x26\xaa\x50\x28\xf5\x1a\x40\xed\xd3\x4d\xc3\x04\x58\xdb\xa2\x28\xe2\x41\x67\xba\x9e\xf9\x1b\x88\x89\x56\x85\xfa\x69\x41\x38\x5d\x90\xea\xfc\x1c\x0d\xac\xae\x57\x67\x42\x9f\xef\x8c\xb2\x78\xbc\x2a\x6c\xcd\x6d\x6f\xf8\x7b\x53\xdc\x9b\xd9\x3d\xd3\xff\xcd\xb5\x79\xab\xfc\xf6\x60\x7e\x78\x9a\x7b\x82\xb6\xa6\x8c\xc7\x39\x96\x39\xef\x9d\x06\x96\x8e\xe5\x8f\x57\xfa\xe9\xe2\x92\x63\x5d\x8c\x9d\x46\x1e\x66\x52\x23\x94\x34\x7c\x87\x0b\x98\xc9\xba\xd0\x43\xa5\xdf\xb3\x7a\x3e\x76\x34\x5d\x99\xfd\x2e\x91\x7b\x1b\xbb\x2b\xfc\x4d\xd4\xed\x51\x44\xc9\xe6\x8b\xe4\x57\x5c\xc4\x09\x4c\x26\x10\x45\xdb\x84\xb2\xcb\x9b\xc2\x46\x36\xbc\x12\x34\x1d\x94\x06\xb4\x51\x84\x7b\x35\x13\x90\x3f\x6c\x57\xc8\x20\xc2\xd0\xe7\x8f\x1b\x4a\xb2\x13\x7d\x2d\x0c\xaa\x92\xe5\xb8\x4f\x2e\x7d\xcf\xe1\x7a\xfb\xb9\xce\xc7\x6f\xed\x78\xe7\x61\x93\x77\xef\x75\xcc\xfb\xbd\x2d\x98\x41\x98\x53\xf3\xec\xa9\x1b\x1e\xb8\x99\x8d\x4c\xd8\x5e\xd8\x9d\xed\xd7\x8b\xdd\xad\x1f\x08\xfd\x8d\x2f\x66\x6e\xbf\x28\x19\xe1\x6e\x75\x31\xf3\x5c\xb9\xe2\xf2\x5e\x3a\xe2\xe5\x53\x11\xad\xa2\xdb\x0b\xc8\xde\x0f\xf6\xbf\xb1\x39\xee\xb7\x6b\xe7\xb9\x1b\xdb\x39\xd6\x68\x10\x0a\x6a\x9e\x95\xcf\x88\x3e\xdc\xe2\xd7\xeb\xe3\xfa\xfc\x1d\x44\xe1\x40\x46\xc9\x76\x22\x56\x6e\xac\x11\xb1\x0f\x05\x2e\x7e\xaf\xa3\xc0\x5a\xf0\xf3\x1c\x01\x4e\x68\x1b\x04\xd0\x2b\xc0\x4f\x5b\xdd\xd8\xc2\xf7\x39\xfb\xaa\x1d\x4c\x66\xb9\xe1\x52\xa4\xd6\x3a\x36\xdc\x18\x7b\xab\xe3\xc6\x56\x4b\xfb\x5f\x83\x90\x06\xa4\xc0\xb0\x05\xd9\xd6\xd0\xd8\x53\x26\x99\xbe\x91\x5f\x09\xc3\x08\xe7\x16\x6d\xec\xec\x83\x36\x8a\x8b\x8a\x9c\x0e\xb4\x51\xe3\x83\xa5\x8d\x8d\x73\xb0\x15\xf7\xa1\xe1\x65\xc8\xa5\x33\x59\x60\x6c\xef\x73\x93\x09\x84\x8c\x32\xa8\x04\x1b\xc6\x7b\xc5\xfe\x45\xc3\x4d\x1c\x3e\x3d\x52\x9a\x95\x71\x74\xc9\x78\x8d\x05\x18\x09\x3f\x6b\xaf\x5d\x1d\xa5\xe0\x20\x26\x69\xff\xa9\xf2\xbf\xdc\xcc\xe2\x88\xf0\x45\xeb\x89\x3a\x46\x98\x36\x9e\x9d\x00\xdf\x0f\xf8\x83\x82\x6a\xf3\x40\x13\x59\xdc\x87\xac\x63\xf8\x24\xcd\xa5\x9c\x8b\xc2\xf2\x63\x99\xbb\x50\x2a\x74\xa5\x70\x8e\xac\xa8\xb9\xc0\x8b\xc7\x1c\xb1\xc0\x22\xcc\xb8\x78\x6c\xb9\xc2\xa2\x4f\xb0\x8d\xda\x43\xd3\x98\xb5\xf8\x87\x2d\x49\xcc\x7a\x4f\x21\xa2\xeb\x5a\x1f\x56\x9a\x25\x16\x20\xcd\x0c\x95\xa7\x77\xc8\xd0\x18\x8f\xbc\xb4\x17\x16\x79\x67\xd9\xb4\x05\x2e\xb6\x1f\xe1\x59\x7e\xc7\x2a\xec\xba\x6c\x63\xbb\xe4\x17\x90\x77\x03\xf6\xfa\x78\xbe\xbe\x78\xea\x07\x6e\xf2\x99\x45\xe6\xed\xe6\x4c\x23\xac\x81\x18\x44\xf0\x78\x87\xad\x57\xd1\x7e\xb2\xe7\xa8\xed\xf5\xd8\xef\xb2\xc3\x26\x8b\xdb\xbd\xdb\x6e\x23\x90\xb6\xb1\xd6\xe9\x32\x5c\x3a\xfc\x7a\xa7\x4b\xea\x24\xc2\x4b\x7f\xfa\xb5\xa5\x5c\x03\x53\x7e\x8c\x2a\x47\x6a\x8f\x90\x6c\x5e\x53\x59\x30\x12\x98\xce\x51\x14\x5c\x54\xbd\x5d\x5b\x03\x46\x3e\xf7\x78\xbd\xf4\xf7\x1d\xfa\xd1\x57\x01\x7f\xe1\xf0\xcf\x09\xc4\x61\x20\xb4\x5e\x5a\xbd\xa8\x68\xc1\x93\x93\xa6\xef\x85\x88\xe9\x3c\x1a\x06\xaa\x5f\xf1\x93\x1f\x84\x0f\x1f\x06\x3d\x05\xea\x7c\xe4\xc4\x1a\x45\x29\xfd\x0d\xe3\x5b\x6e\x53\x60\xe4\xcc\x85\x73\x2b\xd3\x39\x48\x05\xd6\x72\x0a\xa5\x15\x0c\xfc\xfc\xb7\x6f\x91\x77\x35\x19\x03\x77\xba\x18\x77\xc8\xf6\x43\x34\x12\xd3\x68\x44\x0b\x9b\xf7\x4c\xff\xae\x58\x2e\xb3\x33\x29\xee\x51\x99\xae\x3b\xf8\xff\x00\x99\xfe\xf1\xf2\x87\x1b\x00\x00"),
+          path: "mongo-api-grpc.tml",
+          root: "mongo-api-grpc.tml",
+        },
+      
+        "mongo-api-http-test.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\xd9\x6e\xdb\x38\x17\xbe\xf7\x53\xb0\x02\x1c\x48\xad\x7e\xa5\x49\xef\x5a\x04\x45\x93\xa6\x29\xfe\x4e\x1d\x23\x49\x67\x2e\x82\x60\xc0\x88\x47\x36\x5b\x99\x74\x48\x2a\x8e\xe1\xea\xdd\x07\x87\xa4\xe4\x4d\x5e\x94\xce\x14\x28\x8a\x98\xe4\x59\xbe\xef\xac\xf6\xe1\x21\xd1\xa0\x1e\x81\xa8\x42\x68\x62\x86\x40\x06\xfc\x91\x8b\x01\x51\xf0\x50\x80\x36\x84\x0e\x28\x17\xda\xd8\xab\x21\x15\x2c\x07\x15\x13\x05\xa6\x50\x02\x9f\xe1\xb1\x82\x54\x2a\x06\x8c\x28\xd0\x63\x29\x34\x24\x9d\xac\x10\xa9\x53\x1c\x7a\x21\x32\x34\x66\x9c\x7c\x9e\x6b\x78\x20\x2f\xed\xd1\x95\x33\x14\xb9\x8f\x06\xb4\x49\xae\xbc\x9e\x2b\xa7\x58\x91\x59\x87\x10\x82\xea\xc9\xdb\x13\x52\x3f\xeb\xc1\xa4\x7a\x11\x46\xf6\x85\xb7\x95\x5c\xa3\xe5\xcf\x37\x37\xfd\x50\x81\xb6\xc6\x22\xaf\x01\xfd\x46\x45\x9d\xb2\xd3\x39\x3c\xac\x51\xba\x0b\x4d\x28\x11\x30\xa9\x4f\x27\xdc\x0c\x17\x39\x19\x81\x19\x4a\x16\x93\x31\x35\x43\x42\x05\x23\xff\xbf\xbe\xec\x91\x7b\xc9\xa6\x44\x66\xe4\x91\xe6\x05\xc4\x84\x67\x44\x48\x43\x04\xcf\x3d\x0d\x5e\x5b\x68\xc8\x4b\x44\xc7\xc5\x20\xb9\x89\xbd\x2e\xa2\x8d\xe2\x62\xe0\x55\x56\x1f\xac\x26\xc2\x85\x01\x95\xd1\x14\x66\xa5\x27\xa7\xe2\xca\xf3\xf1\x48\x95\x33\x7e\x3f\x35\xa0\x93\xd3\x22\xcb\x40\xd9\x1b\xee\xbd\x21\x2f\x4e\xd0\x11\xff\xde\xdf\x80\x52\x48\xe3\x77\x2d\x45\xd2\x83\xc9\xb9\x48\x25\x03\x15\x1e\xa0\xaa\x28\x71\x1f\x43\x2b\x1e\xbd\xb3\x8f\xd7\x94\xe0\x3f\x93\x7c\xa2\x86\xe6\x59\x18\x64\x94\xe7\xc0\x88\x91\x04\xac\x6c\x4d\x1f\x2a\x7c\x4b\xba\xaf\x1e\x82\x18\xf5\x44\xb5\x7c\x69\xff\x2a\x3b\x8b\x31\x59\x8e\xaa\x85\x19\x2e\xf2\x1d\x13\xe7\xa0\x0f\x9c\xa6\x23\xb0\xec\xa3\x75\x97\xb8\x28\x8d\xec\x2f\x04\x0c\x25\x08\x93\xa0\x6d\x48\x86\x32\x67\xf6\xa5\x15\xac\x22\x56\x25\xab\x57\xb8\x12\x26\xab\xe1\xf6\x0e\x19\x6e\x8c\x8b\x63\x16\x9e\xc6\x90\x1a\x60\xf1\x12\xb9\x5f\xa9\xd2\x43\x9a\x7b\x2e\xab\xc0\x34\x32\xba\x85\xcd\x4a\xb7\xb3\xbe\xc6\xa7\x67\x11\x73\x61\x42\x85\x89\xc9\x40\x9a\x45\x17\x3b\x0d\x51\xff\x26\x46\xde\xb5\xb9\xe3\x07\x28\x1d\xbd\xdb\xdf\x3d\x06\x2d\xdc\xdb\xe8\x00\xf2\x1b\x93\x83\x81\x7c\x8e\xed\xaa\xdf\xb8\x2a\xe8\x3e\x54\xd6\xf1\x63\xa3\x0f\x2f\x14\x64\x39\xa4\x26\xf9\x08\x30\x3e\x7f\x28\x68\x1e\xd6\xa4\x45\x8d\x36\x6b\x78\x2b\xb6\xb4\x95\x21\x5d\x1d\xc4\x35\x05\xce\x70\x65\xb3\xec\xb8\xc4\xba\x01\x6d\x7c\xdb\x3b\x53\x40\x0d\x7c\x10\xec\x02\xcc\x52\x9e\x55\xb6\xab\x5e\xe9\xbb\x1c\x1d\x73\x2c\x87\x50\xc0\xe4\x2b\x8c\xa4\x9a\x9e\xd2\xf4\x07\x08\x16\x46\xb6\x7f\x28\x9e\x6a\x7b\x1f\x39\x9b\x90\xc3\x08\x29\xce\xf8\x93\x29\x14\xe8\xe4\x8a\x0a\x26\x47\xb3\x59\x72\x6d\x54\x91\x9a\xe4\xf2\xfe\x3b\x82\xef\xd1\x11\xd8\xff\xca\x52\x87\x47\xd1\xed\xeb\xbb\xaa\x12\x35\x8a\x2f\xb5\xed\xb8\xaa\xe6\xd0\xc4\xd6\xa7\xe4\xab\x2d\xca\xbe\xd4\x26\x26\xc1\x21\xc2\xcf\x61\xe4\x3d\xe0\x19\xf6\xd6\xe4\x0c\x83\xf3\xc2\x61\x48\xae\x0d\x35\x85\x76\xd0\xd9\x76\x8e\xb5\x7d\x4a\xba\x8c\x64\x52\x91\xd4\x8a\x78\x9e\xd9\x5b\xc7\xf5\xba\xca\xb8\x36\xe9\xfe\x3a\x5d\x88\x41\x0d\x6b\x4f\x54\x17\xe0\x40\xbd\x42\x2a\x93\xd9\x2c\x71\xf3\x25\xf9\x02\xd3\xb2\x8c\x31\x31\x77\x02\xbd\xfc\xd2\x06\xe3\x00\x7c\xc5\x36\x01\xbc\xfc\xb2\x13\xdb\xbc\x6b\xcd\x1f\x24\xa7\x38\x0e\x30\x47\x10\x45\xf4\x5c\x12\x46\x5c\x6b\x2e\x06\xc1\x7e\xb0\x7b\xd2\x7c\x92\x85\x68\x15\x60\x6f\xc1\xef\x0f\x15\x0f\x41\xdc\xa4\x77\xce\xc4\x96\xfa\xfa\x83\xeb\xe6\xba\xba\x77\x75\x83\xd9\xbd\x5e\x4b\xdb\x2a\xcf\x0b\xae\x95\x9b\x95\xc1\x00\xfe\x1d\xd7\x65\xa7\xa8\x18\x40\xbb\xe2\x7b\x53\x39\xb8\xdc\x23\xbd\xd5\xc4\x15\x4d\x98\x4a\x61\xe0\xc9\x24\x58\xfd\x03\x85\x6c\xd4\xc1\xdd\xd0\x33\x37\xf5\x4d\x57\x52\x9e\xf0\x7d\x46\xf3\xfe\x0d\xc1\x67\xcd\x7b\xbb\xb0\x9d\x30\xd0\xe9\xc1\x98\x0e\xe0\xe4\xe8\xa0\xea\x9c\x7d\x50\x7d\x3c\x39\x0e\xfe\x8b\x4a\xca\xb9\xfe\xb5\x52\xc2\x09\x8a\x1e\xd7\xf1\x47\x67\x3b\xcb\x81\x59\x19\x5e\xeb\x15\x67\x31\x3f\x63\x92\xa1\xd8\x5a\x3c\xbc\x63\x3c\xb3\xd7\xc9\x8d\x34\x34\x47\xad\x6f\xc8\xcf\x9f\x24\x07\x11\xda\x63\xd7\xa2\x74\x84\x37\xc7\x78\x63\x4f\xd1\x79\x3c\x39\xaa\x4f\xae\x96\xc3\x80\x97\xc7\xdb\x09\x46\x31\x72\xe4\xb6\xe0\x63\xdc\x70\xdf\xf8\xcc\xf1\xc3\xcf\xde\x77\x99\x7b\xd0\x65\xf8\xa2\xcb\xaa\x27\x41\x3c\x77\x24\x5e\xf7\xd6\xdf\x5a\x4c\x4b\x68\x7d\x51\xe1\xca\x37\x2f\xaa\xdb\x3b\xb7\x1a\xcf\x82\xc3\xf7\x28\x77\xf2\xbf\xa3\x00\x7b\xd4\xfb\xd5\xdc\x1a\x51\x31\x0d\x16\xf2\xb0\x18\x07\xe5\x02\xc8\xd6\x09\x8d\x6e\x2c\x66\xeb\xf6\x8c\x3d\xa5\x6c\x79\x43\xdf\x42\xee\x72\xf6\x76\x1f\x9a\xdb\xdf\x5c\x63\xe5\x4b\x65\x7a\xbd\x64\x1b\x1a\xe2\xb7\x31\x73\x0b\xc7\x47\xc8\xc1\xc0\x6f\xec\x8d\xd8\x9d\x74\xeb\x65\xe4\x38\x5a\x29\xb8\xbd\x3b\xa1\xbe\x7d\x7d\xd7\xa6\xee\xb6\x77\xc2\x67\xf4\xbf\x7e\xb1\xb0\x3a\xa0\x37\xeb\xeb\x83\xe5\xe4\xf6\xe8\x6e\x67\xe7\xeb\xc9\x33\x04\x2a\x4c\x23\x88\x0d\x29\x54\xd8\x58\x6f\x6e\x81\xb5\xd2\x7f\x73\x61\xea\x17\x2b\xbb\x42\x0b\x8c\xed\x17\x06\x0b\x11\x37\x86\x5f\xdb\x1c\xda\x82\x74\xc5\xb3\x2b\xba\xfb\x8c\xb4\x67\x05\x96\x79\xf3\xbf\x35\xb0\x17\xb0\x33\x9d\xf7\x03\xdc\x3e\xca\x0e\x2f\x7b\x66\x70\x1b\xba\xe0\xb9\x52\x52\xe9\xc6\xe6\xb7\xa1\xb5\xad\x37\xc2\xe6\x2e\xd7\xdc\x20\x9a\x7e\xca\x68\xfe\xea\xe4\xa6\x9a\xd5\x79\x05\x14\x7f\x85\x09\x66\x41\xb4\x93\xd5\xc6\x39\xb3\x93\x57\x2e\x1e\x69\xce\x99\xff\x76\xbc\x6b\xda\xac\xf2\xda\x36\x81\xfa\xd4\xa4\x43\x0f\x73\x9f\x4c\x71\x52\x3d\x69\x3e\xe4\xb9\x9c\x00\xc3\xbd\x05\x7d\xf8\xec\x78\x89\x12\xfc\xce\x1c\xd8\xcb\x20\x22\x27\x27\x24\x08\xf6\x05\x6f\xf7\x13\x2b\x49\x86\x56\x9b\xa5\xa3\x10\xba\x18\x8f\xa5\xc2\xb7\xd5\x0f\x4d\x4d\xa4\xac\x3a\xb6\x81\x9a\xd4\x3c\xc5\x24\xa5\x22\x85\x1c\x67\x46\x35\xa9\xfe\xe2\x66\x78\x66\x4f\x1b\x87\x97\x53\xe1\xc4\xc2\xa8\x35\xc9\x55\x95\xba\xee\xe3\x8c\x39\x2b\x61\x6a\x9e\x76\x72\x7e\x41\x0d\x4c\xe8\xf4\x86\x8f\x40\x16\xad\x72\x09\x9e\xc6\x5c\x01\xab\x60\x36\x33\xb7\xac\x7e\x03\x6f\x3c\xab\x54\x2f\xd4\xa0\x5f\xda\xb3\x70\x36\x4b\xfa\x34\xfd\x41\x07\x50\x96\xc9\x9f\x98\xbe\xd4\x70\x29\x6c\x45\xcf\xca\xe8\x5d\x25\xbb\x8c\xeb\x9b\x18\x2b\x99\x82\xd6\xf4\x3e\x87\x73\x61\xb8\x99\xb6\x01\xf7\x58\xdb\xc1\x8d\x40\x2a\xdd\x0c\xaf\xc1\x4a\xec\x35\x45\x1d\x42\x08\x29\x3b\x65\xe7\x9f\x01\x00\xcc\xbd\x88\x96\x60\x17\x00\x00"),
+          path: "mongo-api-http-test.tml",
+          root: "mongo-api-http-test.tml",
+        },
+      
+        "mongo-api-http.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x5f\x6f\xdb\x38\x12\x7f\xf7\xa7\x98\x15\xd0\x40\xda\x53\x95\x60\xd1\x05\x0e\xee\xf9\xa1\x69\xdd\xf4\xae\xdd\xc4\xd7\x38\xbb\x0f\x87\xc3\x95\x91\x46\x36\xb7\x32\xe9\x92\x54\x5c\x23\xeb\xef\x7e\x18\xfe\x91\x25\xd5\x76\x9c\xee\xa2\xfb\xb0\x68\x63\x5b\xe4\x70\xfe\xf3\xc7\x19\xea\xf4\x14\x26\x6c\x86\x50\x60\xc9\x05\x6a\x30\x73\x84\x7f\x5d\x5f\x5d\xc2\xad\x2c\xd6\x20\x4b\x60\xb0\xa4\x79\x59\xc2\xfd\x7d\x36\x5d\x2f\x71\xb3\x01\x85\xb9\x54\x85\x06\x85\xa6\x56\x02\x0b\xb8\x5d\xd3\xec\xb5\x51\x75\x6e\xb2\xab\xdb\x5f\x31\x37\xd9\x25\x5b\xa0\xfd\xd8\x6c\xde\x30\x51\x54\xa8\xb2\x81\x59\x2f\xd1\xc9\xd3\x96\x16\xee\x07\x00\xe0\x46\xb8\x30\xf0\xe1\x57\x2d\xc5\x30\x22\x89\xd1\x07\x3b\xf5\x1e\xf5\x52\x0a\x8d\x13\x54\x7d\x2a\xd5\x9d\xf2\x0b\xa6\xd2\xb0\xaa\x4d\x66\x68\xa0\xe1\xe6\x14\xff\xcf\x7f\xb7\xc6\x34\xdc\xec\x54\xf4\x61\xb0\x19\x0c\x4e\x4f\x61\xac\x94\x54\x41\xfa\x21\xf7\x94\x8c\x57\x58\x80\xc2\x4f\x35\x6a\x93\x42\xc5\xb5\xe1\x62\x66\x49\x4b\x8e\x55\xa1\x2d\x09\x17\x33\x62\x7b\xc7\x2a\x5e\x30\xc3\xa5\x00\x5e\x02\x13\x6b\xef\x94\xae\xb8\x8e\x77\xec\x14\x68\xa3\x88\xab\xd7\x16\x69\xcc\x1b\xf5\xda\x09\xb1\x36\x4d\x58\xfe\x91\xcd\x70\xb3\xc9\xec\xa8\x5b\xea\xd7\x38\x65\x52\xb9\xe0\x06\x17\x4b\xb3\x6e\x4c\x3d\x22\x74\xc0\x17\xcb\x0a\x17\x28\x8c\x86\xb9\x31\xcb\xcc\x8f\xa7\xa0\x51\xdd\x05\x73\xbf\xcc\x10\x59\x92\xd1\x34\x37\xe3\x96\xec\x96\xe5\x1f\x51\x14\xc0\xb4\xf5\x63\x06\x3f\xc9\x5a\x18\x2c\x60\xc5\xcd\xdc\xb1\xbe\x36\x8a\x2f\x27\x0a\x4b\xfe\x39\x05\x6e\xac\x04\xd4\xc3\xc1\xe9\x29\xf1\x82\x8b\xf1\x94\x12\xe3\x94\x3e\xc0\x7a\x5b\x07\x71\xa9\xcd\x55\x9b\x8e\x24\x52\xaa\x02\x55\xea\xbe\xce\xd7\x6e\x12\x98\x28\xa0\x97\x39\xf0\xa9\x46\xb5\x86\x25\x53\x6c\x81\x06\x95\x4e\xad\xa0\xee\x3f\xcb\xa5\x49\xf5\xf7\x76\x07\x64\x6f\x71\xed\xdc\x04\xb5\xa8\x50\xeb\x20\x0b\xb8\x06\x8d\xc6\xb2\x99\x5c\x5d\x4f\xb7\xfa\xe6\x0a\x99\xf1\x89\xe4\xb4\xa6\x2c\x22\x6d\x7d\x02\xd9\x8d\xd7\x31\xf4\x9e\x17\x1b\xf0\x7b\x6d\xd7\x42\x5e\x58\xf2\xc9\x4d\x9b\xbc\x5e\x16\x7b\xe4\x70\xef\xeb\x9d\x32\x5f\x8d\xdf\x8d\xa7\xe3\xc0\xa4\xc0\x0a\xf7\x31\xa1\x70\xd8\xcc\x3d\x26\x7b\x3a\xf9\x7c\xee\x53\xe0\xfe\x3e\xf3\x3f\x37\x1b\x3b\xf1\x13\x1a\xc5\x73\x0d\x0b\xf7\x9d\xf9\x67\x9f\xa5\x97\xb8\x6a\x9c\xc0\x40\xe0\xea\x18\xc8\xe9\xa4\xe7\x36\x29\x77\x64\x64\x36\x28\x6b\x91\xc3\x25\xae\xe2\xdb\x1d\x0a\xa6\xb0\xe8\xeb\x95\xc0\xf7\xc7\x68\xe0\x20\xce\x69\x0e\x27\x47\xac\x70\x0b\x5a\x9e\x1a\x06\x1d\xd3\x66\xc6\xab\x30\x84\x85\x1b\xdb\x78\x27\x5d\xd3\x5e\x79\x33\x9d\x4e\x40\xc9\x7a\x1b\x3a\x1f\xe5\x35\x70\x63\xdd\x3b\x97\x85\xdd\x08\x4b\x66\xe6\xde\xf0\x78\x7e\x94\x39\xc9\x56\x44\xbc\x72\xfb\x35\xa0\xd6\x2f\x8a\x1b\xda\x6f\x0a\xbe\xf7\xe3\x56\x6c\xe2\x3d\xc0\x0b\x18\x8e\x3c\x8c\xe9\x6c\xaa\xf8\x22\x56\xd9\xcd\xfb\x77\xd9\x84\x99\x79\x0a\xd1\x69\x94\x38\xba\xb2\x21\x7a\x29\x85\x61\x5c\xe8\x98\x17\x8e\xc0\xb3\xa2\xff\x2b\x92\x66\xf1\x2d\x5e\xa5\x01\x38\x98\xa9\xf5\xa5\x34\xaf\x65\x2d\x8a\x14\x3a\x78\x38\x56\x2a\xcc\x24\x0d\x13\x17\x15\xef\x41\xfb\xa5\x57\xdc\xe4\x73\x2f\x27\x67\x9a\x52\x1d\x46\x23\x88\x22\x38\x39\x01\x45\xb1\x27\xe7\x8d\x46\x4e\xa4\x7b\xbc\x40\x33\x6c\x78\xce\x33\xc2\x24\x52\x4a\x25\x8f\xe2\x32\x91\xba\xc3\xc6\x41\xc5\x3e\x46\x5b\xca\x55\xf6\x06\x59\x81\x2a\x4e\xb2\x6b\x34\x71\xf4\xa2\xaa\xe4\x2a\x4a\x21\xba\x18\x4f\x53\x0b\x3f\x51\xf2\xb0\xdb\x9c\x0e\x97\xd2\xd8\xe5\x58\xa4\x50\x2e\x4c\x66\x29\xcb\x38\xf2\x39\xf3\x44\x13\xb2\x09\x69\x80\x39\xaa\x28\x6d\xac\x49\x5a\x4a\x1e\xe7\xa7\x19\x1a\x6b\x5d\x0a\xbc\x78\x78\xf1\xa4\xee\x2c\x76\x00\xf7\x88\xf5\xaf\x2c\x98\xb5\x59\x38\x78\xeb\xb1\x28\xb0\x64\x75\x65\x8e\x76\xef\xcd\x34\xf5\xa8\xf9\x2d\xbd\x1c\xf6\x3b\xa5\x9a\x93\xe6\xb6\x7a\xa8\xd5\x02\xd4\x69\xac\x30\x37\xdb\x23\xb1\x7f\xd2\x05\x2c\xf4\x10\xf1\x58\x2c\x20\xf1\x8f\x84\x81\x02\x4b\x54\x30\x0f\x20\x9a\xbd\x94\x15\xa9\xe8\x1f\xe3\xe8\x08\xb9\xd9\x3b\xae\x4d\x94\x0c\x2c\x3f\x67\xd1\x70\x04\x0e\x4c\xfe\x4d\x8f\xb1\x9f\xb3\xa7\x31\xa1\x8e\x25\xca\x2e\x68\x77\xd8\x31\x1f\x2a\x5e\xba\x03\xdb\x6f\xcd\x2d\xb8\xf8\x51\x88\x98\xce\xa3\x36\x3c\x34\x2b\xbe\xf3\x93\x70\x72\xd2\x1a\x29\x50\xe7\x6d\x3e\x7b\xf3\xe0\x9c\x15\xde\x2f\xdd\x0c\x70\xac\x16\xb5\x36\x70\x8b\xc0\x74\x0e\x52\x01\x71\x4d\xa1\x24\xe8\x82\x27\x7f\xfb\x14\xf9\x92\x26\x39\x84\x63\xa1\x10\xd9\x61\xfc\xf9\xba\x67\xfe\xf9\x7a\xb7\x03\x68\x1c\xa2\x1d\xd5\x4e\xc7\x25\x94\x72\x29\xa0\xb2\x8e\xe6\xc2\x4c\xa8\x8c\x8a\xad\xc7\x53\x70\xa5\x7c\x23\x8e\xa8\xbe\x1b\x81\xe0\xd5\x63\x9d\x84\x4a\x1d\x32\xb7\x57\xd1\xed\xd7\xa7\x47\xf8\x4d\x54\xf3\xb5\xa9\x6d\x44\x1a\xcd\xe6\xa1\xb6\xa0\xc8\xbc\xa8\xaa\x58\xd9\xe3\x0e\x3f\x9b\x38\xf1\x01\xee\x95\xae\x69\xbf\x6e\x3d\xac\xfb\x3c\xa3\xb6\x83\xf2\x2e\xa2\x6d\x1a\x3d\xa8\x28\xdf\xc2\xc6\xa8\xcf\xac\x99\x68\xb7\x4e\xf7\x9b\xf6\x72\x9b\xeb\x54\xd3\xf7\x5c\x75\xf5\x36\x05\xd2\x76\xcb\x8c\x9e\x86\xce\xa4\x66\xec\x7d\xd7\xb4\x61\xdf\xd6\x2d\xa5\x6d\xf0\x86\xde\x9b\xad\xf5\x56\xc1\x61\xd0\xd4\x97\x45\x89\xc7\x49\x77\x96\xee\xaa\xbe\x0b\xcc\x65\x81\x05\x94\x4a\x2e\x2c\x44\x86\xce\xae\x05\x8b\xa9\x4d\x04\x2a\x16\xb9\xb1\xb5\xd8\x63\x71\x32\x1c\xe5\xdf\x1c\x29\x5f\x5a\xc1\x01\x2b\xef\x98\x02\xac\x70\x01\x4d\x08\xdb\x19\x34\x1c\x01\x35\x8a\xd9\x25\xae\x5e\x59\xa7\xa8\x58\x65\xe7\xb2\x58\x27\x99\x7b\x8e\x4f\x68\x71\xf2\xfc\x77\xec\x95\x36\xd6\x71\x61\x9b\xe1\x6d\x3f\x3d\x84\x27\xda\xa7\xe9\x03\x79\xfa\xc5\x1e\x72\x76\x76\xf7\xd0\x21\x65\x5b\x9b\xc3\xc5\xe6\xe1\xed\xb1\x2f\xbf\x9d\xe8\xc2\xcb\xf3\xf9\x36\xc3\xce\xb1\xdc\x6d\x9e\x7c\xe3\xc1\x8b\xc7\xa6\xd1\x0c\x8f\x3d\x6d\xa9\xa4\xf2\x65\xf4\x1f\x99\x4e\x17\xd8\x9c\xbb\x64\xed\x6e\x30\xeb\x46\x21\xd4\x55\xbc\x7c\x30\x12\x33\x34\x5f\x1f\x86\xab\xb7\xdd\x08\xb8\xfa\xf0\x40\x1f\xdc\x44\xa1\xdd\x0e\x3f\x08\x09\xc4\xfa\x2b\x8b\xa5\x50\xb1\xfe\x99\x01\xbc\x59\x16\x7f\x09\x3c\x70\x76\xf6\x33\x31\x85\x43\x0a\xb7\x32\xd1\x85\xea\x88\x64\xcc\x6c\xf8\x7c\x83\xd0\xb2\xf1\x52\xda\xc3\x5c\x98\x90\x8e\xae\xd7\x38\x70\xa3\xf2\xd5\xa0\x10\xba\x98\x3f\x33\xad\x5c\x6f\x15\x25\xfb\x03\xe2\x28\xbe\x80\x86\x87\x43\xe1\xcc\xfb\x23\x43\x41\x51\x6e\x83\xb3\xf7\xbc\xbd\x52\xf5\x8c\xb7\x0d\x53\xb8\x0b\x2a\xa5\x6a\x13\xb3\xdc\x5e\xe1\x5a\xe4\xe0\x46\x13\x5b\x6d\xa5\xa5\x80\x0b\x6e\x42\xad\xc0\x4b\xfb\xe9\x5a\x66\x29\x30\x04\xdb\xca\xd2\xb0\x60\xcb\xa5\x13\xe5\x31\xac\x7c\x6c\xf0\xc9\x96\x7d\xa1\xf7\x3a\xba\x68\x5b\xff\xd1\x9f\x54\x21\xf0\x4e\x61\x0a\x53\x90\x1e\x37\x3e\xe6\x65\x98\x0e\x4d\xb4\xa3\xf9\xa7\x30\xa8\x04\xab\xec\x25\x90\xb2\x27\x79\x27\x6c\x21\x89\xc6\x0b\x6e\xe2\x70\x5f\x16\x36\xf8\x6b\x77\x53\x6e\x24\x75\xb8\xbe\x4e\x8b\x82\x9e\x49\xda\xdc\xaf\xfd\xc2\xcd\x3c\x8e\xac\xae\x2e\xee\x0e\x22\xe2\x24\x49\x3a\x11\xef\x00\x4c\xe3\x7e\x32\xc1\x5f\x86\x79\xb3\x7c\x50\x5d\xb4\x29\x33\x82\x6d\xb2\x3c\x3a\x01\x86\xf0\xec\xec\x19\x94\x52\xf9\x77\x03\xdb\x5b\xa6\x1f\xdd\x38\xbd\x30\x18\x7f\x5e\x72\x85\x85\x4f\xf1\x14\x9e\xfd\xf0\x83\x9d\x62\xf0\x73\x73\xed\x6f\x15\xb6\x97\x6f\x3f\x9e\x9d\xb9\x59\xb1\x06\x69\xe6\xf4\x7e\xc4\x06\xbf\x1d\x8d\x10\x31\x2e\xc2\xed\x29\x2f\xe1\x7f\x29\xc8\x8f\x14\x37\x54\x2a\x8b\x3b\xb7\x5c\x3d\x39\xc9\x73\xa2\xdc\x06\xc8\xdf\x42\xb6\x02\x7a\x23\x96\x4a\xe6\xa8\x35\xbb\xad\x70\x2c\x0c\x37\xeb\x1d\xd7\x61\x94\x3b\xad\x2b\xb1\x7d\x17\x6b\xc3\x03\x82\x02\xcd\x7e\x2e\x5d\xef\x1d\xe2\x75\xc1\x0c\xae\xd8\x7a\xca\x17\x28\x6b\xd3\xd6\xf7\x4b\xda\x1d\x19\xeb\xd3\x23\xf4\x86\x7e\x91\x4b\x0f\x21\xc5\x53\x81\x33\x66\xf8\x9d\x7d\x25\x85\x33\x54\xbd\x3c\xe9\xdd\xa1\x50\x97\x06\x67\x74\x0a\xd5\x42\x63\xa8\x0a\xba\x8d\x27\xd4\xaa\xca\x7e\x66\x55\x8d\x3a\x05\xc1\x16\xd8\x60\x70\xcc\x85\x49\xbb\xfb\xf2\x8e\xe8\xba\x8d\x3b\x2d\x69\x36\xa6\x9b\xef\x77\xec\xde\xf2\xb3\x94\xa0\xb4\xed\x12\xd1\x54\x69\xda\xa8\x5c\x8a\xbb\xec\x85\x91\x3c\xb6\x5c\x1a\x9e\x2d\x14\xfe\xed\x37\x10\xf0\x0f\x38\xdb\xc9\xbb\x7d\x5e\x3f\xd1\xdb\x8b\x8a\x9d\x7e\xeb\xde\x59\x90\x0d\x29\xb4\xc4\x76\x43\x26\x9c\xe2\x2e\x34\xdb\xda\x61\x2f\x50\x33\x0d\x4c\xf4\xde\xd2\x35\x85\x9c\xa7\x74\xdb\xdc\x47\xa4\x5d\x8f\xec\x06\x4c\x8f\x0a\x21\x22\xdd\xa8\x28\xd4\x14\x93\x8e\xc0\x7b\xfb\x34\x6c\x43\x54\x53\x41\xdd\xa1\x52\xbf\x63\x9f\xea\xcc\xbf\xd6\x1b\x59\x4e\xfe\x69\x6f\x21\x1c\xd0\x4f\xa1\x0e\xe8\xd7\xcc\xef\x70\xa1\x8d\x42\x78\x07\x77\x84\xdb\x88\xec\x18\xaf\x39\xbe\x14\x7e\x55\xb2\x1c\xef\x37\xc1\x7b\xfd\x4b\x54\x7f\x24\x3f\xa5\x2e\x94\xee\x52\xd9\x72\x59\xf1\xdc\x3a\xe4\x94\x8a\xce\xe7\x90\xcf\x99\xd2\x68\x46\xb5\x29\x9f\xfe\x3d\x4a\x76\x9c\xf1\x4e\xb0\x9b\x09\x85\xea\x58\xb8\x42\x75\x95\x64\xee\x67\x7c\xc7\xaa\x1a\x93\xc1\x66\xf0\xff\x01\x00\xa1\xc5\x73\x52\xea\x1e\x00\x00"),
+          path: "mongo-api-http.tml",
+          root: "mongo-api-http.tml",
+        },
+      
         "mongo-api-json.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xcf\x4a\xc4\x30\x10\xc6\xcf\x0d\xe4\x1d\xc6\x3d\x48\x0b\x4b\xf6\xae\xf4\x05\x44\x76\xc5\xc5\x93\x08\x3b\xcd\x4e\xd7\xd6\x76\x22\x49\xea\x1f\x42\xde\x5d\x92\xae\xe0\x41\xd0\x5e\x12\x18\x92\xdf\xf7\xe3\x1b\x29\x36\x1b\xe8\x9d\x61\x68\xbb\x0f\x3f\x59\x72\xa0\x94\x92\xe2\x0d\x2d\x94\x52\x40\x08\x6a\xef\xed\xa4\xbd\xda\x35\x3d\x69\xaf\xb6\x38\x52\x3e\x62\xbc\xd9\xef\xb6\x50\xc3\x21\x04\x18\xf1\xf5\x1e\xf9\x68\xc6\x3c\x3b\x7f\x81\x55\xe3\x0c\xaf\x60\xd5\xe7\x2b\xc6\x83\x14\x95\x14\x39\xf2\xd6\xe0\xf1\x4f\xb6\x25\x3f\x59\x76\x80\xc0\xf4\x0e\x1d\x3b\x8f\xac\x09\x4c\x0b\xf8\x43\xec\x0e\xf5\x0b\x9e\x28\x46\xf5\x2b\x30\x46\x25\x45\x3b\xb1\xfe\x57\x66\xa9\x0d\x7b\x62\x0f\xce\xdb\x8e\x4f\x15\x94\x0b\x82\xd6\x40\xd6\x1a\x5b\x41\x90\xa2\x48\x0d\xd2\x40\xe3\x12\xd3\x54\x4e\xd1\xb5\x09\x03\x57\x75\xde\x8b\x7a\xe0\x11\xad\x7b\xc6\xa1\x7c\x7c\x6a\x3e\x3d\x7d\x1b\x56\x6b\xb8\x4c\xfc\xea\x3a\x3f\xbf\xa8\x81\xbb\x21\x27\x17\x73\x6f\x4b\x82\xc3\xec\x2e\x45\x31\x3b\x9c\x09\x89\xbf\x4e\x5c\x29\xf2\xfc\x2b\x00\x00\xff\xff\x39\x66\x83\xa1\x2e\x02\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x4f\x4b\xc3\x30\x18\x87\xcf\x0d\xe4\x3b\xfc\xec\x41\x5a\x18\xd9\x5d\xd9\x17\x10\xd9\xc4\xe1\x49\x84\xbd\xeb\xde\xce\xce\xf6\x8d\x24\xa9\x7f\x28\xf9\xee\x92\x6c\x82\x07\x41\x77\xe9\xa1\x24\xcf\xf3\xf0\x8b\x56\xf3\x39\x0e\xde\x0a\xda\xee\x23\x8c\x8e\x3d\x8c\x31\x5a\xbd\x91\x43\xa5\x15\xa6\xc9\xac\x83\x1b\x9b\x60\x56\xdb\x03\x37\xc1\x2c\x69\xe0\xfc\x89\xf1\x66\xbd\x5a\x62\x81\xcd\x34\x61\xa0\xd7\x7b\x92\x9d\x1d\xf2\xbf\xd3\x15\x94\x5b\x6f\xa5\x44\x99\xf8\x25\x62\xdc\x68\x55\x6b\x95\x95\xb7\x96\x76\x7f\xb2\x1d\x87\xd1\x89\x07\x41\xf8\x1d\x9d\xf8\x40\xd2\x30\x6c\x0b\xfa\x11\x76\x47\xcd\x0b\xed\x39\x46\xf3\x2b\x30\x46\xa3\x55\x3b\x4a\xf3\x2f\x67\xd5\x58\x09\x2c\x01\x3e\xb8\x4e\xf6\x35\xaa\x33\x44\x33\xb0\x73\xd6\xd5\x98\xb4\x2a\xd2\x82\xdc\xf3\x70\x4e\x69\x1a\xa7\xe8\xda\x84\xc1\xd5\x22\xbf\x8b\x79\x90\x81\x9c\x7f\xa6\xbe\x7a\x7c\xda\x7e\x06\xfe\x2e\xac\x67\xb8\x4c\xfc\xfa\x3a\x1f\xbf\x58\x40\xba\x3e\x9b\x8b\xe3\x6e\xe7\x88\xa7\x63\xbb\x56\xc5\xb1\xe1\x44\x48\xfc\x19\xa4\xeb\xb5\x8a\x5a\x69\xf5\x35\x00\x39\x66\x83\xa1\x2e\x02\x00\x00"),
           path: "mongo-api-json.tml",
           root: "mongo-api-json.tml",
         },
       
+        "mongo-api-memory.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\xdd\x6e\xab\x46\x10\xbe\xf7\x53\xcc\x39\x52\x23\x38\xd9\x10\xe7\xd6\x11\x91\xea\xfc\x54\x55\x9b\x36\xaa\x9a\x2b\x0b\x45\x98\x1d\xac\x15\xb0\x4b\x97\xa5\x35\x42\xbc\x7b\xb5\xcb\x02\xc6\xc1\x76\x12\xa5\x52\x4f\x14\x09\x33\xb3\x33\xf3\x7d\xf3\xb3\xcc\xe5\x25\x64\x98\x09\x59\x2d\xc3\x28\x41\x4e\x81\x65\x79\x8a\x19\x72\x55\x40\x5d\x7b\x56\xda\x34\x04\x12\xc4\x9c\xf1\x0d\x48\x8c\x84\xa4\x05\x30\x6e\x2d\xf5\x2f\x21\x29\x4a\x10\x31\x44\x12\x43\xc5\x04\xf7\x66\xaa\xca\x71\xcf\x77\xa1\x64\x19\x29\xa8\x67\x00\x00\x59\x0a\x45\xc5\x23\xef\xb1\x54\xb8\x35\x12\x46\x0b\x58\x05\x85\x92\x8c\x6f\x8c\xa0\x0b\x95\x85\xf9\xaa\x15\x07\x75\xed\xfd\x59\xe5\xd8\x34\xb3\x66\x36\x8b\x4b\x1e\x01\xc7\x7f\x1e\x77\xa3\x38\x2e\x7c\x1b\x87\xad\xad\x33\x55\x4a\x0e\x67\x23\x5d\x6d\x43\x2c\x26\x63\xd4\x3b\x61\x9c\x6c\xbd\xe7\xd7\x85\x5b\x51\x72\xe5\x44\x6a\x0b\x91\xe0\x0a\xb7\xca\xbb\x6d\x9f\x2e\x38\x8c\x2b\x02\x28\xa5\x90\xae\x05\xc0\x62\x88\xd4\xd6\xbb\x97\xd2\x71\xe1\x8b\x0f\x9c\xa5\x56\xb3\x03\xef\xe2\x8a\xe8\xbc\x3f\x85\x51\x12\x6e\xb0\x69\xf4\xf1\xfb\x6d\xce\x24\x52\xeb\xdb\x58\x34\x33\xf3\xc8\xd6\x5e\x96\x7a\xbf\x8a\x28\x71\x5c\x23\xa0\x18\xa3\xb4\xe2\x67\x9e\x0e\x0a\xeb\x3e\x45\xee\x64\x6b\x8f\xd1\xc2\x25\x1a\xc0\x51\x7a\x77\x98\xa2\xc2\x29\x7e\x04\xf2\x72\x9d\xb2\xe8\xe7\x3b\x68\xeb\xe2\xb6\x54\xdf\xc3\xf4\xb3\x59\x76\x91\x5f\x08\x88\x04\x16\xbe\xd6\xdb\xea\xae\x3a\xb4\xc1\x35\x7c\x11\xc9\x69\x2c\xbf\x09\xf5\x20\x4a\x4e\x77\x51\xd0\x36\x1b\x83\xd7\x21\x09\x6d\x8a\x63\x21\x81\x71\x8a\x5b\x02\x8c\x6a\x00\x32\xe4\x1b\xd4\x30\x74\x63\x0f\x31\x59\xac\xf5\xbe\x3f\xe4\x70\xd0\x59\xba\xda\xc0\x87\x30\xcf\x91\x53\x5b\xaf\xd5\xc2\xf8\x0e\x88\xd5\xaf\xcc\xeb\xf9\xd5\x22\xf0\x3c\xcf\x1d\x39\x58\x4b\x0c\x93\x5e\xd2\xec\x92\xb0\x7c\x4f\x55\xfe\x56\x4f\xf1\x81\xca\x63\x8a\x19\xf4\x23\xf2\x3f\x2f\xbc\x06\xeb\xd5\xb5\xf7\x87\x79\xf7\x7e\xc1\xaa\x69\x82\x6b\x98\xea\x81\x38\x53\x1a\xb8\x90\xb1\xf3\xb5\xed\x1b\xf8\xe1\x2f\x08\x53\x89\x21\xad\x00\xb7\xac\x50\xc5\x57\x02\x53\x1e\xdd\x3d\xd0\x13\xe5\x3b\x66\x78\x0a\x2f\xf8\xc6\xf8\x3d\x05\xfc\x09\xd5\x1b\xe7\xd6\xe9\x4b\xf9\x91\xeb\xaa\x37\xae\x9b\xcf\xbf\xb7\x8c\x46\x33\x3f\x36\xd1\x1d\xd6\xc9\xb9\x6e\x8d\xdf\x32\xdd\x23\x83\x53\xd9\x7d\xce\xe9\xc1\xf1\xd8\x4b\xf0\x77\x36\x2f\x9f\x71\x51\x4e\xf5\x30\x0c\xb7\x5d\x87\xf4\x55\xc8\x8f\xb5\xf9\x8f\x69\xba\xac\x7e\xd7\xeb\xc7\x74\x3d\xda\xcd\xa4\x2b\x86\x79\x5b\x56\xf6\xdd\x05\x67\x15\x1c\xea\x7f\x8b\x8f\xe8\x3c\xa1\x94\x36\x51\x6d\x44\x1d\xca\x3a\xeb\x7d\x12\x98\x13\x98\x8f\xbe\xb6\xbd\x0b\x94\xf2\x14\x8f\x65\xf5\xc0\x30\xa5\xd3\x24\x12\xec\x20\x13\xf8\x3b\x4c\x4b\x04\xc6\x15\xca\x38\x8c\xb0\x6e\x8e\xcc\xb0\xc5\x71\x74\x4a\xfb\x12\x9e\x00\x68\x69\xbf\x2f\xc3\x04\xf2\x70\x63\xd0\x12\x90\x58\xe4\x82\x17\xf8\x84\xf2\xc9\x0a\xf7\x0b\xf0\xc1\xa5\x89\xb3\x94\xfc\x27\x9b\x53\xbf\x96\xb6\xc5\x67\xb4\xe8\x40\x19\x5a\x37\x30\x87\xb3\xb3\x57\xc4\xb4\x78\xc0\x58\xa8\x50\x2a\xdd\x3d\x8e\xb1\xb9\x80\x2b\x17\xbe\xed\xdb\xf4\xa7\x59\x6c\x0d\x6e\xcc\xae\xc6\x68\xd1\x65\xa2\xfb\x6b\xd5\x7e\xaf\xee\x95\x96\x99\xfe\xd7\x5b\xef\xc2\xb7\x47\xcf\x8f\x05\xd3\x27\x0f\x86\xd2\xca\xe3\x81\xda\x2f\x9d\x5e\x47\x4c\xac\x05\x72\x1a\xec\xa6\xd9\x4e\x80\xa6\x9f\x85\x09\x8e\xab\x3d\x27\xbd\xef\x61\x83\x7a\x19\x6f\x4f\xe3\xd5\xa9\x73\xd7\x7f\x5c\xad\x80\xec\x5e\x28\x8c\x06\xa3\x0f\xb2\x6d\x92\xfe\xe8\xd4\x0e\xfc\xef\x00\xf7\xbc\x12\xae\x07\x0d\x00\x00"),
+          path: "mongo-api-memory.tml",
+          root: "mongo-api-memory.tml",
+        },
+      
+        "mongo-api-outbox-test.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5d\x6f\xdb\x3a\xd2\xbe\xf7\xaf\x98\xf8\x7d\x73\x20\x75\xb5\x74\x4e\xf6\xce\x85\x2f\xda\xe4\x14\x9b\x3d\xdb\x0f\x34\x2d\xf6\x22\x08\x16\xb2\x38\xb2\x79\x22\x93\x5e\x92\xce\x07\x52\xff\xf7\x05\xc9\xa1\x6c\x29\x8a\x6c\xa3\x45\x71\x16\x08\x60\x20\x11\x35\x9c\x79\x38\x9c\x99\x67\x48\x8d\x46\xb0\xc0\x85\xd2\x0f\x9f\x56\xd3\x4a\x98\x39\x6a\x10\x8b\x65\x85\x0b\x94\xd6\xc0\xe3\x23\xfb\x94\x17\x37\xf9\x0c\xd7\x6b\x56\x4b\x64\x30\x57\x15\x17\x72\x06\x76\x8e\x80\xb7\x5e\x74\x49\x6f\x39\x58\x05\xc2\x42\x2e\xf9\x60\x34\x82\x32\x17\x55\x4b\x52\x95\x7e\x9e\xc6\x42\x69\x6e\xc0\xa0\x85\x3b\x61\xe7\x42\x7a\x61\x36\xb0\x0f\x4b\x7c\x02\xca\x58\xbd\x2a\x2c\x3c\x0e\x00\x00\x16\x15\x98\x07\x59\xb0\xf7\x2b\x8b\xf7\x7e\x84\x54\x5f\x5d\x37\x10\xff\xe6\x46\xfd\x7b\xa7\x19\x16\xf9\xf2\xca\x58\x2d\xe4\xec\x7a\xaa\x54\x35\x58\x0f\x1c\x42\xb2\x01\x39\xe7\xc6\x03\x9b\x89\x5b\x87\xd8\xab\x74\x8b\x79\x0a\x3d\xae\x55\xb3\x41\xb9\x92\x05\x24\x4b\x78\xd5\x02\x9c\x46\xbd\x49\x61\xef\xa1\x50\xd2\xe2\xbd\x65\x67\xe1\x6f\x46\xca\x9f\x82\x4d\x01\xb5\x56\x9a\xd6\xb9\x64\x8b\x8a\xfd\x53\x15\x37\x49\xea\x9f\x39\x96\xa8\xc3\xe8\x57\x59\x85\x71\xff\x42\x94\xb0\x64\x6e\x8d\x57\x5e\x71\xd8\xaa\xe2\xe2\xfc\x9a\x14\xb9\x9f\x46\xbb\xd2\x12\xca\x85\x65\xbf\x39\x1b\x65\x32\x74\x33\xc2\x86\xd1\x8a\x08\x97\x2a\xe1\xd8\x0c\x33\x68\x2a\x0b\x18\xd6\x03\x82\x46\x2e\x99\x40\xbe\x5c\xa2\xe4\x49\x1c\xa1\x69\xe9\x60\xcb\xa8\x14\xd1\xdb\x37\x42\x72\x43\xc3\xc1\xdd\x61\x44\x95\x90\x57\x55\xed\x59\x4e\x1e\xcf\xe0\x0f\x25\x24\x72\x98\x3e\xc0\x30\x1b\xf6\xf9\xdb\xeb\x49\x52\x08\x3b\x7c\xa8\x07\x6f\x73\x4d\x48\xae\xae\x83\x06\x2f\x5f\x2a\x0d\xff\xa6\x15\xc1\x78\x02\x3a\x97\x33\xdc\x2c\x7e\xe3\xdd\x30\xb7\x76\x86\x7f\xa4\x79\xec\x77\x21\x79\xc3\x79\xe4\x95\x60\xc7\xb0\x7f\x28\x21\xe3\x8c\x61\x36\x4c\xc9\x55\x06\xed\x3b\x17\xb6\x06\xad\x81\xbb\x39\x5a\x97\x9c\xcd\x40\x0c\x39\x14\x9f\x28\x70\x97\xb4\x5f\x3e\x9d\xb6\x36\xb7\xcf\x79\x64\x2b\xa9\xe7\x06\x6c\x59\xd0\xe1\xb2\x25\x3d\xd4\xa3\x14\x90\x51\xe3\x35\x4c\xbc\x32\x5a\x1c\xde\x2f\xb1\xb0\x9f\xb1\xca\x1f\x90\x83\x76\x7f\x4d\x47\xa6\xd1\x92\xfc\xfb\xac\x51\x4c\x2c\x1a\x0b\xa2\x74\xa5\xc6\x0d\x1b\x50\x1a\xb8\x42\x03\x52\x59\x17\x68\x06\x25\xdf\x56\x51\xa8\x55\x88\xec\x60\x80\x9c\xd1\x80\xd1\x9d\xaa\x16\x5e\x39\x5b\x42\xce\xd8\x97\x2c\x20\x85\x57\x8d\xcc\xfd\xb8\xb2\x53\x75\xef\x95\x64\xa4\x11\x39\x08\x97\xcd\xc1\x67\x06\xa5\xcd\x5c\x66\xfb\x10\x72\x72\xcc\x4b\x3b\x83\x69\x4c\x60\xf7\xfa\x68\x02\x52\x54\x34\xcb\xfd\x2c\x7b\x97\xdb\xbc\x6a\xe4\x6a\x80\xa0\xbc\x51\x5a\xcd\x18\x8e\xff\xf2\x1f\x97\xb0\x5a\x37\x02\x4d\x94\xde\x36\x1c\x4d\x36\xb8\xba\x94\xd7\x2f\x8f\x69\x2f\x90\x37\x0d\x64\x30\x53\x16\x8e\xf9\x70\xb3\xc0\xcc\xab\x8e\xe6\xd6\x83\xe0\xd0\x2f\x68\xec\x96\x3f\x92\x6d\xef\x6d\xdc\x61\x8c\x50\xd2\x39\xc3\x79\xf6\x32\x3c\x26\x76\x3b\xa0\x48\x86\x9d\x55\xca\x60\x0c\xa9\x42\x55\x71\xd2\x99\xaa\x2a\x2c\xec\xb3\xf3\xce\xdf\x26\x85\x92\xa5\x98\xb1\xf3\xb7\x29\x3b\x4b\x0a\x55\xa5\xec\x5c\xab\xe5\xd6\xc4\x7d\xe6\x75\x6c\xf4\x96\x06\xa7\xb5\x43\x6d\xd0\x3b\x75\x58\x1b\xf3\x3f\xe0\xdd\x7b\x25\x67\xaa\x36\x12\x10\x18\xab\x34\x76\x09\x3b\xd4\x19\x2c\xd0\x6a\x51\x18\x3f\x90\x66\xc0\xa7\xd1\x1b\xf6\x3e\x83\x22\x97\x05\x7a\xaf\xc4\xc0\xfd\x97\xb0\xf3\x2f\x62\x81\x6a\x65\x93\x38\xf6\x36\x2f\x6e\x66\x5a\xad\x24\x4f\xd2\x0c\x7e\x3d\x81\x57\x60\xc5\x02\xd9\x25\x16\x2a\xd6\xa6\xe0\x87\xa0\x2f\xae\x01\x2b\x5c\x38\xdd\x95\xca\xf9\x3b\x71\x6f\x57\x1a\x13\xdb\x88\xd8\xf1\x24\xc0\x67\x67\x1a\x73\x8b\x2e\xa2\x33\x3f\x2d\x7d\xbd\x7f\x44\xe7\x9c\xbb\x56\xe3\xd2\x73\x3c\xfb\x38\xfd\x03\x0b\xcb\x3e\xe4\x0b\x5c\xaf\x63\x89\x13\xd2\x2a\xe0\xd3\x9e\x40\x6f\xa0\xf9\xba\xe4\x0d\x34\xec\xf1\x91\x7d\xf6\x9a\xd8\xef\xf8\xb0\x5e\x1f\x8e\x71\xe5\x35\xee\x86\x79\x00\xc8\x73\xac\xb0\x0f\xe4\x21\xf0\x34\x2e\xd4\xed\x4e\x78\xa5\x56\x8b\x1e\x80\x91\x7d\x3d\xc6\x5f\x5a\x24\xf1\xe8\x8c\x8d\x61\x91\xdf\x60\xd2\xea\xa4\xd2\x35\xd1\x9a\x2b\x4c\x1d\x71\xbc\x5d\x10\xba\x43\x3a\x8b\x24\x85\x3a\x86\x5e\xbb\x32\x67\x60\xa9\xfc\x66\xf0\xb7\x74\xb7\xcc\x09\x29\x12\x25\x91\xf3\x78\xb2\x31\xc2\xa8\x5b\x78\x4d\xef\x8e\x26\x30\x2c\x7c\x04\xf3\x2c\xec\x34\xcf\xb8\xdf\x1e\x3e\xec\xaf\x98\x71\x16\x05\x08\x77\x8d\x2f\xd0\xd4\x66\xf1\x74\x71\xeb\xad\xb5\x83\x82\x36\xa7\x81\x2f\xcc\xbc\x3a\xb9\xa6\x88\x78\x1d\xa5\x26\x21\x1a\xbe\x7d\xa3\x81\x76\xd0\xb8\x70\xe9\x0a\xa6\xbd\x56\x41\x8d\x8e\x55\xbe\xc3\xf7\xf4\x19\xdf\x04\x6b\xb4\x94\xff\xbb\x1d\x66\x34\x72\xc0\x62\x4e\x9f\x2c\xa6\x27\xb4\x6b\x68\x0d\x5f\xd6\xd0\xa4\xda\x89\xa8\x8b\x92\xac\x7e\xa1\xa4\x17\x4a\x7a\xa1\xa4\x48\x49\xdf\x59\xf1\x37\x29\x1e\x0f\x10\xdd\x2b\xb3\x7a\x85\xe9\xe0\x87\x92\x44\xad\xcb\x47\x92\x2a\x4b\x98\xc0\xc9\xc6\x02\x7b\x9f\xdf\xbf\xb1\x16\x17\x4b\x7f\x46\x3d\x0d\xc6\x47\x23\xf8\x32\xc7\xba\x54\x87\x92\x22\x0c\xcc\xb1\xe2\x30\xcd\x8b\x1b\xb8\x9b\x8b\x0a\x1b\x85\xaf\x16\xd2\x0e\x0f\x72\xb6\x17\xf5\xec\x4d\x4f\xfb\xba\xb0\xcc\x2b\x83\x7b\xe8\xfd\xf5\x50\xda\x23\x67\xec\xa0\x39\x25\xab\x07\xb0\x4f\x7c\xa7\x64\xd1\xe5\x2d\x17\x38\xc8\x7b\x79\xcf\x1d\xb8\x9f\xbb\x05\x69\xe7\xcf\x77\x14\xc2\x77\x42\xf2\x64\x6a\x94\x64\xef\x1f\x87\x0e\xc5\x70\xdc\x61\x30\x54\x0a\xbe\x4e\xd9\x47\x89\xc9\x2f\x1e\xd7\x21\x99\x18\x42\xe3\x16\x1b\x87\xa6\xbe\xc2\xe0\x0c\xb0\x4b\xeb\x3a\xca\xa3\x56\x2a\x78\x40\xee\xe0\x8f\x1c\xbe\x7d\x23\xd1\x3a\x94\x8f\x26\x70\xba\x19\xf6\x17\x39\x30\x99\xc0\x70\xbf\x2e\x85\x3c\x6e\x95\x3f\x4a\x43\x5e\x5a\xd4\x70\x0a\x39\x69\xdf\xe6\x52\xbc\xed\x3d\xdd\x5d\xa2\xb5\x15\xbe\x70\xe9\x0b\x97\xfe\x0c\x2e\x1d\x8d\xc0\xa7\x85\x81\x0a\x4b\x0b\xee\x8a\xcd\xdd\xe9\x4c\x1f\xdc\x44\xd4\x7a\xb5\x74\xb5\xe7\x4e\x0b\x8b\x66\xbc\x55\xa6\xe0\x2e\x37\x20\xf1\x16\xb5\xbb\x98\xab\x84\xab\x49\xb9\xd9\xba\x3a\x8b\xda\x9d\x5c\x95\x1b\xeb\x75\x58\x94\xee\xbe\x31\x2c\x39\xdb\xa2\x84\xd8\x8a\x46\xd0\x06\x16\xc2\x18\x17\xfc\x5e\x0f\x25\xff\xf7\x55\xac\x9e\x6b\xc7\xae\xdb\xed\x8d\xe7\x1f\xdd\x35\x63\x57\x71\xfb\x4a\xe7\x19\x88\x17\xb9\xe3\xce\xfe\x63\x9d\xed\x56\x15\x4e\xac\x0d\x55\x43\x72\xc1\x90\xe6\x6f\x1f\x34\x3c\x7e\x76\x71\x0e\x13\xf0\x25\xd8\x1d\x05\x7d\x8b\x72\xc1\x93\xb4\x25\x15\x8a\x61\x2b\x49\xfc\xa6\x7f\x0a\xbb\xdd\x92\xa7\x92\x0d\x93\x70\x93\xf1\x41\xdd\x25\x29\x7b\xc3\x79\xf2\x57\xff\xfc\x77\xb5\x8a\x27\xc9\x66\x38\x87\x4d\x62\x17\xd2\xa0\xb6\x49\x6f\xa5\xef\x8b\xe7\xde\x42\xef\x7e\xeb\xed\xf8\xfd\xce\x36\xeb\xe7\x1d\xac\x4f\x0f\xed\x1e\x28\x25\x32\x22\x98\x1d\x34\x14\x13\x28\x97\x2d\x4a\xea\x3f\x23\x53\xbe\x67\xad\x3d\x6c\x70\xbb\x71\xf1\x33\x1c\x3f\x1f\x3f\xeb\x94\x9d\xb9\x3b\xe0\xa4\x51\xe0\x9e\x6c\x7a\xd7\x86\xd3\xdd\xf1\xd6\x96\x9b\xe7\x2a\x95\x28\xeb\xea\x74\x34\x81\x93\x7e\x77\x48\x55\x0b\x6f\xc7\x53\x74\x86\xbb\x6d\xa5\xf7\xd1\xc6\xd3\x33\x2d\xad\xee\x85\x89\xff\xa7\x99\x78\x34\x82\x37\x92\xea\xbd\xfb\x14\xe6\x79\x08\xc1\x58\x51\x55\x20\x24\x94\x95\x98\xcd\x6d\xb6\xa1\x27\x2c\x95\xee\xea\xbc\xe9\xbb\x09\xdd\x0d\xed\x62\xf9\x18\x7d\x6d\x97\xb4\xa8\xe5\xe2\x7c\xdc\x51\xc0\x37\x7c\xb1\x8b\x79\x6a\xc1\x7e\x06\xda\xc8\x79\x32\xe8\xc9\xe5\x8d\x24\xd1\xc0\xb8\x9b\x05\xc8\xed\xc4\x4c\x83\x1f\xcc\xd2\xcf\x91\x4a\xcc\xd9\x03\x5b\xa4\x5e\x4a\x59\x0f\x5a\x26\xff\x0c\x6d\x99\xb2\x44\x69\x07\x36\x90\x7e\xde\xcf\x85\xfa\x67\x62\x60\xba\x85\x78\x92\xb8\x6e\x03\x9b\xb7\x11\xd3\x70\xe6\x8e\x69\xea\x25\x63\x43\xea\x9d\xe8\xc4\xa5\xb2\xec\xa0\x7b\x81\xfa\x23\x6f\xd7\x25\xed\xc9\x75\xfd\xc1\xff\xf5\x46\xf2\x68\x12\xec\xb5\x53\xb6\x9f\xdf\xea\x4b\x83\xce\x1a\xe5\x15\xd2\xf6\x05\xc6\xab\xbf\xff\xba\xef\xb4\xc7\x6e\x1b\x23\x80\x67\x92\x80\xf2\x2e\xd4\x99\x0b\x1e\x33\x8f\x5d\x9c\x67\x10\x1b\x83\xff\x37\x68\x87\x63\xd8\xdd\x27\x7c\xc6\x9c\x1f\xf8\xd5\xc5\x45\x4c\x2c\x28\x01\xb8\x76\x4a\x9e\x0b\xc2\x1f\xdb\x7a\xb5\xbf\x57\xec\xd7\x82\x39\x1f\xfb\x00\xa3\x0f\xed\x42\x86\xcd\xd0\x1c\xb5\xfb\xef\x01\xee\x50\x63\x24\x9a\xe7\xda\xb2\xf5\xe0\xbf\x03\x00\xba\xad\x0f\x01\x39\x24\x00\x00"),
+          path: "mongo-api-outbox-test.tml",
+          root: "mongo-api-outbox-test.tml",
+        },
+      
+        "mongo-api-outbox.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\xdf\x8f\xdb\xb6\x93\x7f\xf7\x5f\x31\x35\x8a\x40\x4a\x54\x25\xb9\x87\x3e\xb8\x75\x81\x64\x37\xbd\x5b\xf4\x92\x16\x49\x0f\xf7\x10\xe4\x52\x5a\x1a\xaf\x99\x95\x48\x1f\x49\xad\xd7\xd8\xf8\x7f\x3f\x0c\x39\x94\x28\xff\xd8\x3a\x97\xf6\xdb\xb4\xc0\x5a\x14\x39\x1c\x0e\x67\x3e\xfc\xcc\x50\x4f\x9f\xc2\x2f\x52\xd5\x16\xf4\x12\xdc\x0a\x01\x6f\x51\x39\x0b\x1b\x23\x9d\x43\x05\x8b\x2d\xdc\xdf\x97\xef\x9c\xe9\x2a\x57\xfe\xba\xf8\x84\x95\x2b\xdf\x88\x16\x77\xbb\xcb\x97\x05\x68\x85\xb0\xd4\x06\x50\x54\x2b\x12\x20\x79\x24\xda\x72\x52\x69\x65\x1d\x64\x13\x00\x80\x57\x24\xf4\xc2\xa0\x70\x58\xc3\x1c\xa6\x55\xf8\x39\x1d\x5e\xfe\xd7\xba\x8e\x2f\xbb\x75\xbd\xf7\xf2\x12\x1b\xe4\x97\x75\xf8\x39\x9d\xe4\x93\xc9\xd3\xa7\xf0\xce\x09\x87\x07\xba\x4b\xb7\x92\xca\xb7\xe8\xce\x2d\xf4\x5d\x19\xe6\xb0\x20\x0c\xf6\x2b\x5b\xa3\xaa\xa5\xba\x86\x05\x2e\xb5\x41\xea\x2d\x8d\x7f\x89\x05\xb4\xa2\x46\x12\x6f\x50\xd4\x5b\xd0\xaa\x42\x90\x0e\x6c\x57\x55\x88\xb5\x2d\xa8\xb3\x02\x8b\xca\x91\x7d\x68\x9e\x5f\xfd\x3c\x6f\xb1\x11\xdb\x02\xb4\x81\xa5\x90\x0d\xd6\x61\xa4\xee\x1c\x29\x28\x9c\xc3\x76\xed\x8e\x59\xe6\x37\x56\x65\x0e\x53\xd6\x2a\x59\xfc\x5b\xaf\xc3\x1c\xa6\x5e\x99\xe4\xc5\x3b\x9a\x7f\x0e\x53\xd2\x23\x69\xfe\x39\x4c\x3d\x87\x69\x50\x22\x9a\x2a\x98\xe2\xb5\x30\x37\x68\x40\x5a\x5a\x03\x2c\x25\x36\x35\x29\x67\x9d\x36\x58\x83\xc1\x4a\x9b\xda\xc2\x4a\x37\x5e\x21\xea\x23\xeb\x91\x79\xf9\x41\x1a\x68\x84\x75\x6c\x30\x92\xdf\x59\xac\x8f\x98\x03\x9c\x86\x6a\x85\xd5\x0d\x6c\x56\xe8\x56\x68\xbc\x28\x3f\x8c\x44\x89\x7e\x1f\xbc\xe3\xc1\x46\x58\x10\xeb\x75\x23\xb1\x8e\x86\x1a\x29\x3e\x87\xe9\xc7\xd0\x30\xf5\x0e\xe0\x97\x0c\x35\x2e\xa5\x42\x0b\x02\xaa\x95\x50\xd7\x2c\x79\x70\xdc\xdf\x44\x75\x23\xae\x71\xb7\x2b\x4f\x38\x33\xaf\xbc\xe8\xbd\x43\x2a\xa7\x49\xd3\xc1\x72\x50\xe9\xa6\xc1\xca\x49\xfd\x70\x54\x94\xf0\xd6\xcb\xf2\x46\x0c\x66\x0e\xc2\x41\xf4\x61\x55\xc0\x66\x25\xab\x15\x48\x4b\xf2\x95\x6c\x7c\x14\x79\xdf\x96\x5a\xd9\x72\xe2\xb6\x6b\x0c\xbe\x01\xd6\xab\x0b\xf7\x7e\x87\xaf\x2e\x61\x61\xb5\xe2\x39\xaf\x6a\xf8\x83\x1e\x67\xd3\x8f\xb2\x9e\xc2\x27\xff\x53\xd6\xd3\x3f\x7c\x5f\x0a\x6b\x1a\x4d\xc6\xe5\x6e\x37\x52\xf5\xfd\xfc\xef\xd0\xf3\xb7\x6e\xd1\xc8\xea\xea\x72\xaf\xf7\xda\x37\x27\xa2\x87\x86\x30\x8e\x17\xfa\xf8\x4b\x0c\xcd\xb2\xd9\xde\xba\x95\x3e\x2a\xb6\x71\x8a\x83\xf6\x30\x93\x8f\xf3\x3d\xf5\x2c\xb5\xc5\x71\xe1\x21\x74\x7e\xc1\x91\x06\x52\xb9\xd8\x39\x46\x5f\xec\xdf\x3f\x87\x21\xaf\x8c\xd1\x66\x4f\x3e\x52\x5b\xa2\x09\x7c\x3a\xda\x1c\x04\x44\x70\x73\xb2\xc5\xf2\x77\xd9\x62\x94\x12\xa1\x0e\x3e\x8d\x1e\xc3\xa8\x37\x78\xe7\x58\xdb\xc3\x91\x0a\xef\xdc\x47\xd6\x33\x0e\x1f\xb5\xb1\x69\x50\x1d\x19\x4c\xa0\x70\xa8\xfb\x5e\xeb\x1f\x93\x9d\x0f\x22\xbf\xff\x96\x62\x33\x06\x12\x79\x6d\x8d\xd6\x49\x25\xbc\xc3\x8f\x01\xb6\xc6\x46\xde\x22\x01\xc6\x62\x0b\x42\xa5\xe1\x5e\x46\x61\xd0\x76\xd6\x91\x70\xad\x9a\x2d\x18\x74\x9d\x51\xde\xd3\x3d\x26\x0e\x70\x22\x13\x71\x05\x85\x08\xcf\x41\x50\x6d\xd0\x19\x89\x35\x74\xca\xc9\x86\x10\xb8\xd6\x68\x63\xec\xb4\x62\x4b\xe2\x79\x30\xa9\xe1\x47\x42\x1b\xd0\x5c\x28\x8f\xbe\x1c\x4a\xc3\x0a\xa5\x72\x68\x96\xa2\x42\x8e\x28\x7e\x93\x55\xee\x0e\x2a\xad\x1c\xde\xb9\xf2\x22\xfc\x2d\x58\xa2\x8f\xc3\x1c\xbc\x3f\xb0\xc5\xc2\x8a\x2f\x06\x44\x08\x0b\x0c\x86\x53\xa2\xc5\x88\x99\x87\xd8\xc1\x2f\xae\xe5\x2d\xf9\xda\xf0\xa2\x9c\x2c\x3b\x55\x1d\x48\xce\x2a\xdd\xb0\x63\xe6\xd1\x41\x83\xe6\x6c\x53\x7a\xff\x24\x41\xc5\xa0\xa0\x75\xe2\x1a\xbd\xe2\x7c\x20\x1f\x20\xed\x58\x0f\x42\x03\x0f\x42\x09\x5e\x8d\x7b\xac\x19\x24\x48\x7a\x44\x47\x86\xc6\x22\x39\x44\xc3\x74\xb4\x59\x7c\x98\xf0\xba\xb2\xb6\x5e\xc0\xe3\x13\xa8\x70\xf9\x32\x4f\x34\xce\x6a\xe1\xc4\x42\x58\x84\xc7\xed\xb5\x2e\x2f\xf9\xa9\x80\x9b\x01\xd1\x8a\x5e\x9f\xbe\x01\x1b\x6c\xbf\x08\x8b\x72\xc8\xbc\x85\x8a\xb0\xb5\x39\xdb\x55\xe9\x0d\xcc\xe6\x21\x1e\xdf\xe8\x4d\x96\xfb\xd6\xe0\x0a\xb3\x79\x40\xe5\xd0\x93\xfe\xbb\xba\x9c\x05\x48\x7e\x83\x9b\x88\xca\x59\x5e\xf4\xef\x09\x85\x67\x5e\xf3\xa1\x2d\xe2\xed\xac\x5f\xc4\xf0\x2e\x60\xea\xcc\xaf\x66\x68\xf5\xf8\x37\x1b\xb1\x85\xe1\x25\x63\xcf\x0c\x94\xde\x0c\xad\x09\xb6\x24\x6f\x76\x13\xff\x47\x2e\x69\xcd\xb4\xcc\x68\xeb\xf2\x22\x3b\x70\xbd\xb6\x5e\x94\x95\x6e\xf2\xbc\xbc\x52\x16\x8d\xcb\xbc\x11\xf2\x1f\xfc\xd8\x6f\xe6\x3e\x9c\x07\x4b\x50\xef\x96\x22\xb6\xb2\xe5\xab\x56\xba\xac\x7f\x20\xeb\x2e\xb3\x29\x33\x13\xa7\xd9\x47\x38\x36\xbc\xd0\x69\x5e\xc4\xee\xff\x2d\xdd\x2a\x9b\x0e\x81\x31\x2d\x20\x2a\xb2\xd7\x87\xac\x3a\x0d\x6e\xb1\xff\x6a\x38\xaa\x06\x4f\xd9\xef\xe3\x77\x7d\xea\x77\xbf\xf4\x07\x40\x96\xe7\x79\xbf\x1c\x0e\x2f\xec\x3d\x24\xb5\xdf\xf8\xa5\x92\x0d\xe3\x82\x45\xe7\x1a\x8e\xbb\x56\xdc\xa0\x4d\x23\xc8\x8b\x62\x4e\x29\x13\xb2\x1c\x89\x25\xd6\x05\x60\x63\x11\x0c\xb6\xfa\x16\x2d\x05\x91\x5c\x0e\x41\x45\x91\x47\xfc\xa8\x42\xe3\x84\x24\x58\x55\xda\xf5\x6c\x29\x72\xdc\x06\x97\xae\x0f\x75\x42\xd1\xa0\xd3\x31\x6e\x76\x76\x68\x0e\xab\x3a\x19\x9b\x09\x54\x16\x9e\xfe\x76\x06\xc7\x71\xc5\xdb\x7d\xae\xcf\x05\x43\xdf\x0a\x43\x52\x18\x7c\xa9\xc5\x6e\xa4\xab\x56\x2c\xb3\x22\x4d\xe2\x6c\x73\xef\x91\xb3\x7e\x07\x69\xdc\x9c\xa7\x2d\x43\x82\x71\x55\x07\x1f\x2e\xaf\x2e\x8b\x10\xb6\xaf\xef\xa7\xdf\x5a\x74\x53\x8e\xe2\xd7\xf7\x4c\x25\x38\xd8\x3c\xfb\xde\xed\xf2\xa3\xb3\x11\x3a\xbd\x32\xe6\x8d\x76\x3f\xeb\x4e\xd5\xf0\xf9\xb3\x6f\xba\xb2\x97\xdd\x3a\xe3\x7e\xf9\x09\x7d\xde\xfa\x3d\x4e\xf4\x09\x53\xd4\xb8\x14\x5d\xe3\x86\x41\xc1\xd3\x8e\xc4\xee\xd7\xc4\x5f\x70\x09\x56\xe5\x2b\x02\x30\x8c\xe4\xcd\x2f\xaf\x2e\xcb\xff\xc0\xbb\x2c\x3f\x3f\xce\x76\xa3\xe3\x34\xe4\x0b\x7c\xa0\xdb\x94\x70\x30\x6d\xde\x3f\x77\xe8\x90\x15\xe9\xd9\xba\xf0\x94\xe0\xa4\x27\xd3\xd2\x45\x24\x28\x68\x8a\x74\x0a\xbd\x0c\x59\x2c\x9f\x7d\x31\x7f\x34\x75\xc8\x58\xb6\xb0\x41\xe3\x73\x42\xd6\xa5\x20\x4e\x63\xb6\x14\xd7\x9c\xec\xb1\xe2\x12\x43\x06\x0a\x78\xb7\xd6\x0a\x95\x93\xa2\x81\x85\xa8\x6e\xf4\x72\xd9\x27\xa2\x7a\x09\xb5\x5c\x2e\xd1\xa0\xea\x0f\x4a\x12\x4e\xf1\x4a\x41\xad\x4d\xdd\x13\xa2\x9e\xd9\x88\x8d\x90\x8e\x26\x24\xe4\xf0\xb3\x07\x72\x15\x12\x0d\x9a\x82\x34\x85\x46\x38\x34\xc9\xb2\xa4\xf3\x59\x46\x98\xa5\x84\x5f\x69\x88\x00\x2b\xd5\x75\x83\x60\xbc\xcd\xed\x4a\x77\x4d\x0d\xa6\x53\x9e\x03\x10\x77\xf2\x3b\xc2\xec\x29\xdd\x9e\x51\x3a\xf2\xf4\x29\xbc\x14\x14\x8d\x16\x5d\xd8\xb0\x56\xdc\xc9\xb6\x6b\xa1\xd2\x5d\xe0\x18\xac\x07\x1b\x27\x50\x46\x06\x1f\xf2\x80\x30\x5c\x2a\x37\x89\x02\xaf\x88\xa0\xdd\x8a\x66\x90\x49\x27\x31\xbc\xed\x14\xd0\xfa\x2d\x2c\xd0\x6d\x10\x15\x54\xa2\x69\x2c\x6d\x69\x22\xae\x1f\x4c\x63\xca\xcb\xce\x78\xfa\xda\xcb\x7e\x19\xb6\x61\x4f\x74\x42\x5e\x96\xd2\x58\x17\x6d\x4b\xce\x35\xde\xdb\x6d\x01\xb5\xee\x16\x84\xa6\x64\x27\xbc\x45\xb3\x8d\xb2\xc3\x20\xb1\x24\xe3\x4b\x17\x57\x17\xe6\x3b\xae\xcd\x6b\x71\xc7\x07\xb4\x1d\x34\xea\x0d\x77\xe8\x55\x41\x76\x60\xbf\xbd\x53\x48\xcb\x3a\x86\x19\x53\x99\xa9\x55\xff\xdd\x10\xe5\x1d\xaf\x3b\x95\x87\x47\x0e\x8f\xc5\x36\x72\x47\x4f\x9a\x4d\xb7\xa6\x0c\x27\x58\x2b\xca\xf5\x48\xc2\xa5\x82\xd6\xd7\x57\x7c\xb2\x8f\x35\x88\x6b\x21\x29\x7d\x0f\xc5\x02\xf6\x3e\x3f\x2c\xe8\x72\xc4\x26\x03\xd5\xf5\x8f\xf5\x02\x5e\x6b\x75\xad\x2f\x5f\xfa\x47\x06\x15\x88\xe0\xf2\x3a\xfc\xf5\xef\xd6\x3d\xbf\xef\xa3\x9b\xa1\x85\x68\x59\xe2\xbe\x91\xa7\x0b\x50\xb8\x39\x86\x3b\xb1\xec\xb1\x87\x3c\x91\x1a\x07\xda\x4e\xf1\x94\xc0\x8e\xd3\xe9\x31\xdf\xeb\xc2\xc7\xeb\x58\x83\x84\xcf\x17\xd0\xee\x2f\xa6\x80\x56\xc7\x45\x17\xc7\x56\x95\xc3\xe3\x54\xe9\x51\x26\xf0\x28\x79\x33\x1c\x0b\x3e\xc2\x66\xf0\xfc\xd9\xb3\x81\x1b\xc6\x30\x99\x79\x47\x28\xdf\x61\xa5\x53\x7e\xca\x6e\x7b\xe2\x6d\xe2\x62\x24\x76\x78\xe1\xf7\x95\x07\xbd\x96\xaa\x73\x38\xbc\xab\x74\x33\x23\xa4\x1e\x5a\xea\xc5\x0c\x5a\x3d\x3c\xb3\x25\x66\x90\xd0\xde\xde\x00\xcc\x94\x3d\x6a\x8f\x4e\x0e\x82\x85\x00\x04\x7e\xd9\x14\x14\x66\xdb\xaf\x8f\x33\xc7\x64\x73\x38\xc5\x23\x70\x96\x06\x6d\x09\x74\x28\xd2\xae\x05\x21\x49\xd6\x49\x7b\x1c\x22\x84\x86\x53\xe2\xdd\x8b\x8d\xbc\xc9\x8c\x36\x23\x27\x8c\x3a\x96\x47\x46\x02\xe4\x64\x45\xb5\xb7\x3e\xb7\xc0\xcd\xef\xbe\x25\x33\x65\x94\xdc\x93\x00\x3a\x75\xfc\xcb\xf2\x9d\xd3\xeb\x8c\xd9\x10\x79\xe1\xb0\xb3\x72\x09\x1f\x8b\xc8\xe3\x4d\xc9\xee\xe5\xee\xc6\x04\xfd\xd1\x23\xf8\x46\x5a\x56\xe5\x95\x5f\x76\x4d\x5a\x46\xad\xe2\x3f\x73\x2e\x79\x08\x47\x46\xca\x1d\xec\xc3\xe4\xc1\x1c\xa5\x0e\x0f\x92\x6f\xa6\x3a\xf4\xbf\x45\x92\x94\x28\xeb\x59\xd8\x8f\xdf\x55\xee\xae\xbc\xd4\x0a\xb3\x84\x61\xed\x11\xa6\xa4\x37\x1b\xf3\x62\xe8\xbb\x1b\xfb\x91\x5f\x94\x47\x33\xb4\x94\x8b\x36\xd8\xe3\x60\x80\x02\xae\xfa\x32\x4c\x58\xe8\xd6\x64\x0c\x1f\x5d\xcc\xe9\x63\xbf\xa0\x01\x03\x09\xb9\xd1\xfe\x61\x48\x65\x98\x51\x4d\x7a\x45\x65\x58\x7f\x80\x6f\x56\xb2\x41\x8f\xee\xc2\x34\x32\x9e\xe3\x5c\x34\xe8\x71\x94\xea\xb7\xd6\xc9\xa6\x21\xe9\x51\x4d\x3a\xb8\xc3\x09\xd9\xf3\x83\x02\xac\x8e\x93\x7a\xae\xc4\xc3\xc9\xcd\x79\x21\x78\x06\xd7\xe1\x30\xa2\x6a\x7f\x50\xa4\x4d\x8f\x9d\x13\xb1\x10\xbd\xf1\x30\x1a\x32\x79\x90\x73\x07\x8f\x1f\x5c\x90\x13\x02\x46\xc5\x6c\x9a\x88\x0e\x7e\x3e\xe5\x88\xa0\x3c\xea\x4f\x9c\x9b\xd1\xf1\x59\x01\xaf\x8c\xe1\x3e\x3c\x82\x5d\xc0\xff\x89\x49\x49\x01\x16\xad\x95\x5a\x25\xa1\x55\x2f\xca\x37\xb8\xc9\x96\xa2\xb1\x98\x3f\x48\xc2\xcf\x8e\xa2\x50\x17\x8c\x73\xfd\xe5\x01\x34\x2c\x7a\x2f\x73\x0d\x96\xe6\x69\xcb\x8b\x46\x5b\xcc\xf2\x51\x62\xe1\x57\x1c\x22\xa1\xcf\xf6\xf2\x1f\xbe\x72\xb9\x9c\x71\xf4\xbe\xfa\xb7\xa2\xc7\xc9\xc5\x9f\x97\x82\x9a\x83\x04\x34\x44\xd0\xfb\x0f\x3e\x64\x7d\xf3\xff\x76\x68\xb6\x24\x68\x3f\x6b\x8c\xcf\xdf\x4a\x35\x9d\xc1\xfb\x0f\x81\xcf\xdc\x8f\x2a\x37\xa3\xd4\x72\xb7\x67\x7b\x66\xde\x3f\x4b\x55\x67\x7e\x96\xbc\x7c\xa7\x8d\xcb\xfa\xd2\x71\x01\xbe\xdc\x9f\x97\xff\x29\x5b\xe9\x32\x53\x7a\x0c\xca\xcb\x17\x4d\x93\x3d\x0a\x16\xfd\xea\xed\x22\xf4\x90\x78\x8b\xff\xd4\x46\x1d\xaf\xc1\x79\x98\x9c\xcd\x7d\x65\x25\x6b\xc5\xfa\x7d\x30\xee\x87\x85\xd6\x4d\xb2\x5d\x04\xaf\x94\x2a\xf6\x47\xe6\xc7\x58\x9e\x20\xd7\xf6\xf7\x43\xbc\xa3\x83\x65\xce\xc0\x91\x44\x61\x9a\xe1\x14\x9c\x24\xab\x60\xc1\xa4\xf6\x7b\x3f\x63\x19\x2b\x7f\x1f\xe0\xf3\x67\xce\x9a\x7d\x69\x8f\xaa\x09\xa9\x8b\x0c\xaf\x93\x32\x5e\xf9\x82\xe8\x48\xa6\xf4\x66\x5f\xad\xa3\x33\xcc\xc1\x99\x0e\x47\xfd\x08\x8a\xa5\xea\xf0\x84\xa2\x7d\xf4\xf7\x64\xab\x64\xe2\x49\x40\xce\x46\x3c\xe5\x5b\x5f\xa2\x47\x3a\x17\xb9\xda\x36\x8b\x85\xe4\xa1\xdc\xf6\xd0\x3c\x07\x5b\x11\xdd\x27\xfe\xdb\x9d\xbd\xec\x70\xdb\x7c\x2c\x92\xfb\x4b\xd6\x82\xef\x58\x99\xda\x7a\x7f\x2c\x60\xb8\x42\x9a\xf1\x56\x45\x56\x0c\x4f\xe0\xf9\x6e\x72\xb8\xd6\xb3\x2b\x51\x41\xa7\xdd\x43\x16\x38\x3b\x92\x5b\x61\x6e\x46\x51\x4c\x77\x2c\xf6\x4f\x0b\x3e\xe6\xef\x28\xf7\xfc\xe9\xd6\x25\xfb\x42\x2a\x3e\x79\x92\x62\xc2\x68\xd4\x50\x72\xf5\xfe\xc3\xbc\x68\x54\x6e\xdd\x4b\xdd\x99\xba\x44\x0f\x5b\x32\xef\xef\x9b\xd2\xcb\x7a\x92\x1b\xb7\x97\x6b\xb2\x35\xb1\x8e\x58\x7c\xf1\xd9\x40\x14\x7c\x8a\x01\xa5\x8e\x1d\x6e\x32\x86\x33\xe6\xe1\x7a\xa9\x07\x4d\xde\xf0\xa8\x06\x39\xd0\xa1\x9b\x4d\x26\x5f\xe4\x0c\x1c\xd7\x23\x7f\xf8\xdb\xfc\x20\x6a\x3e\x2d\x7a\x5b\x9e\x72\x15\x5e\x7f\xe2\x2e\x93\xe3\xb1\xd9\xcb\x9c\x25\xfb\xc3\x62\x66\xfb\x62\xfa\x93\x35\x76\x85\x9f\x08\x70\xd2\x12\xc9\x10\x54\x21\xe6\xde\x73\xf4\x7f\x00\xc6\xe3\x90\xf6\xf8\x5e\xbb\xe0\x08\x87\x43\x46\x97\xb2\x1f\x20\x3d\xb6\xca\x17\x75\x9d\x99\x92\xcb\x7f\x59\x54\x24\xcf\x53\xc7\xfe\xeb\x11\xe2\x6c\x87\xe0\x7c\x20\xf5\x87\x68\xd8\x7f\x08\x1f\x38\xca\x23\x2a\x8c\x63\x7f\x88\x7a\xb6\x28\xbf\x48\x8a\x5a\x74\xbb\x24\xa4\x8b\x37\x92\x1c\xa4\x14\xb8\x43\x1d\xb5\xcf\xea\xfb\xa2\x40\xc8\xcf\x48\x30\xa3\x46\xdc\x29\x2e\xf8\xd1\xf8\x58\xcc\xeb\x2b\x7f\xd1\x52\x89\xc0\x50\x3f\x0c\xa9\xe1\xbf\xfd\xcf\xf3\xef\xfd\x89\x61\x4f\x21\xc4\xbe\x5b\x10\x69\xc9\xc7\x85\x31\xde\x54\xbb\x92\x4b\x7f\xfb\xd8\x49\xe5\x86\xfe\xdf\xc1\xf3\x3e\x1d\x09\x5d\x7e\x82\xe7\xdf\x27\x8e\x10\x1a\xe7\xf0\xfc\xfb\x23\xd6\x34\x65\x5c\xd2\x8f\x3f\x86\x9e\x6c\x5c\x26\xeb\x44\xb3\x2c\xe7\xb6\x3d\x4c\xee\xd5\x08\x1b\xad\xae\xfd\xda\x85\xe2\x1a\xe3\x66\xa5\x6d\xfc\xc0\x27\xf9\x9a\xa7\x20\xe3\xfa\x8b\xad\x88\xba\x9a\xbe\x06\x3a\x69\x9b\xbd\x04\x64\x7c\xdd\x34\x86\xc8\xff\x27\xab\x0f\x49\xfe\x59\xa4\x7e\xcc\xde\x7b\x26\x9e\xb0\xfd\x66\x4c\x0f\x7c\xdc\x7f\x67\x4a\x6f\x92\xfc\x1c\x8e\xef\xd9\xbb\x57\xe9\x64\x50\x1f\x8d\x8c\xe3\xfc\xd6\x0b\x4a\xc6\xc6\x4d\x88\x0a\x98\x92\x5b\x7a\x03\xb3\x88\x21\x10\xe5\xf2\xb8\x1a\x47\x54\x49\xd4\xe1\x91\x2c\x7d\x6f\xd8\x5f\x7e\x21\x77\x14\x96\xcf\xbd\x6a\x3b\xd4\x3a\x59\xef\xa3\x47\xf1\x69\xff\x92\xef\x4b\x2c\x71\x02\x87\xaf\xd4\x52\x67\xd3\x77\xde\xc3\xeb\xa1\x7e\xf3\x2f\x39\x94\xc3\xc6\xd0\x99\x1c\x7e\x8d\x8f\xa2\x03\x9c\x8d\x1b\xd9\xe3\xac\xe9\x70\x74\x29\xcd\xcc\x6b\xb8\x5f\x66\x4c\x3d\xf8\x9c\x2f\x7e\xb7\x23\x2d\x7d\x09\xa8\x08\x0e\x92\x6f\x4d\xa4\x85\x56\x5a\xba\x94\x1a\x7f\x0a\xc7\xec\x8b\x46\xa4\xdd\x49\x6e\xff\xfd\x21\x55\xa8\x04\x01\x51\xb8\x77\xe3\xa2\x1b\xde\xa6\x55\x36\xbd\x8c\x77\x19\xfa\x16\x4d\x1c\x45\x9f\x2f\xf1\x7d\x19\x35\x21\xd7\x7f\xd7\xda\xd0\x4d\x87\xb0\xe9\x05\xbb\xbf\x8b\x4b\x65\x47\x42\x99\x8e\xdf\xff\xe6\xef\x14\xba\xed\x47\xdf\x03\xb7\xe9\x39\x64\x94\xdf\xee\x15\xcc\x0e\xa0\xea\xfe\xbe\x7c\xeb\x6d\x53\xfe\x82\xdb\x70\x75\xdf\x27\x26\x31\x17\x1b\x60\xc8\x37\xd3\xa7\x29\xe4\xe1\xa3\x6f\x6c\x07\xf7\xf6\x53\xbc\x4f\xbf\xbe\x24\x6e\x13\xdd\x2b\xf5\x1a\xa7\x9d\x68\x7a\x6c\x89\x6b\x2a\x2f\x18\x73\x47\x38\x77\x41\xb5\x50\xce\xe7\x4f\x41\x0c\x7b\xa1\x2f\xb7\x0d\x09\xc2\x6e\x72\x44\xfd\xf9\x49\xf5\x59\x88\xd7\x8d\xd2\xeb\x67\xe1\x3b\x8d\x44\xd2\xa8\xc7\x37\x73\x78\x56\x80\x92\xcd\x64\x37\xf9\xbf\x01\x00\x23\x78\x9a\x2d\x14\x2d\x00\x00"),
+          path: "mongo-api-outbox.tml",
+          root: "mongo-api-outbox.tml",
+        },
+      
+        "mongo-api-random.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xdb\x6e\xe3\x36\x13\xbe\x16\x9f\x62\x7e\x5f\xfc\x90\xb2\x86\xe2\x1c\x36\x3d\xa4\xbe\x58\x24\x28\xda\xa2\xd8\x06\xcd\xf6\x2a\x08\x0a\x5a\x1c\xd9\xdc\x48\xa4\x3b\x24\xe3\xb8\x86\xde\xbd\x18\x4a\x96\xe5\x64\xb7\x1b\x17\x2d\x10\x24\x11\x35\x1c\x7e\x87\x99\xa1\x8e\x8f\xe1\x1a\x4b\x19\x2a\x7f\x8b\xa8\x40\x61\xa9\x0d\x3a\xf0\x0b\x04\xc7\x0b\xc1\xa1\x82\xd9\x1a\x7e\x95\x46\xd9\x7a\xb3\xc9\x6f\x3d\x85\xc2\xe7\xbf\xcc\x3e\x62\xe1\xf3\xf7\xb2\xc6\xf8\xab\x69\xdc\x18\x9c\x6d\x37\xca\x1a\xc5\xf1\x31\x10\x16\x96\x94\x03\x49\x08\x4b\xb2\x2a\x14\xa8\xc0\x1a\xc0\x47\xa4\x35\x50\x30\xb9\x78\x94\xb4\x77\xbe\x36\xfe\xe2\x1c\xa6\x70\x22\x38\xc1\x15\xa1\xf4\x96\x7a\x54\xd2\x80\x36\x1e\xa9\x94\x05\xc2\x6a\xa1\x8b\x05\xe0\xd3\xd2\x3a\x7e\x05\x35\xfa\x85\x55\xe0\x2d\x38\x6f\x09\x41\xc2\x0e\xed\x8d\x2c\x1e\xe4\x1c\x9b\x26\xff\x24\x83\xa6\xc9\x85\x5f\x2f\xb1\x3f\x70\x77\xca\x46\x24\x71\x11\xd3\xc2\x3f\x41\x61\x8d\xc7\x27\x9f\x5f\xb5\x7f\xc7\x80\x15\xd6\x87\x9c\x93\x01\x12\x59\x12\xcd\x90\xdf\xf7\xc1\x14\x3b\x8e\x50\x06\x53\x78\x6d\x0d\x44\x48\x2d\x4d\x5d\x2f\x2b\xac\xd1\xf8\xd6\x9a\x17\x38\xf7\xf1\xc7\x84\x9c\xe6\xdf\x05\xdd\x43\x46\x28\x64\x55\xb5\x50\x82\x51\x48\x95\x36\xb8\xc3\xbd\xd2\x7e\x11\xdf\x2d\xc9\x3e\x6a\x85\x0a\x24\xcd\x43\x44\x9f\x0b\x8e\x82\xb4\x34\x43\xac\x19\xfc\x07\x1a\xc3\x46\x24\x84\x3e\x90\x81\xd2\xb0\x10\x2d\xf1\xac\xd3\xfe\xcb\x05\x0d\xed\x6e\xae\x2d\x83\x2b\xd0\xc6\x79\x69\x0a\x04\x5b\x1e\x56\x5b\x51\x0f\xd6\x4e\x7b\x07\xa5\xc6\x4a\x39\x70\xe8\xb9\x52\x29\x82\x80\x47\x59\x05\x74\xa0\x48\xae\x0c\x94\x64\xeb\x7d\xf5\x38\x2a\x67\xbc\x9d\x7a\x5f\x86\x9e\x12\x1c\xf5\xbb\xb2\x83\xc0\x6e\x44\xc2\x4d\x79\xa8\xe8\x22\xd9\x6c\x3a\x3a\xef\x9c\xd3\x73\x03\x5d\x18\x8c\x38\xd5\x08\x46\x34\x82\xa6\xe9\x2d\xe1\xc5\x57\x3b\xe1\x7a\x2b\x4c\x6f\x83\x63\x1f\x0e\x00\xd8\xbb\xf0\x59\xcd\xe5\x4e\xe8\x38\xfb\x50\xc5\x3d\xc3\xf9\xf4\x6a\x03\x5c\xca\x48\x7d\x06\x77\xf7\x87\x60\xe4\x92\x85\x6f\xa7\x2d\x90\xf7\xb8\x4a\xb7\xff\xdc\xda\x40\x05\xa6\x03\x28\x59\x26\x44\xc2\x2a\x3a\xde\x50\xcb\x07\x4c\x0f\x3a\x6b\x0c\x93\x31\x98\x4c\x24\x25\xcf\x3b\xce\x31\xb9\x04\x0d\xdf\x81\xb9\x04\xfd\xe6\x0d\x77\x4f\x97\x7e\x0a\x72\xb9\x44\xa3\xd2\xf8\x38\x7e\x05\xfb\x94\xb2\x4c\x24\x8d\xd8\x73\xdb\x75\x76\xb3\x8e\xed\x88\x76\x60\xb6\x1d\xf0\x8f\x5d\xf5\x0b\xb2\x61\x1e\xc7\x0d\xb7\x58\xdf\x33\xdd\x74\x19\x77\x95\xa3\xcd\x9c\x43\xda\x73\xd5\xf6\x66\xea\xec\x64\x44\x9f\x1e\x3d\x6a\xb6\x1d\x53\x63\xe8\x0c\x3d\x54\xe5\x38\x3a\x33\xd8\x0c\xcc\x7a\x55\xf9\xb0\xbd\x6c\xcd\xef\xdd\x00\x6c\xab\x62\x8e\xf1\xc9\x71\xbe\x44\x97\x3c\xe9\xd8\x3a\x35\xcb\x77\x13\xb4\x9b\x73\x97\xf1\xe5\xff\xa6\x60\x74\x15\xc3\xb7\x66\x18\x5d\x45\x58\x22\x49\x9a\x97\x2e\x8d\x39\x9e\xad\x2a\xac\x71\xbe\xf3\xe7\x67\xf4\x1e\xc9\xc1\x14\x46\x72\x56\x28\x2c\xe7\x0b\xfd\xf1\xa1\xaa\x8d\x5d\xfe\x41\xce\x87\xc7\xd5\xd3\xfa\xcf\xc9\xc9\xe9\xd9\xf9\xdb\x8b\xaf\xbe\xfe\x66\x24\x76\x8d\x76\xeb\x89\xc5\xdf\xcd\x52\xd7\x2e\xd8\x72\xe7\x7e\xb1\x90\x24\x8b\x78\xc2\xa0\x1f\xa9\xb3\x67\x98\x67\x6f\xb0\xf5\x9e\x74\x29\x37\x22\x99\x85\x72\xd0\x0e\xb3\xb5\xc7\x67\x45\xde\x6a\xc8\x61\x2c\xc9\x2c\x94\x77\xfa\x1e\xa6\xfb\x34\xef\x28\xff\xd1\x78\x93\x56\x68\xd2\xbd\x17\x59\x76\xcf\x82\x6d\xf5\x6a\x8f\x4d\x67\xa1\xdc\x5e\x2a\x6d\xf4\x07\x5d\xe3\x80\x70\x47\xd2\xf3\x2a\x0f\x14\x6d\x62\x29\xae\x51\x12\x9c\x4e\x26\x93\xcf\x73\xe6\x44\xcf\x46\x39\x67\xc9\x79\x7d\x70\xbd\xc5\xb5\x6b\xbe\x3f\x39\xdd\xb8\x7d\xfe\x49\x9a\x20\x69\x3d\x86\x93\xd8\xe9\xfd\x4f\x7c\xf9\xdb\x87\xab\x2c\x7f\xa7\x54\x1a\x9f\xae\x03\x49\xfe\xe0\x48\x23\xef\x8b\x33\x93\x9e\x5d\xbc\x3d\x3a\x3d\xcf\x32\x38\x6a\x93\xfd\x60\x03\xbd\xe4\x78\xe3\x69\x40\x73\x69\xe3\x57\x13\x5f\x6b\xfb\x9c\xff\x96\xde\x8d\xa7\x67\x0c\x8f\xf6\x28\xfa\x6d\xe5\x77\xf1\x29\x65\x3d\xef\xff\xfb\x5a\x34\xe2\xaf\x01\x00\x76\x0d\x91\x7a\xc2\x0a\x00\x00"),
+          path: "mongo-api-random.tml",
+          root: "mongo-api-random.tml",
+        },
+      
         "mongo-api-readme.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\x41\x8f\xd3\x30\x10\x85\xef\x95\xf2\x1f\x06\xf5\x92\xa2\xe2\xde\x57\xe2\x50\x52\x54\xed\x01\xa8\x16\xf6\x54\xad\x14\xd7\x99\x3a\x66\x1d\x4f\x64\x4f\x44\xa4\x2a\xff\x1d\x39\xe9\xd2\x16\x52\x28\x82\x5c\x9c\x19\x65\xde\x7c\xef\x39\x87\x83\xf8\xcc\xbe\x51\x2c\x3e\xed\xbe\xa2\x62\xf1\x51\x56\xd8\x75\xf0\x81\x9c\xa6\xd5\x3b\x58\x6e\xee\x93\xc9\xdb\x3f\x3f\xc9\x64\xfb\x6a\xbb\x26\x78\xc0\x9a\x3c\x43\x26\x7d\xf1\x94\x96\xcc\x75\xb8\x5b\x2c\x34\xf9\xbe\xad\xa4\x2f\x84\xa2\x6a\xb1\x93\x85\xc6\xc5\xe1\x20\x36\x52\x3d\x4b\x8d\x1b\xc9\x65\xd7\xcd\x7e\x33\x31\x94\xbf\x8e\x24\x93\x64\x72\x83\x07\x30\x01\x24\xc8\x86\xe9\x8d\x46\x87\x5e\x32\x16\x90\x3d\x3c\xae\xc0\x54\xb5\xc5\x0a\x1d\x4b\x36\xe4\x60\x4f\x1e\xb8\x44\xc8\x47\x45\x8f\xca\x39\x18\x07\xf5\xc0\xd1\x7f\xb9\x79\xd6\x62\x00\xca\x45\x24\xfa\x52\x22\xec\xc9\x5a\xfa\x66\x9c\x86\x0a\xb9\xa4\x02\xb0\x35\x81\x43\xbf\x41\x35\x81\xa9\x02\xaa\x23\x89\x21\x17\xee\xe2\xd4\x74\x0a\xef\x5b\x54\xf1\x35\xcf\x73\x4d\xc9\x24\x96\xa9\xe2\x16\x14\x39\xc6\x96\x45\x36\x9c\x73\xd8\xb7\xb0\x6f\x9c\x4a\x15\x59\x78\x5d\x69\x12\x19\x59\x8b\x2a\x8a\xcd\x00\xbd\x27\x7f\x3c\x7a\xad\x6b\x4c\xe1\x05\xca\xb8\xde\xf5\x29\x9b\x98\x99\x0c\x50\xa3\x67\x69\x5c\x9c\x60\xea\x03\x7b\x21\xcd\xa8\x71\x7c\x86\xda\xd7\x63\xac\x33\x48\x8d\xe3\xf9\x11\xea\x07\x4e\x94\xf0\x28\x19\xcf\x35\xfa\xc6\xb8\x61\xb4\x58\xc1\xe9\x52\x8e\x7f\x41\xd7\x89\x2b\xb7\xff\xb3\xfd\xe9\x14\xd6\x78\x0e\xbc\xc6\x51\xdc\x39\xd4\xcd\xce\x1a\x75\xbf\x82\xc0\xde\x38\x3d\x83\xf4\x2f\xd6\x8e\xf9\x5c\x23\xc3\xd2\xda\xcb\xdd\x4b\x6b\xaf\xa4\xb5\x7d\xfa\xc7\x7d\x8f\x75\x71\x99\xeb\xd0\xb8\xc9\xed\x7f\x09\x7a\x85\x16\x2f\x00\x86\xc6\x8d\x71\x9f\xe4\xbe\x07\x00\x00\xff\xff\x64\x58\x5b\x5b\x9d\x04\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\x41\x8f\xd3\x30\x10\x85\xef\x91\xf2\x1f\x06\xe5\x92\xa0\xc5\xbd\xaf\xc4\xa1\xa4\xa8\xda\x03\x50\x2d\xec\xa9\x5a\x29\xae\x33\x75\xcc\x3a\x9e\xc8\x9e\x88\x48\x55\xfe\x3b\x4a\xd2\xa5\x2d\x04\x28\x62\x73\x71\x66\x94\x99\xf7\xbd\xe7\x1c\x0e\xe2\x33\xfb\x56\xb1\xf8\xb4\xfb\x8a\x8a\xc5\x47\x59\x63\xdf\xc3\x07\x72\x9a\x56\xef\x60\xb9\xb9\x8b\xa3\xb7\x7f\x7f\xe2\x68\xfb\x6a\xbb\x26\xb8\xc7\x86\x3c\x43\x2e\x7d\xf9\x98\x56\xcc\x4d\xb8\x5d\x2c\x34\xf9\xb1\xad\xa4\x2f\x85\xa2\x7a\xb1\x93\xa5\xc6\xc5\xe1\x20\x36\x52\x3d\x49\x8d\x1b\xc9\x55\xdf\x67\x7f\x98\x98\xca\x5f\x47\xe2\x28\x8e\xae\xf0\x00\x26\x80\x04\xd9\x32\xbd\xd1\xe8\xd0\x4b\xc6\x12\xf2\xfb\x87\x15\x98\xba\xb1\x58\xa3\x63\xc9\x86\x1c\xec\xc9\x03\x57\x08\xc5\xec\xd2\xe3\xe6\x02\x8c\x83\x66\x42\x1f\xbf\xdc\x3c\x69\x31\x79\x28\xc4\x40\xf4\xa5\x42\xd8\x93\xb5\xf4\xcd\x38\x0d\x35\x72\x45\x25\x60\x67\x02\x87\x51\x41\xb5\x81\xa9\x06\x6a\x06\x12\x43\x2e\xdc\x0e\x53\x49\x02\xef\x3b\x54\xc3\x6b\x51\x14\x9a\xe2\x68\x28\x53\xc5\x1d\x28\x72\x8c\x1d\x8b\x7c\x3a\x6f\x60\xdf\xc1\xbe\x75\x2a\x55\x64\xe1\x75\xad\x49\xe4\x64\x2d\xaa\xc1\x43\x06\xe8\x3d\xf9\xe3\x31\xee\xfa\x1d\x53\x78\x86\x32\x6e\x74\x7d\xca\x66\xc8\x4c\x06\x68\xd0\xb3\x34\x6e\x98\x60\x1a\x03\x7b\x26\xcd\xa9\x75\x7c\x86\x3a\xd6\x73\xac\x19\xa4\xc6\xf1\xcd\x11\xea\x07\x4e\x92\x40\xee\x51\x32\x9e\xef\x18\x1b\xf3\x86\xd1\x62\x0d\xa7\x4b\x39\xfe\x05\x7d\x2f\x66\x2f\xaa\xef\x7f\xb6\x9f\x24\xb0\xc6\x73\xe0\x35\xf2\xbc\x52\xd3\xee\xac\x51\x77\x2b\x08\xec\x8d\xd3\x19\xa4\xff\x20\x3b\xe7\x73\x8d\x0c\x4b\x6b\x2f\xb5\x97\xd6\xce\xc9\x67\x90\x6e\x1f\xff\x53\xef\xa1\x29\x2f\x73\x9d\x1a\x57\xb9\x7d\x91\xa0\x57\x68\xf1\x02\x60\x6a\x5c\x05\x70\xbe\xee\xfb\x00\x64\x58\x5b\x5b\x9d\x04\x00\x00"),
           path: "mongo-api-readme.tml",
           root: "mongo-api-readme.tml",
         },
       
         "mongo-api-test.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x51\x6f\xe2\x46\x10\x7e\x06\x89\xff\x30\xb5\x54\xc9\xbe\x52\xdf\xe5\x1e\xa9\x78\x80\x70\x4d\x5a\xf5\x42\x74\x26\xbd\x47\xb4\xec\x8e\xc9\xf6\xd6\x5e\xba\xbb\x26\x89\x10\xff\xbd\xf2\xda\x98\x70\xe0\x04\x93\x90\x9c\x52\x47\x4a\x14\xcc\xcc\x7e\x33\x1f\x33\xdf\x87\xec\x39\x51\xe0\xb6\x9a\x00\x00\x54\xc6\x21\x9f\x42\x17\x22\x36\xf1\x4f\xed\x8b\x45\xf6\x4e\xfa\x33\xe8\x77\x40\x6a\xff\x0c\x0d\xc6\x73\xd7\xf9\x3c\xbc\x38\x1b\x8e\x47\x9f\x82\xd1\x78\xd0\x77\xbc\xf6\x3a\xf0\x5c\x6a\x53\x16\x7a\x3e\x0c\x46\x1b\xc1\x57\x1a\x55\x59\xf0\x55\xf0\xe9\xcb\x46\x70\x2f\x31\xd7\xe5\x65\xf4\xae\x46\xe7\xdf\x95\x72\x49\xb4\xbe\x91\x8a\x95\xa5\x5c\xf6\x82\xe0\xeb\xf0\xcb\xa0\x48\x5a\xb6\x9a\xd9\x3f\x06\xb5\x39\x95\x02\xba\xe0\x2c\x16\x42\xde\xa0\x02\x3f\x30\x2a\xa1\xc6\x1f\x4e\xfe\x41\x6a\xfc\x0b\x12\xa1\xfd\xb3\x5c\x8e\xd3\xe8\x31\x95\x42\x20\x35\x5c\xc6\x4e\xab\xe9\xa5\x07\xbd\x7f\x0f\x23\xd4\xe6\x0c\xcd\x62\xb1\x23\x7b\xb9\x84\x39\x11\x9c\x11\x83\x1a\xcc\x35\x82\x42\xa3\x38\xce\x89\x00\x19\x02\x81\x92\x24\x7b\xae\x42\x2a\x15\x83\x50\xc9\x08\x08\x44\x32\x9e\x4a\x36\xf1\x5b\xcd\x30\x89\xe9\x23\xa0\xae\x81\x77\x69\xc1\x3c\x9e\xfa\x23\x6f\xd1\x6a\x36\x70\x8e\xb1\xd1\xd0\xe9\x42\x94\x56\x40\xb5\x7f\x81\x37\xae\xd7\x6a\x36\x78\x08\xab\xd0\xbf\x51\x4d\xa4\x46\xd7\x66\xac\x52\x36\x33\x68\xa2\x8d\x8c\xfc\xc0\x10\xfa\x6d\xc0\xf5\x4c\x90\x3b\x57\x6a\x3f\x30\x4c\x26\xc6\x4b\xcf\x2b\xf8\x25\x33\x6e\xf1\xd8\xc4\x66\xe6\x74\xb7\x21\x3b\xb7\xbd\x7a\xe3\x73\xda\xd8\xa0\xef\x66\xa3\xe9\x79\xab\x74\x6a\x6e\xdb\x40\x49\x4c\x51\xa4\xc7\x50\x19\x1b\xbc\x35\xfe\x57\x6e\xae\x47\x3c\x42\x99\x18\x77\x75\xad\x4f\xe8\xb7\xa9\x92\x49\xcc\x5c\xaf\x0d\x27\x1f\xe0\x1d\x18\x1e\xa1\x1f\x20\x95\x31\xf3\xb2\xf3\x18\x86\xa8\xf2\x03\xdd\x02\x05\x05\x46\x6d\x40\xa5\x52\x8c\x90\xdf\x9a\x44\xa1\xf6\xff\x92\x84\xed\xa4\x36\xe7\xf7\xcf\x60\x78\xe1\x16\xd1\x8f\x45\xe6\x05\xf0\xd0\xe2\xfc\xd4\x85\x98\x0b\xb8\xb7\x76\x29\x33\xda\xff\x9d\x70\x81\xcc\x75\x82\x84\x52\xd4\x3a\x4c\x84\xb8\x03\x21\x09\x43\x06\xe9\x29\x10\x4a\x55\x36\x2f\xf9\xac\x74\xe0\xe7\x5f\xfe\xf5\x1d\xdb\x8f\x57\x4c\xfb\x1a\x22\xdd\x95\x27\x42\x38\x05\x73\x19\x9f\x64\xc6\xfd\x01\x0a\x34\xe8\xda\x0f\x2c\xe5\xd3\xbf\x4c\x26\x82\xd3\x3f\x06\x45\x6c\xde\x7a\xa7\x6b\xe3\x4f\x15\x92\xfb\xf1\xde\x6f\xd5\x89\x21\x2c\x2d\x7a\xb5\x22\x0f\x94\xcd\x63\x23\x81\x4d\x0e\xa2\xa6\x2a\x88\xbf\x66\x67\x9c\x0d\x55\xd6\xf0\x19\x9a\xdd\xec\x1c\x3a\x16\xb9\x8a\x20\x03\x6d\xa4\xda\xaf\x46\xab\x23\x07\x12\xf1\x04\x3c\xcb\xc9\x72\x53\x2a\x7b\x42\x1c\xa2\x96\x42\x3c\x59\x2f\xcb\x91\x5f\x5d\x32\x1b\x3f\x90\x5e\x36\xb6\xc5\xb2\xf1\x42\x4a\xd9\xd8\xda\x87\x46\xe3\x58\x02\x99\x12\xdf\xa8\xa5\xf1\x85\xa5\x31\x4b\xd2\xed\x95\x46\x76\x0a\x91\xec\x09\x91\xb5\xee\x10\x4d\x9d\x36\x38\x33\xcb\xd6\x98\x33\xa7\x0d\xbf\x9e\xa4\xbf\xcf\x22\x9a\xa9\x92\xe4\x55\xbc\x80\x64\x56\x44\xbb\xc7\x14\x0f\x41\x60\xec\xe6\xc9\x1e\x74\xbb\xf0\xa1\x7a\xb3\x46\x20\xd1\x06\x4e\xaa\x8a\x76\xe5\x3e\x0f\x05\xda\xdb\x1d\x86\x8a\xa1\xea\xdf\xbd\x9e\x49\xf4\xef\x6c\x09\xaf\xec\x15\x3f\xd8\x97\xeb\xda\x2c\x6a\xb3\x38\xbe\x59\x6c\x39\xc5\x6a\x17\x4b\x0c\xa3\x36\x8a\xb7\x6d\x14\x25\xf1\xd9\x4e\x7c\xe7\x10\x34\xbd\xc8\x65\xbc\xf7\x3d\x97\x1b\x6e\xae\x77\xdb\xc3\x83\xb0\xb5\x2f\xd4\xbe\x50\xfb\xc2\x91\x7d\x61\x0f\x11\xb8\x9a\xb1\x6d\x11\x48\xb2\x8b\x47\x93\x80\x0c\xf4\xd5\x6f\x23\xd4\x22\x50\x8b\xc0\xdb\x17\x81\xf5\xcd\xfb\x8f\xf5\x24\x3d\x3a\x49\x76\xe3\x3e\x16\x13\x03\xdd\xcd\x09\xda\x3d\x40\xb9\x9e\x15\x03\xb4\xce\xcf\x5f\x1f\x32\x51\x99\x08\x1f\x7d\xa6\xaa\xc3\xec\x6b\x2d\xd9\x1a\x6e\xdd\x81\x88\xe4\xf3\x3c\xd2\x7b\x10\xb5\xfe\x7a\x59\x3b\xcb\xb3\x39\xcb\xff\xcf\x2d\x36\x3b\x2e\xf7\xd3\x03\x5a\xb7\x02\x70\xfc\xe6\xab\xc3\x6c\xb6\xbf\xcf\x43\xc9\xac\xfb\x6e\x95\xee\x43\x7b\x11\x8c\x84\x29\x1a\x60\x96\xd8\xca\x45\xee\xc7\xc0\xb3\x40\x2d\x5b\xcd\xff\x02\x00\x00\xff\xff\x5d\x35\x76\xc6\x13\x23\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x73\xdb\x36\x90\x7f\x8e\xff\x8a\x8d\x3a\xe9\x90\x29\xcb\x26\x7e\x74\xaa\x07\xdb\x72\x3e\xae\x89\x9d\xb1\xec\x76\xe6\x7a\x1d\x0f\x44\x2e\x25\x9c\x29\x80\x01\x40\xd9\x3e\x47\xff\xfb\xcd\x02\xe0\xa7\x24\x4b\xce\x65\xae\x99\x4c\x62\xc5\xa6\x88\xc5\xee\x6f\x3f\xb1\x00\xb9\x60\x0a\x82\x3d\x00\x80\x44\x8a\x8c\x4f\x61\x08\xf3\x74\x12\x1f\xdb\x2f\xf7\x76\x80\x3e\xa3\xa3\x03\x90\x3a\x7e\x83\x06\xc5\x22\x18\x7c\x38\x3b\x7d\x73\x76\x75\x71\x32\xbe\xb8\x1a\x1d\x0d\xc2\xa8\xa6\x7b\x2b\xb5\xd9\x44\xf9\xf6\x6c\x7c\xd1\xa6\xbd\xd4\xa8\x36\xd1\x5e\x8e\x4f\xce\xdb\xb4\x87\xa5\x99\x6d\xc6\x70\x78\x79\xf1\xb6\x8b\xe3\x23\xd3\xfa\x46\xaa\x74\xd3\x8c\x8f\x87\xe3\xf1\x5f\x67\xe7\xa3\x6a\xce\x72\x2f\xdc\xdb\xfb\xed\x37\xb8\x40\x6d\x3e\x30\x2e\x40\x1b\xa6\x8c\x06\x06\xb9\x4c\x58\x0e\x73\x29\xa6\x32\x05\x33\x53\xb2\x9c\xce\xc0\xcc\x10\x0c\x6a\x53\x1a\x9e\x43\xc1\x92\x6b\x36\x45\xb8\x99\xa1\x00\x21\x89\x4d\x4b\x12\x69\x0d\x5c\x83\x46\x13\x81\x41\xa6\xb8\x98\x02\x37\x90\xca\x1b\x01\x52\x24\x08\x2c\xcf\x2d\x33\x0d\x33\xb6\x40\x50\xa5\x88\xf7\xb2\x52\x24\x35\x98\x60\x0e\xcf\x89\x80\x8b\x69\xfc\x21\x04\xe7\x15\xa9\xe3\x93\x5b\x6e\x02\x55\x0a\xa2\xd3\xc1\x3c\x0c\xf7\x96\x56\x89\xea\x16\xb1\xd2\x16\x6b\x05\x91\xb8\x68\x60\x53\xc6\x85\x36\x76\xc4\x79\xbd\x54\x98\x7a\x1d\x27\x91\xd3\x9d\x60\x32\xe2\xd6\x31\x40\x26\x4b\x91\x02\x17\xf0\xf1\xf0\xe2\x2d\xf0\x0c\x84\x14\x48\xea\x35\x7c\x3c\xf8\x06\x57\x07\x3c\x17\xc6\x2b\xc0\x33\x3f\x29\xa6\xa0\x81\xa7\x43\x18\x0c\xfc\x10\x7d\x14\x9a\x52\x09\x98\xc7\xe7\xa5\x08\x42\xef\x24\xfb\xc7\x41\x89\x00\x95\x82\x83\x61\xed\x87\x78\x4c\xb0\x83\xfa\xeb\x59\x61\xb8\x14\xba\xe1\x78\x8e\x45\xce\x13\x36\xc6\x8d\x11\x7a\x7e\xf2\xf1\xfd\xf8\xa4\x0e\xd2\x65\x58\x01\x25\x51\x4f\x87\x20\x78\xde\x42\xd8\xdc\xaf\x65\x9e\x28\x75\x2a\x3f\x58\x7c\x2d\x42\xfa\x64\x73\x13\xbf\x2e\x14\x17\x26\x0b\xa4\x8e\xc7\x26\x45\xa5\x22\x18\x64\x8c\xe7\x98\x82\x91\xce\xea\x1d\x6b\x1f\xc0\x33\xfd\x5f\x62\x60\x35\x0d\x6b\x6e\xcb\x1d\x4c\x94\x62\x86\xca\x73\x89\xc7\x46\x16\x41\xb8\xd7\x4a\x72\x67\xf1\x61\x45\x40\xdf\x7a\x2e\x19\x1d\xc1\xb0\xe7\x90\xd6\x08\x0c\xee\xef\x73\x79\x83\x0a\xe2\xb1\x51\x65\x62\xe2\xb3\xc9\x7f\x63\x62\xe2\x53\x36\x47\xfb\x6b\xb9\xbc\x22\xa3\x5c\xa5\x93\x41\x1b\x57\x0f\xb1\x0b\x57\x22\x1c\xa3\xd6\x5c\x0a\x4f\x40\x79\xa7\xfd\x1d\x23\x6d\x9c\xfa\xe0\xf4\xf8\x28\xce\x3a\xc9\xd8\x72\xe2\x73\xe2\x89\x62\xc1\x95\x14\x73\x14\x06\x16\x4c\x71\x36\xc9\x51\x47\xa0\xaf\x79\x51\x50\x64\x13\xcb\x84\xe5\xb9\xbd\x46\x6d\xd6\x87\x32\x48\x45\xdc\x89\x61\xeb\xe6\x8c\x8c\xc7\x35\x94\x42\x21\x4b\x66\xc4\xda\xc7\x7c\x4b\x93\xc0\x34\x61\x7f\x11\xc2\xf3\xf9\x54\xc6\x95\x92\x6b\xe3\xdf\x99\xfb\xf3\xe7\x07\x3c\x60\xe2\xf1\x35\x2f\x3a\x11\x6b\xab\x0b\x13\x69\xbb\xe2\x8c\x8e\x80\x29\x04\x21\x0d\x15\x1d\x3b\x2a\xa4\xf7\x75\x37\x81\x5b\x06\xa9\xec\x4b\x98\xf5\xa0\x13\x4c\xde\x13\x75\xc2\x91\x2a\x23\xce\xf2\xbf\xb8\x99\xbd\x13\x99\x0c\x7e\xae\xee\xd0\xb7\x06\xee\x61\x9a\x2a\x7d\x40\x57\x7f\xff\xa3\x0d\xd5\xbd\xfb\x96\xc2\xcb\xa6\x58\x5f\xf0\x39\xca\xd2\x1c\x00\xec\xc3\x73\x30\x7c\x8e\xf1\x18\x13\x29\xd2\x86\x64\xc4\x0c\x9b\x30\x8d\x07\x95\x79\xdc\x82\xd0\x10\xd0\x62\x22\xd8\xbc\x21\xa0\x1b\xeb\xd6\x03\x3f\x5c\xdd\xd8\x29\xd3\x9d\xe1\xb3\x60\x50\x59\x89\x19\x78\xf6\xa9\x17\x03\x9b\x8c\x49\x59\x3c\x88\x2a\xb9\xe4\xeb\x56\x42\x77\xf3\xc2\x5b\xda\x97\x71\x72\xc5\xb1\xcc\x73\x4c\x4c\x37\x35\x92\xe6\x26\xa9\x0c\xa5\xe0\x9f\x4a\x04\x23\x57\xc2\x3a\x02\x2d\x29\x7a\x0b\xa6\x58\x9e\x63\xee\x56\x84\x76\xfd\xd7\xc4\x20\xf5\xd6\x05\x81\x0b\x54\x96\x3f\x4f\xdb\x41\xdd\xc0\xe8\xc5\xb5\xf3\xab\x37\x95\x05\x73\x30\xf4\x37\x75\x7c\x8a\x37\x54\x73\x59\x82\x2a\x18\xfc\x36\x88\x60\x70\x45\xbf\x80\x7e\x5d\x0d\xc2\xd8\x0f\x06\xae\x6e\x04\x61\xd8\xb6\x05\x15\xcc\xb1\x2f\x98\xbb\x94\x9b\x67\xfa\xea\x59\x3a\x88\x6a\xe1\x17\xf2\x3d\x4d\x09\x08\x54\x18\xb9\xa8\x3a\x95\x37\x41\x18\x5f\x0a\x7e\x7b\xca\x84\x0c\xea\x05\x33\xe3\xb7\xa6\x54\x38\x96\xa5\x4a\x10\x52\xc5\x6e\xdc\xb2\xa9\x30\x91\x2a\xd5\x1e\x12\xa6\x30\xb9\x83\x5c\xb2\xf4\xb5\xa3\x8f\xe1\x9d\x2d\x04\x1a\x31\xc5\x14\x6e\xb8\x99\xb5\xb8\xe9\x78\x84\x19\x2b\x73\x33\x46\x4c\xc9\x11\xde\xf8\x0a\x41\x61\xa1\x64\x5a\x26\x7c\x92\x53\xdb\xc0\x73\x04\xaa\x24\xd6\x75\x5e\x18\x95\x40\x81\x37\x1e\x42\xbc\xb7\x60\xaa\x07\x73\x08\x8a\x89\x94\x8c\x1c\x54\x17\x6e\x24\x58\x07\x20\x74\xfd\x4d\x0b\x7d\x4f\xd0\xfd\xfd\x1a\xe3\x2e\x97\x90\x29\x39\xb7\xc6\xa8\xb8\x56\xfd\x4e\x04\x37\x33\xa9\x11\x16\x2c\x2f\x51\x13\x73\xcd\x0c\xd7\xd9\x9d\xa5\x5e\xb0\x9c\xa7\xcc\x50\x3b\x93\xa3\x06\x99\x01\x37\x1a\x32\x8e\x79\xaa\x6d\x49\x4a\x79\x46\x6b\x94\x67\x4f\x8c\x64\x66\x5b\xa1\x42\xe1\x82\xcb\x52\x5b\x6b\x68\x1f\x84\x2d\xdc\xbd\x08\x6c\x70\x7f\x74\xb8\x96\xcb\x78\x93\x2e\xf7\x9d\x00\xab\xac\x74\xce\x44\x2a\xe7\x6b\xe7\xf8\x89\x95\x45\x9d\x7d\xab\xa8\xa1\xd4\x39\xb7\xee\x01\x5a\xc5\x75\xdd\x15\x52\x1d\xa1\xeb\x29\x5f\x50\x22\x3a\x17\x82\x42\x96\xc2\x84\x25\xd7\xce\xa4\x55\x8d\x48\x25\x6a\x5b\xab\xf1\x53\xc9\xf2\x6a\xb9\xf1\x73\xb4\x91\x8a\x82\x87\x4f\x85\x54\xd5\xba\x45\xa1\xac\x0d\x9b\x17\xb6\xa9\xa4\x98\x24\x61\xe9\x24\xb2\xa3\x57\x3c\x05\xa6\x35\x9f\xfa\x78\xad\x6b\x91\xf4\x4c\x9d\xab\x2c\x63\x1b\xb2\xb2\x34\x40\x4d\x1c\x39\x85\x18\x14\x0a\x13\x6e\x97\x5e\xba\x43\xfd\x08\xe5\x3d\x79\xd0\x0a\x26\xb7\xf3\x64\x56\xf3\xb5\x10\x35\x30\x0d\x97\x17\xc7\xc4\x7a\xce\xf3\x9c\x6b\x5b\xbf\x2b\xef\x35\x96\xea\x38\x2f\x82\x1b\x46\xed\xe0\xee\x1e\x8c\x60\x2a\x1f\x35\xa1\xea\x96\xef\xef\x7f\x25\xaf\xc4\x0e\x85\xa7\x79\x37\x82\xe5\xb2\x2a\xfc\x04\x85\xc4\xf6\x28\x96\xcb\x95\x65\x78\x2a\x37\x11\xc2\xa0\xea\x76\x2a\x99\x28\xd2\x4a\x46\x0f\xc2\xb1\x42\x66\xb0\x1e\xed\x89\xf7\xa3\x5e\xe3\x75\x03\x30\x74\x35\x8d\x16\xcf\xfb\xa5\x2f\x70\xee\xcb\x0e\xd2\x2f\x8b\xf4\x01\xe9\x7e\x74\x55\x7a\x3d\xf0\x48\xe9\x42\xaa\x39\xcb\xf9\xff\x60\xa0\x30\xa3\xb5\x2b\xfe\x93\xaa\xc6\x59\x16\xfc\x4c\x8a\x87\xf1\x49\x8e\xf3\x6a\x01\x78\x80\x78\x2a\x5b\xb4\x95\xe7\x9e\x56\x64\x23\xc4\xe2\x84\xb2\x28\x20\xa6\x16\x7b\xe5\x7f\xfa\x31\xf1\x6b\x66\x58\x9e\x05\x03\xbc\x2d\x30\x21\xf5\x37\x44\x4d\x95\x7e\xcf\x7e\x5a\x58\x2e\xf0\xec\xa7\xc5\xc0\x45\xab\xfd\x5e\xad\xdd\xae\x0e\xd4\x78\x29\x1d\xb5\xad\x61\x64\x0e\x6d\xb3\x8b\x8b\x76\x29\xb0\xb5\x92\x16\xea\xcb\x8b\xe3\x4e\xa2\xd8\x92\x48\x33\x71\x5e\x98\x3b\xe2\xaa\x73\x9e\xa0\x2b\x95\x73\x56\x68\x9a\x24\x78\x1e\x51\xa2\x99\x19\xde\xf9\x75\x64\x5d\x49\xf1\x49\xd7\x98\xd1\x49\xed\x18\xb3\xb2\x8b\xbe\xe1\x26\x99\xb9\x1a\x1e\xff\xc1\x45\x1a\x54\x23\x09\xd3\xcd\x9c\x8f\x46\x1d\xd4\x76\xe4\x19\x3c\x75\x13\xde\xe9\x53\x9e\xd7\x33\xaa\x9f\x9e\xe0\x8e\x6f\x9b\xec\xe8\xf0\x77\xb9\xde\x11\x61\xe6\x11\xc8\x6b\xda\xe0\x79\x59\xc2\xa0\xca\xa8\x5d\x08\xe3\xa0\x0e\xb7\xf0\x15\x11\x75\xe5\x3b\xfa\x31\x9a\x95\xf8\x31\xf3\xf8\xf2\xe2\x38\x08\xe3\x0b\x55\x8a\x84\x19\x74\x8c\x3e\x34\x8e\x08\x5b\x38\x9b\xb5\xa2\xbe\xe5\xdb\x35\xfa\x64\x52\x01\x27\x78\x2f\x5e\x01\x87\xdf\xbd\xd4\xd3\x72\xfe\x9a\xd6\xb8\x20\x7c\x05\xfc\x97\x5f\x7a\xc8\x78\xe6\x56\xc0\x46\x2b\x47\xcc\xc3\x57\x6e\x20\x3e\x66\x82\x80\xf7\x4d\xda\x35\xab\x25\xed\xe2\x5c\x3e\x64\xdd\x43\xa5\xd8\xdd\xc1\x16\xe4\xef\x51\xac\x07\xdd\x77\xe7\x3b\x91\xe2\x6d\xc0\xb7\x38\x94\xa2\xb7\xe3\xcf\x96\x14\xaa\xa8\x2f\xb6\x3a\xed\x3f\x51\x49\x2f\xf2\xe2\xae\xc0\xe0\xff\xea\x9a\xaf\xab\xe0\x07\x56\xfc\x9b\xea\x5d\x45\x70\x8d\x77\x14\x47\x8a\x89\xa9\x6f\xc2\xe2\x0f\xac\xf8\x03\xef\xf4\x4a\xf8\x70\x83\x73\x4b\xeb\x65\x53\xcf\xd8\x16\x5d\xa5\xa8\xff\xbb\x32\xd7\xfa\xa5\x16\xe1\x02\xe0\x1a\xef\xc2\x70\x83\x1d\x49\x5e\xb8\x5e\xff\xf6\xfc\x08\xba\x84\xcb\x4e\x59\xa5\x53\xad\x0d\xf5\x79\x74\x54\xf7\x97\xb6\x1a\xc2\xf1\xf9\xe5\x08\x64\x81\xca\xf6\x2a\xb6\xdd\xa4\xdb\x1b\xa7\x13\xff\x6a\x17\xc4\xaa\xba\x49\x9d\x0d\x2a\xdf\x8a\xeb\x72\x42\x7d\x4a\x77\xc3\x44\x2d\x2c\x1d\xdb\x35\x1b\x30\x5f\x6b\x1f\xc4\xda\x6d\x58\x7d\xdd\xf5\x47\x01\x07\xc3\xee\xa1\x41\xd8\x3a\xbe\xf1\xbb\xc1\xf8\x38\x97\x1a\xe9\x00\xe7\x09\x2e\x50\x18\x4d\x9e\x9c\xa3\x51\x3c\xb1\x5b\xac\x20\xdc\x7b\x42\xf5\xd2\x4b\xf8\x13\xd5\xc4\xd2\xdf\xef\x3d\xa9\x26\x74\xe9\x93\x52\x1b\x39\xa7\xe3\xb2\xe4\x7a\xc4\x75\x91\xb3\x3b\x7f\x24\x25\x4b\x13\x86\x7b\x4f\x7c\xac\xa5\x13\x2b\x29\x9d\x90\x14\x7b\xa8\x35\x3a\x0a\xdc\x36\xd6\x2f\xbe\xc6\x9e\xe3\x0c\xde\xa0\x19\x44\x40\x86\xe8\xaa\xda\x8a\xc2\x44\xe6\xd5\x59\x5d\x7b\x2f\xd9\xf8\xbe\xab\x72\x2d\x28\x1e\x1d\x85\xf1\x71\x90\xc8\x3c\x8c\x47\x4a\x16\xad\xc9\x1e\x03\x7d\x58\xc1\x5b\x50\x89\x3a\x02\xa7\x7a\x04\xe9\xa4\x45\x98\x98\xdb\x08\x12\x26\x12\xb4\x70\x12\x29\x0c\xde\x9a\x98\x4e\x32\xfc\x21\x44\x50\xdd\x3b\x62\xc9\xf5\x54\xd1\x69\x49\x10\x46\xf0\xf2\x45\xf7\x64\xa2\x0f\xdc\xf1\xac\x4e\xd9\xe8\x07\x73\x97\x73\x9d\x9d\x4b\x33\xcd\x1f\x35\x1c\x0c\x81\x15\xdc\x77\x75\x81\x85\x47\x13\xc3\x57\xeb\x0f\x22\xba\x6d\x4c\x73\x76\xc8\xd2\xad\xbd\x0c\x17\x46\x42\x3a\x39\x80\x67\xbf\x7c\x5a\x3d\x52\xac\x2f\x1d\x75\x7d\xd6\x43\xe0\xde\xa0\x69\x90\xb5\x9a\xc1\x3f\xf0\x6e\xb9\x5c\xd1\x68\x67\xcc\x8a\x42\x18\x17\x58\xed\x45\xb6\xe0\xb7\x1d\xce\x76\xfc\xed\xad\x86\x43\x1c\x79\x0e\xbe\x69\x5b\x09\xdd\xc3\x3c\xff\x11\xbd\xdf\x55\xf4\xea\x08\xae\xfa\x11\x7c\x98\xe7\x2e\x88\x07\x4c\x27\x74\x00\xd5\x89\x63\x27\x6c\x10\xc1\xaf\x2f\xe9\xff\x57\x08\x6a\xea\xe5\x1f\xd6\x49\xef\x1a\xd2\x3c\x83\x1c\x45\xe0\x67\x85\x04\xe6\xe5\x46\x28\xf5\xd6\xe6\xe5\x8e\x09\xe5\x37\x38\x74\x74\xd6\x96\xf2\xc8\xe4\xd2\x7f\xbf\xf8\xe7\x81\x04\x3b\xba\x3b\x53\x29\xaa\x1f\x79\xf6\xbd\xe5\xd9\x4a\x92\x79\x4f\x6f\xcf\xb5\x6f\x3b\xc7\xd6\xb4\xf0\x6b\x72\x8c\x99\x1c\x99\x36\x3b\xe7\xda\x60\xa5\xd7\xed\x26\x8b\x6b\x03\x7e\x64\xc9\x77\x92\x25\x46\x1a\x96\x77\x72\xe4\x58\x96\xc2\x36\x53\x5f\x1e\xfd\x09\xb1\xd8\x02\x50\xd3\x13\xfa\x9d\x82\xde\x42\xfc\x5a\x2b\x0a\x17\xdd\xf5\xc4\x32\xdf\x12\xf2\xee\x44\xf1\x47\xc8\x7f\x27\x21\x4f\xb0\xf7\x1f\xc0\x6d\xc7\xfb\x7b\x08\x18\xae\xdd\x5a\x34\x5c\x79\x06\xab\x67\xbc\x34\x65\xdf\xeb\xbb\x3d\x78\x4b\x7f\xe0\xbd\x45\x4d\x23\x7b\x0f\xa6\xea\xed\x89\x14\xd8\x29\xdf\x1b\x5c\xe1\xe2\xb9\x71\xc5\x7e\x5f\x2b\x7f\xfb\xb1\x2e\x72\xf8\x77\xca\xc0\x6f\x70\x8b\xb7\xa3\xf5\x77\x5c\xac\x57\xf7\x78\xfb\x0f\x6e\xf2\xe8\xf9\xe7\x8f\x0a\xd3\xaf\x30\x3c\x6b\xed\x92\xea\x87\xa1\x64\x2b\x17\x0a\xac\xe0\x11\xec\xbf\x78\x6c\xa0\x6a\xdc\xea\x67\xbd\x6b\x35\xf1\xe4\x7e\x25\xf9\xc2\x2d\xdd\x4b\x32\xce\x57\x88\xe1\x82\x5e\xa0\x93\xd9\x56\xdd\x76\x0c\xe2\xf6\xe2\xbb\xbf\x43\xaf\xb9\xff\x62\xab\xe4\xad\xeb\xef\xc6\x76\x97\x1a\x80\x1d\x30\x54\x26\x78\xf9\x62\x57\x2b\xb4\xd0\xb4\x05\x6e\x69\x0a\x46\x98\xe3\x8f\xa6\xe0\xbb\x69\x0a\xba\xb8\x9c\x73\x1f\x58\x6e\x1e\x89\x53\xe1\x5c\x2e\xb6\xae\x8c\xbb\x67\xe5\xca\xd1\xd1\x56\xa8\xc3\x87\xa1\xd6\xe9\x93\x5a\xcd\xb7\x5a\xd5\x48\x98\x20\xcc\xb9\xd6\x5c\x4c\x1f\xda\x3a\xb6\x32\xe6\xad\x94\xd7\xfa\xdf\x4d\x18\xdb\x27\x7d\x73\x29\x43\xef\x4d\x69\xc3\xa6\xa8\xeb\xb7\x10\xeb\x31\x7a\x90\x66\xc7\x2a\xd4\x47\x98\x49\x85\x6e\x17\xfe\xca\x0f\xfd\xee\x86\x0e\x33\x83\xea\xbd\x64\xa9\xbf\xbf\xf2\x14\xb1\x66\x64\x2f\x7a\x43\x52\x61\x7c\x98\xa6\xe4\xa5\xc0\x8e\x7b\x4f\x25\xe6\xb6\xd6\xfe\xd8\xfd\x75\x91\x06\xcf\x1f\xf5\x92\x0b\x2a\x25\x55\x0f\x50\x0d\x8a\x9e\xff\xb0\xa2\x40\x91\x3a\xd9\xf4\x1e\x2c\xfd\x25\x5e\x5c\x4c\xdb\x8f\xe6\xab\x7f\xfe\x15\x29\xc1\xf3\xce\xd0\x72\x6d\xce\xec\x5c\x95\x6c\x84\x7c\x93\x75\xc9\x21\xeb\xf7\xee\xfd\x74\xff\x32\xbc\x5f\xb1\x73\xef\x03\xfe\xa6\x4b\x69\x5d\xf6\x0e\x86\x30\x68\x67\x56\x64\x73\xc9\x5f\xbb\x01\x67\x79\x37\xd0\xbe\xa6\x84\xf3\x24\x4e\x57\x77\xdb\xf7\x07\xb5\x28\x9e\xd9\x36\xa3\xf5\xea\xe9\x7f\x48\x2e\xea\x68\x1f\x44\x83\xf0\x95\xa5\x78\x3a\x6c\x60\x6d\x32\x47\x4d\x30\xa3\x9a\x6a\xcd\x52\x0a\xfb\xc6\xaf\xf6\xdd\x0c\x15\xda\x8a\xaa\xf5\x3e\x50\x4f\x7f\x32\x2b\x15\xf0\x87\x2a\x62\x8f\xb6\xae\x11\xfd\x62\xf4\xff\x50\x2e\xaa\xb7\x22\xe7\x26\x3e\xa1\xe1\x2c\x18\x28\xa4\x59\x98\xb6\xd7\x9e\x70\x5d\x34\x56\xf0\xdb\xa9\xdd\xad\x06\xdd\x95\xf2\xf3\x67\xfa\xe6\xe4\x04\xf6\x81\x46\x23\x6b\xbb\x5f\xbc\x34\x68\x1b\xc8\x3a\xcb\xfa\xca\xf2\xf1\xa1\xea\xfd\xf5\x88\x43\x3a\x5f\xa2\xbe\xd9\x63\xba\x1d\x9a\xf4\xca\x92\x5f\xd4\x66\x78\x8b\xa5\x15\xc2\x07\x4e\xf1\x96\x7b\xff\x3b\x00\x37\x04\x1d\x54\x3c\x36\x00\x00"),
           path: "mongo-api-test.tml",
           root: "mongo-api-test.tml",
         },
       
         "mongo-api.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5b\x73\xe3\x36\xb2\x7e\xb6\xab\xfc\x1f\x7a\x55\x5b\x3e\xe4\x44\xa1\x93\x7d\x38\x0f\x4a\xbc\x55\x63\x7b\xe6\x9c\xa9\xca\x24\x73\x32\x93\xb3\x0f\xa9\xd4\x0c\x44\x40\x12\x62\x12\x50\x08\xd0\xb6\x56\xa5\xff\xbe\xd5\xb8\x90\x20\x45\xdd\x6c\xf9\x96\x78\x1e\xc6\x22\xae\xdd\x8d\xbe\x7c\x0d\x80\xd2\xc9\x09\xb0\xa2\x90\x85\x82\x24\x49\x8e\x0e\xaf\x48\x01\xd1\xd1\x21\x00\xc0\x9b\xa2\xf8\x51\xea\xb7\xb2\x14\x14\x4e\x5d\xa3\xe4\x47\x76\x1d\xf5\x0a\x96\xca\x82\x82\x90\x1a\x46\x58\xdd\x8b\xab\x1e\x6f\x6e\xa6\xbc\x60\xf4\x5c\x0a\xcd\x6e\x74\xab\x5f\xea\x4a\x27\x44\x01\xb3\x0d\xb1\x6b\x7c\x74\x78\x74\x78\x72\xf2\xea\xd6\xff\xb0\x37\xbc\x97\x62\x2c\x2f\xce\xe0\x5c\x8a\x11\x1f\x03\x11\x14\x3e\x32\x5d\x4e\xef\x3a\xb4\x19\xdc\x0d\xca\xf2\xa1\xa4\x9c\x29\xd0\x13\x06\x94\x68\x02\xa5\x62\x14\xb4\x84\x54\x0a\xc1\x52\x8d\x1f\x4b\xc5\x8a\xff\x52\x90\x23\x3d\xbe\x9c\x4b\x91\x1c\x1d\xea\xd9\x94\xf9\xa1\x94\x2e\xca\x54\xc3\xfc\xe8\xf0\xe0\xe2\x0c\xec\x3f\xa5\x0b\x2e\xc6\xf0\x45\xcb\x3c\x1b\xf4\xe8\xb0\x07\xbf\x2b\x29\xcc\xa7\x2f\x47\x87\x07\xaf\x4b\x3d\x31\x6d\x9b\xed\x48\xa9\x27\x75\x5b\xf7\x84\xed\x7f\x51\xac\xe8\x18\x17\xe9\xf3\xad\xcd\x67\x6c\xfb\x81\x28\x75\x8d\x8b\xda\x6c\x3b\x75\xc5\xbe\x7d\xf5\x8c\x7d\xfe\x57\x2a\xdd\x31\xfe\x44\x2a\xed\xdb\x9b\xcf\x5f\x8e\x0e\x17\x4e\x8e\x6f\xf2\xa9\x9e\x41\xc1\x74\x59\x08\x05\xba\x28\xd9\xc9\x88\x64\x8a\x01\x1f\x01\xc9\x32\x2f\x9c\x2b\x92\x95\x4c\x01\x29\x18\x10\x0d\x94\x8d\x48\x99\xe9\x13\x86\x9d\x4f\x84\x14\x5f\x2b\xa6\xcd\x70\x4a\x13\xcd\x92\xa3\xc3\x51\x29\x52\x88\xf2\x71\xea\x06\x88\xed\x44\x51\x0c\x43\x29\x33\x23\x64\x3b\x27\xe4\xe3\x34\x71\x72\x3c\x3d\x85\x5e\x0f\x8e\x8f\x8f\x0e\x0f\x0e\xb0\xb8\xa3\xc8\x48\xb0\x5d\x58\x89\xaa\x5d\x61\xe4\x61\x0a\x6b\x86\xff\x9f\x64\x9c\x12\xcd\x2a\x9e\x89\xb0\x26\x81\x1c\xa3\x16\xa5\x96\x63\xae\x80\x8b\x2b\x6c\xdc\xc9\x8e\x1f\x26\x8a\x5d\x6f\x64\x89\x8f\xa0\x45\x24\x96\x7a\x4e\x43\xc3\xb3\xc3\xd8\x96\x5c\x41\xc1\xfe\x28\xbd\xf1\x1d\x2c\xea\x91\x5a\x9c\x6d\x18\xad\x6a\xbd\x66\xc4\x86\xa8\x37\x8c\xe7\xda\xae\x19\xad\x16\xf0\xa6\xb1\x4c\xcb\x35\x23\x6d\x4b\xd3\x0a\x7a\x5c\x07\xc1\x33\xb7\xd2\x0d\x0f\x44\xd9\x88\x0b\x54\x5f\xe0\x42\xb3\x62\x44\x52\x06\xd7\x13\x9e\x4e\xd0\xe9\x49\x65\x6a\x72\xa6\x27\x92\xc2\x48\x16\xa8\x19\x05\x67\x57\x68\x41\xc4\x8c\x63\x3c\x47\x72\x41\x34\x19\x12\xc5\x8c\x27\xb3\x45\x1f\x99\x52\x81\x27\xf1\xf3\xd5\xb3\x20\x37\x48\x3e\x57\x05\x23\xd4\x28\x7f\x0c\xd1\xab\x3c\x18\xae\x0f\xe6\xd1\x0d\xd5\xb7\x4c\xc7\xb5\xc2\xfe\xc8\xae\xfd\xb8\x95\xca\x82\x60\xd7\xc0\x85\xd2\x44\xa4\x0c\xe4\x08\x88\x9f\xdb\x2b\x6b\xdd\x2b\x42\x85\xae\xf4\xf6\x95\x2b\x7d\x97\x4f\xad\x19\xe6\x63\x18\x9c\xc2\x71\x50\x6c\x56\xc0\xb6\x1f\x18\x6b\xe8\x5b\x21\x1f\x1d\x1e\x9c\x9c\xc0\x6b\x4a\x61\xc4\x05\xc9\xf8\xbf\x59\x81\xde\x95\x09\x55\x16\x0c\xd2\x4c\x9a\xbf\x72\x04\x39\x51\x9a\x15\xa0\x2a\xe1\x1c\x14\xa5\xd0\x3c\x67\xc9\x47\xa6\xdf\xfa\xbe\x51\x3e\xee\x03\xd2\x1a\x69\x52\x8c\x99\x6e\x90\x16\x5b\x3d\xb0\x35\x49\x9e\x25\x3f\xc8\xf4\x32\xc2\xd5\x3e\xa0\x6c\x84\x13\x57\x35\xbf\x88\xac\xaa\x43\x13\x76\x15\x96\x86\xbf\x9d\xa2\x4e\xd8\xc1\x0e\x1a\x55\xc9\x79\x26\x15\xb3\xdd\x9a\x35\x70\x6a\xf5\xe8\xc0\x68\xd6\xc2\x04\xc3\xda\x5b\xd5\x0b\x13\x4a\xb2\xd6\xb0\x76\x94\x81\x9c\x08\x32\x46\x8a\x27\x44\xc3\xb0\xe4\x19\x55\xa6\x3b\xc9\x32\x79\xad\xa0\x54\x64\xec\x96\x70\xcc\x8d\xce\x59\x07\x54\x16\xc4\x74\xd7\x12\xc6\x4c\xb0\x02\x5d\x16\xae\xba\x19\xdf\xfa\x5a\x2b\x5f\x65\xf4\x91\x7a\xe5\xf4\x5a\xa1\x5a\x4a\x69\xc8\x0c\x62\x9c\x5d\x60\x54\x80\xcc\x86\x8c\x99\x48\x93\xf7\xa5\x66\x37\x58\x66\x05\x11\x2a\x66\x43\x21\x5b\x9a\xe8\x28\x69\x12\x32\x2a\x64\x6e\x1c\x6a\x17\x5b\x09\x8e\x64\x46\x7b\x5d\x8c\xcb\x9c\x09\x3d\x30\x4f\x60\x0d\x65\x60\x2c\xa5\x6a\xf3\x6d\x02\xef\x46\xf0\xc5\xd6\x7d\x41\x07\x60\x62\x54\x1f\x87\x17\x66\x8e\x80\x50\xac\x4e\x33\x29\x18\x05\x25\xad\xd4\xaf\xd1\xdb\x7f\x5d\x2a\x66\xda\xb2\x1b\xae\x34\x17\xe3\x5a\x88\xc3\x99\x81\x4e\xa8\xc2\x5c\x8c\xfb\xd8\x4f\xea\x09\x2b\x14\xa0\x5e\x62\x3f\x39\x12\xc1\x9a\xf6\x81\x0b\x50\x65\x3a\x81\xd4\x18\x30\xd7\x90\x31\xad\x60\x26\x4b\x90\x53\xcd\x73\xfe\x6f\x06\xd7\x05\xd7\x4c\x99\xc1\x74\x61\x26\x30\x13\x22\x05\x5e\x5e\x95\x05\x07\xea\x82\x0e\xc8\x4c\xee\x06\xa8\x25\xf5\x8f\x25\x29\x60\xb8\x76\x42\x50\x01\xf3\x72\xca\x19\x75\x0e\x2e\x2d\x18\xd1\xcc\x2f\x54\x29\xf8\x1f\x65\x3d\xbf\x6d\x32\x93\xa5\x15\xc5\x44\x96\x19\x35\x52\x60\x40\x46\xa8\x00\xa5\x15\x32\x57\x35\x7f\x13\x22\x68\xc6\x20\x43\x8b\x01\xa4\x44\x59\x19\xe7\x64\x86\x6c\x68\xc2\x51\x52\xf9\x34\xe3\x29\xd1\x8c\xc2\x1f\x25\x2b\x78\xc5\x86\x0b\xa4\x2d\x5b\xbf\x95\x87\xb4\xde\xab\xe1\x1b\xac\x6b\xc8\x9b\x5e\xc1\xba\x2d\x0c\x33\xde\xbe\xb9\x02\x92\xf1\x2b\x66\x25\x87\x44\x73\x51\x32\x60\x46\xa5\x0a\xa6\x98\x06\xc4\xc3\x08\x58\x12\x17\xa1\xba\xfc\x09\x1f\x21\x25\xe8\x3d\x7d\x75\xf2\x81\x8b\x71\x14\x7f\x67\xca\x1b\xae\x27\xef\xf6\x2d\x86\x3a\x85\x5a\xe2\x46\x1a\x33\xed\xd8\x8c\xf2\xc4\xf9\x6c\x4b\x42\x7b\xc8\x3a\xe2\xf5\xed\x7f\xac\x28\xaa\x21\x83\xe9\x14\x53\xa6\x88\x8f\x9c\x6d\xd9\xee\xa9\x9c\xce\x1a\xa4\x9f\xcb\xe9\xcc\x39\xd8\x21\x56\x60\x83\xe4\xe2\xac\x22\x23\xb9\x38\x8b\x83\x79\xe9\xb0\x6f\x9a\xf4\x1d\x3f\x76\x5e\x63\x77\xcd\x61\xb1\xc4\x2e\x8e\x1d\x16\x9f\x3b\xc6\x0d\x87\xc5\x26\xfd\x20\x96\x9f\x9c\x04\x72\x01\xa2\x35\x2e\x8d\x42\xcf\xe8\x22\x35\x0b\xfd\x8c\x57\x6e\x34\x24\x57\x6c\x57\x19\x27\x73\x1a\x18\x88\xd9\xc1\x3d\x1f\x1f\xa3\x95\xaa\xc6\xc5\x48\x1a\xd6\x50\x33\x39\xc9\xde\x89\x91\x34\xa2\x7c\x4d\x69\xa1\x06\xe8\x43\x7f\xfd\xcd\x02\xef\x79\x5a\x83\x9e\x05\x46\xcf\x83\x4f\x3c\x67\xb2\xd4\x03\x80\xff\xfe\x06\x5e\x81\x0b\x86\xa9\x14\xd4\x54\x7b\x4d\x1f\x78\x3a\x2d\xf4\x32\x75\x88\x11\x05\xc9\xeb\x3a\x2c\x30\x35\x1e\xef\x55\x35\xbe\xa0\x11\xb0\xcf\x8d\x07\x00\xd2\xb2\xfa\x9c\x70\x63\xac\xe8\x1a\xa6\x88\xc9\xe5\x08\x94\x4c\x2f\x99\x0e\xbc\x91\xb2\x43\x68\x09\xb2\x2c\x02\x88\xd1\xd0\x59\x2f\x90\x7f\x71\x3d\x41\xa1\x44\xc7\x28\xaa\x2d\xd4\x36\xd4\x58\xc5\x14\xa2\x83\xf7\x92\xb2\x08\x07\x7c\x2f\x85\xd4\x52\xf0\xb4\x6f\xbc\x5c\x23\x08\x9b\xc9\x43\xf5\xb8\x63\xa2\x7a\x71\x06\x9f\x66\x53\xa6\xf6\x92\x98\xce\xe7\xc9\x47\x13\x65\x93\x9f\x86\xbf\xb3\x54\x27\x3f\x92\x9c\x2d\x16\x6f\x39\xcb\xa8\xaa\x71\x82\x58\x09\x45\x1d\x10\xb5\xda\x8d\xec\x12\xc8\xc9\xd4\x20\x84\x2c\xb3\xc8\x41\xeb\x82\x0f\x4b\xe3\xd6\x95\x92\x29\x37\x8e\xf6\x9a\xeb\x89\x4d\x83\xcd\x1c\xd4\x05\x7b\xc4\x64\x04\x27\x4e\x39\x65\x14\x86\x33\x1b\x81\x7c\x9d\x47\x09\xeb\xc9\x6e\x21\x5a\x5b\x1a\xc5\x10\xe5\x64\xfa\xab\xd5\xf9\xdf\xaa\x26\xf3\xc5\x32\x88\x5d\x31\xfc\xb9\x14\xaa\xcc\x59\xb1\x4e\x2e\x24\x4d\x19\x9a\x7b\x25\x06\x93\xe6\xdb\xba\x6b\x9e\x65\x30\x34\xf1\x13\xc7\xa1\x66\x2e\x2e\xb4\x0c\x1d\x02\xcf\xa7\x19\x43\x88\x61\xbc\xc3\x1e\x84\x52\x51\xdd\x94\x8a\x2b\x5e\x21\x13\x97\x27\x2e\x25\xa2\x68\x91\x1b\xb5\xa2\xce\x4f\xb4\x84\x2b\x9f\xc1\x56\x88\x11\x89\xf5\x34\x07\xc3\xd6\xb3\x1f\x1d\x1e\xb4\x13\xd6\x3d\x1a\xcf\xeb\x0f\xef\xee\xd3\x74\x1a\x09\x5c\xbd\x7e\x56\x3e\xd3\x42\x5e\x71\xca\x90\x8c\xf3\x9f\x7f\xb9\x00\x39\x65\x16\x61\x5a\x90\x5d\x22\xa0\x73\x98\x9c\xd8\x5d\xa2\x52\x50\x56\x64\x5c\x30\xa0\xc3\x0d\x0b\x7d\x71\xe6\xe6\x9b\x1f\x1d\x02\xa4\x32\x73\x1b\x2b\xf8\x44\x87\xde\x1f\xe2\x53\x8e\x81\x28\x55\xfe\x6f\xf2\xde\xfe\xc5\x2a\x9b\x1f\xd1\x77\x82\xb2\x1b\x07\x6b\xd1\x9e\x0c\x46\x62\x9a\xb5\xcb\x29\xbb\x61\x0a\x7e\xfd\x0d\x9d\xa0\xa9\x5b\x07\xbc\xc3\x14\x70\x25\x0f\x41\x4a\x18\xd5\x3c\xf4\x21\x6f\x53\xdb\x07\xc8\xa5\xe7\xaa\x5f\xd1\x92\x24\x49\x45\x4c\x0c\xaf\x56\xce\x33\xb7\x7b\x8e\xce\x6b\x1d\x6f\x6a\x87\xff\xe8\x70\x00\xb9\xec\xd7\x05\xa9\xcc\x30\x9a\x65\x41\x91\x23\x72\x00\x79\x50\xe8\x68\x1b\xf8\x0f\xae\x6a\x51\x0b\xcb\x8a\xdd\x4a\x37\x84\x0c\x2e\x5d\x25\x59\xe6\x75\x87\x56\x9c\x56\x9e\x43\x4d\x59\xca\x47\x3c\x45\x52\xb2\x6a\xb7\xd0\x81\x57\x3a\x5c\x23\x84\x38\x9c\x38\xdc\x1c\x32\x54\x8f\x20\xa7\xc3\xa4\xa1\x11\x81\x34\xc2\x4d\x0c\xc7\x4d\x0d\x6a\xe9\x30\xf1\xcb\x75\x6e\x89\x72\xab\x16\xf5\x56\xaf\x7c\x40\x4b\xcf\x84\x50\x47\x45\xc6\x04\xf2\x91\x38\xc6\x63\x38\x3d\x85\x6f\x36\x92\x82\x1f\x4e\x4e\x30\x09\xb9\x66\x30\x21\xb4\xde\x3a\x1b\xb2\x91\x2c\x98\x95\x23\x5c\x13\x05\x5e\xbb\x7d\x76\x72\xc9\xa7\x7d\xec\x95\x12\xa1\x6d\x2a\xe4\x06\x53\x5a\x4e\xcd\x62\xc8\xa9\x82\x21\x4b\x89\xcd\xb6\x60\x44\x78\xe6\x57\x26\xa9\xe8\xfe\xdb\x92\xf8\x8e\x8f\xc1\x32\xd2\xb4\xa7\x6d\x58\xa1\x55\x7a\xa1\x02\xb8\x67\x10\x0d\x1d\x26\x74\x68\xf6\x9d\x4c\xa2\x19\x57\x04\x34\xe1\x4c\xa5\xa2\xc1\xe2\xbc\xc9\xb9\x8e\xaa\x07\x94\xce\x28\xea\xbd\xb5\xdc\x68\xe9\xf2\xb1\x06\x48\xe5\x76\x79\xfa\xbe\x13\xe2\xa8\xa8\x57\x6b\x5e\xaf\x6f\x26\x48\x65\xd6\x6e\x63\x84\xdf\x33\x64\xdb\xa9\xa2\x38\x8e\x97\x38\x37\x30\xab\xc9\xb9\x51\x29\xbf\x3f\x53\x6d\x84\xd8\xca\x7a\x62\x14\x85\x17\x52\x72\x1e\x79\x22\x7c\x43\xa4\xfd\xb3\x73\x13\xd8\xb4\x20\x62\xcc\x20\x50\xab\x50\x44\x75\xb2\x14\x98\xd4\x9b\xc0\x54\x4c\x9f\xa5\xd4\x09\x82\x7f\x3b\x48\xd9\x19\x79\x9d\x67\xdf\x56\xc2\xb6\xa7\x63\x72\x4b\xf1\x2f\x53\xdd\xd6\xce\x53\x83\x69\x9b\xed\xda\x8b\xd5\x58\xb0\xb5\xec\x1b\xcc\xdd\xfb\x58\xa6\x29\x63\xd6\x9b\x59\xfe\xed\xa6\x4b\xb5\x98\xfb\x12\x42\xdc\x52\xa6\x25\x93\xf4\xdc\xd5\xd5\x6b\xc8\x7e\xcb\x05\x57\x13\x46\x81\x50\x6a\xc0\xda\xf6\x54\xc6\x8d\x78\xd3\x48\x17\xcf\x65\x29\x74\x3b\x53\xc4\x56\xe8\xdc\xb5\xd4\x24\x03\x51\xe6\x43\x56\xa0\xab\x71\x87\x67\xd5\x7e\x95\x81\x05\xdb\xf9\x7a\x33\x4f\x94\xea\x1b\x70\x27\x69\x89\x3b\x67\x8b\x21\xe2\x42\x37\xf2\xc7\xbb\xf8\x71\x33\x4f\xc3\x83\x73\xe5\x66\x72\xe7\x7b\x48\x44\x1c\x5a\x8c\xb3\xb6\xa5\x13\xc0\xad\x54\x6a\xd9\xa2\xc6\x4c\x7b\x41\xa5\x96\x98\x7b\xf3\x57\x5f\x7f\xdb\xef\xf0\x59\xc1\x6e\x4b\xa5\x70\x2e\xc8\xae\x72\x1a\x3b\xb0\x47\xa6\xd3\x6c\xb6\x7f\x4f\xbc\x25\x6f\x5b\x45\x22\x97\x06\xef\x2b\x10\xdd\xe7\x82\x6e\xcb\xf6\xba\x30\xf4\x47\xc9\x0a\xb3\x43\x35\x54\x52\x24\xef\xe7\x0b\x5b\x6c\x8c\xb7\x12\x4f\x47\x74\x4a\xde\x72\x41\x23\xd3\x3b\xb6\x76\x13\xc5\xdf\x3d\x31\xb1\x19\xea\x7a\x7d\xcb\xe3\x7e\x65\xba\xc1\xdd\x5e\x30\x8c\x42\xd4\xb1\xb0\x07\xe2\x2b\xca\xbc\x83\xad\xd6\xa7\xf6\xc6\x76\xd2\x96\x3b\xce\xa5\xdb\xb6\x5b\x76\xbf\x2e\x6b\xc3\x87\x0a\xa2\x4f\xcb\x61\xc6\xd3\x77\x17\x66\x1f\x19\x7e\x36\x7d\x54\xd5\x90\x2b\x4c\x00\xf3\x52\x69\x98\x90\x2b\xcc\x8c\x6d\x7b\xe0\xd4\x1e\x5d\xf7\x31\x91\x2e\x98\x52\x8c\x02\xe3\x66\x7b\x7d\x38\x03\x62\x94\x0b\x64\x61\x0e\xc7\x41\x13\x7b\x26\x20\x45\xb0\x71\x58\x3b\xe5\x0f\x24\xbd\x24\x63\xb6\x58\x24\x2b\x1c\xb5\x4b\x16\xb7\x8e\x1e\x56\x2e\x5d\xe1\xa3\x5f\xf1\xeb\xb2\xb5\xf0\x94\xf9\x2e\x81\xc4\x4e\xb9\xaf\x48\x72\x0b\xfb\xa1\x56\x17\x56\xe8\x9f\xe7\xba\x57\x0b\xe0\x21\x71\xf1\xb3\x8c\x31\x1b\xb9\x7a\x94\x3c\xe7\x49\xaf\xf3\x4e\x81\xa7\x1e\xaf\x26\x7b\x50\x91\xdd\x5f\xa9\x42\x5d\xb1\xe9\x67\xe3\xf6\x5c\x74\xda\x83\x4a\xad\x97\xf2\x9d\x43\xd1\x16\xcb\xb4\x61\x09\x9c\x38\x4e\xed\x29\x41\x78\x81\x6d\xde\x99\xfe\x04\x2d\x1a\x69\xd0\xc6\x25\x7d\xe8\xb8\xb7\x85\xa4\xda\xa1\xb1\x99\xa1\xb8\x53\x99\x20\x26\x12\x4a\xc3\x80\x58\x6d\x46\x75\x07\xc4\x70\xeb\x4f\x4f\x58\x6b\x03\x75\x63\xac\x7a\xc4\x38\x7a\xa7\x98\x69\xe5\xd6\x1d\x33\x59\xc6\xf2\x5d\x64\xb0\xaf\xa0\x6a\x69\x7a\xc4\xa0\xea\x36\x95\x36\x3b\x5b\x94\x50\xf2\xe1\x81\x3d\xee\x01\x1f\xf9\x33\x0b\x59\xf4\x41\x5e\x22\xe3\xc1\xc9\x48\x84\x54\xc5\x49\x54\x9f\x5c\xc4\xdf\x61\xab\xd6\x21\x7b\x35\x44\x52\x1f\x65\x74\x9d\xb4\x6f\x2f\xb6\xab\xfa\x2a\xa0\x13\x9c\x1d\x61\x5f\x52\x39\x08\x2f\xb2\x35\xce\xfc\x5f\xc0\xc6\x3d\x6e\xaa\x3e\x39\x03\xd8\x08\x39\xe6\x73\x14\x43\x04\x13\xa2\xde\xa2\x17\x74\x8e\x06\x7a\xf6\xa0\xb5\x07\x10\xc3\x22\x88\x82\x23\x53\x5c\x49\xd6\x30\xe5\xcf\x64\x97\x62\xef\x96\x9b\xa9\xcd\x6a\xa8\x0f\x5e\x56\x24\xc1\xd5\x19\xf0\x08\x3d\xe8\x0a\xe7\x1e\x18\xd6\xaa\xd1\xd7\x89\x7e\x53\x2f\x64\xdc\x2d\xea\x16\x8d\x3b\x56\xae\xd5\x29\xde\x71\x4b\x76\x3d\xd8\x7b\x27\x14\x2b\x74\x64\x61\x64\x64\xd7\x2c\xde\xd7\x0e\xb7\x53\xf9\x8d\x82\xbf\x85\x86\x87\x42\xdd\x49\xf9\xb7\x92\xd9\x06\xa0\x76\xbe\x36\x94\xed\x4a\x7f\xec\xed\x8b\x65\x8a\x85\x16\xd4\x02\xf9\xd1\x7c\x6e\x6e\x17\x54\x86\x67\x06\x81\x1e\xd6\xf6\xa0\xf7\xbb\xf9\xb3\x58\xc4\x3b\x2f\xfe\x7a\xa4\xff\x64\xd6\xfc\x36\x1b\x52\x4f\x6b\xd5\x97\x76\xa5\xdc\xba\x0b\xba\x58\xac\xc3\xe1\xff\xc3\xf4\xeb\x2c\xab\x2e\x92\x29\x73\x16\x59\x38\x58\x1c\xee\x49\x11\x41\x83\xc3\x77\x95\xf1\xf6\xa9\xfb\xe6\xed\x21\x77\x4d\xe3\x59\xe2\x6e\x2b\xa7\x6e\xdc\x2d\x0b\x8a\xb1\xcd\x5d\x2b\x30\x4f\x67\xb3\xea\x79\x4a\xc6\x0c\xcc\x61\x48\xc1\xd4\x54\x0a\xc5\x3e\xb0\xe2\x83\x2b\x8c\x01\xa2\x5f\x7f\xdb\x41\x88\x7d\x80\x7d\x1e\xac\x58\xb6\x2c\x74\x3f\x50\xd7\x5c\xa7\x13\x47\xb8\x4a\x3e\xc9\x1f\xe4\x35\x2b\x22\xc3\x90\x9d\x2a\x25\x8a\x41\x8f\xaa\xb4\xd7\x87\x1e\x65\x2a\xed\x0d\x6a\x1d\xf7\x8c\x9f\x42\xef\xeb\x1e\x7c\xe5\x9f\x5b\x98\xef\x61\x33\x83\xea\x82\xe4\x5d\x1c\xea\x56\x90\xc7\xdc\xe8\xfb\x13\x1d\xdd\x6c\xc7\x1e\xe6\x27\x46\xc1\xbf\x3f\x85\x6f\xe0\xf8\x78\x49\xc7\xbf\x77\x17\x2b\x30\x15\x30\x36\xdf\xc0\xc3\x56\xfd\xce\x66\x3f\xa1\xaa\xa0\x26\x38\xf3\xa9\xac\x28\xbc\x72\x5b\x0d\x90\x31\x11\xb9\x87\xb8\x79\x8b\xd2\x3a\xb4\x15\x27\x9c\x2a\x39\x3a\x3c\x30\x55\x3f\x77\x90\x52\x1d\x65\x6e\x71\x71\xb3\x92\x83\x9b\xf6\x8a\x14\x76\xce\x7f\x11\xa1\x19\x75\x27\xc5\x9f\xe4\x47\x4d\x0a\x8d\xf6\xda\x16\xd5\xb7\x5d\xa2\xfa\xa7\x97\x54\x30\x14\x9c\xb6\x9b\x99\xa4\x30\x1c\xfe\x14\xbe\x41\x42\xcc\xf5\xed\x2d\xfa\xc3\x2b\x43\x45\xc7\x30\x61\xb7\x13\xf8\x87\xa1\xb9\x22\xfa\x9f\xf0\xad\x4b\x30\xc3\x5e\x5f\x7d\xd5\xbc\xca\xbd\x06\x55\xb7\xf6\xa3\xce\x06\xff\x87\x91\x6a\x60\x35\xc0\xd1\xd6\x8b\xfb\xb0\xdc\x03\xd5\xd4\xa1\x6d\x5f\x64\x1e\x5b\x40\xa2\xa7\x90\x22\x2e\xc6\x9f\xad\x2d\x0c\x3c\x4e\x09\xe8\x6d\xe1\xdd\x9e\x61\xf9\xb3\x53\x8f\xcf\xd7\x86\xf7\xde\xa0\xb1\x96\xad\x1e\x46\x2f\xab\xb1\xa1\xe1\xfb\x3a\xdb\x9e\xcd\xda\xad\x5d\x71\xbb\x35\x8a\x79\x79\x60\x23\xfd\x76\xd3\xd6\x92\xfa\x5e\xad\xe2\xa0\xd7\xc2\x23\xfd\xf8\x31\x8f\x5c\x37\xa4\xa9\x0f\xe2\x8b\x77\x3d\x73\xb5\x15\x68\xe0\x05\xd7\x2c\x57\xb0\x53\xb4\xde\x90\xde\xba\x1b\xb3\x4b\xf9\x2d\x4e\x47\xfd\x74\xdd\xd7\x69\xb7\xc5\xe1\xe1\x69\xf0\xc7\x4b\x3e\x8d\x42\x73\x88\x93\x1f\x38\x2e\x59\xa0\xef\x71\xf2\x51\x16\x3a\xf2\xee\x37\x41\xd0\x73\x6c\x69\xd9\x17\x8c\xaf\x62\x72\x08\x35\x57\x5f\xe0\x34\xb0\xd1\x42\x51\x3a\x7c\x14\x74\xbf\xf5\x26\x7e\x97\x0a\x76\xee\xe8\x43\x73\x57\x7f\xad\xea\x42\x3b\x97\xf0\xf7\xce\x34\xcb\xeb\x6b\x67\x4e\x5d\x5a\x04\xa1\x22\xed\xba\x2b\xdc\xc9\xbb\xdf\x65\xf1\x77\xbc\x71\xb6\xb5\xfa\xb0\x91\xa5\x2e\x11\x58\x1e\x4e\x11\xd2\x30\x41\x23\xfb\xec\x52\xd9\xa5\xe3\x90\xf9\xdc\x86\xbc\xc5\xc3\xda\x42\xf1\x62\x0b\x8f\x6e\x0b\x7e\xf9\x05\x85\xa5\xec\xd6\x2b\x4d\x13\xe8\x75\xe4\xbc\x0e\x75\xbe\xa4\xbe\xdb\xa5\xbe\x01\x48\x5f\x91\x01\xb7\x53\xdf\xdb\xe4\xb6\x7b\x4d\x6b\x1d\xc9\xcf\x24\xbb\x7d\x0e\xf9\xed\x9f\x38\xb7\x7d\x1a\xb7\x12\x1f\x16\x22\xef\x0b\x1e\x3f\x19\x80\xbb\x1f\xe8\xba\xcb\x51\xd0\x1d\x03\xf8\xfd\x9c\x0b\xb5\x82\xfc\xfe\x4f\x86\x6e\x8d\x02\x6e\x83\x00\x56\x23\xe1\xbb\xa5\x65\xf0\x5c\xb1\xf4\xfe\x70\xf4\x92\x5b\xf0\x4d\xab\xb7\x85\xba\x50\x36\x4a\xe2\x4e\x52\xbf\x93\x45\xbf\xe0\xef\x27\x60\x79\xae\x55\x97\xb6\x38\x50\x1e\x80\xed\xb3\x99\xd9\xaf\x0b\x91\xf6\x96\xb7\x9e\xcd\xa1\x31\x5c\xb2\x99\xc1\xe0\x06\x0e\x9b\x41\xab\xef\xeb\x9a\xb0\xbf\x12\x0c\x77\x82\xec\xc6\xe0\x28\x25\x7f\xe6\x64\x28\x86\xc6\xdb\xd7\x10\x3d\x16\x12\x47\xaa\x33\xfb\x5d\x55\x4f\xf3\x14\xe8\x92\xcd\x9c\xc8\xee\xf3\x1d\x90\x1d\xc4\xef\xbe\x41\xe0\xcf\x00\xb5\xf7\x2f\x86\xa7\x07\xcb\x9f\x89\xfe\xec\x84\xed\x2f\xd9\x6c\x60\x79\xba\x1b\xca\x37\xc8\x6a\x15\xc2\xbf\x0d\x22\xf8\x49\x30\x8b\x01\x5e\x20\xc0\x0e\x10\x60\x47\xe5\xd9\x11\x2c\xdc\x56\x35\x97\x60\xc5\xed\x30\x76\x97\x1a\xed\x86\xb0\xf7\xcd\x87\xbf\x0f\x94\xb1\x7c\x05\x96\xee\xb0\x92\x3b\xf3\xfc\xbc\x4d\x67\xad\x59\xec\x61\xe3\xe3\x4f\x6e\x3a\x01\x22\xdf\x08\xc8\x77\x40\xe2\xfe\x35\xbc\x70\x0f\xfc\x2f\x86\xba\xb7\x7c\x41\xf1\xf1\x00\xf6\xd3\x45\xd6\x0f\xfb\xa2\xdb\x5f\x13\x61\xef\x5b\x08\x4f\x0f\x5f\x3f\x33\x2d\xda\x09\x67\x3b\xde\x3e\x73\x1a\xbc\x54\xf9\x02\xb9\x5f\x20\xf7\x43\xe1\x86\x17\xc8\xfd\x02\xb9\x5f\x20\xf7\xfd\x42\xee\x5f\xa6\xe6\x35\xcb\x52\xbd\x00\xee\x8d\x80\xdb\xca\x6a\x2b\xcc\xfd\x78\x6f\x3c\x5b\x22\x1f\x11\x77\x8f\xcc\xd7\x86\xf5\xa1\xf3\x07\x6c\x6e\x61\xc8\x35\x0a\xb9\xf5\x57\x1d\x3c\xf7\x77\x9f\x1f\x54\x68\xcf\xf9\xd5\xe8\xfb\xd2\x95\x1d\xd2\x8f\xc7\x7b\x81\xfa\x2e\xf9\xc7\x7d\x09\x0e\xb6\x78\xbf\xfa\xef\x53\x7b\x96\x3a\x38\xb5\x87\xaa\x67\x33\x74\x66\x75\x42\xe1\xdf\x09\xef\x41\x70\x6b\xfd\xef\x53\x4d\xc6\xee\x6b\xf9\x3f\x91\x71\x35\x48\xe3\xbd\x50\xdf\x7e\x29\xb3\x99\xcf\x4d\x7f\x34\xd9\x92\x2d\x16\x3b\xe5\x37\x2f\x2f\x7e\xaf\xed\xf5\xb4\x5f\xfc\x76\x21\xdc\x28\x44\xdf\xad\xd9\xbe\xf0\x6f\x69\xa1\xd4\x8e\xef\x00\x6f\xf1\xdd\x35\xeb\xcd\xf2\x11\xc1\xf0\x8e\xe8\x76\x87\x77\x90\x5b\x8b\xc0\xc2\x17\xb4\xda\x6f\x24\x77\xb7\xdd\x5e\x87\x77\xb8\xa0\xd6\x6c\x8a\x0a\xd6\xab\xb4\x68\x6d\xd3\xee\x85\xac\x7b\xac\x7f\x1b\xfe\x82\x68\x72\x9b\x37\xe2\x6f\x63\x13\xd5\x84\x8f\x6b\x16\x77\xde\x3a\x71\x8b\x53\xb3\xb3\xff\x88\xf7\x48\x66\x03\xcb\xaf\xcf\x6f\x78\x87\xdf\x25\x79\xf7\x8c\x27\xd7\x7f\xfb\x64\xe3\x35\x87\x37\x37\x2c\xf5\x57\xa9\x30\xef\xc4\xfc\x4b\xd7\x3f\x80\xe2\x7e\x7f\xcb\xfe\x22\x14\x4b\x4b\x53\x65\x7e\x87\x2b\x2d\x95\x96\x79\xdd\x9e\x8c\x09\x17\x4a\xbb\x5f\x07\xdc\xf9\x0b\xe1\x91\x8e\xee\x7c\x2e\xf8\xcd\xa3\x3e\x8c\x6e\xec\xaf\xa1\xa5\x32\xb3\xbf\x77\x74\x5e\x4d\x15\xfb\xc3\x92\xfd\x64\x6f\x48\xd0\x23\xe6\x6e\x56\xda\xac\xfe\xa9\x86\x07\xfd\x82\xa2\xa7\x9d\x5b\xdc\x73\xde\x60\x15\xee\x39\xde\xd7\xdf\x11\xf1\xd7\xab\x3c\xba\x89\x3a\xe2\xd1\x3e\xd6\xfa\x41\xf4\xf8\xa9\x7c\x81\xe2\x4f\x9e\x49\xcf\x36\xba\xf8\x6d\x70\xd0\x6a\x17\x6d\xbc\x67\x97\xeb\x59\xfe\x32\x75\xff\xa3\xb1\x38\x8e\x62\x38\x49\xc0\xbd\x79\x6d\xe9\xfb\xaf\x53\x7d\x93\x5c\x98\x1f\x1b\x1b\x74\x4a\xa6\xf9\x95\xfb\xee\x27\x6c\xbb\x9b\x9a\xf4\xba\x92\xcf\xe2\x3f\x01\x00\x00\xff\xff\x66\x6e\x14\x49\x92\x79\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6d\x73\xdb\x36\xb6\xf0\x67\x7b\xc6\xff\xe1\xac\xe6\x19\x3f\x54\xaa\xd2\xed\xde\x99\xfd\xa0\xae\x77\x26\x89\x93\xdd\xdc\x6d\xda\xde\xa4\xdd\x9d\xb9\x99\x4c\x0b\x91\x90\x84\x35\x49\xa8\x04\x68\x5b\xeb\xd5\x7f\xbf\x73\x0e\x00\x02\xa4\xa8\x57\xcb\x71\xdc\x7a\x93\x6d\x24\x12\x38\x38\xef\x6f\x00\xa9\xb3\x33\xe0\x65\x29\x4b\x05\x71\x1c\x9f\x1c\x5f\xb1\x12\xa2\x93\x63\x00\x80\x57\x65\xf9\x9d\xd4\xaf\x65\x55\xa4\x70\x6e\x07\xc5\xdf\xf1\xeb\xa8\x57\xf2\x44\x96\x29\x14\x52\xc3\x18\x6f\xf7\xfa\xf5\x8c\x57\x37\x33\x51\xf2\xf4\xa5\x2c\x34\xbf\xd1\xad\x79\x89\xbd\x3a\x65\x0a\xb8\x19\x88\x53\xfb\x27\xc7\x27\xc7\x67\x67\xcf\xf6\xfe\x1f\xce\x86\xb7\xb2\x98\xc8\x8b\x17\xf0\x52\x16\x63\x31\x01\x56\xa4\xf0\x9e\xeb\x6a\x76\x57\xd0\x38\xdf\x01\xe5\xf9\x48\xa6\x82\x2b\xd0\x53\x0e\x29\xd3\x0c\x2a\xc5\x53\xd0\x12\x12\x59\x14\x3c\xd1\xf8\xb1\x52\xbc\xfc\xff\x0a\x72\xc4\xc7\x5d\x17\xb2\x88\x4f\x8e\xf5\x7c\xc6\x1d\x28\xa5\xcb\x2a\xd1\x70\x7b\x72\x7c\x74\xf1\x02\x99\x07\x00\x4a\x97\xa2\x98\xc0\x2f\x5a\xe6\xd9\xb0\x97\x8e\x7a\xf0\x2f\x25\x0b\xfa\xf4\xcb\xc9\xf1\xd1\xf3\x4a\x4f\x2f\x5e\x2c\x8d\x63\x95\x9e\xfa\xb1\xf6\x1b\x8e\xff\x49\xf1\xb2\x03\x2e\xe2\xe7\x46\xd3\x67\x1c\xfb\x03\x53\xea\x1a\x85\xda\x1c\x3b\xb3\x97\xdd\xf8\xfa\x3b\xce\xf9\x9b\x54\xba\x03\xfe\x54\x2a\xed\xc6\xd3\xe7\x5f\x4e\x8e\x17\x96\x8f\xaf\xf2\x99\x9e\x43\xc9\x75\x55\x16\x0a\x74\x59\xf1\xb3\x31\xcb\x14\x07\x31\x06\x96\x65\x8e\x39\x57\x2c\xab\xb8\x02\x56\x72\x60\x1a\x52\x3e\x66\x55\xa6\xcf\x38\x4e\x3e\x2b\x64\xf1\xa5\xe2\x9a\xc0\x29\xcd\x34\x8f\x4f\x8e\xc7\x55\x91\x40\x94\x4f\x12\x0b\xa0\x6f\x16\x8a\xfa\x30\x92\x32\x23\x26\x9b\x35\x21\x9f\x24\xb1\xe5\xe3\xf9\x39\xf4\x7a\x70\x7a\x7a\x72\x7c\x74\x84\x97\x3b\x2e\x11\x07\xdb\x17\x6b\x56\xb5\x6f\x10\x3f\xe8\xa2\x27\xf8\x1f\x2c\x13\x29\xd3\xbc\xa6\x99\x15\xc6\x24\x90\x62\xd4\xa2\x84\x10\x06\xa1\x40\x14\x57\x38\xb8\x93\x1c\x07\x26\xea\xdb\xd9\x48\x92\x18\x43\x0b\x49\xbc\xea\x28\x0d\x0d\xcf\x70\xc5\x8c\x14\x0a\x4a\xfe\x6b\xe5\x8c\xef\x68\xe1\x21\xb5\x28\xdb\x00\xad\x1e\xbd\x06\x62\x83\xd5\x1b\xe0\xd9\xb1\x6b\xa0\x79\x06\x6f\xa2\x94\x46\xae\x81\xb4\x2d\x4e\x2b\xf0\xb1\x13\x0a\x91\x59\x49\x37\x3c\x50\xca\xc7\xa2\x40\xf5\x05\x51\x68\x5e\x8e\x59\xc2\xe1\x7a\x2a\x92\x29\x3a\x3d\xa9\xe8\x4e\xce\xf5\x54\xa6\x30\x96\x25\x6a\x46\x29\xf8\x15\x5a\x10\x23\x38\xe4\x39\xe2\x0b\xa6\xd9\x88\x29\x4e\x9e\xcc\x5c\x7a\xcf\x95\x0a\x3c\x89\x5b\xcf\xaf\x82\x5c\x41\xf4\x85\x2a\x39\x4b\x49\xf9\xfb\x10\x3d\xcb\x03\x70\x03\x78\x96\x7b\x50\x03\x43\x74\xdf\x2b\xec\x77\xfc\xda\xc1\xad\x55\x16\x0a\x7e\x0d\xa2\x50\x9a\x15\x09\x07\x39\x06\xe6\x68\x75\xca\xea\x67\x45\xa8\xd0\xb5\xde\x3e\xb3\x57\xdf\xe4\x33\x63\x86\xf9\x04\x86\xe7\x70\x1a\x5c\xc6\xab\x47\x66\xfc\x10\xdd\xe5\x78\x60\x98\x7c\x72\x7c\x74\x76\x06\xcf\xd3\x14\xc6\xa2\x60\x99\xf8\x37\x2f\xd1\xbb\xf2\x42\x55\x25\x87\x24\x93\xf4\xaf\x1c\x43\xce\x94\xe6\x25\xa8\x9a\x39\x47\x65\x55\x68\x91\xf3\xf8\x3d\xd7\xaf\xdd\xdc\x28\x9f\x0c\x00\xfd\x44\xa4\x59\x39\xe1\xba\x81\x5a\x9f\x70\x3b\x32\x77\xe2\x3c\x8b\xbf\x95\xc9\x65\x84\x3a\x73\x94\xf2\x31\x2e\x5c\xdf\xf9\xa9\xc8\xea\x7b\x62\x5c\xdf\x30\x38\xfc\xe1\x1c\x0a\x61\x08\xf5\xd0\xe8\x56\xfc\x32\x93\x8a\x47\xfd\xe5\x3b\x40\x73\xf0\x3a\xea\xe7\x82\x82\xa1\xd3\xc8\x7c\xe2\x05\x13\xa0\x1b\x68\x58\x3b\xca\x40\xce\x0a\x36\x41\x8c\xa7\x4c\xc3\xa8\x12\x59\xaa\x48\xa9\x58\x96\xc9\x6b\x05\x95\x62\x13\x2b\xc2\x89\x20\x9d\x43\x96\x8b\x49\x55\x32\x9a\xae\x25\x4c\x78\xc1\x4b\x74\x59\x28\x75\x82\x4f\x00\x2c\x7f\x15\xe9\x63\xea\x94\xd3\x69\x85\x6a\x29\x25\x72\x35\x8c\x71\x46\xc0\x27\xc7\x47\x79\x86\x11\x03\xd4\xbc\x48\xe2\xb7\x95\xe6\x37\x78\x8d\x58\xd4\x50\xcc\x86\x42\xb6\x34\xd1\x62\xd2\x44\x64\x5c\xca\x9c\xc2\x72\x17\x59\x31\x52\x80\xff\x87\xe7\xe5\xa4\xca\x79\xa1\x87\xf4\x0d\x8c\xa1\x0c\xc9\x52\xea\x31\x5f\xc7\xf0\x66\x0c\xbf\x98\x7b\xbf\xa0\x03\xa0\x18\x35\x40\xf0\x05\xfe\x07\x02\x44\xf1\x76\x92\xc9\x82\xa7\xa0\xa4\xe1\xfa\x35\x87\x92\x7f\x59\x29\x4e\x63\xf9\x8d\x50\x5a\x14\x13\xcf\xc4\xd1\x9c\x52\x27\x54\x61\x51\x4c\x06\x38\x4f\xea\x29\x2f\x15\xa0\x5e\xe2\x3c\x39\x2e\x02\x99\x0e\x40\x14\xa0\xaa\x64\x0a\x09\x19\xb0\xd0\x90\x71\xad\x60\x2e\x2b\x90\x33\x2d\x72\xf1\x6f\x0e\xd7\xa5\xd0\x5c\x11\x30\x5d\xd2\x02\xb4\x20\x62\xe0\xf8\x55\x5b\x70\xa0\x2e\xe8\x80\x68\x71\x0b\xc0\x73\xea\x8f\x4b\x5c\xc0\x70\x6d\x99\x50\x83\x54\x90\xc8\x99\xe0\xa9\x75\x70\x49\xc9\x99\xe6\x4e\x50\x55\x21\x7e\xad\xfc\xfa\x66\xc8\x5c\x56\xb8\x06\xa8\xa9\xac\xb2\x94\x0c\x99\x03\x1b\xa3\x02\x54\x48\x9d\x9e\x0a\xe5\xe9\x9b\xb2\x22\xcd\x38\x64\x68\x31\x80\x98\x60\xee\xc5\x34\xe4\x6c\x8e\x1c\xd2\x4c\x20\xa7\xf2\x59\x26\x12\xa6\x79\x0a\xbf\x56\xbc\x14\x35\x19\x36\x90\xb6\x6c\x7d\x2f\x0f\x49\x46\x9d\x37\x7c\x83\x71\x0d\x79\xd3\x2b\x18\xb7\x85\x61\xc6\xd9\xb7\x50\xc0\x32\x71\x45\xda\x80\xc8\x16\x5a\x14\x15\x07\x4e\x2a\x55\x72\xc5\x35\x60\x3e\x8c\x09\x4b\x6c\x23\x54\x97\x3f\x11\x63\xc4\x04\xbd\xa7\xbb\x1d\xff\x20\x8a\x49\xd4\xff\x86\xae\x37\x5c\x4f\xde\xed\x5b\xc8\xb5\x28\xd4\x12\x0b\x69\xc2\xb5\x25\x33\xca\x63\xeb\xb3\x0d\x0a\x6d\x90\x3e\xe2\x0d\xcc\x7f\x78\x59\xd6\x20\x83\xe5\x14\x57\x74\x49\x8c\xad\x6d\x99\xe9\x89\x9c\xcd\x1b\xa8\xbf\x94\xb3\x39\x31\xf1\x28\x1d\xe1\x0d\x1c\x10\x5f\xbc\xa8\xd1\x88\x2f\x5e\xf4\x83\x75\xd3\xd1\x00\x15\x6d\x3e\xb0\xf4\x90\x73\x38\x22\xbb\x6b\x82\xc5\x2b\x04\xd7\x82\xc5\xef\x1d\x70\x43\xb0\x38\xc4\xc2\x75\x3e\xc7\xf3\x05\x98\xd6\x98\x77\x2a\x8c\x3d\x36\x52\xf3\xd0\xcf\x38\xe5\x46\x43\xb2\x97\x79\x61\xbd\x8f\x8b\x8e\x01\x9b\x6d\xba\xe7\xe2\x63\xb4\x52\xd5\x44\x31\x96\x48\x01\xde\xbf\x10\x2c\x7b\x53\x8c\x25\x5e\x3f\x7a\x9e\xa6\xa5\x1a\xa2\x0f\xfd\xf0\xd1\x24\xeb\xb7\x76\x35\x4c\x7a\x16\x18\x3d\x8f\x7e\x14\x39\x97\x95\x1e\x02\xfc\xe9\x2b\x78\x06\x36\x18\x26\xb2\x48\xe9\xb6\xd3\xf4\xa1\xc3\xd3\xa4\x5e\x74\x0f\x73\xc4\x82\xe5\xfe\x1e\x5e\xa0\x3b\x2e\xdf\xab\xef\xb8\x0b\x8d\x80\xfd\x92\x3c\x00\xb0\x96\xd5\xe7\x4c\x90\xb1\xa2\x6b\x98\x61\x4e\x2e\xc7\xa0\x64\x72\xc9\x75\xe0\xe8\x94\x31\x1e\x2d\x41\x56\x65\x90\x62\x34\x74\xd6\x31\xe4\x9f\x42\x4f\x91\x29\xd1\x29\xb2\x6a\x0b\xb5\x0d\x35\x56\x71\x85\xd9\xc1\x5b\x99\xf2\x08\x01\xbe\x95\x85\xd4\xb2\x10\xc9\x80\x8a\x92\x46\x10\xa6\xc5\x43\xf5\xb0\xa5\xe1\x1e\x7f\x70\x36\x5c\xbc\x80\x1f\xe7\x33\xae\xee\x0a\x0a\xe7\xc3\xed\x6d\xfc\x9e\xa2\x6c\xfc\xfd\xe8\x5f\x3c\xd1\xf1\x77\x2c\xe7\x8b\xc5\x6b\xc1\xb3\x54\xf9\x3c\xa1\x58\x99\x8a\xda\x44\xd4\x68\x37\xf2\x8a\x41\xce\x66\x94\x21\x64\x19\x2d\xc1\xb4\x2e\xc5\xa8\x22\xb7\xae\x94\x4c\x04\x39\xda\x6b\xa1\xa7\x64\x07\x66\x8d\xd4\x06\x7b\xcc\xc9\x18\x2e\x9c\x88\x94\xa7\x30\x9a\xd3\x98\xfa\x9e\xcb\x12\xd6\xa3\x1d\x20\x8b\x52\x34\xc4\x44\x7d\x88\x72\x36\xfb\x60\x74\xfe\x63\x3d\xe4\x76\xe1\xec\xc6\xdb\xef\x0a\xf0\x2f\x65\xa1\xaa\x9c\x97\xeb\xf8\xc2\x92\x84\xa3\xb9\xd7\x6c\xc0\x54\xc7\xde\xbb\x16\x59\x06\x23\xaa\xd9\x10\x4e\x4a\xec\x11\x85\x96\xa1\x43\x10\xf9\x2c\xe3\x98\x62\x88\x62\x72\x10\xa6\xd4\x58\x7b\x54\x6d\x46\x85\x48\xac\xe0\x89\xad\x13\x97\x0a\x51\x21\x8b\xcd\x5a\xe1\xeb\x13\x2d\xe1\xca\x55\xb0\x75\xc6\x88\xc5\x87\xc3\x39\x00\xeb\x57\x3f\x39\x3e\x6a\x17\xac\x1e\x11\x92\xe6\x2b\xbc\xe6\x11\x81\x31\x5e\x24\xa5\x03\xdb\x5c\x1a\x33\x91\x21\x07\x19\x94\x55\x86\x19\x11\x08\xad\x3c\x32\x9a\x4d\x06\x46\x07\x09\x9e\x4d\xae\xa8\xff\x82\xe3\x66\x4c\x4f\xe9\x36\x66\x4e\x5a\x96\x3c\x85\x54\x26\x94\xf9\xa1\x27\x89\x27\xd0\x13\x9a\xe7\x2a\xfe\x2a\x56\x97\x55\xcf\x51\x13\xe0\x16\xe4\xae\x74\xb5\x6e\x73\x9c\x1c\x1f\xbd\x43\x8c\xc2\x0b\x6f\xb9\xa2\xbc\xda\x5d\x70\xb4\x1a\x32\x5d\xf6\xaa\xa7\x8d\x15\x18\x29\x99\x99\x69\x71\x42\xc7\x0b\x79\xa5\x34\x6a\x19\xc3\x34\x8f\x29\x0d\xff\x05\xc9\x94\x95\x2c\xd1\x98\x22\x66\xb2\x98\xf4\x5c\x60\x89\xc6\x01\xc0\x3e\x76\xec\x64\x19\xf5\x2d\x5e\x61\xdb\x63\x1c\xd3\x38\xf8\x02\x7a\xd0\x83\x2f\x60\x1c\x5b\x94\x3d\xae\x5e\x92\x4d\xe1\x20\xd6\x3c\xa0\x83\x9b\xaa\x75\x49\x50\x38\x0e\x45\xa5\x50\x56\xf8\xc5\xc9\x8a\x64\xa3\xd9\x44\x39\x21\x92\xb0\xd5\x00\x32\x93\x18\x03\xbf\xe2\xe5\xbc\x86\x43\x77\x97\xd5\xab\x5b\x2a\x0a\x3e\x7c\xf4\x1c\x58\xc7\x78\x04\x5f\x95\x5c\x59\xcf\x66\x34\x4e\x81\xe2\x33\x86\xc5\x0e\x59\x65\xef\x1b\xf0\xac\xbd\x6a\x2f\xde\xc9\xdf\xdc\xb0\x51\x51\x64\x62\x97\x3c\x72\x41\x79\x00\x19\x2f\xa2\x2b\xc3\x77\xd5\xc7\xf8\x84\x6c\x13\x45\xca\x6f\x06\x56\xdf\x87\xe7\x50\xb2\x62\xc2\xc1\x0d\x23\x90\x35\xcc\x0f\x34\xf8\x23\x9c\x9b\xe1\xb1\x5d\xbe\x0e\x62\x56\xb6\x3d\xcb\x68\x4a\xe7\x99\xc8\x78\x3a\x24\x19\x1b\x3c\x54\xfc\xdf\x52\x14\x91\x83\x39\x20\x22\xbd\xab\x74\xe1\x64\xff\x58\xf6\xfc\x87\x37\xf7\x19\xc9\x1a\xfd\x14\xef\x4e\x8d\x43\x9e\x95\xf2\x4a\xa4\x1c\xd1\x78\xf9\xee\xa7\x0b\x90\x33\xac\x5c\x4d\x26\x71\x76\x06\x95\x42\xc5\xa4\x12\x16\xfd\x2f\xaa\x41\x55\xa4\xbc\xcc\x44\xc1\x21\x1d\x6d\xf0\xbb\x17\x2f\xac\xba\xdd\x62\x77\x3b\x91\x99\x95\x3b\x7e\x4b\x47\x2e\x3d\xc1\x6f\x39\xe6\x85\x89\x72\xff\xc6\x6f\xcd\x77\xbc\x65\xda\x15\xe9\x1b\x94\xa4\xad\x32\x01\x44\x41\x25\x0b\xd7\xbc\x7d\x3d\xe5\x37\x1c\x75\x1a\x73\x12\xba\x87\x97\xa7\x99\x29\x97\xdf\xfd\xd3\x16\xcc\x00\x53\x29\x2f\x15\x46\xa8\x0f\x7f\x93\xf2\xf2\xbd\x66\x13\xfe\xf1\xc3\x47\xfc\xec\x6d\x00\xbf\x05\xbc\x43\xad\x46\x43\x82\xb2\x2a\x50\xd9\x57\x53\x2d\x0b\x6f\xdd\x4c\xdb\x58\x85\xee\x4d\x68\xb8\x66\xb6\x9d\x90\x62\x24\x1b\xcb\x32\xa6\x75\x94\x81\xca\xc7\x12\x43\x9d\xa9\x25\x4d\x8d\x36\x25\x05\x47\x18\x16\x22\xde\xd3\xbc\x6e\x62\xe1\x6c\xd3\x97\x49\xf4\x0d\xd8\x1d\x82\xd8\xee\x1f\x0c\x80\x67\x3c\x87\x67\x1e\xd7\x1f\x58\x72\xc9\x26\x7c\xb1\x88\x57\xe0\x5f\xc7\x9b\x15\xcd\x83\xba\x08\x96\xe3\xd5\x2c\x70\x4e\x00\x2b\x45\x2f\xf8\x01\xe4\x6d\x11\x0f\x00\x72\xe9\x54\x61\x50\x0b\x30\x8e\xe3\x5a\x82\x7d\x78\xb6\x72\x1d\xd2\x2c\xb0\x18\xc2\xe9\xa6\x71\xf8\x27\x1d\x0d\x21\x97\x03\x7f\x21\x91\x19\x66\xe4\x59\x70\xc9\x22\x39\x84\x3c\xb8\x68\x71\x1b\x3a\x24\x83\x5b\xa4\x4d\x43\xe3\xbf\xba\x74\xaa\x6f\xc7\x2e\xbc\x72\x3d\x4f\x53\xbc\x03\x2c\x4d\x55\x98\xfd\x20\x28\x0c\xc2\xa8\x0e\x4c\x87\x77\x14\x2a\xe9\xc0\x56\xfa\x38\xc3\xa6\x18\x36\x5a\x18\x53\x23\xa1\x61\x14\x4c\x83\x81\x34\x93\xba\x3d\x38\x0d\x57\x50\x56\xff\xac\xc2\x09\x5d\x7b\xed\x3c\x1d\xad\xe1\x77\xdf\xe1\x1d\x19\x98\x35\xa1\x03\xb2\x28\x52\x65\x2a\xbf\x90\x2d\x79\x3a\x8a\xa7\xbe\xdc\xc7\x4b\xb6\xe2\x37\x37\xc2\x9a\x1f\x6f\x62\xe1\x8e\x77\x08\xbf\xf3\xba\x08\x71\x4c\x0e\xee\xad\xe1\x74\xcd\xe8\x00\x07\x04\xf8\x81\x10\xc6\x58\xc0\x66\x33\x5e\xa4\x51\xfb\x8e\xa1\xc0\x3b\x76\xfa\x8a\x56\xa9\x3a\x98\x6d\x45\x62\x2d\xb2\xcd\xef\xa6\xc0\xea\xa6\x17\x02\x6c\x19\xbf\x17\xcf\x00\xd3\xac\xd9\x0c\x05\x6d\xc5\x3e\x16\xa5\xa2\x7c\x0c\x23\xef\xd6\xf2\xc1\x45\xba\x3d\xc1\x92\xc4\xf6\x75\x0d\x4e\x28\xb6\x5c\x1c\x9e\x5b\x37\xff\x37\xbb\xb4\x5d\xca\xf8\x9e\xa5\xee\x8a\x13\xa7\xb5\x59\x2a\x2a\x97\x45\x96\xc5\xef\x42\xbd\x31\x0c\x1b\x9e\x2f\xc9\xb3\x39\xa5\xad\x51\xc8\xe1\x9f\xad\x6a\xd6\xb9\x82\x81\x15\x60\xe2\xe9\x70\xcc\xdb\x80\x7a\x17\xfa\x96\x84\x06\x25\x76\x4c\xa3\x33\x82\x4b\xf0\xd4\x4e\x37\x3b\x78\xb8\x93\xc5\x8a\x79\xad\x62\x65\xa0\x7a\x1d\x2e\x60\x27\x55\xe0\x69\xdb\x52\xfd\x96\x9e\xa5\xdd\x0b\xcf\x0d\xae\x2d\x38\x20\x02\x11\xdd\x42\x50\x0d\x03\x5f\x92\x87\x85\x85\x89\x5d\x5b\x90\x7d\xe4\xf3\x57\x9e\x4f\x26\xee\x53\x00\x68\xb4\x90\xec\xf6\x05\x66\xa0\x36\x79\x49\xeb\xa8\x51\x57\x92\x6a\xc6\x13\x31\x16\x09\xba\xf5\xac\xde\x3d\xde\x8e\x6b\xc1\xc2\xe1\x66\xa1\x65\x16\xa2\xdd\x48\x49\x96\x39\x45\xe2\xae\x19\x65\xf7\x3f\x70\x9e\x0b\x7d\x2f\x0d\x52\x36\x02\x46\xbd\x95\xc8\xd8\x95\x08\x97\x5e\xcd\x44\x31\xae\x19\x68\x09\xef\xe3\x5e\xdc\x57\x1b\x51\xc1\x0f\x67\x67\xf0\x66\x0c\xd7\x1c\xa6\x2c\xf5\x5b\xa9\x2e\x0c\x20\x38\xcc\x50\xc0\xa5\x57\xae\x5b\x7d\x29\x66\x03\x9c\x95\xb0\x42\x9b\xd6\xb8\x05\x86\x4e\x8b\x36\x9e\xe5\x4c\xc1\x88\x27\xcc\x74\xdf\x6d\x1e\x6d\x20\xc6\x35\xde\x7f\x58\x62\xdf\xe9\x29\xa9\x51\x3b\xa1\xdb\x86\x14\xb7\x73\x31\x70\x9d\x32\xdf\xe1\x4a\x47\x71\x3a\xa2\xbd\x51\xda\x78\xe8\x37\xfc\xd5\xb2\x3d\x87\xc2\x79\x95\x0b\x1d\xd5\x5f\x50\xfa\xe3\xa8\xf7\xda\x50\x83\xc7\x14\x4c\x77\xce\xf5\xe6\xea\x8a\xa4\xd7\x1f\xb8\x49\xd8\x57\x8b\x7a\x5e\xf3\x7a\x03\x42\x28\x91\x59\x7b\x0c\x31\xbf\x47\x68\xbb\xda\xa4\xdf\x5f\xa2\x7c\xd9\x43\x1a\x95\xb2\x38\xf8\x8d\x31\x73\xd3\x2f\x8c\x1e\xcd\x31\x29\x7e\x19\x39\x24\x5a\xce\x91\xd0\xf7\xde\x31\x50\xab\x6e\x1f\xe9\xe1\xc7\xaf\x02\x53\xa1\x39\x6b\x3d\xe6\x0e\x5c\xb6\x46\xee\xb8\xbc\x3f\x87\xcd\x4c\x4b\xe4\x96\xec\x5f\xc6\xba\xad\x9d\xe7\x81\x37\x5c\x25\xac\x86\xc0\xd6\x92\x4f\x3d\xd8\xde\xfb\x2a\x49\x38\xc7\xc8\x24\x0a\x43\x3f\x3a\x7c\x4f\xe3\xc1\x98\xd0\x6f\x29\xd3\x92\x49\x3a\xea\xfc\xed\x35\x68\xbf\x16\x85\x50\x53\x9e\x62\x3e\x89\x08\xef\x80\xa5\x45\xa4\x2b\x48\xbe\x94\x55\xa1\xdb\x3b\x07\xe8\x05\x30\x5d\xd2\x52\xb3\x0c\x8a\x2a\x1f\xf1\x12\xf3\x31\x9b\x84\xd5\xfb\x97\xe9\x68\x6b\x5f\x4f\xeb\x74\x65\x4b\x7d\x88\x44\xa1\x1b\xfb\x09\x77\xf1\xe3\xb4\x4e\xc3\x83\x0b\x65\x57\xb2\xe7\xbd\x10\x89\x46\xd8\xb5\xd6\xb6\x74\x22\xcc\xc1\xd8\xd1\xa2\x70\x63\xd4\x32\x2a\x31\xc8\x6c\x23\xa2\xed\x0c\xc6\xa1\x63\x65\xf4\xe5\xd7\x76\xab\xa0\xa1\x66\xde\x81\x78\x85\xb3\x41\x76\x95\xd3\xd8\x81\x3c\x36\x9b\x65\xf3\x3b\x98\xc8\x46\x57\xb0\x96\xb6\xad\x22\x91\xdd\x16\x09\x78\x71\x27\x8a\xef\x53\xa0\xdb\x92\xbd\x2e\x0c\xe1\x3e\x32\xed\x58\x8e\x94\x2c\xe2\xb7\xb7\x36\x37\x26\xe3\xad\xd9\xd3\x11\x9d\xe2\xd7\xa2\x48\x23\x9a\xdd\x37\x76\x13\xf5\xbf\xf9\xcc\xd8\x46\xd8\xf5\x06\xb4\x57\x3e\x3f\x2c\x4f\x57\x92\x62\xa2\xc4\x05\xc7\x28\x94\x5a\x12\x0e\x80\x7c\x8d\x99\xc5\xca\xcb\xc7\x7b\x63\xb3\x68\xcb\x1d\xe7\xf2\xaa\xd1\x95\x0a\xdc\xaf\x6d\x1b\xa2\xaf\xae\x53\xf4\x59\x35\xca\x44\xf2\xe6\x82\xce\x15\xc0\x3b\x9a\xa3\xea\x81\x42\x61\x07\x92\xfa\xf6\x53\x76\x85\x0d\x30\x33\x1e\x44\x8a\x3b\x16\x78\x6a\x82\xdf\xcc\x4a\xae\xf0\xb0\x28\x17\x74\xdc\x62\x34\x07\x46\xca\x05\xb2\xa4\xc3\x92\xa0\x99\x39\x23\x22\x8b\x60\x23\x79\x87\xf2\xd6\x96\xb1\x5b\x47\x0f\xc3\x97\xee\x62\xdb\xd1\x6b\x3b\x5f\x41\x21\x71\xa7\x82\xc0\x2c\x79\xa8\x48\xe2\x46\xec\x60\x3f\x29\x21\xb0\x4a\xff\x1c\xd5\x3d\xcf\x80\x7d\x54\x74\x85\x09\xb5\x0c\x68\xd9\x78\xc4\x38\xf4\xbb\x8f\x24\xc6\x6c\xa4\xea\xec\x0c\x7e\xf4\x76\x26\x14\xc8\x22\x9b\x43\x26\x59\xd8\x43\x32\xbd\x03\x73\xb6\x6c\x5a\xf7\x93\x59\x89\xa7\xcb\x69\xcf\x88\x04\x87\xe5\x82\x81\x8e\xc7\xd4\xa9\xff\xb3\x83\x7d\x98\x99\xb6\x81\x61\x99\x6c\x7b\x0c\x2f\xa8\x80\x34\xda\xd9\x87\xff\xfc\x27\xbc\xf7\x1c\xfb\x90\xf6\x56\x20\x29\x0b\x27\x90\x05\x1d\x85\x6f\x84\xce\xbf\x72\x8d\xe6\x15\x68\x93\x1f\xbd\x32\x1a\x74\x31\xb3\xc1\x50\xfc\x4b\xb4\x9f\x9b\xd3\xf7\x4b\x30\x03\xda\xcc\xf2\x21\x79\x03\x38\xdd\xd8\x1c\xda\x41\xab\x50\x4c\x21\x78\xea\xda\xa8\x07\xb2\xac\x35\xac\xdb\x3d\xe1\x39\x74\xe9\xfd\x59\xbb\x9e\x9d\x72\x21\x0f\x0f\x5d\xbd\x09\x86\xf1\xdf\xf9\xdc\xb8\xf9\xde\xb0\xa6\xc0\x6f\x1b\x98\x0f\xb7\xb7\xd8\xc4\x88\xbf\xaf\xf4\x48\xde\x80\xb3\x48\x7e\xc5\x0b\xdd\x90\x00\xb5\xd4\x5e\xe1\xe5\xc8\x8b\x8a\xbe\xdb\x04\xc2\x33\x69\x80\x39\xe8\x26\x29\x75\x90\x6d\xd1\xe1\x45\x4a\x78\x34\x00\xac\xc8\xec\xde\x51\xd2\x60\x73\xbb\x55\xe6\x73\x7b\xfb\x65\x17\x8d\x4e\x57\x14\xd7\x3a\x5b\x22\xcd\x73\x20\x90\x15\x42\x72\xe8\x1d\x5c\xdd\x76\xcf\xb4\x5a\x37\xb7\xd0\xd7\x0d\xba\x68\x99\x7d\x6e\x4e\x74\x85\x0f\x1b\x05\xec\x0c\xa4\x17\x8c\xf0\xf7\x17\x6b\x85\xfc\x30\x39\xe9\x16\x9c\xea\xaf\xb7\x88\x8d\x9a\xe2\x75\xbe\x4b\x87\x97\x43\xd3\xaa\xc0\x10\xc4\xb6\x7b\x88\x0b\x01\xf4\x47\x11\x16\x3a\x5b\x39\xf6\x38\x63\x50\x3c\xb0\x34\x0d\x2b\x87\xba\x6b\xdf\x5d\x39\x84\xfb\xcd\x7a\xca\x5b\x47\x1d\x36\x26\x2d\x0f\x58\x70\xdc\xa9\xb8\x30\xc7\x40\xbb\x8b\x8b\x5d\x13\xb7\x43\x55\x1f\x06\xa7\x5e\xdb\xf8\x6c\x00\x33\x77\xbd\xbf\x45\x9b\xc9\x78\x1e\xdf\xde\xb6\x46\x2c\x16\xf1\x1b\xf5\xbf\xbc\x94\x51\xb3\x4e\x59\x31\x18\xce\xcd\xf9\xdb\xef\xe4\xb5\xdb\x65\x5a\x2c\xd9\xee\x32\x3a\x3f\xcd\xd2\x06\x3a\x2d\xf0\xf6\x76\x27\xf8\x2e\x9f\xf0\x69\xcb\x2c\xbb\xcd\xb0\xc2\xa5\x06\x56\xdf\xa2\xea\xef\x7c\xbe\x58\xdc\x83\x07\x68\x5b\x7f\xc8\x99\x4e\xc7\x68\x52\x5a\x23\xc3\x4d\x9e\x71\xaf\x6c\xd9\x1e\x93\xde\xe8\x16\x1f\x92\x41\xee\x40\xf5\xf0\x1c\xad\xd5\x9f\x86\xb3\x16\x15\x75\x72\xe5\xe8\x68\x07\x7e\xb8\x23\x82\x3e\xf8\x9a\xf3\x6f\x07\xa2\xae\x3e\xff\x1d\x1e\xfd\x16\x63\x77\x32\x51\x96\x03\x30\x3b\xeb\xfe\xe8\xaa\xa5\x2a\x8e\x3c\xb9\xfd\x6f\x70\x54\xeb\x19\x8c\x1a\x84\xe3\x0b\x5f\x2e\xd2\x8f\x0e\xc0\x8b\x43\x32\xa3\xc9\x0d\xff\x48\xc8\x6f\xb7\xf7\xf0\x20\x85\x5e\x73\x8f\xf5\x73\x35\xee\x8d\x55\x9f\x09\x47\x11\x4c\x99\x7a\x8d\x21\xdf\x46\x55\xe8\x99\xa3\xaa\x3d\x80\x7e\x1d\x9c\xf0\xaf\x3b\xd5\x6b\x19\x4c\xb4\xb9\x93\xfb\x7e\xd4\x4a\x06\x77\x32\xb9\x79\x3b\x38\xda\xd6\xc1\x76\x6c\x8d\xd7\x4f\x0a\x60\x5f\x69\x45\x22\x10\xda\x97\x83\xda\x86\xbe\x8e\xf5\x9b\x66\x21\xe1\x56\xb6\x5b\x0c\xee\x90\x5c\x6b\x52\xc0\xbc\x2e\x61\x36\x04\xda\x5d\x4e\xec\x5a\x64\xdb\xd4\xa5\x5b\x3f\x5d\x34\xdc\x52\xa4\x6b\xd1\xf5\x6a\xf3\x41\x12\xca\x6f\x59\x79\xc9\x4b\x3c\xe5\x46\xf8\xc6\x6f\x2e\x56\x64\x34\x9b\xab\xf5\x37\x85\xe2\xa5\x8e\x4c\xcb\x22\x1a\xdb\x53\xd8\xab\x7c\xd9\x86\xd2\x7d\xab\xa2\xac\x59\xbe\xaf\x2a\xe1\x3b\xf5\x7c\xb5\x56\x5b\x67\xb2\x51\x97\xb7\xd1\xdc\x35\x7a\xba\x59\x2b\xfb\x3b\xca\x75\x25\x8d\x66\x27\xc8\xe6\x3f\x07\xc2\xdf\x22\x77\x7b\x8b\x0f\x55\x86\xdc\xae\x5b\x57\xb7\xb7\xf1\x85\x7d\x00\xc4\xf2\x2e\xb2\x6a\xfc\x68\x2d\x87\x68\xbb\x37\xc3\x59\xdf\xe6\xfa\x1d\xda\xcb\xda\x26\xcf\xa3\xb0\x98\x26\x05\x16\xbd\xdb\x5b\x5e\xa4\x8b\xc5\x7a\x3b\xb8\x63\x43\xaa\xb3\xc4\xa2\xee\xd0\xbd\x54\x58\x01\xe4\xcd\x7d\xa7\x2e\x4b\xdd\x87\xb9\x1b\x34\xa0\x2d\xfd\x9a\x3d\x5d\x4d\xa7\xbf\x72\xfd\x3c\xcb\xea\xc7\x8d\xf1\xf9\xf1\xcc\x0a\x5e\x35\x76\xaa\x59\xe1\x8f\xe2\x32\x50\x99\x68\x3f\xd7\xb0\xb1\xb7\xe2\x1e\xe6\x7b\x94\x4d\x26\xc3\xa7\xee\x26\x93\x2c\x53\x5e\xd6\x0f\x6e\xd0\xb7\x17\xf3\xfa\xfb\x0c\xcf\x92\xd3\x11\xa9\x92\xab\x99\x2c\x14\xff\x81\x97\x3f\xd8\x8b\x7d\x80\xe8\xc3\xc7\x1d\x98\x38\x00\x38\xe4\x71\x2b\x43\x96\xe9\x53\x1d\xa9\x6b\xa1\x93\xa9\x45\x5c\xc5\x3f\xca\x6f\xe5\x35\x2f\x23\x22\xc8\x2c\x85\x2f\x85\x80\x5e\xaa\x92\xde\x00\x7a\x29\x57\x49\x6f\xe8\xf5\xce\x11\x7e\x0e\xbd\x2f\xf1\xa1\x30\xfb\xbd\x55\xfa\x7d\xda\xee\x50\xfd\x18\xfd\x1d\x7c\xd9\x76\xe6\x46\xcf\x7d\x77\x9f\x54\xf9\x2d\x14\xbc\x2b\xc8\xc3\x36\x05\x29\xf8\x9f\xf1\x58\xf5\xe9\xe9\x92\x8e\xff\xd9\x1e\xb7\xc6\xfe\x08\x4a\x20\x78\xb8\xde\xec\x5a\x3f\xcf\xb2\x17\xf3\xef\x51\x55\x50\x13\xac\xf9\xd4\x56\x14\x76\x56\x6a\x00\x78\xa6\xdb\x7e\xe9\x0f\x1a\x0d\x17\xe3\xd0\x56\x9c\x7b\xc4\x37\x75\x1c\xd1\xad\x77\x1d\xa8\xd4\x07\x1c\xb7\x78\xbc\xff\xcb\xaf\x9b\xcb\xe2\x11\x01\x02\xfc\x4f\x56\x50\x4e\x46\xd2\xf8\x51\xbe\xd7\xac\xd4\x68\xaf\x6d\x56\x7d\xdd\xc5\xaa\xbf\x38\x4e\x05\xa0\xe0\xbc\x3d\x0c\x07\x34\xc0\xd3\xb3\x00\x47\x0b\x7a\xc9\xc7\x16\xf3\xe1\x19\xcc\xba\xc1\x84\xd3\xce\xe0\x8f\x84\x73\x8d\xf4\x5f\xe0\x6b\xdb\x67\x0a\x67\x7d\xf1\x45\xf3\x85\x1f\x4b\x6a\x1b\x68\x74\x73\x27\xec\xc5\xf0\x7f\x2a\x5e\xce\x87\xc6\x01\x59\xdc\x7a\xfd\x01\x2c\xcf\x40\x35\xb5\xd5\xb6\xbb\x44\x5f\x03\x73\xc1\xbf\x3d\x85\x18\x89\x62\xf2\x33\x61\xd8\x1b\xda\xeb\x21\xbe\xad\x7a\xb7\x47\x24\xff\x6c\xd5\xe3\xe7\x6b\xa2\xbd\x37\x6c\xc8\xb2\x35\x83\xf4\xb2\x86\x5d\xff\xa1\xcb\x6d\xe8\x56\x87\xdb\xa3\xed\xe5\xf6\x68\x64\xf3\x32\x60\x12\x56\x7b\x68\x4b\xa4\x6e\x56\xeb\x72\x30\x6b\xe1\xda\x03\x75\x11\xe2\xd3\x2a\xdb\x93\xe9\x6a\x57\x1d\xf8\x20\xa6\x4d\x98\xed\x82\x0f\xe3\x8b\x77\x3a\x7d\x50\xcf\x42\x03\xc7\xe7\x4c\x73\x7c\x60\x76\x87\x68\x1d\x26\xba\xb2\xec\xea\x70\xd9\x57\x2b\xf4\xa0\x0f\xf1\x05\x4f\x64\xca\x4b\x9a\xdb\x28\x4c\x70\xf9\xd4\x2d\xdf\xfd\x12\x06\x3f\x78\x7d\xc9\x15\x9e\x19\x7d\x7f\x29\x66\x51\x68\x1e\xfd\xf8\x5b\x91\x0b\x1d\x05\xfa\xdf\x8f\xdf\xcb\x52\x47\x56\x67\xfb\xf1\xf3\x2c\x8b\x4e\x0d\x2e\x2b\x83\xd7\xbe\x31\x3a\x4c\x3d\x57\x3f\x32\x4b\x69\xa4\x49\x4d\xd3\xd1\x01\x8a\x93\xdd\x34\x6c\xa7\xe3\x04\x5d\x2a\xd9\x79\xb6\xc0\xaa\xe8\xba\x79\xb5\x2a\x37\xd4\x39\x7c\x3a\x45\xf3\xdc\x3f\x9c\x62\xd5\xa5\x85\xd0\xfe\x67\xd9\x5a\xb4\x53\x9b\x03\xb5\xba\xad\xb4\xb7\xb7\x8d\x2b\x8b\x45\x84\x88\xb8\xaa\x0b\x9b\x6b\x18\xa6\x16\x0b\xc4\x22\x76\xef\x15\xc1\x21\xfd\xba\x9a\x5b\xa7\x56\x1b\x39\xd3\xc5\x49\x04\xaf\xfc\xe3\xac\xc6\x90\x6d\x3b\xe7\xe4\xb8\x35\xcb\xa3\x78\x72\xbc\x4c\xf4\xbd\x99\x54\xf9\x64\x52\x0f\x6e\x52\x9d\x3d\x85\xfa\xb9\x35\x6f\x5b\x56\xa1\x6e\xbb\x14\xa4\xa3\xf1\xf0\xad\x64\xe9\x00\x4e\xcd\x2c\xfb\xa2\x8d\x83\x9f\x7d\xc1\x45\x56\x74\x20\xb6\x91\xdf\x16\x22\xda\x86\x7b\x35\xd7\xec\x60\x67\x6a\xcd\xac\xbb\xa3\x01\x61\x4b\x80\xa7\x3e\xc4\x76\x7d\x88\xa0\x62\x5a\xd1\x8e\x68\xf7\x21\xf6\x69\x34\x1c\xb4\xc7\x60\x51\x7e\x24\xad\x86\x93\xe3\x7d\x8c\xf1\xd3\x36\x1b\x6a\x03\x0c\x69\xfe\x6d\x34\x1a\x96\x49\xfb\xed\xd7\x2b\x87\xaa\x55\x3e\xdb\x6a\xe3\x30\x75\x44\xf3\x76\xd8\x27\x58\x63\x90\xfb\xa5\x41\xab\x97\x5a\xa7\x00\x9b\x66\xb5\x52\xa5\x4d\xc3\xbb\x34\xa8\xb5\x46\xa0\x50\x77\xca\xa5\xf6\xc9\xa3\x6a\xad\x6d\x68\xae\x53\xa3\xfd\x6b\xe6\xdf\x7b\x61\xd3\xe0\xeb\x9d\x8a\x1a\xff\x65\xab\x54\xf6\xf0\xe9\xec\xe7\x9b\xd2\xee\xc2\xf4\x06\x27\x5b\xe9\x6d\xfd\xc6\x8d\xae\xe2\x11\xed\xe0\x4e\x66\x70\x27\x17\xfb\x54\x56\xee\x55\x56\x1e\xd6\x15\x76\x18\xde\xde\x76\xf7\x3b\x37\x3b\x34\xbb\x2e\xab\xb3\x35\x7b\x50\x55\xbe\x98\xd3\x2e\x41\xad\xb4\xca\xbf\x69\x30\xac\x27\x3b\x9e\xa3\xa0\x13\x5c\x70\xc9\xe7\x54\x6c\x52\xdd\x47\xa5\xaa\x2b\x3b\x71\xf0\x0e\x96\xfc\xd8\xeb\x4d\xcb\xc8\xee\x62\xf3\x92\xfb\x9d\x6e\xe2\x54\x78\xc0\x19\x8b\xce\x1d\x18\x75\xd0\x92\x13\xb1\xce\x78\xfa\x80\x0f\x80\x6f\x28\x07\x2f\xf9\xdc\xb2\xec\x80\x66\xb6\x64\x2b\x3b\xb0\xff\x76\xd1\x55\x84\x3c\xca\x9a\xf2\xf0\x6c\xf8\xfc\xea\xcf\x47\xa2\x3f\x3b\x15\xb1\x97\x7c\x3e\x34\x34\x1d\xb6\x9c\xc5\x88\x01\xab\x4a\x59\x3f\x74\xfb\x4c\xeb\xfb\x82\x47\xa7\x08\x75\x6d\x08\xde\xc7\x59\xfc\x96\x53\xab\x1d\x95\x69\xc7\x24\x6c\x5f\x55\x6d\xa8\xeb\xfe\xc5\xe4\xc9\x71\x8b\x35\x0f\x55\x4a\x1e\x9a\x1d\xdb\x64\xa5\x3c\x3b\xa0\x29\x7c\xba\x4c\xf4\xd0\xac\xb2\xf0\x90\x1b\x2b\xaa\xc2\x0e\xbf\xb4\xc3\xea\xdd\x62\x79\xdc\xce\x6a\xad\x23\x3a\x90\x9c\x7f\xc3\xce\x6a\x1b\xeb\x14\xfa\xc9\x3a\xbd\x75\x0a\xbd\x64\x9d\xcb\xd5\xe3\x0e\x65\xa3\x3d\xe0\xdd\xd8\x99\xfc\x9d\x95\x88\xdd\xb5\xa1\x3b\xfa\x6e\x0b\xc4\x87\xac\x06\x3f\xdf\x32\x30\x7c\x40\xc0\x7d\xbc\x07\x7b\xba\xa3\x2d\xd5\x76\xf4\x28\xcb\xc1\x43\x33\xe1\xf3\x2b\x06\x1f\x99\x16\xed\x54\x14\x6e\x78\xf3\xd3\x53\xa5\xf8\x54\x29\x3e\x55\x8a\x4f\x95\xe2\x53\xa5\xf8\x54\x29\x3e\x55\x8a\x4f\x95\xe2\x27\xad\x14\xcd\xbb\xb1\xa0\x52\x4f\x75\xe2\xc6\x3a\xd1\xf0\x6a\xab\x52\xf1\xe1\x5e\xdc\x66\x90\xf4\xe5\xa2\x8d\xcb\xcd\x57\xa1\xd5\x59\xe3\xa3\x7a\x53\xda\x98\x7e\x80\x62\xe0\x98\x8f\xa7\x03\x51\x25\x70\x9b\x73\x2f\xbb\x34\x32\xfb\x59\xa4\x6b\x6a\x8e\x0d\xb6\x6b\xed\xac\x36\xc4\x90\x39\x9d\xbe\xca\xbc\x2c\xcd\x08\x69\x53\x32\xb1\xd7\xcb\xd2\xac\x41\xef\xef\xad\xee\x8b\x2b\x0f\xf1\x86\xb4\x4f\x4a\x7d\xfd\x88\xed\xe3\x7c\x83\xda\x27\xe6\xd5\xe3\x7d\xc1\xda\x1d\xc8\x76\xd8\x76\x1a\xc8\xa3\x78\x0d\xdb\x2a\x82\x1f\x92\x71\xf7\xdc\x90\x79\x7a\xad\xdb\xef\xef\xb5\x6e\x36\x11\xf2\x7a\xea\x62\xf5\x96\x62\x7c\xb0\x57\xb9\xd9\x1c\x95\x2a\x4c\xfb\xd3\xd9\xea\xf1\xbd\x99\xaa\x22\x2a\x36\xab\xef\x0e\xe5\xf5\x36\xce\x67\x1b\x27\xb6\xc1\x41\xed\x5b\x82\xef\x58\x53\xaf\x56\xac\x0d\x0e\x64\xfd\x7b\xb2\x4e\x8e\xef\xe6\x0a\xd6\xca\x63\xdd\x50\x8c\x7a\xbd\x5a\x5f\xd7\x42\xed\x16\xa4\x9f\xb1\xfe\xfd\x76\x17\x4c\xb3\xf5\xef\xb8\x5b\x61\x14\x9f\xa9\x1f\xa9\x89\xda\xe8\x4a\x96\x8d\x72\x17\x4f\x52\xaf\xf3\x7b\x71\x26\xdb\xf8\x82\xb5\x0e\xc7\xaa\xb4\x67\xdc\x60\x1b\x3d\x7e\x0c\xce\xa6\xe3\x55\x78\x2b\xc5\x61\xfc\x8c\x2d\x4a\xef\xc0\xea\x6d\xb8\xd5\x14\x47\xbb\x03\xd2\xd2\xc9\x7b\x7b\x4d\xdf\xbd\xd4\xf6\x01\xe4\x3b\x34\x22\x0f\xa0\x72\x6d\x95\xa8\x79\xd2\xf5\x6e\xbe\x57\x37\x3c\x71\x4f\x25\x60\x7b\x11\xdb\x6c\x68\x51\x70\x3d\x15\xc9\x14\xf7\xff\xe4\xb5\x69\x21\xf2\x1b\x9e\x54\x74\x4b\x8e\x81\x41\x52\x29\x2d\x73\x3f\x9e\x4d\x18\xfe\x36\x04\x0d\xf5\x84\x6e\xdd\xb8\x43\x3c\xba\xdb\x76\x42\x95\x9c\xa5\xf4\x33\xd9\x03\x18\xdf\xd0\x8a\x51\x22\x33\x78\x86\xa1\xdb\x76\xdd\xb0\x2a\x77\x47\x39\x0e\xd3\xa4\x43\x84\x1e\xf0\x44\x87\xe1\x36\x07\x39\xe3\x25\x35\x1d\xf6\xd2\xa6\x7d\x35\xe5\xf3\xae\xee\xef\xb9\x72\x37\x0a\xd7\x6f\x70\xe2\x3e\x4b\xf7\xfb\x14\xe4\xda\x9a\xdb\x4b\x79\x7c\x13\x75\xe4\x17\x87\x90\xf5\x27\xd1\xe3\xad\x03\xec\xba\xe0\xba\xd8\x82\x9d\x2b\x09\x37\x31\xf4\x7b\x47\xa4\x23\x1b\x73\x16\xd8\x82\xdc\x5a\x24\x5d\x2e\xba\x60\x39\x57\x33\x96\xf0\x9a\x2a\xa1\xac\x9b\x4d\xdd\xef\xf0\x58\xa7\x67\xa6\xf3\x14\x1f\xe0\x42\xbe\xbe\x95\xe6\x37\x09\x19\xe4\x42\xa9\xe6\x4f\x58\xc7\x27\xc7\x89\x44\x8f\xbd\x0c\xff\x1c\xfe\xf8\x27\xbb\xf8\xfb\x64\xca\x73\x66\x39\x62\x96\xad\x3b\x9d\xb8\xb6\x87\xa7\x40\x69\x89\x0f\x69\xed\xb2\xa5\x62\x53\x3b\x35\xa0\xc5\x18\xfc\x3f\x7c\xd8\xcc\xae\x99\xda\xe4\x1f\x46\x95\xc8\xb4\xdf\xf1\x32\x15\x88\x23\x5c\x91\xfb\xa6\x83\x91\x7a\xca\x45\x09\xca\xcc\xd6\x6c\xa2\x5c\xf4\x31\x00\xa3\xbe\x6d\x2e\xc1\x6d\x83\xd9\xae\xe3\x14\xac\xdd\x1b\x12\x11\xf4\x79\x11\xee\xc5\x3d\xc7\xf7\x53\x58\xfc\x14\xd7\x6d\x86\x84\xfc\xb7\xa3\xfc\x2e\x57\x93\xfd\x03\xe3\x16\xec\xce\x1d\x91\xef\x6f\xa2\xdb\x11\x1a\x52\xc9\x15\xfe\xbe\x3f\xf0\x1b\xa1\x34\xcc\xb9\x8e\xe9\xc7\x27\x33\x7e\xc5\x33\xb3\xbe\x09\xd3\xd7\xf8\xa4\xb4\x02\x56\xd6\xc8\xe0\x1b\x22\x65\x81\xea\x41\xa0\x7b\x72\x3c\xc6\x97\x9c\xe0\x29\xc9\x44\xf7\xf0\x04\x53\x2f\xc7\x33\x1f\x4c\xf3\xde\xc0\x71\x0f\x98\x8b\xfd\x9c\x1e\x03\x14\x05\x41\x73\xe0\xc7\x4c\x64\x70\x2d\xf4\x14\xac\x41\x82\x2c\x09\x3a\xae\x6b\x7f\x06\x73\x32\xe1\xa9\x1d\x73\xcd\xca\xa2\xe7\x44\x10\x30\xae\x3b\xcc\xa7\x23\x78\x2b\x8b\x89\xbc\x78\x31\x40\x25\xad\x37\xe9\x2c\xad\xf6\x9b\x45\xd0\x1d\xf6\xac\x43\xfd\xb6\x31\xda\x8a\x7c\x45\x8c\x5e\x6c\x8e\x13\xbb\x74\x77\x57\xba\x91\xb5\x5e\x39\x91\x79\x8e\xf2\x70\xbd\xd0\x8b\x00\xe2\x2d\x5a\xcd\x10\x7a\xd6\xb6\x7b\x03\xf8\x07\xcb\x2a\x3e\x44\x8e\x2d\x06\xcb\xe3\x6a\xcd\xf4\x23\x9d\x2d\xac\x19\x2e\x64\xf1\x2d\x72\xdd\x4f\x22\x21\xac\x9f\xf1\xdc\xf9\x34\x3b\xc5\x48\x6a\x31\x68\x91\x8e\xe7\x4c\x4a\xae\xaa\x4c\x5b\x63\xb4\x9b\x9c\xe8\xbe\x6b\xb6\xc7\xef\xaa\x22\xb2\x7c\xc0\xb7\x5a\xd1\x78\xcf\xed\x5f\x79\x59\x6f\xd4\x60\x44\x88\x28\x13\xa4\x77\x7d\x52\x70\x30\xbf\x70\x73\x7a\x4a\x03\xe3\x97\x32\xe5\x18\x1a\x96\xdd\x5c\xc0\x58\xbb\xd8\x87\xaf\x3e\x52\x06\x08\xe7\xd0\x23\xf3\xe4\x3d\x3f\x66\x7b\x1c\x6b\x6a\x43\x0d\xa0\x8b\xe4\x8e\xba\xb4\xb4\x6d\x10\x7d\x4a\x78\x1d\x8a\x8a\xa3\xd3\x0e\xf1\xc5\x37\x17\xfd\xf9\xcb\x44\xdf\xc4\x17\xb2\xe0\x51\x3f\x78\x71\x51\xb0\x30\xbe\x5c\xd3\xdf\x48\xf9\x98\x55\x99\xee\x1e\x4a\xbf\x0f\x5a\xa3\x4f\xc8\x86\x0d\x98\xf7\xb2\x2a\x13\x7b\xe6\xa7\xb9\x37\xf8\x5e\x56\x65\xc2\x17\x8b\xff\x1b\x00\x87\xf1\xe1\x3a\x7a\xa5\x00\x00"),
           path: "mongo-api.tml",
           root: "mongo-api.tml",
         },
       
+        "mongo-consume.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5b\x8f\xd3\x38\x14\x7e\x6e\x7e\xc5\xa1\x2b\x50\x23\x85\x70\x99\x51\xb5\x3b\xcc\x20\xc1\x0e\x08\x1e\x66\x17\x31\xb0\x2f\x08\x81\x9b\x38\xad\x21\xb5\x2b\x5f\xd2\xa9\x42\xfe\xfb\xea\xd8\x4e\x27\x6d\x92\x36\xb3\xda\x79\x6b\x72\x6e\x3e\xdf\xf7\xf9\xd4\x71\x59\x02\xcb\x20\xfe\x20\x69\xc6\x6e\xa0\xaa\xfc\xf3\xb5\x30\x32\xa1\xf6\xd9\xff\xae\xaa\xa0\x2c\x81\xf2\x14\xaa\xea\xc9\x13\x28\x4b\x1f\x53\x55\xd7\x5a\x32\x3e\x07\x49\xb5\x91\x5c\x81\x5e\x50\x98\xb3\x02\x5f\x15\x24\x37\x14\x88\x02\x02\xca\x3a\xc5\x41\x66\x78\xd2\x0e\x9e\x38\x4f\xc6\x35\x95\x19\x49\x68\x59\x85\x30\x71\x21\x11\x50\x29\x85\x0c\xa1\x0c\x46\x2c\x83\x22\x02\xf1\x13\xce\x2e\xc0\x46\xc4\xde\x29\x7c\x81\x6f\xcb\x60\x34\x72\xab\x40\x37\xce\xf2\x60\x54\x05\x41\xfd\x6a\x3c\x8e\x20\x5b\xea\xf8\x0d\xa6\xcb\x26\x63\x7a\xb3\xa2\x89\xa6\xa9\x5f\x5a\x04\x99\x30\x3c\x85\x87\x9f\xc6\x91\x4b\x1e\x06\x55\x10\xec\xb6\xfa\x5a\x88\xfc\x48\xa3\x33\x21\xf2\x76\x9b\x18\xd8\xd9\x24\xba\x1f\x69\x11\x5d\x86\x34\x98\x91\x5c\xd1\x9e\x1e\x31\xc7\xa0\x0e\xdf\x73\x3d\x3d\xed\x6a\x91\x9b\xe5\x8c\x4a\xdb\x23\xc7\x1e\xa6\xa7\x11\x64\x84\xe5\x68\x63\x19\x30\x0d\xa9\xa0\x0a\xb8\xd0\x90\x31\x8d\x1e\x02\xc3\x31\xfd\x6e\x06\x91\xc1\x8c\x69\x15\xc3\xdb\x5c\x10\x8d\xe1\x2b\xc1\xb8\xf6\x66\x15\x61\x89\x94\x26\x22\xa5\x29\x64\x52\x2c\xe1\x87\x12\x3c\x82\xa5\x51\x1a\x66\x4e\x23\x73\x2a\x55\x1b\x63\xbb\xf4\x36\xc8\x91\x2d\x07\x86\x71\x1d\xc2\xc4\xaf\xfc\x16\xf0\x82\x48\xf0\x0d\x05\xc1\x48\xad\x99\x4e\x16\x50\x34\xe0\xd7\x9b\x15\xb5\xea\x4b\x88\xb2\xe5\xcf\x82\xd1\x88\xc3\x05\xfe\x9c\x9e\x4e\x8a\xf0\xd6\xf2\x7b\xbf\xe9\xd9\xb4\xdf\x76\xf2\xbc\xdf\x36\x3d\xad\x6d\x85\x7f\x69\x0e\x14\x32\x87\x2a\x99\x43\xa5\x8c\x6f\x8c\x65\x16\x29\x9b\x14\x5e\xc2\x92\xe8\x45\x7c\x45\x6e\x2c\xb6\x08\xc2\x56\x80\x4f\x77\x95\xf6\x30\x05\x51\x50\x99\xe5\x62\xad\xdc\xaa\x50\x64\x61\x30\x1a\x55\xfd\x05\x5d\x73\x28\xf9\x7b\x2d\x95\xa1\xd0\x5c\xe7\x2c\xab\x9f\x26\xb5\x53\x08\x0f\x2e\xa0\x38\x54\x70\xbb\x8b\xbc\xf6\xb6\x1b\xa9\x18\x52\x78\xdb\xa4\x7f\xba\xe7\xc2\x29\xcd\x88\xc9\xf5\x59\x70\x34\xa9\xdb\x70\x5d\x53\xc1\x4e\x15\xe6\xf6\x29\x9c\xc3\xf4\x14\x1e\x3d\x82\x09\x87\x73\x78\xfc\xec\xfc\x7c\x82\xaf\x1f\x3f\x0b\xe1\xd7\x2f\xe0\xf0\xf2\x02\x1a\xef\x42\x28\x7b\x0b\xef\xf3\xf6\x30\x1d\x47\xc0\xdd\xf6\xf4\x35\x7d\x20\x77\xc3\xad\x35\x9c\x3e\xb3\x41\xd3\xc9\x74\x8e\x27\xa6\x80\xd3\x39\xd1\xac\xa0\x20\xe4\x76\x5a\x61\x89\xe6\xc0\xea\x99\x56\xad\x69\xf3\x99\x0d\x19\x37\xa6\x67\xde\x98\xc1\x03\xa7\xde\x98\x28\x2f\xb3\xa7\xaf\x9d\x51\x60\x0e\xce\x02\x73\x70\x18\x74\x59\x77\x26\x4f\x43\x55\x8a\xcd\x39\x4d\x6d\x4b\x38\x25\xbb\x07\x70\x04\xd3\x53\xdc\x18\x2c\xb3\x7e\x0f\x2e\x90\xd0\x7d\xa9\x53\x29\xad\x84\x9d\x9f\xcb\x0b\xe7\xf0\x74\xd0\x96\x30\xdc\x07\xec\xc9\x18\x45\xe5\x2c\x61\x9d\xbc\xd1\xe1\xd6\xd2\xa5\xf0\x5a\xcc\x96\xc0\x81\x3a\x36\xff\x49\xc8\xf6\xaf\xef\xa8\x92\xeb\xf9\xd1\xfe\xab\xf3\xf1\x6d\xf5\x85\x30\xf1\x41\x4d\xcd\x1d\x15\x99\x8f\x39\x6b\x9f\x2f\x6e\xcd\x27\xcf\x1b\x66\x1f\x30\x29\xc2\xc6\x39\x84\x0f\x13\x45\x5b\x13\xdd\x38\x0f\x1d\x54\x7b\x4b\xe2\x61\x0f\xe8\x9f\xd8\x92\x1e\x39\xbc\x69\xb6\xa4\x31\xfa\x45\xb0\x22\x52\xa1\xd5\x9d\x0e\xfb\x8e\x25\x38\x3d\x18\xb7\xd9\x3e\xbe\xfd\xf3\xe4\xe4\xe4\x0f\xc8\x84\x5c\x12\xdd\xe6\x0c\xd3\x76\x12\xd6\x28\x7a\x07\xca\xb6\x51\x7d\xa4\xb9\x85\x37\xac\x36\xe2\x03\x91\x8a\x4e\xec\x4f\xbf\xe0\xbf\x08\x17\xee\xdf\xa4\x01\xe6\x36\x7b\x59\xf5\x90\x82\x1e\x5d\x94\xb4\x60\xff\x7b\xf6\x83\x26\xfa\xfd\xe5\x11\xe8\x67\x4a\xf0\xd8\xfb\xa6\x07\xe1\xc7\xfc\x8d\x83\xa1\x83\x9f\x49\x58\xd0\x1b\x90\x74\x25\xa9\xa2\x5c\x13\xcd\x04\x6f\x93\x50\x2f\xa6\x93\x88\xbd\x25\xdc\x81\x8c\x9d\xc8\xe3\x84\xe0\xe0\xc1\x88\xf7\xaa\x8e\x79\x47\x6f\xf0\xc0\xd5\x1c\x7c\x3b\x39\x9d\xdd\x67\x1b\x55\x3b\x5c\xf5\x7e\xd8\x08\x1b\x0c\x2c\xdd\xf2\xf4\x5b\x71\x80\xa8\xd7\x1b\x4d\xd5\x41\x96\x66\x8c\x13\xb9\x81\x94\x68\x12\x39\x36\x0e\xed\x10\xe4\xc9\x9d\xdd\xf1\x09\x66\x44\xd1\xae\x59\x66\xcb\x76\xf2\xf1\xe5\xeb\x6c\xa3\xef\xb6\x2b\x38\xcb\x1b\xf0\x73\x96\x37\x09\x70\xf9\xfa\xe8\xb1\x78\xbf\xb6\x1d\x36\x5d\xe2\x4b\xdb\x6d\x17\x8d\xde\xc5\x37\x76\xad\xd3\x37\xdc\x41\x12\x5f\x22\x36\xb4\xfe\xb6\xdd\xdd\x5a\x76\x51\x9d\x7c\xed\xc0\x3b\x60\x67\x5d\x91\xd5\x91\x4d\xb5\x24\x2b\x3c\xc1\x64\x8c\xe6\xe9\x1e\x41\x42\x02\xa7\x0a\x0f\x97\xa9\x48\xcc\x92\xf2\xae\x43\xce\x15\x59\x75\x32\xb3\x24\xab\x2f\x0e\x87\xaf\x0d\xc3\xff\xc7\x94\xe5\xe2\xaa\x8f\xa9\xee\xea\x7d\xde\x36\xd7\x65\xd3\x1a\x63\x5b\xf5\x66\x1a\xc4\x4c\x0d\xd1\x20\x5a\xae\x73\x96\x1c\xfb\xa3\x51\xe8\xd3\x22\x24\x67\xaa\x8b\x05\x9b\xb0\x67\x87\xdc\x0b\xfc\x3b\x69\x9b\xc8\xdd\x05\x33\x6c\x66\x10\x5e\xef\x88\x7a\xc5\x37\xb7\x80\x49\x43\x9f\xd8\xbb\x0c\xbc\x89\xc2\x59\x03\x89\xe0\x9a\x30\x8e\x57\x0f\x1b\xd4\x73\x03\xd3\x9f\x74\xd3\x81\x98\x4b\x39\xb1\xc1\x7d\x5a\xc5\x48\x88\xe3\xd8\xdf\x20\xd9\x2b\x12\x54\x67\x26\x24\x7c\xb3\x66\xd4\xae\x24\x7c\x4e\xf1\x41\xa1\x0d\xcf\xa9\xdf\xea\xeb\x19\xcc\xfe\xe5\x27\xdd\x7c\xdd\xde\xcd\xd4\xa0\x68\x69\x68\x6b\x4c\xdb\x96\xda\xdd\xbb\x51\xe1\x65\xd0\x21\x17\xf7\x5d\x42\xe4\x9c\x6a\xd0\x0b\x29\xcc\x7c\x61\xff\x3c\x10\x5a\xe9\x7c\x94\xc5\x64\xb3\xa2\x0a\x91\x5d\x2f\x58\xb2\x00\x22\x71\x52\x09\x49\x53\x14\x99\x5e\xd0\x0d\xbe\x8a\xe1\x15\x32\xe8\x53\xe7\x94\x14\x54\xd5\xc9\x0d\x4f\x16\xd8\x6c\xda\x86\xd3\xad\xb1\xad\xc0\xa8\x8e\x6d\xbc\x0b\x9d\x0a\xeb\xbb\x2c\x1b\x72\xd1\x3a\xea\x6d\x65\x84\x20\x6e\x8f\x8d\xd8\x57\x7c\x45\xa4\x5a\x90\x7c\xe2\x1e\xca\xb1\xad\x3a\x3e\x73\xa9\xaa\x43\xe7\x47\xfb\x3d\x81\x88\xe3\x37\x96\x24\x6b\xbc\xd9\x33\x89\xb6\x2e\xff\x60\xb4\x05\x2e\xfe\x48\xd6\xf0\x1d\x7f\x9d\xf9\xdc\xdf\xb7\x9f\x03\xcd\x75\x7c\xe6\x4b\xbf\x12\xb7\xc6\x47\x92\xac\xc3\x17\xc7\x4a\xfb\x67\x49\xd6\xb1\x2d\xd9\x48\xe3\xb0\xc2\x0d\x50\x96\x40\x79\x0a\x55\x15\xfc\x3b\x00\x79\x2d\xd2\x6d\x7f\x15\x00\x00"),
+          path: "mongo-consume.tml",
+          root: "mongo-consume.tml",
+        },
+      
+        "mongo-fields.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xc1\xaa\xd4\x40\x10\x45\xd7\xe6\x2b\xee\x72\x22\x43\xe6\x23\x14\xc1\x8d\x2e\xde\x52\x5c\x54\x3a\x95\xbc\x76\xd2\x5d\xa1\xba\x7a\x24\x84\xfc\xbb\x74\x92\x19\xde\x03\x47\xdc\x17\xa7\xee\xbd\xe7\x72\xc1\x17\xcf\x63\x97\xa0\x6c\x59\x63\x02\x21\xd0\x04\xe9\x41\xe3\x88\x64\xa2\xdc\xa1\xdf\x4f\xa4\x87\xbd\x32\x96\xa5\x79\x31\xcd\xce\x9a\xef\xed\x2f\x76\xd6\x7c\xa3\xc0\xeb\x7a\xc6\x95\x67\xee\xd0\xce\xe5\xca\x2b\x22\x05\x4e\xf8\xed\xed\xd5\xc7\xea\x72\x41\x90\x38\x48\xd7\x36\xf8\x6a\xf0\x61\x1a\x39\x70\xb4\xf4\x2f\xe4\x11\xcd\x47\x63\xed\xc9\x31\x72\xda\x1f\x0c\x1c\x59\xc9\xb8\xc3\x44\xee\x4a\x03\xa7\xa6\xea\x73\x74\x38\xf1\xc8\xe1\x19\xae\x3e\xba\x9e\x6a\x9c\x02\x4d\x3f\x92\xa9\x8f\xc3\xcf\x07\x7e\x59\xcf\x60\x55\xd1\x1a\x4b\xf5\x61\x1f\xa4\xb0\x3e\x8b\xcb\x25\xeb\x81\xd9\x7e\xd4\x67\x44\x3f\x56\x6b\x55\x9a\x7d\x92\x98\x72\x60\x24\x3e\xfa\xfc\xd7\x60\xe8\x55\xc2\x76\x3e\xf8\x9b\x8f\xc3\x7d\xf8\x77\xa3\x9f\x41\x77\x37\xdc\x95\x5f\xed\x7c\x37\x26\x0a\x65\xea\x76\xcc\xdb\x71\x1f\x2a\xe3\x5e\x07\x7e\xcf\x71\xe5\xb9\xf0\x69\x47\x6f\x66\x24\x1b\x24\x78\xe3\x30\xd9\x0c\x9f\xca\x87\xe0\x53\x2a\x71\x44\x41\xb8\xd1\x98\x19\x8e\x22\xa2\x18\x5a\x86\x93\x78\x63\x2d\xd3\xfb\x68\xb2\x71\x6d\x9e\xb8\x80\xbd\xa5\x1d\xfd\x4e\xc6\xc7\xa7\x36\x8e\xd9\x4e\x1d\x19\xe1\xef\x42\xea\xa3\xc1\x52\x2d\x4b\x73\xdc\xaf\xeb\x43\xce\xa1\xe0\xad\xa3\x17\xc9\xea\x78\x5d\xab\x3f\x03\x00\x3b\x37\xf4\x4b\xdb\x02\x00\x00"),
+          path: "mongo-fields.tml",
+          root: "mongo-fields.tml",
+        },
+      
         "mongo-functions-test.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5d\x6f\xe2\x38\x14\x7d\x06\x89\xff\x70\x37\xd2\x4a\xc9\x6c\x36\x33\x9d\x47\x56\x3c\xc0\x30\xdb\xee\x6a\xa7\x54\x13\xba\xf3\x88\x8c\x7d\x43\xbd\xe3\xc4\x5d\xdb\xa1\xad\x10\xff\x7d\x65\x27\xd0\x32\x7c\x94\x50\x98\x1d\x55\xa9\xd4\x4a\xa4\xd7\x3e\xd7\xc7\xf7\x9c\x83\x60\x4a\x14\xf8\xad\x26\x00\x00\x95\x59\xc2\x27\xd0\x81\x94\x8d\xa3\x0f\xee\xc5\xac\xf8\x8f\xfd\xe9\xf7\xda\x20\x75\x74\x8e\x06\xb3\xa9\xef\x7d\x1a\x5c\x9e\x0f\x46\xc3\x8f\xf1\x70\xd4\xef\x79\x41\xf8\x58\x78\x21\xb5\xd9\x56\x7a\x31\x88\x87\x2b\xc5\xd7\x1a\xd5\xb6\xe2\xeb\xf8\xe3\xe7\x95\xe2\x6e\x6e\x6e\xb6\xb7\xd1\xbd\x1e\x5e\x7c\xd3\xca\x15\xd1\xfa\x4e\x2a\xb6\x6d\xc9\x55\x37\x8e\xbf\x0c\x3e\xf7\x97\x8b\xe6\xad\x66\xab\xd9\x60\xe3\x92\x84\x4b\xbc\xfb\x24\xb3\x89\xec\xf7\xfc\x82\x9c\xa0\x28\x33\xa8\xcd\x07\x29\xa0\x03\xde\x6c\x26\xe4\x1d\x2a\x88\x62\xa3\x72\x6a\xa2\xc1\xf8\x1f\xa4\x26\xba\x24\x29\xba\x3f\xf3\xf9\xc8\x56\x8f\xa8\x14\x02\xa9\xe1\x32\xf3\x5a\xcd\xc0\xc2\xbc\x7d\x0b\x43\xd4\xe6\x1c\xcd\x6c\xb6\x61\xf5\x7c\x0e\x53\x22\x38\x23\x06\x35\x98\x1b\x04\x85\x46\x71\x9c\x12\x01\x32\x01\x02\x5b\x16\xb9\x7d\x15\x52\xa9\x18\x24\x4a\xa6\x40\x20\xb5\x47\x60\xe3\xa8\xd5\x4c\xf2\x8c\x3e\x03\xea\x1b\x78\x63\x1b\xe6\xd9\x24\x1a\x06\xb3\x56\xb3\x81\x53\xcc\x8c\x86\x76\x07\x52\xdb\x01\xd5\x96\x16\x3f\x68\x35\x1b\x3c\x81\x45\xe9\xdf\xa8\xc6\x52\xa3\xef\x56\x2c\x96\xac\xae\xa0\xb9\x36\x32\x8d\x62\x43\xe8\xd7\x3e\xd7\xb7\x82\x3c\xf8\x52\x47\xb1\x61\x32\x37\x81\xdd\xcf\xb1\xef\x26\xd1\xdc\x87\x40\x49\x46\x51\x58\x5c\x2a\x33\x83\xf7\x26\xfa\xc2\xcd\xcd\x90\xa7\x28\x73\xe3\x2f\x9e\xf5\x08\xfd\x3a\x51\x32\xcf\x98\x1f\x84\x70\xf6\x0e\xde\x80\xe1\x29\x46\x31\x52\x99\xb1\xf2\xbe\x18\x26\xa8\xca\x0d\xfd\x60\x81\x82\x02\xd3\x10\x50\x29\x8b\x91\xf0\x7b\x93\x2b\xd4\xd1\x5f\x92\xb0\x8d\xdc\x94\x04\xfd\x19\x0f\x2e\xfd\x65\xf5\x73\x95\x65\x03\x3c\x71\x38\x3f\x75\x20\xe3\x02\x9e\xa8\xca\xf2\xa7\xa3\xdf\x09\x17\xc8\x7c\x2f\xce\x29\x45\xad\x93\x5c\x88\x07\x10\x92\x30\x64\x60\x77\x81\x44\xaa\x6d\x17\x5e\x5e\x76\x1b\x7e\xfe\xe5\xdf\xc8\x73\xe7\x09\x96\xc3\xfc\x08\x61\xa5\xf0\x42\x08\x6f\xc9\x5c\xc1\xa7\x55\x48\x1f\x05\x1a\xf4\xdd\x85\xb1\x71\x08\xc5\xcd\x87\x0b\x85\x84\x8e\xe4\xe8\x2a\x1f\x0b\x4e\xff\xe8\x2f\x37\x28\xf9\x68\x97\x5e\xa3\x90\x3c\xbb\x49\xf0\x5b\x75\x0a\x09\xb3\xc7\x5b\xa8\x61\xc7\x01\x79\x66\x24\xb0\xf1\x41\x24\x56\x05\x89\x1e\x79\x1c\x15\xe3\x57\xb0\x70\x8e\xa6\x02\x8f\x87\x4e\x55\xe9\x22\xc8\x40\x1b\xa9\xf6\x6b\xdc\xf9\xc8\x81\xec\xbc\x00\xcf\x11\x35\x5f\xb5\xca\xae\x10\x87\xb8\xa5\x10\x2f\xf6\xcb\xed\xc8\xaf\xce\x32\x1b\xeb\x7e\xd9\xf8\x4e\x66\xd9\x58\x9b\xe9\x46\xe3\x54\x1e\x69\xc9\x6b\xd4\xee\xf8\xc3\xba\x63\xb1\x48\x87\x0b\x9b\x6c\x2f\x7d\xb2\x2b\xc4\x0e\x3e\x3c\xa2\xa9\x17\x82\x77\xeb\x78\x1d\x71\xe6\x85\xf0\xeb\x99\xfd\x3d\x8a\x6f\x5a\x33\x29\x5b\xfb\x0e\xae\x59\x11\xed\x09\x7d\x3c\x01\x81\x99\x5f\x2e\x0e\xa0\xd3\x81\x77\xd5\x0f\x6b\x04\x12\x6d\xe0\xac\xaa\x6f\x57\x3e\xe7\xa1\x40\x7b\x07\xc4\x40\x31\x54\xbd\x87\xff\x2f\x27\x7a\x0f\xae\x85\x3a\x2e\xea\xb8\xa8\xe3\xe2\x84\x71\xb1\x96\x15\x0b\xe1\x55\x89\x8c\x3a\x2a\x5e\x77\x54\x6c\xa9\x2f\xd4\xf3\x4d\x46\x50\xfb\x90\xcb\x6c\xef\x0f\x5e\xee\xb8\xb9\xd9\x1c\x10\x3b\x61\xeb\x64\xa8\x93\xa1\x4e\x86\x63\x26\xc3\x1e\x8a\xbf\xbe\x65\xeb\x8a\xcf\x8b\x87\x27\xd3\x7b\x01\x5a\xeb\xbd\xd6\x7b\xad\xf7\xe3\xbf\x13\xb4\xe7\x7a\x5f\xcf\xdc\xb3\x33\xe7\xb4\xf9\x7e\x39\x46\xd0\x59\x1d\xab\xcd\x53\x55\x5a\xd7\xee\xa9\x7a\xdc\xb4\x7c\x7d\xc8\x98\x15\x26\x7c\xf2\x41\xab\x0e\xb3\x6f\xb4\x14\x2a\x5e\xfb\xc0\x21\x95\xc7\xf9\x12\x6f\x27\x6a\x9d\x2d\xb5\xce\x9f\x66\x4b\x1d\x0d\x1b\x68\xa8\x18\xb3\x07\xf0\xe1\xd4\x7e\x7a\x46\xaa\xc3\xac\x72\x72\xf0\x17\x91\x05\x25\x9d\x2a\x94\x24\xee\x21\x18\x09\x13\x34\xc0\xdc\x15\x54\xee\x7c\x3f\x5a\x8e\x02\x35\x6f\x35\xff\x0b\x00\x00\xff\xff\xaf\xf4\x3d\xff\x25\x23\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x53\x1b\x39\x12\x7f\x0e\x7f\x45\xc7\x5b\x6c\xcd\x64\x67\x67\x09\x8f\xe4\x78\x00\x4c\x36\xa9\x0d\x90\xc2\x66\xb7\xea\xf6\xb6\x28\x79\xa6\xc7\xd6\x31\x23\x39\x92\xc6\xc0\xb1\xfe\xdf\xaf\x5a\xd2\x7c\xf9\x9b\xbd\x54\xdd\x51\x97\xc2\x01\x7b\xd4\xea\xfe\xf5\xa7\x5a\xed\xcc\x98\x82\x60\x0f\x00\x20\x91\x22\xe3\x63\x38\x86\x22\x1d\xc5\x67\xf6\xc3\x93\x5d\xa0\x57\xff\xf4\x08\xa4\x8e\x7f\x46\x83\x62\x16\xf4\x2e\xae\x2e\x7f\xbe\xba\x1d\x9e\x0f\x86\xb7\xfd\xd3\x5e\x18\xd5\x74\x1f\xa4\x36\xeb\x28\x3f\x5c\x0d\x86\x6d\xda\x1b\x8d\x6a\x1d\xed\xcd\xe0\xfc\xba\x4d\x7b\x52\x9a\xc9\x7a\x0c\x27\x37\xc3\x0f\x5d\x1c\x9f\x99\xd6\xf7\x52\xa5\xeb\x76\x7c\x3e\x19\x0c\x7e\xbb\xba\xee\x57\x7b\xe6\x7b\xe1\xde\xde\x4f\x3f\xc1\x10\xb5\xb9\x60\x5c\x80\x36\x4c\x19\x0d\x0c\x72\x99\xb0\x1c\x0a\x29\xc6\x32\x05\x33\x51\xb2\x1c\x4f\xc0\x4c\x10\x0c\x6a\x53\x1a\x9e\xc3\x94\x25\x77\x6c\x8c\x70\x3f\x41\x01\x42\x12\x9b\x96\x24\xd2\x1a\xb8\x06\x8d\x26\x02\x83\x4c\x71\x31\x06\x6e\x20\x95\xf7\x02\xa4\x48\x10\x58\x9e\x5b\x66\x1a\x26\x6c\x86\xa0\x4a\x11\xef\x65\xa5\x48\x6a\x30\x41\x01\x6f\x88\x80\x8b\x71\x7c\x11\x82\xf3\x8a\xd4\xf1\xf9\x03\x37\x81\x2a\x05\xd1\xe9\xa0\x08\xc3\xbd\xb9\x55\xa2\x7a\x44\xac\xb4\xc5\x5a\x41\x24\x2e\x1a\xd8\x98\x71\xa1\x8d\x5d\x71\x5e\x2f\x15\xa6\x5e\xc7\x51\xe4\x74\x27\x98\x8c\xb8\x75\x0c\x90\xc9\x52\xa4\xc0\x05\x7c\x3e\x19\x7e\x00\x9e\x81\x90\x02\x49\xbd\x86\x8f\x07\xdf\xe0\xea\x80\xe7\xc2\x78\x05\x78\xe6\x37\xc5\x14\x34\xf0\xfa\x18\x7a\x3d\xbf\x44\x2f\x85\xa6\x54\x02\x8a\xf8\xba\x14\x41\xe8\x9d\x64\xff\x38\x28\x11\xa0\x52\x70\x74\x5c\xfb\x21\x1e\x10\xec\xa0\xfe\x78\x35\x35\x5c\x0a\xdd\x70\xbc\xc6\x69\xce\x13\x36\xc0\xb5\x11\x7a\x7d\xfe\xf9\xd3\xe0\xbc\x0e\xd2\x79\x58\x01\x25\x51\xaf\x8f\x41\xf0\xbc\x85\xb0\x79\x5e\xcb\x3c\x57\xea\x52\x5e\x58\x7c\x2d\x42\x7a\x65\x85\x89\xdf\x4f\x15\x17\x26\x0b\xa4\x8e\x07\x26\x45\xa5\x22\xe8\x65\x8c\xe7\x98\x82\x91\xce\xea\x1d\x6b\x1f\xc1\xbe\xfe\x87\xe8\x59\x4d\xc3\x9a\xdb\x7c\x07\x13\xa5\x98\xa1\xf2\x5c\xe2\x81\x91\xd3\x20\xdc\x6b\x25\xb9\xb3\xf8\x71\x45\x40\x9f\x16\x5c\xd2\x3f\x85\xe3\x05\x87\xb4\x56\xa0\xf7\xf4\x94\xcb\x7b\x54\x10\x0f\x8c\x2a\x13\x13\x5f\x8d\xfe\x89\x89\x89\x2f\x59\x81\xf6\xd7\x7c\x7e\x4b\x46\xb9\x4d\x47\xbd\x36\xae\x05\xc4\x2e\x5c\x89\x70\x80\x5a\x73\x29\x3c\x01\xe5\x9d\xf6\x4f\x8c\xb4\x71\xea\x83\xd3\xe3\xa3\x38\xeb\x24\x63\xcb\x89\x6f\x88\x27\x8a\x19\x57\x52\x14\x28\x0c\xcc\x98\xe2\x6c\x94\xa3\x8e\x40\xdf\xf1\xe9\x94\x22\x9b\x58\x26\x2c\xcf\xed\x7b\xd4\x66\x75\x28\x83\x54\xc4\x9d\x18\xb6\x1e\x4e\xc8\x78\x5c\x43\x29\x14\xb2\x64\x42\xac\x7d\xcc\xb7\x34\x09\x4c\x13\xf6\xc3\x10\xde\x14\x63\x19\x57\x4a\xae\x8c\x7f\x67\xee\x3f\xff\xdc\xe0\x01\x13\x0f\xee\xf8\xb4\x13\xb1\xb6\xba\x30\x91\xb6\x2b\x4e\xff\x14\x98\x42\x10\xd2\x50\xd1\xb1\xab\x42\x7a\x5f\x77\x13\xb8\x65\x90\xca\xbe\x84\x59\xf7\x3a\xc1\xe4\x3d\x51\x27\x1c\xa9\xd2\xe7\x2c\xff\x8d\x9b\xc9\x47\x91\xc9\xe0\xfb\xea\x09\x7d\x6a\xe0\x9e\xa4\xa9\xd2\x47\xf4\xee\xf7\x3f\xb4\xa1\xba\xf7\xd4\x52\x78\xde\x14\xeb\x21\x2f\x50\x96\xe6\x08\xe0\x10\xde\x80\xe1\x05\xc6\x03\x4c\xa4\x48\x1b\x92\x3e\x33\x6c\xc4\x34\x1e\x55\xe6\x71\x07\x42\x43\x40\x87\x89\x60\x45\x43\x40\x0f\x56\x9d\x07\x7e\xb9\x7a\xb0\x53\xa6\x3b\xc3\x67\x41\xaf\xb2\x12\x33\xb0\xff\x65\x21\x06\xd6\x19\x93\xb2\xb8\x17\x55\x72\xc9\xd7\xad\x84\xee\xe6\x85\xb7\xb4\x2f\xe3\xe4\x8a\x33\x99\xe7\x98\x98\x6e\x6a\x24\xcd\x43\x52\x19\x4a\xc1\xbf\x94\x08\x46\x2e\x85\x75\x04\x5a\x52\xf4\x4e\x99\x62\x79\x8e\xb9\x3b\x11\xda\xf5\x5f\x13\x83\xd4\x5b\x17\x04\xce\x50\x59\xfe\x3c\x6d\x07\x75\x03\x63\x21\xae\x9d\x5f\xbd\xa9\x2c\x98\xa3\x63\xff\x50\xc7\x97\x78\x4f\x35\x97\x25\xa8\x82\xde\x4f\xbd\x08\x7a\xb7\xf4\x0b\xe8\xd7\x6d\x2f\x8c\xfd\x62\xe0\xea\x46\x10\x86\x6d\x5b\x50\xc1\x1c\xf8\x82\xb9\x4b\xb9\xd9\xd7\xb7\xfb\x69\x2f\xaa\x85\x0f\xe5\x27\xda\x12\x10\xa8\x30\x72\x51\x75\x29\xef\x83\x30\xbe\x11\xfc\xe1\x92\x09\x19\xd4\x07\x66\xc6\x1f\x4c\xa9\x70\x20\x4b\x95\x20\xa4\x8a\xdd\xbb\x63\x53\x61\x22\x55\xaa\x3d\x24\x4c\x61\xf4\x08\xb9\x64\xe9\x7b\x47\x1f\xc3\x47\x5b\x08\x34\x62\x8a\x29\xdc\x73\x33\x69\x71\xd3\x71\x1f\x33\x56\xe6\x66\x80\x98\x92\x23\xbc\xf1\x15\x82\xc2\xa9\x92\x69\x99\xf0\x51\x4e\x6d\x03\xcf\x11\xa8\x92\x58\xd7\x79\x61\x54\x02\x05\xde\x7b\x08\xf1\xde\x8c\xa9\x05\x98\xc7\xa0\x98\x48\xc9\xc8\x41\xf5\xc6\xad\x04\xab\x00\x84\xae\xbf\x69\xa1\x5f\x10\xf4\xf4\xb4\xc2\xb8\xf3\x39\x64\x4a\x16\xd6\x18\x15\xd7\xaa\xdf\x89\xe0\x7e\x22\x35\xc2\x8c\xe5\x25\x6a\x62\xae\x99\xe1\x3a\x7b\xb4\xd4\x33\x96\xf3\x94\x19\x6a\x67\x72\xd4\x20\x33\xe0\x46\x43\xc6\x31\x4f\xb5\x2d\x49\x29\xcf\xe8\x8c\xf2\xec\x89\x91\xcc\x6c\x2b\x34\x55\x38\xe3\xb2\xd4\xd6\x1a\xda\x07\x61\x0b\xf7\x42\x04\x36\xb8\x3f\x3b\x5c\xf3\x79\xbc\x4e\x97\xa7\x4e\x80\x55\x56\xba\x66\x22\x95\xc5\xca\x3d\x7e\x63\x65\x51\x67\xdf\x2a\x6a\x28\x75\xae\xad\x7b\x80\x4e\x71\x5d\x77\x85\x54\x47\xe8\xfd\x98\xcf\x28\x11\x9d\x0b\x41\x21\x4b\x61\xc4\x92\x3b\x67\xd2\xaa\x46\xa4\x12\xb5\xad\xd5\xf8\xa5\x64\x79\x75\xdc\xf8\x3d\xda\x48\x45\xc1\xc3\xc7\x42\xaa\xea\xdc\xa2\x50\xd6\x86\x15\x53\xdb\x54\x52\x4c\x92\xb0\x74\x14\xd9\xd5\x5b\x9e\x02\xd3\x9a\x8f\x7d\xbc\xd6\xb5\x48\x7a\xa6\xce\x55\x96\xb1\x0d\x59\x59\x1a\xa0\x26\x8e\x9c\x42\x0c\xa6\x0a\x13\x6e\x8f\x5e\x7a\x42\xfd\x08\xe5\x3d\x79\xd0\x0a\x26\xb7\xf3\x64\x52\xf3\xb5\x10\x35\x30\x0d\x37\xc3\x33\x62\x5d\xf0\x3c\xe7\xda\xd6\xef\xca\x7b\x8d\xa5\x3a\xce\x8b\xe0\x9e\x51\x3b\xb8\xbb\x07\x23\x18\xcb\x67\x6d\xa8\xba\xe5\xa7\xa7\x1f\xc9\x2b\xb1\x43\xe1\x69\x3e\xf6\x61\x3e\xaf\x0a\x3f\x41\x21\xb1\x0b\x14\xf3\xf9\xd2\x31\x3c\x96\xeb\x08\xa1\x57\x75\x3b\x95\x4c\x14\x69\x25\x63\x01\xc2\x99\x42\x66\xb0\x5e\x5d\x10\xef\x57\xbd\xc6\xab\x16\xe0\xd8\xd5\x34\x3a\x3c\x9f\xe6\xbe\xc0\xb9\x0f\x3b\x48\xbf\x99\xa6\x1b\xa4\xfb\xd5\x65\xe9\xf5\xc2\x33\xa5\x0b\xa9\x0a\x96\xf3\x7f\x61\xa0\x30\xa3\xb3\x2b\xfe\x95\xaa\xc6\x55\x16\x7c\x4f\x8a\x87\xf1\x79\x8e\x45\x75\x00\x6c\x20\x1e\xcb\x16\x6d\xe5\xb9\xd7\x15\x59\x1f\x71\x7a\x4e\x59\x14\x10\x53\x8b\xbd\xf2\x3f\xfd\x98\xf8\x3d\x33\x2c\xcf\x82\x1e\x3e\x4c\x31\x21\xf5\xd7\x44\x4d\x95\x7e\xfb\xdf\xcd\x2c\x17\xd8\xff\x6e\xd6\x73\xd1\x6a\x3f\x57\x67\xb7\xab\x03\x35\x5e\x4a\x47\x6d\x6b\x18\x99\x43\xdb\xec\xe2\xa2\x5d\x0a\x6c\xad\xa4\x83\xfa\x66\x78\xd6\x49\x14\x5b\x12\x69\x27\x16\x53\xf3\x48\x5c\x75\xce\x13\x74\xa5\xb2\x60\x53\x4d\x9b\x04\xcf\x23\x4a\x34\x33\xc1\x47\x7f\x8e\xac\x2a\x29\x3e\xe9\x1a\x33\x3a\xa9\x1d\x63\x56\x76\xd1\xf7\xdc\x24\x13\x57\xc3\xe3\x5f\xb8\x48\x83\x6a\x25\x61\xba\xd9\xf3\xd9\xa8\xa3\xda\x8e\x3c\x83\xd7\x6e\xc3\x47\x7d\xc9\xf3\x7a\x47\xf5\xb3\x20\xb8\xe3\xdb\x26\x3b\x3a\xfc\x5d\xae\x77\x44\x98\x22\x02\x79\x47\x17\x3c\x2f\x4b\x18\x54\x19\xb5\x0b\x61\x1c\xd4\xe1\x16\xbe\x23\xa2\xae\x7c\x47\x3f\x40\xb3\x14\x3f\xa6\x88\x6f\x86\x67\x41\x18\x0f\x55\x29\x12\x66\xd0\x31\xba\x68\x1c\x11\xb6\x70\x36\x67\x45\xfd\xc8\xb7\x6b\xf4\xca\xa4\x02\x4e\xf0\x0e\xde\x01\x87\xbf\x79\xa9\x97\x65\xf1\x9e\xce\xb8\x20\x7c\x07\xfc\x87\x1f\x16\x90\xf1\xcc\x9d\x80\x8d\x56\x8e\x98\x87\xef\xdc\x42\x7c\xc6\x04\x01\x5f\x34\x69\xd7\xac\x96\xb4\x8b\x73\xbe\xc9\xba\x27\x4a\xb1\xc7\xa3\x2d\xc8\x3f\xa1\x58\x0d\x7a\xd1\x9d\x1f\x45\x8a\x0f\x01\xdf\xe2\x50\x8a\xde\x8e\x3f\x5b\x52\xa8\xa2\x1e\x6c\x75\xda\xdf\x51\x49\x2f\x72\xf8\x38\xc5\xe0\x3f\x75\xcd\xd7\x55\xf0\x82\x4d\xff\x9b\xea\xdd\x46\x70\x87\x8f\x14\x47\x8a\x89\xb1\x6f\xc2\xe2\x0b\x36\xfd\x05\x1f\xf5\x52\xf8\x70\x83\x85\xa5\xf5\xb2\xa9\x67\x6c\x8b\xae\x52\xd4\xff\x5d\xda\x6b\xfd\x52\x8b\x70\x01\x70\x87\x8f\x61\xb8\xc6\x8e\x24\x2f\x5c\xad\x7f\x7b\x7f\x04\x5d\xc2\x79\xa7\xac\xd2\x54\x6b\x4d\x7d\xbe\x40\x33\x91\xa9\xae\x9b\xcc\xce\x90\xeb\xc7\x1c\x67\x98\xc3\xd9\xf5\x4d\x1f\xa8\x10\x52\xf7\xa2\xad\xd1\xd6\x70\x23\x61\xd5\x95\x88\x55\x45\x94\xda\x1c\x54\xbe\x2f\xd7\xe5\x88\x9a\x96\xee\xed\x89\xfa\x59\x9a\xe1\x35\xb7\x31\x5f\x78\xb7\x03\xef\xb6\xb0\xbe\x12\xfb\xe1\xc0\xd1\x71\x77\x8c\x10\xb6\x06\x3a\xfe\x7e\x18\x9f\xe5\x52\x23\x8d\x74\x5e\xe1\x0c\x85\xd1\xe4\xdb\x02\x8d\xe2\x89\xbd\x74\x05\xe1\xde\x2b\xaa\xa0\x5e\xc2\xaf\xa8\x46\x96\xfe\x69\xef\x55\xb5\xa1\x4b\x9f\x94\xda\xc8\x82\x06\x68\xc9\x5d\x9f\xeb\x69\xce\x1e\xfd\x90\x4a\x96\x26\x0c\xf7\x5e\xf9\xe8\x4b\x47\x56\x52\x3a\x22\x29\x76\xcc\xd5\x3f\x0d\xdc\xc5\xd6\x1f\xc7\xc6\x4e\x76\x7a\x3f\xa3\xe9\x45\xd6\xfa\x5d\x55\x5b\x71\x99\xc8\xbc\x9a\xde\xb5\x6f\x97\x4d\x34\x74\x55\xae\x05\xc5\xfd\xd3\x30\x3e\x0b\x12\x99\x87\x71\x5f\xc9\x69\x6b\xb3\xc7\x40\xaf\xc4\x3c\x44\x90\x30\x91\xa0\x95\x92\x48\x61\xf0\xc1\xc4\x34\xb2\xf0\xd3\x86\xa0\x7a\x76\xca\x92\xbb\xb1\xa2\xb1\x48\x10\x46\xf0\xf6\xa0\x3b\x82\x58\xc4\xe3\x78\x56\xe3\x34\xfa\xc1\xdc\x25\x57\xe7\x8a\xd2\x6c\xf3\x33\x05\x6f\x37\xd7\xd7\x05\x16\x1e\x05\x99\x73\x47\x44\x57\xee\xc8\x72\x0a\xdf\xad\x1e\x41\x74\x1b\x98\x66\x6a\xc8\xd2\xad\x5d\x0c\x17\x46\x42\x3a\x3a\x82\xfd\x1f\xbe\x2c\x0f\x13\xeb\xb7\x8e\xba\x99\xf2\xa4\x23\x1a\x9c\x6f\x80\xda\xea\x0b\x7f\xc1\xc7\xf9\x7c\x49\xe7\x9d\x95\x50\x14\xbb\x38\xc3\xea\x5a\xb2\x45\x21\xdb\xec\x6c\x57\xa8\x7d\xeb\x70\xc6\x8d\x3c\x07\xdf\xbf\x2d\xc5\xec\x49\x9e\x7f\x0b\xdb\x17\x19\xb6\x3a\x82\xdb\xc5\xd0\x3d\xc9\xf3\x35\x88\x7b\x4c\x27\x34\x84\xea\x04\xb0\x93\xde\x8b\xe0\xc7\xb7\xf4\xef\x2b\x44\x33\xf5\xf3\x9b\x95\xd4\xbb\xc6\x32\xcf\x20\x47\x11\xf8\x5d\x21\x81\x79\xbb\x16\x4a\x7d\xbd\x79\xbb\x63\x26\xf9\x4b\x0e\x8d\xcf\xda\x52\x9e\x99\x55\xfa\xf7\x83\x3f\x36\x64\xd6\xe9\xe3\x95\x4a\x51\x7d\x4b\xb0\x97\x9a\x60\x4b\xd9\xe5\x3d\xfa\x17\x92\xec\x7f\x3b\xb9\x56\xf4\xef\x2b\x92\x8b\x99\x1c\x99\x36\x3b\x27\x59\x6f\xa9\xd1\xed\x66\x89\x0b\x81\x6f\xe9\xf1\xc2\xd2\xc3\x48\xc3\xf2\x4e\x72\x9c\xc9\x52\xac\xee\x9b\xfe\x7a\xd8\x27\xc4\x73\x0b\x62\x4d\xdf\xcb\xef\x14\xed\x16\xf3\xd7\x3a\x43\xb8\xe8\x9e\x20\x96\xf9\x96\x58\x77\x73\xc4\x6f\xb1\xfe\xc2\x62\x9d\x7a\xe8\xc3\x0d\x8a\xd8\xf5\xc5\x6b\x01\x1c\xaf\xbc\x2d\x34\x5c\x79\x06\xcb\x13\x5c\xda\x72\xe8\xf5\xdd\x1e\xa4\xa5\x1f\x67\x6f\x51\xd3\xc8\x85\xaf\x9d\xea\x1b\x87\x14\xd8\xa9\xcf\x6b\x7c\xe3\xe2\x76\x83\x6f\x0e\x17\xd5\xf4\x8f\x9f\xeb\x33\xa7\xd0\x4e\xa9\xf7\x12\xee\x75\x3b\xfa\x67\xc7\xf3\x7a\xf9\x62\x77\xb8\xf1\x66\x47\xdf\x7f\xfe\xdf\xd4\x1a\x9e\xb5\x6e\x42\xf5\x77\x9c\x64\x02\xe7\xf2\xfa\x91\xad\x33\x52\xbd\x27\xab\xd8\x49\x4d\x62\x1e\x6a\x64\x67\xee\xaf\xb3\xee\xf3\xbe\x70\x43\xa5\xa4\x5a\x08\x0e\xff\x95\xeb\x6e\xe5\xad\xde\x39\x0f\x23\x38\x3c\x78\x6e\xea\x68\xdc\x1a\x68\x7a\xd7\x82\xe7\xc9\xfd\xa1\xf6\xb5\x2e\x98\x6f\x69\xcc\xf4\x15\xb2\x6a\x4a\xff\xa5\x4f\x66\x5b\x95\xdd\x31\xad\xda\x8d\xc1\xe1\x0e\x0d\xf0\xe1\xc1\x56\xc9\x5b\x7b\x83\xb5\x3d\x38\x35\x27\x3b\x60\xa8\x4c\xf0\xf6\x60\x57\x2b\xb4\xd0\xb4\x05\x6e\x69\x58\xfa\x98\xe3\xb7\x86\xe5\xc5\x35\x2c\x5d\xa0\xce\x89\xcf\x39\xf9\x9e\x09\x5c\x61\x21\x67\x5b\x4f\xed\xdd\xd3\x71\x69\xa4\xf5\x7c\xec\xc7\x9b\xb1\xd7\x89\x94\x5a\xdb\x6c\xb5\xbb\x91\x30\x42\x28\xb8\xd6\x5c\x8c\x37\xdd\x6c\xe7\x7b\xff\x1e\x00\xf4\x84\xfb\x12\x5a\x2e\x00\x00"),
           path: "mongo-functions-test.tml",
           root: "mongo-functions-test.tml",
         },
       
         "mongo-functions.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x73\xdb\xb6\xb2\x7f\xb6\x67\xfc\x1d\xf6\x6a\xce\xe4\x52\xa9\x42\xb7\xe7\xe1\x3e\xb8\xf5\x99\x89\xed\xe4\xde\xcc\x34\x6d\x6e\x93\xde\xf3\xd0\xe9\x24\x10\x09\x49\x68\x48\x40\x25\x40\xdb\x3a\x1e\x7d\xf7\x3b\xbb\x00\x48\x90\xa2\x24\xeb\x8f\xe5\xa4\xc7\x79\x48\x44\xfc\x59\xec\x2e\x16\xbb\xbf\x05\x01\xe6\xf4\x14\x78\x51\xa8\x42\x43\x1c\xc7\x27\xc7\xd7\xac\x80\xe8\xe4\x18\x00\xe0\x55\x51\xfc\xa4\xcc\x6b\x55\xca\x14\xce\x5d\xa3\xf8\x27\x7e\x13\xf5\x0a\x9e\xa8\x22\x05\xa9\x0c\x8c\xb0\xba\xd7\xaf\x7a\xbc\xba\x9d\x8a\x82\xa7\x97\x4a\x1a\x7e\x6b\x5a\xfd\x12\x57\x3a\x61\x1a\xb8\x6d\x88\x5d\xfb\x27\xc7\x27\xc7\xa7\xa7\xcf\xb7\xfe\x83\xbd\xe1\xad\x92\x63\x75\x75\x01\x97\x4a\x8e\xc4\x18\x98\x4c\xe1\x3d\x37\xe5\x74\x57\xd2\x44\xdc\x11\xe5\xf9\x50\xa5\x82\x6b\x30\x13\x0e\x29\x33\x0c\x4a\xcd\x53\x30\x0a\x12\x25\x25\x4f\x0c\xfe\x2c\x35\x2f\xfe\x53\x43\x8e\xfc\xf8\x72\xa1\x64\x7c\x72\x6c\x66\x53\xee\x49\x69\x53\x94\x89\x81\xbb\x93\xe3\xa3\xab\x0b\xb0\x7f\xb4\x29\x84\x1c\xc3\x27\xa3\xf2\xec\xac\x97\x0e\x7b\xf0\x87\x56\x92\x7e\x7d\x3a\x39\x3e\x7a\x59\x9a\x09\xb5\x6d\xb6\x63\xa5\x99\xd4\x6d\xdd\x13\xb6\xff\x55\xf3\xa2\x83\x2e\xf2\xe7\x5b\xd3\x6f\x6c\xfb\x8e\x69\x7d\x83\x93\xda\x6c\x3b\x75\xc5\xbe\x7d\xf5\x8c\x7d\xfe\x47\x69\xd3\x41\x7f\xa2\xb4\xf1\xed\xe9\xf7\xa7\x93\xe3\xb9\xd3\xe3\xab\x7c\x6a\x66\x50\x70\x53\x16\x52\x83\x29\x4a\x7e\x3a\x62\x99\xe6\x20\x46\xc0\xb2\xcc\x2b\xe7\x9a\x65\x25\xd7\xc0\x0a\x0e\xcc\x40\xca\x47\xac\xcc\xcc\x29\xc7\xce\xa7\x52\xc9\x17\x9a\x1b\x22\xa7\x0d\x33\x3c\x3e\x39\x1e\x95\x32\x81\x28\x1f\x27\x8e\x40\xdf\x0e\x14\xf5\x61\xa8\x54\x46\x4a\xb6\x63\x42\x3e\x4e\x62\xa7\xc7\xf3\x73\xe8\xf5\xe0\xd9\xb3\x93\xe3\xa3\x23\x2c\xee\x28\x22\x0d\xb6\x0b\x2b\x55\xb5\x2b\x48\x1f\x54\x58\x0b\xfc\x7f\x2c\x13\x29\x33\xbc\x92\x99\x49\xbb\x24\x50\x62\xb4\xa2\xc4\x4a\x2c\x34\x08\x79\x8d\x8d\x3b\xc5\xf1\x64\xa2\xbe\xeb\x8d\x22\x89\x11\xb4\x98\xc4\x52\x2f\x69\xb8\xf0\x2c\x19\xdb\x52\x68\x28\xf8\x9f\xa5\x5f\x7c\x47\xf3\x9a\x52\x4b\xb2\x35\xd4\xaa\xd6\x2b\x28\x36\x54\xbd\x86\x9e\x6b\xbb\x82\x5a\xad\xe0\x75\xb4\xa8\xe5\x0a\x4a\xf7\xe5\x69\x09\x3f\xae\x83\x14\x99\x9b\xe9\x86\x07\x4a\xf9\x48\x48\x34\x5f\x10\xd2\xf0\x62\xc4\x12\x0e\x37\x13\x91\x4c\xd0\xe9\x29\x4d\x35\x39\x37\x13\x95\xc2\x48\x15\x68\x19\x85\xe0\xd7\xb8\x82\x18\xd1\x21\xcf\x11\x5f\x31\xc3\x86\x4c\x73\xf2\x64\xb6\xe8\x3d\xd7\x3a\xf0\x24\x7e\xbc\x7a\x14\x94\x06\xd9\x17\xba\xe0\x2c\x25\xe3\xef\x43\xf4\x3c\x0f\xc8\x0d\x80\x1e\x1d\xa9\x81\x15\xba\x5f\x1b\xec\x4f\xfc\xc6\xd3\xad\x4c\x16\x24\xbf\x01\x21\xb5\x61\x32\xe1\xa0\x46\xc0\xfc\xd8\xde\x58\xeb\x5e\x11\x1a\x74\x65\xb7\xcf\x5d\xe9\x9b\x7c\x6a\x97\x61\x3e\x86\xb3\x73\x78\x16\x14\xd3\x0c\xd8\xf6\x67\xb4\x1a\x06\x56\xc9\x27\xc7\x47\xa7\xa7\xf0\x32\x4d\x61\x24\x24\xcb\xc4\xbf\x78\x81\xde\x95\x4b\x5d\x16\x1c\x92\x4c\xd1\xbf\x6a\x04\x39\xd3\x86\x17\xa0\x2b\xe5\x1c\x15\xa5\x34\x22\xe7\xf1\x7b\x6e\x5e\xfb\xbe\x51\x3e\x1e\x00\xf2\x1a\x19\x56\x8c\xb9\x69\xb0\xd6\xb7\x76\x60\x6b\xe2\x3c\x8b\x7f\x54\xc9\xe7\x08\x67\xfb\x28\xe5\x23\x1c\xb8\xaa\xf9\x55\x66\x55\x1d\x2e\x61\x57\x61\x79\xf8\x8f\x73\xb4\x09\x4b\xec\xa8\x51\x15\x5f\x66\x4a\x73\xdb\xad\x59\x03\xe7\xd6\x8e\x8e\xc8\xb2\xe6\x14\x0c\x6b\x6f\x55\x4f\x4c\xa8\xc9\xda\xc2\xda\x51\x06\x72\x26\xd9\x18\x39\x9e\x30\x03\xc3\x52\x64\xa9\xa6\xee\x2c\xcb\xd4\x8d\x86\x52\xb3\xb1\x9b\xc2\xb1\x20\x9b\xb3\x0e\xa8\x2c\x18\x75\x37\x0a\xc6\x5c\xf2\x02\x5d\x16\xce\x3a\xd1\xb7\xbe\xd6\xea\x57\x93\x3d\xa6\xde\x38\xbd\x55\xe8\x96\x51\x12\x9b\x41\x8c\xb3\x13\x8c\x06\x90\xd9\x90\x31\x93\x49\xfc\xb6\x34\xfc\x16\xcb\xac\x22\x42\xc3\x6c\x18\x64\xcb\x12\x1d\x27\x4d\x46\x46\x85\xca\xc9\xa1\x76\x89\x15\x23\x25\xa2\xf6\xb2\x18\x97\x39\x97\xe6\x8c\x9e\xc0\x2e\x94\x33\x5a\x29\x55\x9b\xef\x62\x78\x33\x82\x4f\xb6\xee\x13\x3a\x00\x8a\x51\x03\x24\x2f\x69\x8c\x80\x51\xac\x4e\x32\x25\x79\x0a\x5a\x59\xad\xdf\xa0\xb7\x7f\x51\x6a\x4e\x6d\xf9\xad\xd0\x46\xc8\x71\xad\xc4\xe1\x8c\xa0\x13\x9a\xb0\x90\xe3\x01\xf6\x53\x66\xc2\x0b\x0d\x68\x97\xd8\x4f\x8d\x64\x30\xa7\x03\x10\x12\x74\x99\x4c\x20\xa1\x05\x2c\x0c\x64\xdc\x68\x98\xa9\x12\xd4\xd4\x88\x5c\xfc\x8b\xc3\x4d\x21\x0c\xd7\x44\xcc\x14\x34\x00\x0d\x88\x1c\x78\x7d\x55\x2b\x38\x30\x17\x74\x40\x34\xb8\x23\x50\x6b\xea\xef\x0b\x5a\xc0\x70\xed\x94\xa0\x03\xe1\xd5\x54\xf0\xd4\x39\xb8\xa4\xe0\xcc\x70\x3f\x51\xa5\x14\x7f\x96\xf5\xf8\xb6\xc9\x4c\x95\x56\x15\x13\x55\x66\x29\x69\x81\x03\x1b\xa1\x01\x94\x56\xc9\x42\xd7\xf2\x4d\x98\x4c\x33\x0e\x19\xae\x18\x40\x4e\xb4\xd5\x71\xce\x66\x28\x86\x61\x02\x35\x95\x4f\x33\x91\x30\xc3\x53\xf8\xb3\xe4\x85\xa8\xc4\x70\x81\xb4\xb5\xd6\xb7\xf2\x90\xd6\x7b\x35\x7c\x83\x75\x0d\x79\xd3\x2b\x58\xb7\x85\x61\xc6\xaf\x6f\xa1\x81\x65\xe2\x9a\x5b\xcd\x21\xd3\x42\x96\x1c\x38\x99\x54\xc1\x35\x37\x80\x78\x18\x01\x4b\xec\x22\x54\x97\x3f\x11\x23\xe4\x04\xbd\xa7\xaf\x8e\xdf\x09\x39\x8e\xfa\xdf\x53\x79\xc3\xf5\xe4\xdd\xbe\x85\xb8\xd3\x68\x25\x8e\xd2\x98\x1b\x27\x66\x94\xc7\xce\x67\x5b\x16\xda\x24\xeb\x88\x37\xb0\x7f\xf1\xa2\xa8\x48\x06\xc3\x69\xae\xa9\x48\x8c\xdc\xda\xb2\xdd\x13\x35\x9d\x35\x58\xbf\x54\xd3\x99\x73\xb0\x43\xac\xc0\x06\xf1\xd5\x45\xc5\x46\x7c\x75\xd1\x0f\xc6\x4d\x87\x03\x6a\x32\x70\xf2\xd8\x71\x69\xdd\x35\xc9\x62\x89\x9d\x1c\x4b\x16\x9f\x3b\xe8\x86\x64\xb1\xc9\x20\x88\xe5\xa7\xa7\x81\x5e\x80\x19\x83\x53\xa3\xd1\x33\xba\x48\xcd\x43\x3f\xe3\x8d\x1b\x17\x92\x2b\xb6\xb3\x8c\x83\x39\x0b\x0c\xd4\xec\xe0\x9e\x8f\x8f\xd1\x52\x53\x13\x72\xa4\x48\x34\xb4\x4c\xc1\xb2\x37\x72\xa4\x48\x95\x2f\xd3\xb4\xd0\x67\xe8\x43\x7f\xfb\xdd\x02\xef\xbb\xa4\x06\x3d\x73\x8c\x9e\x47\x1f\x44\xce\x55\x69\xce\x00\xfe\xeb\x5b\x78\x0e\x2e\x18\x26\x4a\xa6\x54\xed\x2d\xfd\xcc\xf3\x69\xa1\x17\xd5\x21\x46\x94\x2c\xaf\xeb\xb0\x80\x6a\x3c\xde\xab\x6a\x7c\x41\x23\x60\x5f\x92\x07\x00\xd6\x5a\xf5\x39\x13\xb4\x58\xd1\x35\x4c\x11\x93\xab\x11\x68\x95\x7c\xe6\x26\xf0\x46\xda\x92\x30\x0a\x54\x59\x04\x10\xa3\x61\xb3\x5e\x21\xff\x14\x66\x82\x4a\x89\x9e\xa1\xaa\xee\x61\xb6\xa1\xc5\x6a\xae\x11\x1d\xbc\x55\x29\x8f\x90\xe0\x5b\x25\x95\x51\x52\x24\x03\xf2\x72\x8d\x20\x4c\x83\x87\xe6\xb1\x63\xa2\x7a\x75\x01\x1f\x66\x53\xae\xf7\x92\x98\xde\xdd\xc5\xef\x29\xca\xc6\x3f\x0f\xff\xe0\x89\x89\x7f\x62\x39\x9f\xcf\x5f\x0b\x9e\xa5\xba\xc6\x09\x72\x29\x14\x75\x40\xd4\x5a\x37\x8a\xcb\x20\x67\x53\x42\x08\x59\x66\x91\x83\x31\x85\x18\x96\xe4\xd6\xb5\x56\x89\x20\x47\x7b\x23\xcc\xc4\xa6\xc1\x34\x46\xea\x82\x3d\x62\x32\x86\x03\x27\x22\xe5\x29\x0c\x67\x36\x02\xf9\x3a\x8f\x12\x56\xb3\xdd\x42\xb4\xb6\x34\xea\x43\x94\xb3\xe9\x6f\xd6\xe6\x7f\xaf\x9a\xdc\xcd\x17\x41\xec\x12\xf2\x97\x4a\xea\x32\xe7\xc5\x2a\xbd\xb0\x24\xe1\xb8\xdc\x2b\x35\x50\x9a\x6f\xeb\x6e\x44\x96\xc1\x90\xe2\x27\xd2\x49\x69\x2c\x21\x8d\x0a\x1d\x82\xc8\xa7\x19\x47\x88\x41\xde\x61\x0f\x4a\xa9\xb8\x6e\x6a\xc5\x15\x2f\xd1\x89\xcb\x13\x17\x12\x51\x5c\x91\x6b\xad\xa2\xce\x4f\x8c\x82\x6b\x9f\xc1\x56\x88\x11\x99\xf5\x3c\x07\x64\xeb\xd1\x4f\x8e\x8f\xda\x09\xeb\x1e\x17\xcf\xeb\x52\x7a\x6f\xb1\x87\x05\xf4\x32\x4d\xdf\xc8\x94\xdf\x02\x4b\x53\x0d\xd3\x42\x5d\xd3\x1c\x09\x2a\x13\x23\x60\x72\x46\xa0\xd8\xa3\xca\x2c\x73\xc8\x09\x17\x80\x90\x35\x00\xb5\xaa\xab\x66\xd7\x53\x6a\x64\x86\x1e\x81\xf9\xc0\xe0\x07\x8f\xd2\xa1\x6f\x33\x80\x1c\x95\x5f\x88\x44\xc7\x6f\xed\xbf\x18\xfa\x32\xb7\xc9\x32\xb0\x9c\x71\xda\xae\x43\xcf\x45\xfd\xc3\x5d\x01\x8f\x4a\x2e\x2d\xab\x8e\x46\xd4\x5b\x62\x5d\x57\x17\xb1\xe7\xa2\x47\x6e\x0f\x23\x8b\x18\x41\xc6\x65\xe4\x86\xea\x63\xae\xfc\x2d\x12\x77\xfb\x54\x10\xe6\xbf\xf8\x3c\xf7\x1d\xd3\x0a\x43\xe9\x20\xa6\xa1\xdb\x4e\x87\x94\x58\x13\x92\xee\x57\xa3\x34\xfd\xb5\x27\x9f\xc7\xaf\x72\x61\x22\xaf\x86\x57\x28\xdb\x28\xea\xbd\x66\x22\x73\xbb\x6d\x36\xc8\x84\xb1\x57\x58\x09\x06\xbe\x13\x86\x87\xa8\x57\xcf\x57\x8f\xb4\xd8\xae\x27\xb5\xf5\x88\x49\x3b\x4c\xd4\xef\xf7\x17\xe4\xa4\xc8\xd1\x94\x93\x94\xec\x53\xce\x2a\xb7\xb3\x95\x81\x91\xa0\xe0\x4e\x25\xf1\x65\x84\x0c\xf8\x46\xc8\xf3\x47\x37\x9b\xd8\xac\x60\x72\xcc\xab\xc9\x0d\x94\x51\xe3\xbe\x9a\x6e\xfc\x8a\xb2\x60\x6b\x3b\xd4\x67\x01\x05\x42\xf0\x67\xad\x3e\x5d\x52\x5d\x27\x0a\xdb\xe8\xd2\xf6\x72\x22\x6d\xa8\xe8\x2e\x65\x37\x14\xde\x21\x06\x05\xff\xde\xfb\x32\x49\x38\xb7\x4b\xd6\xca\xd1\x5a\xa7\xfb\x10\xa6\x1f\x4c\xbf\xfd\xd9\xc9\xcb\x6b\x21\x85\x9e\xf0\x14\x7d\x09\x85\x82\xfb\x0d\xed\xa8\x2f\x6c\x2a\xd1\xbe\x73\x29\x4d\x1b\x83\x62\x2b\x74\x31\x46\x19\x96\x81\x2c\xf3\x21\x2f\x30\x52\xb9\x6d\xf9\x2a\x13\x4e\x87\xde\xcf\x10\x99\x28\x31\xb7\xe0\xb6\xe0\x63\xb7\x41\x3f\x80\x7b\x7b\x9e\x3e\x44\x42\x9a\x06\x46\xdd\xdc\xd5\x10\x23\x0d\x3f\x23\xb4\x63\xc5\xbd\x39\x40\x2e\xfb\xa1\x01\x3b\xe3\x5f\x78\xb7\xb0\xc2\x32\x16\x0d\x1c\x13\x6b\xa7\x9e\xc4\xb2\xf0\x20\x8e\xe2\xc5\x77\x83\x2e\x67\xb1\xce\x29\x3a\xc8\xb9\x9b\x4f\x7c\x28\x11\xef\x2b\xe4\x2a\x8f\x88\x59\x39\xe5\x7f\x43\xad\x64\xfc\xf6\x6e\x6e\x8b\xc9\x80\x6b\x65\x34\x1d\x65\xfc\x5a\xc8\x34\xa2\x9e\x7d\x6b\x37\x51\xff\xfb\x47\x57\x12\xf1\xd3\x1b\x58\x89\xf6\xab\xc1\x4e\x97\x72\xc5\x33\x8e\x70\xdb\x32\xbd\x23\xbb\x15\x2f\xde\x89\x54\xfa\xaf\x3d\x8e\x1d\xb0\xe5\x72\x72\xe5\x92\xde\x45\x17\x03\xa5\x26\x4c\x18\x40\x1e\x98\x96\xc3\x4c\x24\x6f\xae\x68\x17\x06\x7e\xa1\x3e\xba\x6a\x28\x34\xa2\xb8\xbc\xd4\x06\x26\xec\x1a\x71\xa5\x6d\x0f\x22\xb5\x2f\x7e\x06\x88\xa5\x0a\xae\x11\x4d\x71\x41\x9b\x53\xc3\x19\x30\x32\x1e\x50\x05\xbd\x5a\x02\xc3\xec\x8e\x9a\x92\x41\xda\x5d\x3b\x9e\x77\x2c\xf9\xcc\xc6\x7c\x3e\x8f\x97\x38\x23\x07\xbf\xbd\x87\xb4\x62\xef\xe8\x22\x07\x95\xe4\x95\xcf\xdc\x05\x97\x59\x9e\xf6\xe5\x2d\xef\xbd\x46\x52\x6b\x01\x4b\x2c\xce\x4b\xd8\xab\x85\x3d\x18\xe8\x3a\x0c\xb8\xfc\x72\xe5\xdf\xc4\xc5\xd6\xf4\x6a\x96\xcf\x2a\x96\x07\x2d\xd2\x35\xc8\x6c\x7b\xe1\x5f\x68\xf1\x3b\x3f\xbc\x0c\x64\xee\xa8\xd3\x9d\x9c\xee\x3d\x26\x64\x8d\xc2\x9d\xf0\xe7\x76\x6f\x29\x3c\xf6\x70\xd7\x89\x50\x83\x16\x0d\xa4\xba\x76\x02\x1f\xde\xc3\xdf\x43\x37\xed\x20\xd0\xc4\x9b\x6e\xf7\x2e\xf0\xfe\x2c\x4d\x43\xd7\x5f\x6d\x77\x74\xbb\xfe\xf0\x2d\xa1\x99\xf0\xd6\x6e\xcc\x5a\xaf\xfc\x88\x11\x63\x55\x74\xb0\x6a\xd9\x39\x3a\xf0\x8c\xe7\x9b\x68\x63\xb7\xf0\x61\x99\x3e\x78\xf8\x70\xb9\xf9\x7a\xf7\x89\xda\x88\xdf\x1d\xd0\x87\x1e\x89\x91\xdf\xcd\x52\xc5\x00\xd4\x67\x14\x35\xd8\x33\x8b\x90\xa3\x7e\x1c\xd5\x7b\x5a\xfd\xef\xb1\x55\xeb\xf5\x4b\x45\x22\xae\x37\xb9\x3a\xdf\xc1\xac\x51\xd4\x75\x7d\x34\x64\xab\x95\xbf\x44\x05\x47\xe1\x79\x86\xc6\xab\x9f\x03\xc6\xd0\xe6\x06\xcd\x17\x65\x05\x6b\x23\xe9\xdd\x1d\x0a\x1e\xc1\x84\xe9\xd7\xb8\xf8\xdd\xfa\x82\x9e\xdd\x87\xee\x01\xf4\x61\x1e\xb8\xfb\x11\x15\x57\x7a\x24\x81\xfc\x96\xf5\x42\x90\x59\xb9\x41\xd3\x2c\xa4\x8a\x95\x99\x4c\xb5\x31\x3e\x42\x27\xb1\xc4\x93\x55\xd6\xb5\x9c\xfa\x32\x65\xaf\xeb\x81\xa2\xba\x29\xbc\x47\xe3\x8e\xb9\x6a\x75\xda\x74\x43\x68\x39\x6a\x79\x23\x35\x2f\x4c\x64\xb1\x50\x64\x67\xa8\xbf\xdb\x1e\x99\x33\xe9\xb5\x6a\xde\xd0\x82\x43\x15\x1e\x68\xcb\xec\x72\xa5\x87\xde\x84\xe3\xbe\x5f\x31\x3c\xd3\x3c\x5c\x13\x2d\x34\x1a\xdd\xdd\xd1\xeb\x94\x6a\x29\x11\x11\xe8\x61\x6d\x0f\x7a\x7f\xd0\x3f\xf3\x79\x7f\xa3\xc9\x5d\x0d\x49\x1f\x71\x4e\xb7\xd9\x23\x78\xec\x59\x5d\xd8\x28\x70\xf3\x2a\xd3\xf9\x7c\x15\x60\xfc\x6f\x6e\x5e\x66\x59\xf5\x66\x5c\xd3\x29\xce\xc2\xe1\xb7\x70\x9b\x80\xc9\x34\x38\xc6\xa3\x33\x61\x31\xe2\x26\x19\xbb\x7b\xef\xf4\x25\x02\x44\xab\x86\x9d\x01\xa2\x2a\x52\x8c\x4b\xe1\xd3\xc5\xac\xde\x5c\x60\xf4\x86\xc0\xd0\xa9\x91\xa9\x92\x9a\xbf\xe3\xc5\x3b\x57\xd8\x07\x88\x7e\xfb\x7d\x03\x75\x0e\x00\x76\xdf\xcf\xb5\x72\x5b\x8c\x79\xa4\x6f\x84\x49\x26\x8e\x5d\x1d\x7f\x50\x3f\xaa\x1b\x5e\x44\x24\x86\x1d\x20\x61\x9a\x43\x2f\xd5\x49\x6f\x00\xbd\x94\xeb\xa4\x77\x56\x5b\xb5\x17\xf7\x1c\x7a\x2f\x7a\xf0\x8d\x7f\x0e\xd1\xcb\x81\x20\x6c\x75\xce\x63\xbf\xc8\xac\xb5\xbe\xe9\x50\xc2\xe2\x56\x20\x22\x4d\x9a\xe9\x1f\xce\xe1\x5b\x78\xf6\x6c\x61\xb2\x7f\x70\x2f\xe3\x10\xe7\xd1\x32\xa8\xa0\x87\x9d\x8d\x8b\xd9\xcf\xa8\x39\x54\xcc\x80\x4e\xb8\xe4\xc4\xa4\xb3\xa7\xca\xac\xc2\xe3\x35\x15\xa1\x8c\xcb\xc8\x3d\xf4\x9b\x27\x26\xec\x5a\x5f\xf2\xce\x41\xc7\x27\xc7\x47\x54\xf5\x4b\x8b\xa5\xea\xcd\x43\xc8\xca\x3d\x0e\x6b\x54\x7a\x71\xc3\x5f\xb3\xc2\x8e\xfd\x4f\x26\x0d\x4f\xdd\x8b\x99\x0f\xea\xbd\x61\x85\x41\x43\x6e\xab\xee\xbb\x2e\xd5\xfd\xc3\x6b\x2e\x20\x05\xe7\xed\x66\x04\xf7\x43\xf2\xe7\xf0\x2d\x32\x42\x47\xb6\xee\xd1\x1f\x9e\x13\x17\x1d\x64\xc2\x6e\xa7\xf0\x77\xe2\xb9\x62\xfa\x1f\xf0\x9d\x4b\x1d\xc2\x5e\xdf\x7c\xd3\x3c\xbe\xb5\x00\x15\x5b\xbb\x09\x17\x67\xff\x8b\x2e\xfc\xcc\xda\x82\xe3\xa8\xd7\x1f\xc0\x62\x0f\x34\x56\x07\x21\x7d\x11\x3d\xb6\xa2\x68\x4f\x23\x1f\x42\x8e\x3f\xda\xf7\x59\x67\x3e\x40\x07\x5c\xb6\x80\x5c\x8f\x04\xfd\xe8\x8c\xe3\xe3\x0d\x49\xdc\x3b\x6b\xcc\x60\xab\x07\x59\x65\x45\x1b\x1a\x4e\xa1\xb3\xed\xc5\xac\xdd\xda\x15\xb7\x5b\xa3\x72\x17\x09\x93\xce\xdb\x4d\x5b\x13\xe9\x7b\xb5\x8a\x83\x5e\x73\x0f\x61\xfb\x87\x7d\xd9\xb3\x26\xbf\x7a\x50\x07\x05\x5b\xbc\xed\xb1\x15\xb8\x88\x0b\x61\x78\xae\x61\xa3\x50\xb5\x26\x2f\x73\x27\x61\x16\x12\x33\x1c\x2e\xf5\xc3\x75\x1f\x93\xb9\x0f\xdc\x0c\xdf\x43\xbd\xff\x2c\xa6\x51\x68\xf8\xfd\xf8\x47\x81\x53\x15\x58\x76\x3f\x7e\xaf\x0a\x13\x79\x37\x1b\x23\x30\x78\x66\xf9\xd8\x0d\xad\x56\x61\x29\xc4\x58\x0d\x0c\xb5\x88\x97\x2c\x06\x4b\x87\x07\x07\xb1\xf7\xde\x54\xed\x32\xb8\xce\x1d\x56\x68\xee\xb2\xae\x34\x54\x68\x43\x66\x7f\xdc\xc2\xf0\xbc\x3e\x6d\xe1\x8c\xa3\xc5\x10\x9a\xcd\xa6\x3b\x75\x9d\xb2\xfb\xcd\x00\x7f\x52\x0b\x47\x5b\x69\x01\x6b\x45\xea\x52\x81\x95\xe1\x1c\xd8\x74\xca\x65\x1a\xd9\x67\x97\x9f\x2d\x6c\x4f\xdf\xdd\xd9\x20\x36\x3f\x9c\xe5\x17\x4f\x96\xff\x08\x96\xef\x27\x5b\xa6\xb0\x90\xc0\x79\x13\x69\x02\xb6\x8e\xb4\xce\x21\xc9\xa7\xec\xae\x8d\xab\x1f\x2c\xc9\xdb\x26\x8b\xdb\x43\x02\xe7\x44\x7b\xca\xe3\xb6\x86\x49\x8f\x75\xea\xe7\x70\x40\x70\x5f\x20\xf0\x8b\x80\x71\xbb\x00\xb4\x4d\x76\xe9\x77\x0c\x5c\xfb\xdf\xb2\x6f\x05\xb7\xfd\x6f\xda\x6f\x1d\xfd\xb6\x89\x7c\xcb\xf1\xde\x6e\xa9\x06\x7c\xad\x88\x71\x7f\x68\x71\xc1\x09\xf8\xa6\xd5\xa1\xef\x2e\x2c\x89\x9a\xd8\x49\xeb\x5b\xaf\xdf\x27\x94\xf9\x28\xeb\xcc\xb5\xea\xb2\x0d\x07\x3d\x03\x48\x79\x31\xa3\xfd\xa5\x10\x4f\xde\xf3\x44\x21\xbd\xc1\x83\xcf\x7c\x46\x48\x93\x40\x1f\x11\xad\xbe\x24\x31\xe1\x7f\x21\xb0\xe9\xf4\xb4\x33\xd2\x44\x7d\xf9\xdf\xc4\x3b\x34\x6e\x08\x41\x74\x58\xa0\x89\x62\x65\xf6\x2b\x0a\x5f\x12\x0c\xfc\xcc\x67\x4e\x3d\x0f\x75\x62\x7a\x03\x35\xbb\xdb\x6c\x5f\x20\x96\xfc\x1a\xd4\xb4\x09\x20\xfd\xcc\x67\x67\x56\x9e\xdd\xa0\x29\x01\x84\x65\xb0\x74\xd3\xc0\xf6\xb3\xe4\x36\x94\x3d\x45\xb2\x95\x91\x6c\x43\x53\xd9\x30\xe6\x6d\x6b\x88\x0b\xd1\x71\x3b\x60\xd8\x65\x34\x9b\xc1\xc2\x7d\xcb\xe1\x4f\x24\x64\x3c\x5f\x02\x00\x3b\xd6\xc4\xce\x32\x7f\x6d\x0b\x65\xe5\x42\xd8\x31\x13\xff\x8b\x2f\x94\x00\x46\xae\x45\x91\x1b\xc0\x47\x7f\x1b\x23\xdc\x9e\xfc\x6b\x41\xc5\xfd\xdf\x58\x39\x34\x2a\xfc\xd2\xe0\xe0\xe1\x6e\x78\xfc\x25\x60\xe1\xd7\xa4\xae\x4d\xe0\xa1\x93\xeb\xa3\x48\x83\x6b\x33\x4f\x48\xf1\x09\x29\x3e\x4c\x00\x7c\x42\x8a\x4f\x48\xf1\x09\x29\xee\x13\x29\xfe\x3a\xa5\xcb\x35\xa5\x7e\xc2\x89\x56\x15\xfb\x86\x8a\x87\xbe\xcf\x66\xa5\x38\x38\x5c\x1c\xd1\x47\x3d\x06\xd0\xf9\xf1\xea\x0d\x97\x6e\x8d\x29\xb6\xbe\xac\xfa\xef\x70\xb3\x6d\x07\x35\x7d\x65\xb7\xdf\xb6\x45\xcc\x0f\x65\x48\x70\x8f\xcb\x71\x7f\x9b\xda\xb7\x31\x67\xe7\xf6\xb5\xcc\xc5\x0c\x97\x68\x0d\x81\xfd\x65\xbe\x1e\x04\x27\x37\xff\x36\x35\x6c\xec\x3e\x39\xf9\x81\x8d\x2b\x22\x8d\x2b\x40\xbe\xfd\x02\x16\xbf\xbb\xa3\xfe\x68\x96\x25\x9f\xcf\x37\x42\xe4\x4f\xb7\xf6\xbe\x9a\x5b\x7b\x2e\x4e\xd1\xf4\x0f\xdc\x0c\xed\x86\xda\x4a\x0b\x02\x36\xbc\xe0\x75\x8f\x3b\xf5\xab\x97\xde\x23\x41\xb8\x0d\x31\xd9\xda\x0b\x66\x2d\x65\xaf\xbc\x6e\xd6\xdd\xf6\x7e\x36\xba\xc1\x11\x95\x66\x53\x34\xa0\x5e\x65\x29\x2b\x9b\x76\x4f\x5b\xdd\x63\xf5\x55\xc6\x2b\x66\xd8\x36\xd7\x19\x37\xb5\xf9\x6a\xb0\xc7\x30\xfb\x9d\xd2\x77\x37\x15\xb5\x00\xfb\x8f\x58\x8f\xb4\x2c\x60\xf1\x26\x64\xe7\x05\x4c\x97\x70\x3c\x20\xe6\x59\xfd\x35\xa7\xc6\x01\xde\x57\xb7\x3c\xf1\xc7\x27\x30\xff\x19\xb9\x8f\x66\xfa\xaf\x9c\xda\xaf\xc1\xdb\xef\x93\xf3\xa4\xa4\x2a\xfa\x2a\x7c\x52\x6a\xa3\xf2\xba\x3d\x1b\x33\x21\xb5\x71\xff\x57\x45\xf5\x01\x42\x97\x59\xe0\x30\x3b\xe7\x15\xc1\xb7\xb8\x07\x30\xba\xb5\x5f\xe9\xc7\x06\xf4\x71\xe4\xcb\x6a\xd0\xbe\xdf\x6b\xde\x25\x8b\x40\x8e\x0f\x9e\x43\x58\x1d\x73\x50\x53\x6e\x3f\x48\x7f\xb8\x0f\x55\xae\x43\xbc\x56\xf9\x5f\xc7\x39\xd4\x0d\xb1\x6a\xed\x74\x47\xb7\x51\xcb\xef\x6e\xff\x29\xa3\x07\x9f\xcb\xc7\xfd\x1c\xd1\xcf\x5e\x2c\x2f\x28\xba\xb2\x75\xf1\x7c\xb9\x2b\x22\x2f\xd1\xb5\xc4\xda\x1e\xa3\xfe\xaf\x7a\x90\x8e\xe6\x38\x40\x20\x2f\x9d\x38\xff\xe1\x45\x62\x6e\xe3\x2b\xfa\xc4\xfb\x59\xa7\x2e\x4c\x51\xf2\xba\xc2\xfd\xc7\x41\xdd\x4d\x29\xd5\xab\x34\x32\xff\xff\x00\x00\x00\xff\xff\x84\x63\xb3\x14\x08\x6b\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6d\x73\xdb\x36\xb6\xff\x6b\xf1\x53\x9c\x3f\xe7\x3f\xbe\x54\xaa\xd2\x6d\xef\xcc\xbe\x50\xd7\x3b\x13\xc7\xc9\x6e\xee\x36\x6d\x6f\xd3\xde\x9d\xb9\x99\x4c\x03\x93\x90\x84\x35\x49\xa8\x04\x68\x5b\xab\xd5\x77\xbf\x73\x80\x03\x12\xa4\xa8\x27\xcb\x4e\xdc\xc6\x75\xa7\x95\x48\xe0\xe0\x9c\x1f\xce\x23\x08\x11\xa7\xa7\xc0\xcb\x52\x96\x0a\xe2\x38\x0e\xae\x59\x09\x51\x00\x00\xf0\xb2\x2c\xbf\x97\xfa\x95\xac\x8a\x14\xce\xa8\x49\xfc\x3d\xbf\x89\xc2\x92\x27\xb2\x4c\xa1\x90\x1a\x26\x78\x3b\x1c\xba\x0e\x2f\x6f\xe7\xa2\xe4\xe9\x0b\x59\x68\x7e\xab\x3b\xdd\x12\xba\x3a\x63\x0a\xb8\x6d\x18\x0e\x83\x61\x10\x9c\x9e\x3e\xbb\xf3\x3f\xc1\xe9\x29\xbc\x91\xc5\x54\x5e\x9c\xc3\x0b\x59\x4c\xc4\x14\x58\x91\xc2\x5b\xae\xab\xf9\x71\x84\x91\x32\x51\xe4\xf9\xa5\x4c\x05\x57\xa0\x67\x1c\x52\xa6\x19\x54\x8a\xa7\xa0\x25\x24\xb2\x28\x78\xa2\xf1\x63\xa5\x78\xf9\x1f\x0a\x72\x64\xc6\x5d\x17\xb2\x88\x03\xbd\x98\x73\x47\x49\xe9\xb2\x4a\x34\x2c\x83\xc1\xc5\x39\x62\x06\x00\x4a\x97\xa2\x98\xc2\x07\x2d\xf3\x6c\x1c\xa6\x97\x21\xfc\x53\xc9\xc2\x7c\xfa\x10\x0c\x9e\x57\x7a\x76\x71\xbe\xd6\x8c\x55\x7a\xd6\x34\xa5\x6f\x1f\x82\xc1\x2f\x8a\x97\x3d\x54\x91\x37\xd7\xd8\x7c\xfe\x10\x0c\x7e\x64\x4a\xdd\xe0\x3c\xb6\x9b\xce\xe9\xb2\x6b\x5e\x7f\xff\x10\x0c\xfe\x26\x95\xee\xa1\x3e\x93\x4a\xbb\xe6\xe6\xf3\x87\x60\x85\xb3\x0a\x2f\xf3\xb9\x5e\x40\xc9\x75\x55\x16\x0a\x74\x59\xf1\xd3\x09\xcb\x14\x07\x31\x01\x96\x65\x0e\x94\x6b\x96\x55\x5c\x01\x2b\x39\x30\x0d\x29\x9f\xb0\x2a\xd3\xa7\x1c\x3b\x9f\x16\xb2\xf8\x52\x71\x8d\xd4\x94\x66\x9a\xc7\xc1\xa4\x2a\x12\x88\xf2\x69\x42\xdd\x87\x76\x98\x68\x08\x97\x52\x66\x08\xad\x1d\x10\xf2\x69\x12\x13\x7c\x67\x67\x10\x86\x70\x72\x12\x0c\x06\x78\x75\xfd\x8a\xc1\xad\x73\xad\x06\xa8\x73\xdd\xa0\x60\xae\x91\x98\xff\xc3\x32\x91\x32\xcd\x6b\x49\x59\x61\x15\x1f\xe5\x44\x95\x49\x0c\xa3\x20\x14\x88\xe2\x1a\x1b\xf7\x49\xe1\xa8\x44\x43\xea\xbc\x0c\x06\x62\x02\x1d\xee\x96\xc1\xc0\xc9\xe7\xdb\x96\x25\x62\x1b\x0a\x05\x25\xff\xad\x22\xfb\x1a\xac\x6a\x32\x1d\x81\xb6\x93\xaa\x1b\x6f\x24\xd7\xc2\x76\x3b\x31\x6a\xba\x91\x54\x03\xe9\x0e\x01\x4d\xc3\x8d\x64\xf6\xe4\xa6\x97\x13\x6a\x5e\x88\x0c\x67\xd5\x77\x2b\x29\x9f\x88\x02\xf5\x13\x44\xa1\x79\x39\x61\x09\x87\x9b\x99\x48\x66\xe8\xc5\xa4\x32\x77\x72\xae\x67\x32\x85\x89\x2c\x51\x09\x4a\xc1\xaf\xd1\x3e\x18\x92\x31\x0e\x21\xbe\x60\x9a\x5d\x32\xc5\x8d\x77\xb2\x97\xde\x72\xa5\x1a\x07\xe1\x46\x6b\xc6\x58\x06\x03\xc4\x50\xa8\x92\xb3\xd4\x28\xf7\x10\xa2\x67\xb9\x47\x6c\x04\xcf\xf2\x86\xd0\xc8\x22\x3f\x24\xad\xfc\x9e\xdf\x38\x9a\xb5\x5e\x42\xc1\x6f\x40\x14\x4a\xb3\x22\xe1\x20\x27\xc0\xdc\xb8\xa4\x91\x4d\xa7\x08\x95\xb6\x56\xce\x67\x74\xf5\x75\x3e\x37\x26\x96\x4f\x61\x7c\x06\x27\xde\x55\x9c\x37\xdb\x7a\x8c\xde\x6f\x32\x42\x54\x83\xc1\xe9\x29\x3c\x4f\x53\x98\x88\x82\x65\xe2\x5f\xbc\x44\x4f\xc9\x0b\x55\x95\x1c\x92\x4c\x9a\xff\xcb\x09\xe4\x4c\x69\x5e\x82\x72\x88\x0c\xca\xaa\xd0\x22\xe7\xf1\x5b\xae\x5f\xb9\xae\x51\x3e\x1d\x01\x72\x19\x69\x56\x4e\xb9\x6e\x31\x35\x44\xae\x06\xf6\x46\x9c\x67\xf1\x77\x32\xb9\x8a\x86\xc1\x60\x90\xf2\x09\x8e\x5a\xdf\xf8\xa5\xc8\xdc\x2d\x31\xa9\xaf\xdb\xf1\xff\xdf\x19\x14\xc2\xc8\xd7\x90\x32\x77\xe2\x17\x99\x54\x3c\x1a\xae\xdd\x00\xd3\x23\x18\xa0\x12\xae\x86\x81\xe7\x7b\x68\x16\x3c\x16\x3d\x45\xea\x06\x09\xc8\x59\xc1\xa6\xc8\xe7\x8c\x69\xb8\xac\x44\x96\x2a\xd4\x1d\x96\x65\xf2\x46\x41\xa5\xd8\x94\xa6\x6b\x2a\x8c\x66\x21\xc2\x62\x5a\x95\xcc\xf4\xd6\x12\xa6\xbc\xe0\x25\xfa\x20\x9c\x61\x43\x1e\xfb\x13\xa0\xca\x68\x5d\xea\x54\xd0\x29\x80\x6a\xab\x1e\xc2\xe8\x05\x28\x3b\x99\xc1\x20\xcf\xd0\xe3\x83\x5a\x14\x49\xfc\xa6\xd2\xfc\x36\x18\x90\xec\xbe\xf2\x35\x4a\xd7\xd1\x36\x62\xa1\xcd\xc1\xa4\x94\xb9\x09\xa6\x7d\xe2\xc4\xc1\xe9\x29\x32\xff\xbc\x9c\x56\x39\x2f\xf4\x18\xbf\x80\x35\x83\xb1\xb1\x03\x6a\xf0\x75\x0c\xaf\x27\xf0\xc1\xde\xf9\x80\x36\x6d\xa2\xcb\x08\x29\x17\xf8\x1f\xf0\x18\xc4\xdb\x49\x26\x0b\x9e\x82\x92\x16\xe7\x1b\x0e\x25\xff\xb2\x52\xdc\xb4\xe5\xb7\x42\x69\x51\x4c\x6b\xdc\x2e\x17\x26\xc5\x41\x35\x15\xc5\x74\x84\xdd\xa4\x9e\xf1\x52\x01\x2a\x1f\x76\x93\x93\xc2\x9b\xc4\x11\x88\x02\x54\x95\xcc\x20\x31\xb6\x29\x34\x64\x5c\x2b\x58\xc8\x0a\xe4\x5c\x8b\x5c\xfc\x8b\xc3\x4d\x29\x34\x57\x86\x98\x2e\xcd\x00\x38\x1e\x8e\xef\x80\xaa\xcd\xd3\x53\x0f\xf4\x2b\x66\x6c\xea\xef\x20\xfa\x66\x0d\x01\x0c\xb2\x04\x40\x4d\x50\x41\x22\xe7\x82\xa7\xe4\xb5\x92\x92\x33\xcd\xdd\xfc\x54\x85\xf8\xad\x6a\x46\xb7\x4d\x16\xb2\x42\xf2\x6a\x26\xab\x2c\x35\x86\xca\x81\x4d\x50\xdf\x2b\x94\x4c\xcf\x84\x6a\x64\x9b\xb1\x22\xcd\x38\x64\x68\x4a\x80\x8c\x60\x9e\xc4\x34\xe4\x6c\x81\xe8\x68\x26\x10\xa5\x7c\x9e\x89\x84\x69\x9e\xc2\x6f\x15\x2f\x05\xc9\x40\x61\xb0\x63\xca\x77\x72\x7b\x68\xb6\xb9\x6f\xfa\xd6\xf2\xf3\x96\xd1\x1b\x87\x84\xd1\xc2\xd9\xaf\x50\xc0\x32\x71\x6d\x54\x00\xd9\x2c\xb4\x28\x2a\x0e\xdc\xe8\x51\xc9\x15\xd7\x80\xe9\x2a\x66\x18\x71\x30\xf0\x7b\x7a\xce\x42\x4c\x90\x07\xf4\x88\xee\x6e\xfc\xa3\x28\xa6\xd1\xf0\x5b\x73\xdd\x77\x2b\x79\x9f\xe3\x08\x82\x81\x42\xa5\x20\x22\x53\xae\x49\xb6\x28\x8f\xc9\x03\x9b\xb1\x3b\xc4\x9a\x90\x35\xb2\xff\xe1\x65\x69\xa9\x79\xa3\x28\xae\x02\xd3\x99\x00\x45\xe7\x96\xc8\xf9\xa2\xc5\xed\x0b\x39\x5f\x20\x64\x83\xf4\x12\xaf\xe3\xfd\xf8\xe2\xbc\x1e\x3d\xbe\x38\x1f\x36\xe3\xa5\x97\x23\x54\xa8\x85\x19\xd4\x8e\x67\x0c\xab\x4d\x11\xaf\x20\x49\xa2\x88\x5f\xd7\x49\xfa\x14\xb1\x85\x25\x69\x5d\x49\x83\x02\x30\xad\x31\x1b\x54\x18\x3c\x28\xbc\x72\xdf\x7d\x38\xe5\x45\x33\xa1\xcb\xbc\x20\xa7\x42\x81\xcd\xc3\x94\xb2\x31\x17\xda\xa2\x4d\xca\x24\x8a\x89\x44\xde\xf1\xf6\x85\x60\xd9\xeb\x62\x22\x11\xbd\xe7\x69\x5a\xaa\x31\xfa\xc4\x77\xef\x6d\xee\xbc\xa4\xa1\x30\x3b\x59\x8d\x82\xc1\xe0\x67\x91\x73\x59\xe9\x31\xc0\x9f\xbe\x82\x67\x40\xa1\x2c\x91\x45\x8a\x77\x9d\x1e\x8f\x1d\x8b\x36\x3d\xc2\x5b\x98\xc0\x15\x2c\x6f\x6e\xe1\x05\xbc\xe1\xd2\xb1\xfa\x86\xbb\xd0\xc4\xd9\x17\xc6\xae\x81\x75\x6c\x39\x67\xc2\xd8\x20\x1a\xfc\x1c\x33\x64\x39\x01\x25\x93\x2b\xae\x3d\xdf\xa5\x8c\x61\x68\x09\xb2\x2a\x5d\x3c\x88\xdb\x5a\xe9\x60\xf8\x87\xd0\x33\x84\x22\x3a\x41\x80\x76\x2a\x66\xad\x93\x8a\x2b\x0c\xe7\x6f\x64\xca\x23\xa4\xf5\x46\x16\x52\xcb\x42\x24\x23\x53\x19\x78\xc1\xd3\x8c\x5a\x2b\x02\xd5\x63\x77\xf8\x43\x2d\xba\x38\x87\x9f\x17\x73\xae\x8e\xaf\x05\x97\xcb\xf8\xad\x09\x8e\xf1\x0f\x97\xff\xe4\x89\x8e\xbf\x67\x39\x5f\xad\x5e\x09\x9e\xa5\xaa\x89\xed\xc5\xc6\x2c\x91\x72\x44\xab\xc3\x98\x9a\x32\xc8\xd9\xdc\x84\xf5\x2c\x43\x5e\x99\xd6\xa5\xb8\xac\x8c\x6f\x56\x4a\x26\xc2\xb8\xcb\x1b\xa1\x67\x46\xd9\xed\x10\x29\x85\x68\xcc\x9c\x18\x8e\x9b\x88\x94\xa7\x70\xb9\x30\x6d\xea\x7b\x14\xda\xb7\x33\xed\xb1\xba\x0c\x06\x56\x92\x68\x08\x51\xce\xe6\xef\xac\x66\xbf\xaf\x5b\x2c\x57\xce\x36\x82\xd5\x36\x3c\x5e\xc8\x42\x55\x39\x2f\xb7\x21\xc2\x92\x84\xa3\x39\xd7\x00\x60\x6a\x42\xf7\x6e\x44\x96\xc1\xa5\xa9\x99\x90\x4e\x8a\xc0\x88\x42\x4b\xdf\xde\x45\x3e\xcf\x38\xa6\x06\xa2\x98\xde\x07\x1c\x35\xcf\x0d\xa3\x36\x01\x42\x0e\x36\xa0\x41\x55\x5a\xbb\x04\x44\x27\xb4\x53\x13\x9a\x72\x41\x4b\xb8\x76\xb5\x63\x9d\xda\x21\xa3\xc4\xae\x47\xb5\x19\x39\x18\x74\x2b\x45\xe2\xc1\xcc\xdf\x4b\xbc\xd0\xf0\x00\x13\xbc\x68\x74\x0c\x68\xd9\x66\xc2\x44\x86\xb8\x31\x28\xab\x0c\x73\x18\x10\x5a\x35\x7c\x68\x36\x1d\x59\x9d\x33\xf4\x10\x7e\x0c\x82\x5a\x9a\x66\x73\xa6\x67\xe6\x2e\xa6\x3a\x5a\x96\x3c\x85\x54\x26\x26\x4d\x43\x4f\x11\x4f\x21\x14\x9a\xe7\x2a\xfe\x2a\x56\x57\x55\x48\x72\x78\x9c\x35\xf9\xa5\xb9\x58\xaf\x25\x04\x83\x9f\x90\x1b\xef\xfb\x1b\xae\x4c\xda\x4b\xdf\x69\x45\xc1\x10\x71\x19\xa6\x9e\xb5\x68\x33\xa3\x51\xb6\x1b\x31\x83\xbe\x14\xf2\x4a\x69\x54\x29\x86\x09\x19\x53\x1a\xfe\x13\x92\x19\x2b\x59\xa2\x31\x99\xcb\x64\x31\x0d\x29\x4a\x44\x13\x8f\xde\x10\x57\xb4\x64\x19\x0d\x89\x25\x6f\x69\x61\x12\x9b\x66\xf0\x05\x84\x10\xc2\x17\x30\x89\x89\xdb\x35\x75\x68\xcf\x07\x32\xcc\x3d\x11\xb8\x2d\x19\xd7\xe6\x06\xdb\xe1\xec\x28\x9c\x1e\xfc\xe2\xa6\x07\x31\xd0\x6c\xaa\xdc\xb4\x99\xe9\x55\x23\xc8\x6c\xee\x0a\xfc\x9a\x97\x8b\x9a\x8c\xb9\xbb\xa6\x4b\xbd\x13\xa1\xe0\xdd\xfb\x46\x74\x12\x63\x1d\x6d\xa4\x5c\x95\x5c\x91\xd7\xb2\xea\xa5\x40\xf1\x39\xc3\xea\xc3\x18\x5e\xf8\x2d\xd4\x78\x5e\x77\xc7\xed\x03\x35\xb7\xd8\x29\x13\x65\xd8\x15\x8f\x5c\x5c\x1d\x41\xc6\x8b\xe8\xda\x82\xad\x86\xc3\x60\x80\x68\x89\x22\xe5\xb7\x23\xd2\xec\xf1\x19\x94\xac\x98\x72\x70\xad\x90\x60\x4d\xf1\x9d\x69\xfb\x1e\xce\x6c\xeb\x98\xc6\xb6\x21\x93\xe6\x32\x24\x6c\xd1\x76\x51\x3c\x9e\x8e\xcd\x9c\x5a\x16\x54\xfc\x5f\x52\x14\x91\x23\x38\x32\xd2\x91\x0b\x74\xe1\xe1\xce\x91\xe9\x55\x55\x50\xf4\x3d\x3a\x3a\x3d\x4f\xd3\xd7\x28\x2b\xb0\x34\x55\x30\x2f\xe5\xb5\x09\x0b\x46\x7e\x5c\x86\x62\xc5\x02\xb3\x27\xf2\x31\x89\xcc\x32\x2a\x2d\xc8\x98\xeb\xd2\xcc\x46\xab\xda\x83\x3a\x4a\xfe\x82\x88\xab\x50\x68\x92\xdd\xd0\x51\x7a\xe9\x9a\x8c\x20\x47\x1f\x57\x8a\x44\xc5\x6f\xec\xff\x47\x90\xc8\x8c\x26\x1d\x2b\xa5\x94\xdf\x72\xb3\xe4\x8c\xb9\x80\x61\x9d\xbc\x19\x2c\x9b\xd4\xfd\x85\xe5\x93\x48\x44\xe1\x06\xff\x7d\x71\x1e\x3b\x26\xc2\x61\x60\x16\xa3\xc5\xc4\xe8\x0e\x8d\x33\xc4\x75\xa1\xaf\x60\x19\xd0\x9a\x2b\xe9\x34\x66\x28\xe6\xd2\xca\x76\x72\x20\x8c\x5c\x0a\x55\x27\x3f\xe9\xa5\x59\x41\x32\xf5\xe5\xd0\x0d\xd0\xca\x7a\x1c\xe5\x3c\x7e\x99\x0b\x1d\x39\xe9\x8d\xc6\x4d\xa2\xf0\x95\xd1\x2c\x9c\x02\x5b\x7e\xd5\x49\x5a\xad\xd1\xe1\x70\xe4\x3a\x61\x8a\x15\x85\xcd\x24\x85\x06\xbc\xee\x7d\x83\x56\x68\x58\x74\x8a\x3d\x1c\x76\x25\xc4\xf4\xcb\x97\xd0\xd4\x44\x34\x76\xbd\xaa\x61\x6e\x79\x3a\x31\x3e\xab\xa1\x88\x5f\x44\x38\xb4\xc5\x07\x79\xfd\x95\x26\xaf\xb1\x3c\x37\x97\x0d\x06\x84\xcd\xf8\xcc\x23\x1a\xbf\x34\xab\x3e\x66\xa6\x23\xd3\xa5\x5b\x1d\xb9\xde\x7b\xa1\x48\x6b\x48\x0e\xc5\xbb\x21\x68\x7b\x91\x40\x87\xc1\xdb\x03\xb1\x07\x73\x8f\x08\x26\x65\x0e\xdf\x56\x49\xc2\xb9\xb5\x4c\x2b\x43\xc7\x1c\xef\x43\x90\xa1\x9b\xf1\x60\x23\x1f\xaf\x44\x21\xd4\x8c\xa7\xe8\x2e\x90\x83\x3d\x87\x1d\x06\x9e\xdc\x4d\xa9\xf6\x42\x56\x85\xee\x56\x69\x68\x5f\x18\x32\xb4\xd4\x2c\x83\xa2\xca\x2f\x79\x89\x61\xc3\x85\x3a\xb7\x04\x94\x5e\x92\x1f\x31\x54\xa2\x44\xdf\x02\x3d\x29\x8a\xe9\x39\xd2\x08\xf6\xf6\x2c\x43\x88\x44\xa1\xfd\x2a\xee\x70\x57\x62\xf8\xf0\xfc\x88\x50\xc4\x07\x3d\xdd\x42\x16\x87\x9e\xbe\x92\xaa\xaf\x3d\xfe\x0a\x82\xbd\xb5\x19\x17\x94\x08\x97\xc4\x8e\xbe\x6b\x26\x0e\x52\x56\x9a\x8d\x2f\xbf\x1e\xad\xf9\x83\x5d\x1e\xcf\x96\x66\x47\x39\xbc\x07\x12\x6e\x1f\xe9\x36\x7b\x3b\x5c\x84\x32\x6b\x20\x97\x4a\x16\xf1\x9b\xe5\xca\x74\x30\xba\xda\x40\xd0\xf6\x81\xf1\x2b\x51\xa4\x91\xe9\x38\xb4\x4a\x12\x0d\xbf\xfd\xc4\xd0\x18\x6e\xc2\x91\x59\x53\x5b\xdc\x1b\x6e\x1d\xbe\xad\xcb\xb8\xe0\x19\xc7\x0c\xcf\xf2\x7b\x24\xa7\xc4\x06\xb1\xd0\xc0\x4e\x0e\xc5\x8e\xd5\xf1\x28\xb9\xb4\xcb\x74\x3d\x1e\x04\x2a\xe5\xd2\xe6\x3a\xf5\x99\x57\x97\x99\x48\x5e\x5f\xe0\x3a\x23\xfc\x64\xba\xa8\xba\x9d\x50\x70\x71\x6e\x0b\x83\x19\xbb\xe6\xb8\x34\x62\x9a\x83\x48\xb1\x14\xc2\x35\x54\x7e\x3b\x2f\xb9\xc2\x54\x88\x0b\xb3\xf4\x7a\xb9\x00\x66\xd4\x05\x64\x69\x9e\x76\x82\x66\x66\xb5\x58\x16\xde\xaa\x53\xe3\x57\x7e\x64\xc9\x15\x9b\xf2\xd5\x2a\xde\xe0\x6b\x28\x07\x27\xf7\x67\x65\x3e\xd2\xff\x8d\x48\x8e\xd7\x17\xb5\x43\x3c\x22\xa9\xb2\x2c\xdd\x87\x2b\xdc\xdb\x22\x52\x33\xe4\x26\x25\x73\xc2\x85\x8d\x9c\x87\xea\x61\xbf\x51\x74\x6c\xa2\x63\x0f\xa7\xa7\xf0\x73\xa3\x77\x42\x81\x2c\xb2\x05\x64\x92\xa5\x54\xc0\xa1\xe2\xd9\xe5\x1d\x05\x65\x55\x00\x2b\x71\x5f\x84\xa9\xd0\x8c\x40\x98\xff\x18\x4a\xb8\xbd\x82\x67\x3c\x3f\x44\x4d\x4c\xc7\x99\x94\x57\x3c\x45\x70\xad\xd2\xfc\xcd\x7c\x8f\xce\xf9\x44\x96\xdc\xce\xd3\x10\xfe\xfd\xef\xf6\xdd\xe7\xb8\x64\x4f\x37\xeb\x39\x24\x4a\xcd\xa4\x99\x3d\x1c\xb5\xd3\xfb\x2b\xd7\xa8\x83\xa8\x73\x23\xc8\x8d\x29\x7b\x60\xd7\x9d\x36\xb9\xbc\x1e\x10\x3d\x20\xf1\x5f\x23\xfe\x99\xdd\x39\xd2\x25\xd7\x12\xcf\xb2\xe1\x4b\x38\x82\x13\xec\x7d\x54\xe2\x88\xd3\xe3\xd3\x34\x78\xa8\x8f\xaf\x69\x1b\x81\x3a\x24\x3a\xdf\x47\x3d\xf2\x28\x2d\xee\x80\xc8\x5d\x53\x42\x47\x66\xfd\x7c\xfc\x77\xbe\xb0\xb6\x13\x8e\x6b\xb6\x47\x3e\x7d\x31\xd9\x14\xe4\x7f\x32\x71\x86\xc2\xfc\xb7\x0f\x82\xe9\x61\xa1\xb2\x73\x73\x8f\x09\xd9\x0e\x38\x89\x7e\x66\x57\xf4\xfd\x5d\x5e\xcb\x3e\xe5\xf4\x1a\xd4\xb7\x57\x41\xa7\x51\x67\xf6\x1e\x3e\x7f\xd8\x03\x94\x2d\x1e\x6f\xb3\xb7\xf1\x3c\xe6\x7d\x39\x1b\x8f\xe4\x63\xf6\x35\xeb\x45\x1d\x3d\x4e\xf2\x72\x30\x96\xa6\x7e\x02\x56\x2f\xcb\xf7\x27\x60\xf5\x83\x6c\xbb\x86\xd9\x7e\x66\xb0\x33\xea\x7d\xb2\xb4\xad\xc5\x45\x3b\x45\xb3\x4f\xd8\x8e\x4e\xd1\x0e\x4d\x00\x8e\xca\xe1\x2c\xcf\x2e\x87\x5b\x2e\xd1\x20\x9c\x9b\xb4\xf7\x52\xa0\x1c\x03\xcd\x22\xe3\x79\xbc\x5c\x76\x1a\xac\x56\xf1\x6b\xf5\xbf\xbc\x94\x51\x2b\xd9\xdb\xd0\x16\xce\xec\x03\xcf\xef\xe5\x4d\xe4\x96\x22\x68\x6c\x5e\xd4\x83\xb5\x39\xf9\x65\x9e\xfa\x9c\x74\xd8\xa0\xbb\x7d\xa4\x1b\xa2\x1f\x2f\x47\xa5\x15\xbc\x0d\x8e\xcc\xb3\xe6\x8e\x18\x7f\xe7\x8b\xd5\xea\x7e\x2d\xbb\x63\xd5\x0d\x0a\x1b\xfc\x9b\xcd\x7c\xec\x54\xed\x70\x70\x7b\x39\x37\x9f\xde\x6e\xef\xf6\x29\xf0\x70\x8f\xa7\xc7\x67\x68\x72\xcd\xd3\x08\xb2\x90\xa8\x0f\x82\xc1\x60\xa7\xf0\xee\x81\xcc\x5d\xc3\x59\xbf\x18\xf5\xa3\xf3\xfa\xa9\xb9\x98\xb8\x67\x3f\xb2\x1c\x81\xbc\xc2\xa0\xd5\x3c\x0b\x24\xf6\xe3\xa8\x91\x6b\xf8\x2d\xb6\x6a\xef\x4a\xa9\x29\x38\xf9\x79\xff\xd6\x94\x4f\x24\x73\x4b\x68\xda\x10\xf3\x89\x96\xe3\x1f\x9b\xf6\xee\xc8\x85\xad\x13\x8d\x60\xc6\x14\x3e\x4b\x02\x0a\x02\x10\xda\x67\x61\x21\xc0\xd0\xb9\x54\xfc\x73\x4f\x0b\x09\x45\x23\x90\x7b\xea\x5f\x37\xda\x84\xa4\x87\x66\xeb\x1a\xfe\xbb\x19\x5e\x5c\xe1\xaa\x37\x16\x60\xa9\xbc\x21\x52\x35\x3a\xb5\x91\xf8\x26\x84\x77\x74\x40\x29\x69\xf6\x76\xb7\xed\x99\x9e\x76\x9f\x06\xa7\x9e\x09\xf3\x26\x6d\x7b\x91\xf1\xba\x50\xbc\xd4\x11\x66\x25\xf1\x9b\x68\x42\x8f\x37\x3b\x26\xd9\x03\xfc\x66\x9c\x49\x8d\x77\xa2\xbb\x0b\xcc\x2d\xd8\xed\x86\xea\x10\x70\x3a\x12\xd9\x35\x46\x0a\x24\xf7\xc0\xad\xcb\x0e\x70\x23\x9f\x67\x01\x75\xf1\xb8\x5c\xc6\x17\xb4\x71\x81\x30\xb2\xae\xf4\x80\xd9\xdb\x5a\x22\x7e\xc2\x49\xdb\x5a\x29\x3d\xc2\x69\x6b\xf3\x5b\x4f\x5c\x91\x7a\x49\xdd\x86\x74\xc6\xd4\x56\xf7\x97\xcd\x78\xe4\x1e\x67\x32\xd3\x5b\xa8\xfd\x95\xeb\xe7\x59\x56\x6f\x8c\xc4\x0d\xad\x19\xcd\x85\x6a\x2d\x92\xe3\x16\x6c\xb7\x99\x83\x81\xca\x84\xad\xcd\x0e\xa8\x44\x00\xb7\x92\x3c\xc6\xca\xcc\x62\x70\x74\x65\x26\xcb\x94\x97\xed\x6f\xe7\x8b\xfa\xfb\x1c\xb7\x23\x99\xc7\x8b\x25\x57\x73\x59\x28\xfe\x23\x2f\x7f\xa4\x8b\x43\x80\xe8\xdd\xfb\x03\xb0\x1c\x01\x1c\xfd\xa8\xd2\x8a\x8d\xc5\xdd\x40\xdd\x08\x9d\xcc\x88\x57\x15\xff\x2c\xbf\x93\x37\xbc\x8c\x8c\x44\x58\xfe\x0c\x70\x53\x3a\x84\xa9\x4a\xc2\x11\x84\x29\x57\x49\x38\xae\x35\xcd\x49\x7a\x06\xe1\x97\xb8\xf7\x85\xbe\x07\x83\x8f\x59\x56\xd5\x3b\x7b\xef\xe8\x47\xf6\x32\x26\xb3\x4f\xba\xf3\xc8\x0b\x4b\x04\x33\xb7\x7f\xc6\x4d\x22\x27\x27\x6b\xd3\x6b\xae\x63\x4a\x4d\x56\x55\x67\x50\x16\xff\xf3\xc5\x0f\x88\xd7\xfa\xb2\xb9\x81\xb1\xd6\x23\x2f\xbd\xaf\xe9\xe0\x1e\x15\xfa\x32\xf4\xf6\xca\x5a\xab\xde\xf0\xe8\x5c\xc5\xc1\xc0\xdc\xf9\xa9\xc3\x4d\xfd\x00\xdd\xe7\x62\xe7\x06\x5d\x07\x86\x19\x18\x9f\x4f\x18\xda\xff\x60\x85\xe6\x29\xed\x28\xf8\x59\xbe\xd5\xac\xd4\xa8\xaf\x6d\xb4\xbe\xee\x43\xeb\x2f\x04\x96\x47\x07\xce\xba\xad\x82\xc1\xa0\x45\xfa\x0c\xbe\x0a\x06\x2b\xb3\xef\x7e\x77\x67\x78\x06\xf3\x5e\x1a\x7e\xaf\x53\xf8\x26\x08\x06\x35\xb7\x7f\x81\xaf\x0d\xe1\x56\x97\x2f\xbe\x68\x76\xe0\x93\x7e\x36\xfa\xda\x5e\xbd\x3c\x1f\xff\x37\xc6\xa9\xb1\x9d\x72\x62\x24\x1c\x8e\x60\xad\x03\xba\x76\x4a\x79\xdd\x25\xf3\xb5\xb1\x15\xfc\x0b\x15\xca\x2d\x8a\xe9\xaf\x86\xa1\x70\x4c\xd7\x7d\xf6\xda\xa9\x67\x68\xa4\xfb\x95\x94\xe0\xd7\x1b\x23\x66\x38\x6e\xcd\x57\xbb\x83\x51\xbc\x9a\x72\xfd\x67\x2e\x77\x68\x93\x8e\x76\x1b\xd3\xe5\x4e\x63\x04\x74\x9d\xac\x99\x93\x4e\xcb\xce\xc4\xb9\x4e\x9d\xcb\x4d\xa7\x15\xa5\xe8\x94\x87\xed\xac\x00\xef\x61\x7b\x02\x25\x61\x34\xc0\xc7\xf3\x3a\x07\x3e\xe6\xa0\x1e\x68\xa0\xf8\x13\xa0\x1c\x37\x6c\x1e\x10\x6d\x28\xab\x42\x4f\x2e\xcb\xbe\x8a\x91\x76\x3b\x87\x30\x84\xf8\x82\x27\x32\xe5\xa5\xe9\xea\xd7\x8f\x38\x78\xea\x06\xef\xdf\x16\x5d\xb7\xdd\x9c\x3e\xfb\xdb\x28\xde\x5e\x89\x79\xe4\xab\xfc\x30\xfe\x4e\x60\x98\xf0\x94\x7a\x18\xbf\x95\xa5\x8e\x48\x15\x87\xf1\xf3\x2c\x8b\x4e\x2c\x1b\x47\x65\xdf\x75\xbc\xf1\xf3\xa5\x56\x3e\xd4\x42\xd0\xe4\x3e\x36\x9f\x4a\x2f\x8f\x4c\x72\x0f\xd2\xa1\x43\x1e\xda\xf4\xe9\x5c\xdf\x13\x9c\x66\x55\x76\x53\x2f\xa7\xa9\x9e\xb6\xfa\x5b\x00\x35\xcf\x9b\x1d\x80\xa4\x13\x6d\x56\xee\xfc\xa8\xbb\x23\xb3\x29\xd6\x50\x6f\xbb\x6a\xb9\x5c\xb6\xae\xac\x56\x11\xb2\xe1\xea\x00\x5c\x18\xc6\x60\xb2\x5a\x21\x13\xb1\xdb\xcc\x8f\x4d\x86\xf5\xa2\xf1\x16\xf5\xd9\x85\x49\x0f\x82\x48\x5b\xc1\x19\xb0\xf9\x9c\x17\x69\x64\xcd\x94\xea\xd1\xba\x65\xb3\x16\x6e\xb9\x0b\xd6\xc5\x7d\x10\x83\x29\x9f\x0c\xe6\x63\x1a\x4c\xcf\xb3\x89\x7a\xa7\x6f\x63\x38\xe4\xc9\x97\x41\x87\xed\x4d\xa5\xee\x77\x92\xa5\x23\x38\xb1\xdd\x68\x73\xfb\xfd\x3c\xa0\x44\xca\x1b\x6a\xde\x5d\x33\xb5\x7b\x32\x76\x43\x45\x10\x51\x43\x67\x39\xed\x2c\xb7\x5b\xf1\x52\xda\xfd\x99\x17\xbe\xa6\xf0\xf5\x2a\x90\x07\xab\x7f\xef\x52\xe0\x1e\x5f\xdb\x92\x64\x4f\x25\xee\x81\x25\xae\x33\x31\x92\xee\x0f\x95\x48\x1f\x9d\x44\x3f\xc2\x3c\xf8\x88\x0c\xb7\x75\xcd\x2f\x44\xef\x3d\x82\x6f\x1c\x69\xd3\x0c\xef\xe8\xd0\x89\xf1\x3b\x5a\xf7\x29\x48\x7b\x84\x46\x5d\x8e\x49\x01\x0e\x0f\xff\x4e\x21\x3d\xa5\x3c\xba\x56\xfb\xac\x93\x6d\x1f\xd0\xbb\x27\xda\xf5\xc7\x7d\x32\xaf\x07\xc8\xbe\x1e\x51\x06\xb6\x3f\xc0\x1e\x6c\x9d\x6c\xcc\xfd\x58\xae\xa7\x76\x41\xf5\x3b\x46\xd1\xef\xec\x24\x9f\x8a\x9a\x7d\x8a\x9a\x7b\xf3\x68\x3d\x86\x74\x77\x3b\xfa\xcc\xcc\xa8\xc7\x8a\x6c\x71\x58\xd7\x34\xe7\x0b\xb3\x4e\x5c\xab\xa4\xf2\x7e\x18\xee\x55\x33\x3d\x5b\x2d\xcd\x0e\x02\xb8\xe2\x0b\x53\xea\x98\x1f\xb0\x20\x4d\x57\xf3\x60\xdb\x03\xcc\xf2\x31\x17\x3b\x84\xd1\xd1\x95\x0e\x62\xe5\x3e\x1b\xce\xfd\x5d\x55\x58\xea\x1c\x80\xd7\xf1\x85\x0e\x4a\x95\x99\x57\xd1\x3d\x9a\x5a\xe4\x8a\x2f\x08\x99\xfb\x31\xa3\xae\x39\x1c\x80\xef\x72\xb5\x9e\xf3\x7f\xf2\x7a\xe6\x91\xe3\xb3\x7f\x4d\x74\xc5\x17\x63\x2b\xc8\x3d\x56\x47\xe8\xec\x60\x43\x65\x14\xac\x87\x89\x6d\x51\xff\x87\x82\x47\x27\x48\xef\x29\xcc\x6f\x0e\xf3\x07\x6a\xcb\x41\x09\xc1\x1d\x35\xd1\xd3\xc6\x3b\x57\x28\x41\x07\x92\x4f\x54\x9e\xdc\x2b\x10\xfb\x25\x47\x3c\x3b\x56\xe1\x3f\x4a\x42\x74\xaf\xc8\x10\x31\x14\xbd\xb7\xda\x58\xf7\x30\x07\x8c\xfb\x07\x70\x3b\x5b\xdd\xca\xf1\x73\xf9\x87\x74\x3b\xfb\x59\x9b\xd0\x9f\xad\xb5\x09\xdd\xb5\xb6\x4e\x55\x72\x40\x39\x42\x9b\x23\x5b\xcf\x5b\xfe\x40\xb5\xc7\xd1\x45\x87\xdb\x3d\x4a\x17\x3e\x7a\x99\xf1\x98\xea\x0b\x7f\x2b\xad\xfb\x78\xbf\x16\x73\x9c\xb5\x90\xa5\x7c\xfa\x42\xe3\xf7\x02\xd4\xfe\x15\xc7\x8e\x1f\x6a\x3f\x95\x21\x4f\x65\xc8\x53\x19\xf2\x54\x86\x3c\x95\x21\x4f\x65\xc8\x53\x19\xf2\x99\x97\x21\xb6\x0c\xb1\x2f\x00\xc0\x53\x97\x3e\xef\x22\xc4\xe2\x70\xdf\x75\xc8\x47\x7e\x23\x85\x15\xc2\xd5\x22\x14\x48\xdb\x6f\x7a\x70\x79\xdc\xe3\x7e\x0f\xc4\xc4\xbc\x44\x73\xe4\xa6\xa2\x7d\xb0\xd9\x81\x56\x67\x33\xfb\x5f\x45\xba\x25\xcb\xdf\x6e\x99\x64\x48\xce\xcc\x1a\x20\x36\x38\x1e\xfb\xea\x06\x3b\x19\x3b\x22\xfd\x5e\x7e\xc7\xa7\x77\x47\xd7\xf3\x10\x20\x3c\xd2\xf7\x3f\xdc\x5d\xd4\xfa\xd7\x5b\x7f\xc8\x77\x44\x1c\x83\xcb\xef\xe4\x3d\x12\x9b\xc4\x7a\x38\x68\x9c\x10\xeb\xd6\xf1\x80\x95\xfb\xd3\x5b\x29\x7e\x6f\x6f\xa5\xa0\xec\xc2\xe4\xf5\xf4\x7e\xfd\xe3\xf6\x56\x55\x86\xe0\x6e\x70\x0f\xa8\x2f\xf6\x31\x82\x5d\x86\xb4\xdd\x48\xee\x58\x83\x1c\x54\x54\x6c\x98\x9a\x3e\x45\xde\xfe\xa6\x85\xe0\xce\x2a\xb9\x15\xf3\x2d\x2d\x51\x69\xc2\x5a\x3b\xb6\xd1\xec\x9f\xaa\xba\xc3\xb6\x37\x74\xe0\x09\x49\x5b\xdf\xd2\xd1\x99\xa8\x9d\xca\x5c\x53\xfd\x04\xfa\xbc\x4b\x1d\xb7\xea\x3b\xc1\xdd\xf0\x3f\xda\x07\xe4\xc7\xad\xef\x6b\x2f\xf8\xe8\x00\x6f\x55\x9d\x32\xc9\x3b\x82\xba\x0f\x2e\x6d\xe0\x29\xe4\x6d\x4e\x98\x4d\xa5\x7e\x7f\xf9\xb2\x47\xee\x11\xa5\xcb\xbd\x6f\x18\x79\x79\xcb\x13\xb7\xd9\x10\x0b\xf0\x09\x9d\x5b\x42\xc7\x1a\xd1\x29\x95\x58\x64\xf3\x5b\x9e\x54\xe6\x16\x1e\x10\x03\x49\xa5\xb4\xcc\x9b\xf6\x6c\x8a\x07\x91\x69\x53\xf6\x36\xd2\x51\x71\x8b\xa3\x1c\x5d\xda\x7a\xc7\x06\x8e\x60\x72\x6b\x86\xc6\xe8\x66\x8f\x0c\xa4\x22\x55\xc8\x82\x2a\xd8\xa3\x0a\x59\x64\xf8\xa3\x3e\x52\xb3\xe8\x72\x90\x73\x3c\xeb\xb3\x2f\xcd\xdc\xa5\x32\x77\x51\x87\x9d\xe9\xb3\xc5\xfc\x61\xf3\xe7\x07\x11\x6c\x73\xe2\xdb\x78\x81\xc9\x6d\xd4\x09\x2d\x77\xb6\xfa\x87\x9e\xbe\x7d\xbd\xf8\x16\x0f\xbe\x0a\x3a\x8d\x3a\x90\x75\x64\xb4\x9e\xfa\x07\x27\x0f\x99\xbf\x59\xfe\x80\x1d\x92\x11\xd0\x6b\xbe\x06\x4f\xec\x52\x73\x96\xf0\x9a\x79\x3c\xd5\xd4\xf8\x8b\xd4\xbd\x4a\xb6\x7b\x86\xd6\x25\x1e\x34\x9a\x65\x6f\xe8\x04\x66\x06\xb9\x50\xaa\x7d\xb0\x4a\x1c\xe0\x81\x72\xba\x87\xfc\x19\x7c\xf3\x27\x33\xf2\xdb\x64\xc6\x73\x46\x54\x95\x7f\xec\x16\x9e\xbe\x3a\xf1\x88\x29\x73\xf6\x19\x0e\x70\xc0\x9a\x19\xa5\x07\x6a\x84\x63\x31\xf8\xff\xb8\x12\x48\x43\xba\x23\xd4\xcc\xc9\xbf\xba\x59\xd8\xa4\xd3\xb5\x48\x68\x3a\xb1\x0b\xd7\x35\xf5\x8c\x8b\x12\x94\xed\x8d\xe7\x81\x91\x0b\xb5\xf4\xf0\x28\x74\xf3\xa4\x15\x96\x3e\xc6\xae\x86\xf3\x46\x0e\xc7\x26\x39\x37\x9f\x57\xf5\x6a\xeb\xf3\xf9\x3c\x5b\x10\x6b\x8a\xeb\x2e\x14\x3e\xec\xd4\xaa\x59\xc9\x6c\xa3\x3e\xb2\x66\x4d\x6b\xb3\x28\x78\x73\x0f\x97\xa6\x84\x86\x54\x72\x85\xc7\xe0\xda\xf3\x77\x61\xc1\x75\x6c\x5e\xb6\x9f\xf1\x6b\x9e\xd9\xe1\xe9\x60\x40\xfc\xdd\x8d\x3d\x25\x9e\x78\xc1\xf7\xd3\xe0\xb9\xa3\x72\x82\x94\x43\x39\x99\xe0\xab\x8d\x70\x4d\x33\xd1\x21\x2e\xb6\x86\x39\x3e\x19\x66\x9a\x87\x23\x07\x1b\x30\x17\xb9\xb8\x59\x9c\xa5\x03\xd9\x1d\x75\x3c\x00\xcc\x9c\x6f\x07\x64\x6b\x20\x4b\x24\x8e\xa3\xd2\x4b\xff\xa7\x53\x77\x28\x63\x78\xc3\xca\xc2\x1d\x75\xe6\x81\xb6\x3b\x88\xf9\x11\x8b\x04\xa5\x6f\xc4\x9e\xdb\x20\xe2\xa2\xd3\x9e\x21\x86\x66\xba\x3f\xc4\xec\xeb\xce\xf7\x58\x0d\xe9\x77\x0e\x5b\x16\x12\x12\x99\xe7\x38\x03\x6e\x29\xe1\xa2\xa1\xb5\xc4\x78\x3a\x86\x90\x2c\x38\x1c\xe1\x91\x71\x95\x39\x01\x35\x5b\x8d\xd6\x9a\xd5\x7a\xd8\x34\x74\x5a\xbf\xb9\xb5\x90\xc5\x77\x08\x73\xd3\xc7\xa0\xbe\xb5\xc3\x73\xe7\xad\xa8\x87\x9d\x99\xd5\xc8\x97\x18\x37\xb5\x96\x5c\x55\x99\x26\xeb\x0a\x5c\xa0\xf7\x0a\x92\x9f\xaa\x22\x22\xf9\xf1\xb7\xfb\xa6\x79\x0d\xef\x6f\xbc\xac\xd7\xec\xd0\xab\xdb\xd3\x68\xcd\xab\x86\x8c\x83\xb7\x4b\x75\x27\x27\xa6\x61\xfc\x42\xa6\x1c\xfd\xfb\xba\x17\x6b\xf0\xa4\xa1\xde\x7d\xf5\xde\x38\x1e\x38\x83\xd0\x18\x21\x0f\xeb\x26\x7b\x33\x48\x62\x7a\xd3\xbd\x0a\xac\xba\xf7\x69\x62\x57\xe7\x87\x26\x0d\x23\xd6\x14\x47\xe7\xe9\xb1\x89\xaf\x23\xfb\xf3\x97\x89\xbe\x8d\x2f\xcc\x51\xc1\xcd\x4f\xb5\xbd\x21\x71\x1b\x7f\x7d\x3d\xe5\x13\x56\x65\xba\xb7\xa1\x51\x5a\xe2\x79\x15\x04\x7e\xe1\xf8\x56\x56\x65\x62\x1e\xbd\xb6\x17\x80\xdf\xca\xaa\x4c\xf8\x6a\xf5\x7f\x03\x00\x8b\x7e\x99\x89\x01\x85\x00\x00"),
           path: "mongo-functions.tml",
           root: "mongo-functions.tml",
         },
       
+        "mongo-hooks.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x53\xc1\x6e\xdb\x3a\x10\xbc\xf3\x2b\x06\x46\x0e\xd2\x83\x9e\x9c\x73\x50\xf7\x52\xa0\xe8\xa9\x2d\x10\xf4\x14\xe4\xc0\x48\x2b\x93\xb5\x4c\xa6\xe4\x3a\x4e\xc0\xf0\xdf\x0b\x52\xb4\xad\xc6\x41\xd1\xf4\x52\xc0\x00\xe1\xe5\x72\x67\x76\x66\xb4\x5c\xe2\x93\xb5\x9b\x6b\x96\x6b\x42\x4f\x83\x36\xe4\x21\xe1\xf3\x7f\x3b\x80\x15\xc1\xde\x93\x93\xac\xad\xf1\xb0\x06\x21\xb4\xd7\xec\x76\x1d\xb7\x5f\x65\xb7\x91\x6b\x8a\xb1\x3d\xd5\xbe\xdc\x7d\xa7\x8e\xdb\xcf\x72\x4b\x31\xc2\x51\x67\x5d\xef\x21\x59\x2c\x97\xd8\x2b\xdd\x29\x28\x6b\x37\x1e\x6e\x67\x1a\x18\xb9\xa5\x1e\x72\x60\x72\x19\x68\x4b\xac\x6c\x7f\x80\xf5\x79\x62\xea\x84\x64\x68\x6e\x05\x3f\xdd\xd3\x8c\xae\x36\x2c\xc4\x2f\x0b\x3c\xc8\x71\x47\xbe\x81\x36\x79\x82\x75\x3d\xb9\x57\xb6\x60\x45\x4f\x79\xee\x5e\xb3\xd2\xa6\x15\x9d\x35\x9e\x51\x89\x10\x9c\x34\x6b\xc2\x85\x36\x3d\x3d\x36\xb8\x98\x74\xb8\x5a\xa1\x4d\x20\x3e\x46\x00\x08\x61\xaa\xc7\x18\x82\x1e\x40\x3f\x4a\x3f\x2e\x63\x9c\x91\x59\x41\x5b\x96\x21\x90\xe9\x63\x14\xe5\xac\x33\x63\x75\x60\xec\xd1\x59\xc3\x52\x4f\xa4\xb2\x20\x3e\x11\x96\xe3\x78\xb6\x56\x2b\x1e\xa4\x9b\x3f\x5d\xe1\xe6\xd6\xb3\xd3\x66\x1d\x70\x60\x7e\xe0\xb9\x08\xa1\x8d\x71\xd1\xa0\xe0\x22\x66\xe0\xeb\xdc\x0e\x47\xbc\x73\x33\xcc\x83\x46\x47\xc8\x56\x0c\x3b\xd3\xa1\x52\xa7\x52\x5d\x1e\x57\x35\x26\x50\x04\x91\xd4\xd0\x03\x14\xde\xe1\x12\xcf\xcf\xd0\x86\x2b\x55\xe3\xfd\x0a\x23\x99\xea\x44\xb5\x2e\xbd\xe9\x37\x61\x63\xf1\xcd\x6c\x8c\xdd\x9b\xe3\xfc\x45\xee\x88\x42\xcc\x9a\x4e\x13\x6e\xd4\xad\x98\x56\x98\x62\x91\x9e\x25\x0b\xfd\x2b\xc1\x59\xeb\x87\x69\xc9\x14\xbe\xb3\x90\x95\xdb\xec\x60\x03\x3d\xa4\x99\x6f\xc9\x74\x4f\xdd\x28\x1d\xf9\x9c\xc8\xac\xd2\x89\x51\xd5\xf1\x63\x76\x94\x1e\xb9\xfd\x30\x9d\x4d\xf9\x98\x8e\x8b\x36\xa0\x91\xb6\xf8\xef\x0d\xa0\x35\xc8\x39\xeb\x8a\x8a\x7e\xaf\xb9\x53\x65\xec\x54\x0a\xe1\x7f\x94\xe8\x9e\x45\x36\x04\xe8\x01\x15\x94\xf4\x1f\x13\xdd\x8b\x02\x71\x68\xad\x11\x63\x1e\xd2\x49\x4f\xb3\x70\x5f\xbd\xb4\x2c\xd1\x6e\x4f\xf7\x69\xd9\xfa\x88\x9e\x53\x56\xc2\xf6\x8a\x93\x46\x8f\x67\xfe\x51\x5f\x6e\x3d\xd8\xed\x28\xb1\xfc\x2b\x23\x58\x51\x32\xb1\x84\xe0\x77\x7e\x9f\x19\x46\x7d\xf5\xc2\x9d\x1a\x77\xd6\x8e\xff\x58\xe9\x24\xc7\x9f\x2a\x3b\xc8\xd1\x93\x88\xe2\xe7\x00\x14\x47\xfd\xb1\xca\x05\x00\x00"),
+          path: "mongo-hooks.tml",
+          root: "mongo-hooks.tml",
+        },
+      
+        "mongo-migrations.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x6d\x6f\xe3\x36\x12\xfe\xee\x5f\x31\x15\x2e\x8d\xd4\x6a\xb5\xbb\xe8\xe1\x80\x73\xeb\x03\x72\xfb\x02\x04\xe8\xee\x01\xd9\xe6\xfa\x21\x17\x6c\x65\x89\xb2\xd9\x48\xa4\x4b\x52\x71\x82\xad\xff\xfb\x61\xc8\xa1\x44\xc9\x72\xe2\x66\xdb\xde\xed\x06\x48\x2c\x93\x33\x0f\xe7\x7d\x86\x7a\xfe\x1c\x5e\xc9\xba\x66\x85\xe1\x52\x40\xc9\x2a\x2e\x98\x06\xb3\x66\x50\xf4\x8f\xf3\xcd\xa6\xe6\xac\x84\x86\xaf\x54\x8e\x0b\x35\xe4\x8a\x81\x62\x85\x54\x25\x2b\x81\x8b\x14\xf2\x5a\x8a\x15\x6c\xb9\x59\xe3\xee\xd9\xf3\xe7\x50\xcb\xe2\x06\x4a\x59\xb4\x0d\x13\x06\xd6\xac\x2e\x61\x79\x8f\x5f\x82\x6a\x85\x60\xca\x92\xbd\xe7\x62\x05\x52\x81\x62\xb7\x4c\x19\xfc\x60\xd6\xac\xc9\x66\x85\x14\xda\x84\xd0\x16\x10\x7d\xec\xf9\x47\x33\xcf\xe1\xfc\xf5\x00\x35\x2f\x41\x56\xf6\xaf\x21\x7b\x04\xc6\x45\x40\xd0\xb3\x20\x1a\x0b\x88\xf0\x2f\x47\xf7\x7b\x59\xdc\xfc\xc0\x1b\x26\x5b\x33\x20\x5e\xb6\xee\xf8\x90\x57\x86\x29\xd8\xae\x79\xb1\x86\xdc\x1d\xb4\x66\x95\x81\x25\x5b\x73\x61\x8f\x99\xfb\x43\xda\x45\x48\x54\x1b\xb9\xd9\xb0\xd2\x8a\x08\x09\x2b\x56\xb3\x5c\xe3\x89\xb9\x01\xae\xc1\xe4\x37\x4c\x80\xbc\x65\x2a\x9b\xdd\xe6\x6a\x00\x62\x01\x2f\x5f\xc0\x57\x60\x78\xc3\xb2\x77\x5c\xb4\x86\x59\x9c\x4c\x29\xa9\x34\x64\x99\xdb\x11\xcf\x00\x00\xde\x28\x85\x5b\x59\x09\x0b\x5a\x90\xbd\x67\xdb\x38\x1a\x29\x0f\x41\x33\x07\x55\x48\xb3\x66\x8a\x00\x47\x89\xa7\xf2\xe6\x6e\xc3\x15\x2b\x5f\x49\x61\xd8\x9d\x19\x51\x2b\xe8\xe9\x3a\xd7\xc0\xdc\xc2\x28\x99\x25\x16\xd6\x3b\xcf\xa9\x13\x5e\x0e\xb7\x4c\x69\x2e\x05\x2b\xa1\x58\xe7\x62\xc5\xc0\x48\x27\x52\x52\x90\x06\x6d\xa4\xb2\xb6\x04\x8d\x14\x2b\x59\x2e\x53\x68\xf2\x92\x21\xc2\xcb\x0d\xe4\xa2\x44\xd2\xad\x28\xa5\xb0\xcf\x5e\xcb\xad\xc8\x7a\x56\xce\x22\xbd\xa1\x72\x01\x68\x99\x8a\x6c\x81\x2b\xf8\xb7\xe3\x9f\xcd\xcc\xfd\x86\xf5\xdb\x40\x1b\xd5\x16\x06\x3e\xd9\x43\xd3\x22\xe0\xc2\xfc\xed\xaf\xf6\xc9\xfb\xbc\x61\xb8\x86\x8b\x95\xfd\x7c\xb9\x81\xaa\x15\x45\x5c\x98\x3b\x20\x11\x64\x24\xa0\x14\xca\x25\x34\xe5\x32\x7b\x87\xf0\x5f\xff\x33\x71\xf2\xb2\xdb\x10\xec\x6f\xde\xb8\xb3\xc2\xfc\x60\x99\x83\x62\xa6\x55\xc2\x79\x26\xc9\x12\x65\x02\x02\x01\x92\xc5\x77\x1a\xce\x66\xc8\x0b\xe2\x66\xd5\x9f\x34\x21\x4a\x71\x42\xe7\xa1\x33\x3b\xc2\x50\x35\x26\xfb\xb0\x51\x5c\x98\x2a\x8e\x4e\xca\x8f\x27\x3a\x4a\xa1\x59\x65\x24\x12\xfb\x37\x0a\x23\x21\x58\x17\xd6\xf7\x87\xde\xe1\x7d\x8d\x34\xb9\xe7\x72\x50\x49\x05\xf9\x44\x38\x21\xad\x10\xcd\xc3\x2a\x81\x9f\x96\x5a\x8a\x79\xf4\x91\x97\xd1\x4f\x63\xfd\xf8\x2f\x51\x22\xf4\xed\x19\x31\xb2\x7e\x83\x0e\xed\xd7\x10\x82\xe8\x27\x3a\xcd\x07\x93\x9b\x56\x0f\x4e\xa3\x4d\x6e\xac\x64\x73\x50\x6c\xc5\xb5\x61\x6a\x02\x32\x6d\x1c\x40\xee\x44\x3e\xc0\xb0\x94\xb2\x0e\x1f\x9c\x99\x1e\x16\xa2\xe8\x1d\x58\xd5\xa0\xef\x45\x91\xbd\x6b\x0d\xbb\x23\x1d\x75\x00\x16\xd0\xe4\x9b\x2b\x6b\xa0\xd7\x1d\xa3\x4f\x3b\xf2\xbc\x0b\x42\x0a\x79\x59\x3a\x5b\x59\xf1\x5b\xd4\x75\x87\xdb\xf9\x9d\xd4\xbd\xaf\x58\xff\x4a\xa1\xd5\x6d\x5e\xd7\xf7\x50\x29\xd9\xd8\x9d\x5c\x70\x63\x6d\x16\xad\x07\x55\x4e\x56\x56\xf1\x9a\x41\xa1\x58\x6e\x58\x69\x35\xca\x0d\xfa\xe3\x69\xb3\x92\x37\xdc\x10\x27\x06\x82\x6d\x4f\x33\x38\x37\xb0\xc9\x05\x2f\x34\xf0\x91\x8d\xda\xc8\x21\x24\x5c\x6e\x90\xb6\xe7\x83\xa9\x80\x1b\xdd\x99\x38\xd7\xe1\xe1\xf3\x5a\xb1\xbc\xbc\x27\xf3\xf6\x87\x1d\x99\x39\x99\x75\x9d\x61\x14\x8c\x5d\x34\x2b\x59\x85\x01\xae\xce\x2e\x05\xc6\xbd\x38\x99\xd9\xc7\xbc\x42\xb3\xbe\xdc\xc0\x62\x01\x82\xd7\xa4\x3f\xfc\xb1\xa0\xe3\x81\x53\xf4\xc8\x4f\x74\x0f\xbe\x43\x6e\xdd\x25\x71\xec\x76\x1d\x79\x76\xc7\x35\x66\xb5\x14\xe4\x0d\xcc\x17\xc1\x61\xae\x7a\xe7\xba\xfe\x16\xbf\x3d\x9e\x79\xab\xd9\x30\x10\xc8\x0a\xc8\x5f\xd3\x8e\xe3\x10\xca\x34\x5f\x58\x40\xb3\x22\x17\xe8\x24\xa8\xbb\x58\x93\xd7\x75\xb0\xb1\x57\x9d\x76\xc1\x95\xf9\x7c\xce\x95\x47\x42\x9a\xe9\x69\xc5\x09\x5c\xf5\x76\x4a\x67\x3c\x4a\x37\x01\xb7\x39\x1a\xfd\x0d\x8b\x03\x4a\x29\xbc\x48\xa1\x66\x22\xee\xf1\xd1\x81\xd1\x22\x3f\xa2\x2e\xac\xb8\x6d\xaa\x09\xce\xd0\x0b\x39\xa0\xbf\x40\x5f\x60\xa2\x8c\xfb\x67\x48\x60\x20\x40\x2d\x95\xc9\x3e\xd4\xbc\x60\x83\x55\x78\xdc\x98\xa7\xf0\x33\x06\xa8\xc4\x3a\x79\xa0\x48\x8a\xad\xfd\x86\x2b\x7e\xed\x85\x0f\xdf\x05\x10\xae\x7e\xee\x9e\xdb\xcd\xbb\x64\x16\x06\xe7\x7e\x21\x29\x0b\x13\xa2\x0d\x23\x0f\x29\xc9\x56\x1e\xb0\x65\x8a\x81\x90\xa6\xf3\xf7\x7b\x66\x26\xf2\x23\x29\x30\x45\x67\x74\xb0\x31\x6c\x0c\x22\x85\xf5\x66\xac\x62\x34\xe4\x86\x42\x81\xd2\xde\xe3\xd1\x31\x1c\xc7\x2a\xe7\xb5\x26\x4b\xb8\xdc\x1c\x93\xf1\x52\x68\xa0\x61\x46\xf1\x42\x67\xef\xdc\xef\x04\x86\xea\xb6\xb9\xd4\x3b\xb7\x73\xe7\x26\xa3\xd4\x42\x5b\xe2\xa8\x5b\xaf\xb3\xcb\x4d\x44\x32\xbc\xcd\x15\xd8\xa2\x21\xa0\xe7\xbe\x61\x4a\xa1\x91\x60\x9e\xb2\xf6\x58\x98\x3b\x44\x96\x42\x43\x8a\x2d\x64\x0d\x5f\x35\x2b\xe9\x19\xd9\xf8\x62\x91\x04\x4a\x26\xe9\xa4\x9e\x1c\x7d\x76\xc9\x4c\xc7\x85\xac\x93\x6e\x2d\x46\x04\xa5\xe0\x8b\x71\xb8\x09\x74\xcd\x94\xea\x1e\x93\xed\x1d\x30\xeb\xfe\xb4\xb1\x17\x8c\xff\xc7\x2b\xf8\xe8\x43\x0e\xe1\x79\x20\xde\xf8\xff\x58\x97\x70\xd1\xb2\xc1\x17\xbb\xd9\x98\x32\xd7\xa4\x46\x2a\x10\x51\xc3\x63\x00\xc1\x81\xf6\x2a\xc9\x47\xc8\x93\x18\x6d\x60\xf6\x2a\x49\xbe\x3d\x2c\x37\xfc\xdf\x64\x6f\x1a\x6e\x62\x6f\x43\x6f\x50\x45\x55\x1c\xbd\xcd\x79\x8d\xe9\x5f\x5a\xe3\xbf\xef\x2d\x35\x4a\xd2\xce\xe0\x7e\xe4\x66\x1d\x04\x58\x1b\x46\x33\x5f\x2b\xed\xad\xb3\xda\x8f\x52\x84\xe3\xd8\xc4\x09\x05\x9e\x89\xa3\x63\x08\xf7\x58\x3a\x06\x18\xc1\xd1\x45\x58\x39\x87\x93\xaf\xbb\xb0\xad\x54\x72\x9c\x60\x0a\x59\x67\xe7\x42\x33\x65\x62\x67\x63\x9f\x48\xad\xf3\x41\xbd\x86\xc5\xda\xdc\x57\x6d\xa9\xaf\x3a\xe6\xae\xe8\x78\x2f\xb7\x71\x92\x5d\xfe\xf0\x2a\x4e\x76\x9f\x2d\x5b\xd7\x06\xfe\xe9\xc2\x0d\x1d\x65\x42\x60\x23\xd8\xe7\xa2\x92\x71\x44\x52\x78\x0a\xd6\x21\x0e\x1b\x50\xba\xbc\x81\x9f\xfa\x8c\x31\x02\x43\x70\x05\xaf\xa7\x42\xbb\xdb\x8a\x67\x71\x91\xdd\x76\x0a\xae\x19\x1e\x14\x70\xa2\x6d\x96\x2e\x5e\x37\x52\x63\xf3\x58\x30\x61\xea\x7b\xc8\xc7\x27\xd2\x29\xd4\xb9\x61\xda\xb8\xe8\x3c\x19\xd0\x1d\xfd\xdf\x18\xd1\x53\x6c\x52\x31\x91\xf4\xd9\x06\x69\x4b\xe5\x8b\xa1\xae\xc9\x09\x5a\x10\x7c\xf6\xc4\x0c\x90\x82\x36\x6c\xa3\x5d\x62\xfd\xbc\x64\x80\x28\xfe\xac\x74\x80\xf4\x9d\x47\x68\xb8\xba\x76\x1e\x3a\x9b\xf6\xe2\xb7\x5c\x94\x31\x36\x24\xd9\xbb\x4f\xb6\xa7\x99\x83\xff\xf4\x17\xc1\xa2\x39\xcd\x26\x76\xbb\x24\xfb\x20\x95\x89\xa3\x67\xb8\x28\xc9\xbe\xe7\x18\xec\xac\x74\x92\xec\xac\xae\xe3\x2f\x89\xe1\x43\xae\x3c\xe1\x35\xa1\x91\x0e\x4a\x32\xfc\xb9\x11\xa8\x4e\x5f\x7a\x4d\x34\x1d\x07\xea\x2f\x9f\xac\x7c\x41\xf1\x78\x25\xd6\x71\xbb\xba\x1d\xd4\xa5\xfe\xbb\x5d\xf7\xd7\x5e\x91\x18\x64\x46\x8a\x42\x01\x2b\xfc\xac\x47\x7c\x9a\xae\x12\x77\x1c\xdd\xae\x2e\x31\x0e\xd6\xf2\x0a\xbe\x98\xcc\x93\x0f\x47\x77\xec\x9d\xd1\x55\xbc\x67\x2e\x5b\x33\x72\x9b\x28\x85\x21\xdf\xee\x33\x06\xed\x5e\x8c\x23\x1d\x11\xa6\x66\x65\xed\x79\xbf\x67\x39\x0e\x9e\x9e\x74\xd7\x68\x18\xbe\xfe\xc7\x89\xdf\x47\x8d\xdf\x25\xf5\xbb\x60\xf7\x94\x90\xff\x59\xe9\xe9\x0f\xcb\xfd\x17\xac\x91\xb7\xec\xbc\x8c\xfb\x6c\xff\x3b\xc8\x08\x69\xf6\x32\x22\x7b\xfc\x3f\xce\xe4\x17\x94\xc2\x9e\xa2\xd7\x3f\x29\x95\xbb\xd9\x10\xeb\xfb\x69\x3f\x56\x6a\x35\xe6\xf1\x27\x77\xd7\x9e\xee\x31\x79\xd5\x66\x4e\xb7\x61\x94\x36\x8f\xf0\xe7\xfe\xb8\xe9\x01\x67\x26\xd9\x94\xb9\xc9\x97\xb9\x66\x29\x68\xa6\xb5\x4f\xd1\xe8\xcc\xe5\xd2\x0e\x9f\x8d\x6a\x29\xae\xf1\x6a\xda\x54\x43\x5e\xde\x1e\x3c\x75\x9b\xe0\x89\x72\xf6\xaa\x96\x9a\xf9\xf8\xff\x48\xef\xe5\x71\x65\xaf\xe2\x20\x65\x3f\x19\x08\xa6\x76\xed\x95\xea\xc5\x7a\x68\xe8\x70\xa0\x3b\x73\x8e\xf5\x40\x6f\xd6\xad\xec\x38\x75\xb6\xe9\x9f\xa4\x34\x76\xfc\xd4\xf1\xc0\x42\x3f\xa8\xf1\xe5\x4d\xf7\xe1\xcc\xcc\x7d\x72\xa1\x27\xbb\xd1\x60\xc8\x1e\xb7\x27\x8d\xc6\x4d\x23\xde\x56\xe0\x9d\xc0\xa0\x0c\x2d\x64\xd3\xe4\xa2\x9c\xc3\x69\xbb\x39\x4d\xe1\xb4\xc4\x24\x72\x65\x4b\x91\xeb\x53\xaa\x16\x4b\x56\xe5\x6d\x6d\xb4\xed\xbb\x00\x6f\x38\x6a\xb4\x7b\xb6\x49\x41\x2a\x24\x7c\xea\xb8\x9d\xa6\x60\x07\xce\x38\x68\xc0\x99\x9f\x62\xda\x6f\xdb\xda\xca\x94\xdb\x51\x57\x39\x31\x5d\x3c\x25\x57\xb8\x68\x9f\x5e\x5d\x6e\x81\xcb\xec\x47\xc5\x0d\x53\x29\xe4\x6a\x65\xef\x51\xdc\x28\x79\x58\xd5\xf1\xca\x56\x3a\xb8\x24\xc1\xac\xfb\x62\xa0\x4e\x1f\xc1\xba\x9b\x11\x76\xb7\x61\x05\x46\x26\x6c\x11\x64\x05\xed\x26\x05\x2b\x26\xe9\xad\x27\x1a\x68\x40\x6f\xb9\xc1\x6b\x24\xb5\xd2\x57\x2f\xae\x89\x76\x91\x6b\x06\x51\xbb\x89\xe6\x1d\xab\x2e\xbc\xa0\xe1\xf4\xad\x71\x0a\x4d\x32\xae\x85\x42\x3b\xc4\x6d\x01\x60\xfc\xc1\xd4\xf4\x96\x26\x8b\xdb\x14\xba\xae\xe8\x44\xff\x67\x5c\x08\x10\xc6\xc0\x61\xa8\xec\xf8\xf2\x4b\x2b\x14\xa4\xbe\x27\x94\x21\x8f\x5a\x58\x26\xef\x25\xa0\x11\x0f\x86\xd1\x3a\x9a\xe4\xd4\x0b\x35\x90\x05\x8a\x30\x90\x06\xda\x93\xc6\x53\xbe\xec\x1e\x0d\xf4\xf4\x0f\x78\x39\x42\xd4\x07\x25\x6d\x54\x21\xc5\x6d\x76\x66\x24\xb7\xcb\xaf\x5e\x5e\xf7\x40\xf6\x83\xc3\xaf\xbf\x82\x80\xef\xf6\x08\x1e\x48\xf6\x5c\xdc\xe6\x35\x2f\x83\x8e\xcd\x61\x3d\xf9\xfa\x97\x28\x85\x49\x76\x7d\x85\xdb\x1f\x6d\x01\x62\x4a\x36\x03\x2b\x08\xeb\x24\x3b\xb5\xb2\x5b\x3f\xd7\x1c\xba\xd4\xfa\x47\xdb\x03\x45\xbf\xa7\xd9\x03\xb9\x52\x68\x11\x3e\x84\x91\x74\xc2\x3c\x89\x12\x4a\xc6\xd0\xf7\x62\xff\x04\xb3\x11\x14\x92\x28\x65\xf1\x4e\xaa\x9e\xf7\x88\x16\xaf\x08\x95\x8f\xbd\xa3\xef\xa7\xa4\x7f\xf2\xec\xe5\x0b\x8d\x65\x7a\x7c\xa2\x13\x2b\xff\xee\xb6\xca\xf3\xcd\xba\xb8\x9f\x8e\xe8\x9f\x99\xec\xad\x54\x4d\x6e\x62\x3b\xe6\xb9\x78\xfb\xea\x9b\x6f\xbe\xf9\x7b\x92\x3c\x69\xd4\x77\x08\x99\x03\x45\xce\x3c\x01\x2a\x99\x4d\x10\x1c\x97\x4e\xb3\xd9\x01\xf7\x69\x85\xed\xcc\x7c\xa6\x81\x93\xaf\x7f\x49\xe1\x88\x98\x4a\xae\xf5\xe2\xda\xdf\x50\xfa\x3e\xde\xe5\xb0\x4a\x74\xef\x26\x04\xf6\x16\xbe\xe4\xb0\x5d\xe3\xc5\xd6\x5a\xd6\x25\xbd\x85\x30\x7c\x81\x21\x1d\xde\xe4\x6b\x08\x2f\xcd\xb5\xbb\x36\x9b\x7e\xa1\x21\x60\x47\x53\x16\x8d\xb7\x96\x56\x43\x2e\x8d\x85\x23\x87\x27\xe5\xb2\x4a\x3c\x36\xa1\xd8\x4b\x69\x47\x16\x7f\x9f\x57\xf7\x55\x79\xad\x1f\x29\xfc\x1e\xed\x4d\xdc\x55\xa3\xe7\xb0\x5f\xe5\xf7\x2a\x8c\xd2\xe0\xb2\xf9\x37\xb6\x24\x23\xb7\x7f\xbc\xf4\xc4\xbb\x81\xf9\xa2\x13\xc1\xb0\xc4\xb4\x9b\xe5\x16\xdf\xff\x98\x2f\xdc\x50\xe7\x3d\xdb\xfe\x6b\xf9\x33\x2b\xcc\x79\xe9\x49\x3c\x7f\x0e\x3f\xe4\x37\xcc\xbe\xf3\xd1\x1b\x1c\xb6\x06\x64\x56\x54\x50\x3d\xf4\xe6\x48\xe6\x85\xfb\x31\xdd\x6f\x12\x71\x36\x34\x9c\x2f\xa1\x49\x9f\xbf\x4e\xdd\xcb\x2e\x6c\x30\x71\xaa\x4d\x34\x98\x0e\x9f\x95\x65\xfc\x2c\x78\x09\x25\xd9\x1d\x1c\x15\x4f\x0b\x8f\x57\x21\x22\x1a\x59\x1f\x80\x63\x65\x15\xcd\xc1\xfe\x0e\xe1\xf5\x80\x0e\x72\xb7\x33\x11\x99\x9d\xeb\xd7\xed\x26\x66\xaa\xeb\x70\x46\xe8\xba\xf7\x62\x66\xfb\x69\x77\xfa\x00\xee\x9a\xc9\x3a\x56\x48\x73\x78\x2e\xd7\x8e\x1f\x75\xae\x83\x27\x38\xca\x0f\x9c\xd6\x83\x26\x5d\x5b\x36\x7f\x94\x43\xb8\x9a\x64\xe7\x6d\x95\x24\x54\x09\x8c\x31\x3e\xc4\x0e\xdb\x2d\x5a\xe3\xfa\x05\x3f\x7e\xa3\x46\x97\x56\x86\xe0\x0f\x74\xb7\x43\x9a\xd3\x11\x2d\x18\x47\x5e\x50\x3f\x35\xe8\x6d\x0f\xce\x60\x9f\x3c\x7f\x3d\x66\xca\xfa\x40\xe7\xe8\x8f\xbf\x3f\x4e\xf5\xf8\xb1\x98\xf6\xf4\x07\x17\xd9\x8f\xcf\x35\x89\xf8\x78\x96\x09\x0b\x5a\x1b\x02\x21\x8c\xb4\xa5\xeb\xf6\xac\xe4\xa7\x32\xc2\x38\x1b\x0d\xae\xb8\x35\x43\xd3\xea\x81\xd8\xc2\xec\xbb\x67\x85\xb9\xcb\x5e\x4b\xc1\xe2\xa4\x2f\xce\x02\xde\x38\x0b\xe8\x9e\x53\xaf\x38\xb9\xd0\x26\x8f\x19\x00\xc0\x6e\xb6\x9b\xfd\x77\x00\xbd\xc0\x26\x26\xa9\x28\x00\x00"),
+          path: "mongo-migrations.tml",
+          root: "mongo-migrations.tml",
+        },
+      
         "mongo-solo-readme.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6b\x23\x31\x0c\x85\xef\xfe\x15\x0f\x72\x49\x96\x61\x7e\xc0\xc2\x1e\x76\xb3\x2d\xe4\xd0\x73\xaf\xf1\xd8\x8a\x47\xd4\x23\x05\x5b\x69\xe6\xe7\x17\x4f\x92\x96\x42\x28\xc4\x17\x59\xe6\xe9\x7b\x7e\xe8\x45\x25\xe9\xff\x7f\xd8\x4d\xc7\x4c\x13\x89\x79\x63\x15\xf7\xe7\xe7\xe3\xdc\xfd\x39\x70\x85\x47\xd0\x48\x48\x24\x54\x2e\x8f\x47\x1f\xde\x7c\x22\xd8\xe8\x0d\xc7\xa2\xef\x1c\xa9\xe9\x06\x5f\x39\x80\xbf\x3b\x1f\xb4\x80\xc5\xa8\xf8\x60\x2c\x09\x67\xb6\x11\x1e\x27\x89\x54\x58\x08\x53\x33\x8e\x03\xa2\x37\x3f\xf8\x4a\xbd\x73\xcf\x9a\xb3\x9e\x9b\x78\x22\x1b\x35\x56\xf8\x42\x5f\x5c\x8a\xbf\x9d\x5b\xad\xf0\xca\x36\xee\x24\xd2\xec\xdc\x7e\xbf\x4f\xea\xfe\xc6\xb8\xf4\xeb\x60\x33\x82\x8a\xd1\x6c\xfd\xf6\x52\x3b\xc4\x01\xd7\x90\x1d\x82\x66\x54\x2b\x2c\xa9\x03\xb7\x11\xaa\xe8\xfb\x7e\x4a\xda\x2f\x84\x0d\xa8\x14\x2d\xd7\xd2\xf0\x8b\xe3\x56\x4f\x62\x37\xb7\xa5\x79\xc4\x6a\x83\x35\x8b\x75\x57\xf4\x27\xf4\x69\xa6\x70\x63\xb6\xfb\x83\xbf\xaf\x85\x7c\xc4\xa0\x9a\x3b\x1c\x66\x1c\x4e\x12\xd6\x4d\xf0\xab\x85\xd9\x6a\xce\x14\xda\x1e\xee\x25\x72\x1f\x01\x00\x00\xff\xff\x44\xd0\x5a\x18\x2d\x02\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x92\xcf\x6a\xdc\x30\x10\xc6\xef\x7a\x8a\x0f\x72\xd9\x14\xe3\x07\x28\xf4\xd0\x6e\x5a\xc8\x61\xa1\xb4\x94\x1e\x4a\xa9\x64\x69\xd6\x2b\x62\x69\xcc\x68\xf6\xcf\xe3\x17\xd9\x5e\x27\x85\xa4\x34\xf5\x65\x2c\x31\xf3\xfb\x66\xe6\xd3\x8e\x73\xcf\x77\x1f\x70\x9f\xc6\x81\x12\x65\x75\x1a\x39\x9b\x77\x7f\xff\x8c\x79\xbe\x0e\xb1\xc0\xc1\x73\x20\xf4\x94\x49\x26\x18\x46\xe7\x1f\x5c\x4f\xd0\x83\x53\x8c\xc2\xa7\x18\xa8\xe6\x75\xae\x44\x8f\xf8\xa7\xf2\x9e\x05\x31\x2b\x89\xf3\x1a\x73\x8f\x73\xd4\x03\x1c\x8e\x39\x90\xc4\x4c\x48\x55\x38\x74\x08\x4e\x5d\xe7\x0a\xb5\xc6\x7c\xe2\x61\xe0\x73\x4d\x4e\xa4\x07\x0e\x05\x4e\xe8\x91\x4b\xe1\xad\x31\x37\x37\xf8\x1e\xf5\x70\x9f\x03\x5d\x8c\xb1\xd6\xf6\x6c\xde\x87\x30\x9d\x37\x5e\x2f\xf0\x9c\x95\x2e\xda\x6e\xe7\xd8\x20\x74\x58\x86\x6c\xe0\x79\x40\x51\x89\xb9\x6f\x10\x6b\x09\x15\xb4\x6d\x9b\x7a\x6e\x27\xc2\x2d\x48\x84\x65\x09\x15\x3f\x29\x6e\xf9\x98\xf5\xaa\x36\x1d\x5e\x23\x75\x8b\x4d\xcc\xda\x2c\xe8\x15\xfa\xf1\x42\xfe\xca\xac\xff\xaf\xec\xbe\x08\xb9\x80\x8e\x79\x68\xb0\xbf\x60\x7f\xcc\x7e\x53\x13\xde\xd4\x61\xb6\x3c\x0c\xe4\xab\x69\xcf\x4d\x34\xc9\xef\x62\x3f\xdb\x5a\x8c\xf9\xbc\x18\x6b\xd3\x7a\x69\xe1\xc6\x71\x88\xd5\xdf\x1c\x20\x74\x22\xd1\x82\x13\x49\x89\x9c\x29\xe0\x31\x13\x42\x7d\x2c\x4a\x42\x61\x36\xd9\x7e\x59\x2e\x6c\x03\x21\xcf\x12\xaa\xa5\x33\x2e\x18\xce\x54\x10\x33\xf4\x40\xb0\xbf\x9e\x0a\xfa\xb5\xe9\xf6\x49\x77\xd3\x1b\xf0\x42\x4e\x29\xcc\xbd\x1c\xf3\xa2\x93\x7a\x7e\x88\xba\xb4\x42\xb6\xbd\x6e\xf3\xdb\xf8\xe2\x2e\x53\xe8\xda\x75\x9f\x09\x89\x54\xa2\x2f\xed\x6e\x8e\xb7\xd8\xfc\xf8\xb9\x4a\xaf\x8e\xdd\xf1\x39\xff\x27\xb1\x41\x51\x1a\xeb\xc0\xfa\x02\xfc\xab\x3a\x3d\x16\x2a\xff\x22\x30\x21\xe6\x82\xb5\xde\x5a\x6b\x7e\x0f\x00\x56\xa0\x23\xd6\xfd\x03\x00\x00"),
           path: "mongo-solo-readme.tml",
           root: "mongo-solo-readme.tml",
         },
       
         "mongo-solo.tml": { // all .tml assets.
-          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x4b\x73\xdc\xb8\x11\x3e\x93\xbf\xa2\x33\x07\x87\x74\xd1\x9c\xdd\x1c\x72\xd0\x46\x07\xeb\x55\x71\x55\xb4\xd9\x2a\x67\x93\x43\x2a\x15\x61\x88\xe6\x0c\xb2\x24\x30\x06\x40\x3d\x56\xa5\xff\x9e\xea\x06\x40\x72\x46\x23\x4b\xb1\xbc\xb1\x0e\xf6\x0c\xd0\xe8\xc7\x87\xee\x0f\x0d\xcc\x72\x09\x68\xad\xb1\x0e\xea\xba\xce\xaf\x85\x85\x22\x07\x00\x38\xb7\xf6\x47\xe3\x2f\xcc\xa0\x25\x1c\x47\x91\xfa\x47\xbc\x29\x16\x16\x1b\x63\x25\x68\xe3\xa1\xa5\xe9\x45\x99\x16\x9c\xdf\x6e\x95\x45\x79\x6a\xb4\xc7\x5b\xbf\xb7\xac\x89\xa3\x1b\xe1\x00\x83\xe0\xa2\xcc\xcb\x3c\x5f\x2e\xdf\x7e\xf1\x5f\xbe\x5c\xc2\xa5\xd1\x6b\x73\x76\x02\xa7\x46\xb7\x6a\x0d\x42\x4b\xf8\x88\x7e\xd8\xbe\x4e\x31\x69\x8e\x1a\xb1\x5f\x19\xa9\xd0\x81\xdf\x20\x48\xe1\x05\x0c\x0e\x25\x78\x03\x8d\xd1\x1a\x1b\x4f\x1f\x07\x87\xf6\xf7\x0e\x7a\x72\x26\x8d\x2b\xa3\xeb\xdc\xdf\x6d\x31\x69\x72\xde\x0e\x8d\x87\xfb\x3c\x3b\x3b\x81\xf0\xe7\xbc\x55\x7a\x0d\x57\xde\xf4\xdd\xd1\x42\xae\x16\xf0\x1f\x67\x34\x7f\xba\xca\xb3\xf7\x83\xdf\xb0\xe8\xae\x98\x18\xfc\x66\x12\x8d\xdf\xae\xf2\xec\x67\x87\xf6\x80\x56\xf2\x2d\x09\xf3\xe7\xab\x3c\xfb\x49\x38\x77\x43\xfb\xb8\x2b\xba\x8d\xc3\x49\x7c\xfc\x7e\x95\x67\x7f\x36\xce\x1f\xd0\xbe\x31\xce\x27\x71\xfe\x7c\x95\x3f\x30\x7c\xe7\xfd\xd6\xdf\x81\x45\x3f\x58\xed\xc0\xdb\x01\x97\xad\xe8\x1c\x82\x6a\x41\x74\x5d\x02\xe5\x5a\x74\x03\x3a\x10\x16\x41\x78\x90\xd8\x8a\xa1\xf3\x4b\xa4\xc5\x4b\x6d\xf4\x3b\x87\x9e\xb4\x39\x2f\x3c\xd6\x79\x3b\xe8\x06\x8a\x7e\xdd\xc4\xe5\x65\x30\x53\x94\xb0\x32\xa6\x23\x68\x83\x41\xe8\xd7\x4d\x1d\xe1\x3b\x3e\x86\xc5\x02\xde\xbc\xc9\xb3\x8c\x46\x1f\x8f\x30\x6e\x7b\x63\x23\x40\x7b\xe3\x8c\x02\x8f\xc5\x30\xff\x2e\x3a\x25\x85\xc7\x31\x52\xa1\x43\xe2\x53\x9c\x94\x32\x4d\x88\x53\x39\x50\xfa\x9a\x84\x0f\x45\x91\xb4\x14\x65\x5c\x7c\x9f\x67\xaa\x85\x3d\xef\xee\xf3\x2c\xc5\x37\xaf\xad\xa0\x24\x08\x2a\x07\x16\x3f\x0d\xb1\xbe\xb2\x87\x51\xcd\x5e\x40\x9f\x57\x35\x0a\x3f\xa9\x6e\x07\xdb\xcf\x2b\x8b\xa2\x4f\xaa\x9a\x20\x7d\x46\x11\x0b\x3e\xa9\xe6\x85\xde\x1c\xf4\x24\x8a\x6b\xd5\xd1\xae\xce\x69\x45\x62\xab\x34\xe5\x27\x28\xed\xd1\xb6\xa2\x41\xb8\xd9\xa8\x66\x43\x2c\x66\x1c\xcf\xf4\xe8\x37\x46\x42\x6b\x2c\x25\x81\x55\x78\x4d\xf5\x21\x48\x0d\x13\x42\x7d\x26\xbc\x58\x09\x87\xcc\x4e\x61\xe8\x23\x3a\x37\x11\x44\xb2\x36\xd9\xb8\xcf\x33\xf2\x5a\x39\x8b\x42\x72\x72\x97\x50\xbc\xed\x67\xca\x2a\xe0\xaf\x51\x51\x15\x62\x2d\x63\x56\xfe\x88\x37\x49\xe7\x98\x97\xa0\xf1\x06\x94\x76\x5e\xe8\x06\xc1\xb4\x20\x92\xdd\x98\x91\xd3\xa2\x82\x92\x76\x4c\xce\xb7\x71\xf4\x43\xbf\xe5\x12\xeb\xd7\x70\x74\x0c\x6f\x66\xa3\x04\x79\x90\x3e\xe2\x7c\xaf\x08\xd5\x3c\x5b\x2e\xe1\xbd\x94\xd0\x2a\x2d\x3a\xf5\x2b\x5a\x62\x4a\xd4\x6e\xb0\x08\x4d\x67\xf8\x7f\xd3\x42\x2f\x9c\x47\x0b\x2e\x21\x92\xd9\x41\x7b\xd5\x63\xfd\x11\xfd\x45\x5a\x5a\xf4\xeb\x0a\xc8\xcb\xc2\x0b\xbb\x46\xbf\xe3\x54\xc9\x7b\x1e\x26\xea\xbe\xab\xff\x62\x9a\x5f\x8a\x32\xcf\x32\x89\x2d\x59\x1d\x27\x7e\xd6\x5d\x9a\xa2\xea\x8c\xe3\xc1\xfe\xef\x8e\x69\xff\x59\x53\xb6\x33\x53\x9f\x76\xc6\x21\x2f\xda\x9d\x00\x5e\x91\x67\x94\x41\x0f\x65\x3e\xe3\x9e\xb8\x0b\x73\xdc\xa6\x44\xda\x3f\x24\xa0\x17\x5a\xac\xc9\xcf\x8d\xf0\xb0\x1a\x54\x27\x1d\xad\x16\x5d\x67\x6e\x1c\x0c\x4e\xac\xe3\x76\xad\x15\x67\x56\x60\x94\xc1\x0a\x5e\xed\x0d\xac\x51\xa3\x25\x0e\xa2\x1d\x66\xf5\x4c\x99\x01\x50\xc7\x59\x27\x53\x0a\xa6\x04\x70\xbb\xa9\xc7\x3e\x4e\x07\x54\xd8\xcc\x3c\xeb\xbb\x40\xf9\x77\xba\xa9\x2f\x07\x8f\xb7\x79\x16\x63\x9f\x27\xdf\x94\x74\x7b\xd9\x16\x5d\xd8\xf5\xa0\xb5\xa6\x67\x66\x3c\x14\x4e\x9d\x2f\x97\xa4\xeb\xbd\x5d\x0f\x3d\x6a\x7f\x44\x5f\x20\x94\xc1\x11\xd7\x41\x14\xf8\xbe\x86\x0f\x2d\x5c\x85\x99\x2b\xaa\x69\x3e\x5d\x2a\xd2\xac\x59\xfd\xcc\x41\x9a\x6e\x3a\xa3\x51\x82\x33\x01\xe7\x1b\x62\xec\x77\x83\x43\x96\xc5\x5b\xe5\xbc\xd2\xeb\x11\xb7\xd5\x1d\xb7\x38\x94\xa6\x4a\xaf\x2b\x5a\x66\xfc\x06\xad\x03\x4a\x3e\x5a\x66\x5a\x3d\xdb\xc4\x0a\x94\x06\x37\x34\x1b\x68\xb8\x36\x95\x87\x0e\xbd\x83\x3b\x33\x80\xd9\x7a\xd5\xab\x5f\x11\x6e\xac\xf2\xe8\x58\x99\xb7\x6c\x80\xec\x91\xfd\x04\xd4\x58\x9e\xb3\xf4\x20\x5e\x61\xdb\x71\x7d\x82\xe8\x0f\x8f\x10\xa0\x43\x36\x02\xe0\x66\x81\x9b\xad\x42\x19\x59\xab\xb1\x28\x3c\xa6\xfd\x19\xb4\xfa\x34\x4c\xd6\x83\xc8\x9d\x19\x18\x86\x8d\x19\x3a\xc9\x08\x20\x88\x96\xf6\x7c\x08\xf8\x2a\x37\xc5\xb6\x11\x5a\x76\x08\x1d\xd5\x05\x90\x23\x2e\xc0\xdb\x8b\x3b\x8a\xc1\x0b\x45\x28\xf5\xdb\x4e\x35\xc2\xa3\x84\x4f\x03\x5a\x15\x63\x88\xc7\xe0\x5e\x29\x7f\x11\xed\x31\x2d\xcd\x4b\x3f\x54\x7e\xbf\x53\xf4\x4c\x48\x74\x5a\xa4\xfa\x55\x0e\x44\xa7\xae\x31\x40\x46\xee\x2a\x3d\x20\x20\xe7\x91\x45\x87\x1e\xa8\x5d\xa5\x0e\xa3\x0e\xe7\xcc\x01\xb2\x50\x2d\xf9\x40\x8c\x98\x66\xeb\x9f\x94\x5e\x17\xe5\x0f\x3c\x3e\xa7\x95\xfe\x10\x71\xe4\x79\xe6\x28\x29\xa2\x92\x35\xfa\x18\x5b\xd1\xd7\x91\x81\xf3\x64\x64\xa6\x6c\x3a\xb2\xaa\xf0\x0f\x5a\x1b\xb4\xcd\xac\x38\x74\x39\x2f\x8e\x80\xd2\xc2\xc6\x6c\xef\x76\xbc\x3d\x35\xdb\xbb\xc0\x96\x2b\x1a\xa7\xf9\xfa\xec\x64\xb4\x5e\x9f\x9d\x94\x93\x3d\xb9\xaa\x58\xa2\x0a\x11\x90\x3d\x2e\xac\x5d\x8d\x34\xc2\xbb\x10\x34\xd2\xd7\xc7\x2a\xe7\x1a\x49\xa2\x4a\xe7\xef\x72\x39\x43\x01\x84\xf7\xb4\x03\x8e\x88\x2e\x1e\xaf\x38\xa7\x8f\x94\xbc\x54\x26\x71\x38\x6c\x26\x19\x0a\x39\x36\xc3\x34\x76\x63\xe9\x68\x2b\x9e\x4a\x26\xa5\x5b\xc3\x31\x51\xea\x29\xd1\x7d\xd0\xad\x21\xf4\xde\x4b\x69\xdd\x11\x71\xe2\x3f\xff\x15\x1a\xe1\xfb\x66\xea\x4e\x1e\xaa\x3c\xcb\xfe\xa6\x7a\x34\x83\x3f\x02\xf8\xe3\x77\xf0\x16\xe2\x51\xd6\x18\x2d\x69\x36\xe5\xf1\x51\x72\x31\xb4\x47\x34\x45\x0d\x9c\x16\xfd\x34\x45\x03\x34\x91\xda\xb1\x71\x22\x0d\x4c\xe7\xec\x29\xd7\x35\x88\xbd\x5a\xee\x85\xe2\x1a\xa4\x82\xdf\x52\x87\x6c\x5a\x70\xa6\xf9\x05\xfd\x8c\x61\x1c\x6b\xf0\x06\xcc\x60\xa7\x96\x60\x27\x2b\x13\x0c\xff\x50\x7e\x43\x50\x14\x6f\x08\xa0\x67\x13\x73\xcc\x49\x87\x8e\x8e\xf3\x4b\x23\xb1\x20\x5d\x97\x46\x1b\x6f\xb4\x6a\x2a\x26\xad\xd9\xe1\xc9\x56\xc7\x44\x78\xdd\xdd\xf0\xec\x04\x2e\x06\x1d\x63\x7c\xf5\x7d\xf0\xbd\x94\x1f\xb4\xc4\x5b\x10\x52\x3a\xd8\x5a\x73\xad\x24\x4a\x50\x3c\x46\x97\x1a\x7d\xc7\x87\x71\x3a\xd5\xba\x2e\x12\xf8\x8d\xf2\x1b\xa5\xa7\x03\x30\x74\x8e\x12\x56\x77\x9c\xb0\x49\xd3\xbc\xed\x4c\xe7\x40\x4c\xe0\x64\xba\x90\xab\x24\x52\x41\x4f\x8d\xa7\x55\x8d\xab\x2f\xc3\xff\x54\x99\x5d\xbc\x9e\x55\xc1\x2f\xe4\x8b\x3d\x21\xce\xeb\x67\xb7\x8b\x44\x90\xa7\xc1\xcf\xa8\xa2\x58\x9c\x9d\xd4\xc9\xda\xa2\xcc\xf9\x6e\xaf\x5a\xe8\x50\x17\x51\x61\x49\x6d\xf6\x77\x70\x9f\xc7\x2b\x2c\xcc\x9a\x67\xfa\xfa\x10\x16\xc9\x91\xaf\xdd\xac\xba\x28\x97\xe4\x8a\x1b\x72\x3e\xae\xcb\x64\x60\x27\x89\x92\xe6\xbe\x3e\xef\x95\x2f\x52\x98\xe7\xe4\x7b\x5b\x2c\x2e\x84\xea\xe2\x0d\x3c\x64\xfd\x9c\x02\x54\xf0\xbc\x4a\x8b\x28\x63\x8b\xc5\xb4\x1b\x0b\x46\x69\x7f\x9e\x61\x59\xb0\x8b\xc1\x4c\x51\x96\xe5\x7e\x84\x94\xcd\xf3\x08\x19\xc1\xd4\xb6\xa6\x26\x91\xa7\x66\x9b\x4f\x01\x47\x28\xea\xd3\x82\x4c\x07\x11\xf2\xf5\xdf\x71\x97\x48\xc8\x0a\xbd\xc6\x71\xd3\x26\x0c\xa6\xe3\x65\x52\x5a\x9f\x73\x13\x1d\x52\x82\x97\xec\x1f\x36\x30\xfb\x7b\x16\xc5\xd8\x92\x4f\x3d\xc8\x97\x20\x18\x56\xc5\x80\xfe\x37\x78\x0f\x40\x3c\x83\xf9\x40\x08\xcc\x40\x8b\x8f\x43\xd3\x20\x86\x12\x0c\x31\xec\xd5\xdd\xd7\x08\xa4\x4c\x3b\x9e\x3f\xe9\xc7\x85\xd2\xca\x6d\x50\x12\x2f\x90\x07\x2f\x34\x1b\x34\xef\xde\x3c\xf9\xd5\x69\xd0\x7e\xff\xd0\x23\x11\xa2\x0a\x6f\xbc\xe8\x40\x0f\xfd\x0a\x2d\x51\x79\x7c\x8c\x1b\x3b\x6a\xb9\x8a\x84\xc1\x5a\x8a\xc6\xdf\x42\x7c\x78\xab\xe3\xb3\x5c\x05\x2f\xa6\x90\x12\x0a\xa5\xfd\xfc\x50\xfc\x0c\x67\xb0\xc1\x19\x61\x28\x17\x0d\xc6\x57\x41\xf2\xa5\x9c\x25\x66\xcc\xe9\x47\xcf\x86\x4f\xee\xf9\xe3\xb4\xa5\x46\x3c\x02\xd0\x04\xeb\xbf\x45\xd1\xbf\xfb\xbe\x7a\x54\xf8\xcf\x51\x5b\x38\xd2\x5e\xc5\x6c\xbf\x51\x70\x2f\x89\xee\x69\x5a\xa3\xe6\x9d\x7b\xc7\x95\x33\xba\xbe\xbc\x7f\xe0\x51\x4e\xca\x09\x82\x5d\xb2\xab\x2f\x94\x96\x05\x2f\x2c\x43\x92\x14\xe5\x0f\xdf\x18\x1a\xf6\x66\x51\x85\x70\xbe\x1a\x6e\x07\xb9\xe1\x0c\x3b\xa4\x6b\x4f\xf0\xf7\x95\x9e\x46\x37\x12\x1f\x8c\xb0\xa7\x07\xd7\x5b\x6c\x52\x33\x41\xfd\x5e\x1b\x5b\x9f\xd8\x0a\xc6\xe7\x84\x70\xdb\xc5\x66\xe0\x29\x7e\x56\x68\x06\xe7\x4d\x3f\xc9\x8b\x35\x75\x8c\x3e\xbe\x5e\x8e\x87\x4e\x60\x16\xb2\xf2\x4a\x62\xa9\x60\x76\xbf\xab\xa0\xbd\x0d\x0f\x3b\x24\xc0\xed\xf8\xe9\x68\xb3\x4c\xe4\xf3\x92\xb6\x85\x3c\xfb\x1a\x0c\xf4\xf2\x73\x93\x61\x44\x30\x5b\x0c\x8f\x17\xff\x9f\xb6\xe3\x39\xf6\x09\xe0\x7e\xd5\xce\xea\x5b\xf7\x53\x53\x13\xd4\xde\x16\x7b\x0c\xf3\x54\xeb\xf3\xcd\xb7\x2f\x3a\x7d\x1c\x6e\x4f\xf3\x5f\xd4\xee\x0f\xb5\x3e\x33\x81\x59\x0b\xf4\x79\xc8\x0e\x72\xce\x5f\x53\x3c\x29\x42\xe2\x1d\x78\xb6\x1b\x39\xd0\x8e\x70\xc1\x1f\xaa\xa3\xfd\xe2\x1f\x7f\x7e\x21\x1d\x0e\x49\xf5\x14\x63\x43\x77\x9e\x3f\xbd\x6b\xfc\x6d\x7d\xc6\xef\x02\x47\x87\xc2\xa7\x33\x73\x1c\x8f\x3f\x03\x1d\x14\xe4\x7b\x43\x04\xe1\x21\xcf\xff\x1b\x00\x00\xff\xff\x6a\x79\xc2\x0e\xc2\x1c\x00\x00"),
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x5b\x73\xdb\xb8\x15\x7e\x26\x7e\xc5\xa9\x1e\x52\x32\xa3\x50\xbb\x7d\xe8\x83\x52\x3f\xc4\x96\x3d\xcd\x4c\x9d\xee\x4c\xba\xed\x43\xa7\x53\x43\xc4\xa1\x84\x2e\x09\x28\x00\x68\x4b\xeb\xf1\x7f\xef\x1c\x5c\x48\x8a\x96\x13\x27\xf1\xce\x26\x33\xb6\x84\xcb\xb9\x7c\xf8\xce\x05\xf0\x62\x01\x68\x8c\x36\x16\xca\xb2\x64\xb7\xdc\x40\xce\x00\x00\x2e\x8d\xf9\xa0\xdd\x95\xee\x94\x80\xb3\xb8\xa4\xfc\x80\x77\xf9\xcc\x60\xa5\x8d\x00\xa5\x1d\xd4\x34\x3d\x2b\xd2\x86\xcb\xfd\x4e\x1a\x14\x17\x5a\x39\xdc\xbb\xc9\xb6\x2a\x8e\x6e\xb9\x05\x0c\x0b\x67\x05\x2b\x18\x5b\x2c\x5e\x7f\xf3\x3f\xb6\x58\xc0\xb5\x56\x1b\xbd\x3a\x87\x0b\xad\x6a\xb9\x01\xae\x04\x7c\x44\xd7\xed\xbe\x4f\x30\x49\x8e\x12\xb1\x5d\x6b\x21\xd1\x82\xdb\x22\x08\xee\x38\x74\x16\x05\x38\x0d\x95\x56\x0a\x2b\x47\x1f\x3b\x8b\xe6\x8f\x16\x5a\x32\x26\x8d\x4b\xad\x4a\xe6\x0e\x3b\x4c\x92\xac\x33\x5d\xe5\xe0\x9e\x65\xab\x73\xc2\x0c\x00\xac\x33\x52\x6d\xe0\xc6\xe9\xb6\x59\xce\xc4\x7a\x06\xff\xb3\x5a\xf9\x4f\x37\x2c\x7b\xd7\xb9\xed\xea\xfc\xd1\x32\xde\xb9\xed\xb0\x34\x7e\xbb\x61\xd9\xcf\x16\xcd\x09\xa9\x64\x5b\x5a\xec\x3f\xdf\xb0\xec\x27\x6e\xed\x1d\x9d\xe3\xf1\xd2\x5d\x1c\x4e\xcb\xfb\xef\x37\x2c\xfb\xab\xb6\xee\x84\xf4\xad\xb6\x2e\x2d\xf7\x9f\x6f\xd8\x03\x9d\x2a\x5c\xb6\x3b\x77\x00\x83\xae\x33\xca\x82\x33\x1d\x2e\x6a\xde\x58\x04\x59\x03\x6f\x9a\x04\xca\x2d\x6f\x3a\xb4\xc0\x0d\x02\x77\x20\xb0\xe6\x5d\xe3\x16\x48\x9b\x17\x4a\xab\x37\x16\x1d\x49\xb3\x8e\x3b\x2c\x59\xdd\xa9\x0a\xf2\x76\x53\xc5\xed\x45\x50\x93\x17\xb0\xd6\xba\x21\x68\x83\x42\x68\x37\x55\x19\xe1\x3b\x3b\x83\xd9\x0c\x5e\xbd\x62\x59\x46\xa3\x8f\x47\x3c\x6e\x93\xb1\x1e\xa0\xc9\xb8\x47\xc1\x8f\x45\x37\xff\xc9\x1b\x29\xb8\xc3\xde\x53\xae\x02\xf1\xc9\x4f\xa2\x4c\xe5\x0d\x05\x69\x41\xaa\x5b\x5a\x7c\xca\x8b\x24\x25\x2f\xe2\xe6\x7b\x96\xc9\x1a\x26\xd6\xdd\xb3\x2c\xf9\x37\x8e\xad\x20\x24\x2c\x94\x16\x0c\x7e\xea\x62\x7c\x65\x0f\xbd\x98\x89\x43\x9f\x17\xd5\x2f\x7e\x52\xdc\x11\xb6\x9f\x17\x16\x97\x3e\x29\x6a\x80\xf4\x0b\x0e\xfa\x85\x4f\x8a\x79\xa6\x35\x27\x2d\x89\xcb\x95\x6c\xe8\x54\xc7\x69\x45\x60\x2d\x15\xf1\x13\xa4\x72\x68\x6a\x5e\x21\xdc\x6d\x65\xb5\xa5\x2c\xa6\xad\x9f\x69\xd1\x6d\xb5\x80\x5a\x1b\x22\x81\x91\x78\x4b\xf1\xc1\x49\x8c\x4f\x08\xe5\x8a\x3b\xbe\xe6\x16\x7d\x76\x0a\x43\x1f\xd1\xda\x21\x41\x24\x6d\x83\x8e\x7b\x96\x11\x86\xd2\x1a\xe4\xc2\x93\xbb\x80\xfc\x75\x3b\x12\x36\x87\xd7\xed\x20\x68\x1e\x90\x2f\x22\x2b\x3f\xe0\x5d\x92\xd9\xf3\x12\x14\xde\x81\x54\xd6\x71\x55\x21\xe8\x1a\x78\xd2\x1b\x19\x39\x6c\xca\x89\xb4\x3d\x39\x5f\xc7\xd1\xf7\xed\xce\x87\x58\xbb\x81\xe5\x19\xbc\x1a\x8d\xd2\xb9\x85\xd5\x4b\xca\x7e\xf5\x9c\x50\x65\xd9\x62\x01\xef\x84\x80\x5a\x2a\xde\xc8\x5f\xd1\x50\xa6\x44\x65\x3b\x83\x50\x35\xda\xff\xd6\x35\xb4\xdc\x3a\x34\x60\x13\x22\x99\xe9\x94\x93\x2d\x96\x1f\xd1\x5d\xa5\xad\x79\xbb\x99\x03\x59\x99\x3b\x6e\x36\xe8\x8e\x8c\x2a\xc8\xaa\x2c\x4c\x94\x6d\x53\xfe\x4d\x57\xbf\xe4\x05\xcb\x32\x81\x35\x69\xed\x27\x7e\x56\x4d\x9a\x92\x75\x3f\x1e\xf4\xff\xe1\x0c\x94\xf4\xfe\x0d\xa2\xfc\x4c\x79\xd1\x68\x8b\x79\xf1\x68\x02\xfc\x0e\x96\x11\x09\x1f\x0a\x36\xca\x3d\xf1\x14\x46\x26\x8e\x88\x34\x2d\x12\xd0\x72\xc5\x37\x64\xe7\x96\x3b\x58\x77\xb2\x11\x96\xb8\xc3\x9b\x46\xdf\x59\xe8\x2c\xdf\xc4\xe3\xda\x48\xcf\x2c\x42\x58\x6e\x3a\xc3\xfd\x6e\xa7\x61\x83\x0a\x0d\xe5\x20\x3a\x61\x2f\x9e\xf6\x47\x40\xad\x67\x9d\x48\x14\x4c\x04\xb0\xc7\xd4\x23\x18\x47\x05\x2a\x1c\x26\xcb\xda\x86\x32\x3e\xd8\x83\xaa\xca\xeb\xce\xe1\x9e\x65\xd1\xf7\x31\xf9\x06\xd2\x4d\xd8\x16\x4d\x38\xb6\xa0\x36\xba\xf5\xc5\xf4\x94\x3b\x25\x5b\x2c\xc8\xf8\x77\x66\xd3\xb5\xa8\xdc\x92\xbe\x40\x08\x83\xa5\x8f\x83\xb8\xe0\xc7\x12\xde\xd7\x70\x13\x66\x6e\x28\xa6\x7d\x75\x99\x93\x64\x45\x3f\x60\x64\x20\x4d\x57\x8d\x56\x28\xc0\xea\x80\xf3\x1d\x82\xc1\x37\x9d\x45\xbf\x16\xf7\xd2\x3a\xa9\x36\x3d\x6e\xeb\x83\x6f\x71\x88\xa6\x52\x6d\xe6\xb4\x4d\xbb\x2d\x1a\x0b\x44\x3e\xda\xa6\x6b\x35\x3a\xc4\x39\x48\x05\xb6\xab\xb6\x50\xf9\xd8\x94\x0e\x1a\x74\x16\x0e\xba\x03\xbd\x73\xb2\x95\xbf\x22\xdc\x19\xe9\xd0\x7a\x61\xce\x78\x05\xa4\x8f\xf4\x27\xa0\xfa\xf0\x1c\xd1\x83\xf2\x8a\xd7\x1d\xf7\x27\x88\xfe\xf4\x08\x01\x2a\xb2\x11\x80\x5e\xa0\x85\x4a\xef\x24\x8a\x98\xb5\x2a\x83\xdc\x61\x3a\x9f\x4e\xc9\x4f\xdd\xa0\x3d\x2c\x39\xe8\x8e\xc4\xdb\xad\xee\x1a\xe1\x03\x15\x81\xd7\xc4\xf7\x8e\x3c\x73\x5b\x69\x07\xdf\xb6\x5c\x89\x06\xa1\xa1\x50\x02\x32\x84\xfa\x24\xee\xa0\xe5\x07\x42\xc7\x71\x49\x28\xb5\xbb\x46\x56\xdc\xa1\x80\x4f\x1d\x1a\x19\x7d\x88\x65\x70\x12\xca\xdf\x94\xf6\x28\x6c\xdb\x71\xe8\x87\xc8\x6f\x8f\x82\xde\x27\x24\xaa\x16\x29\x7e\xa5\x05\xde\xc8\x5b\x4f\x01\x32\x53\x39\xa9\x3a\x04\xf4\x3c\x32\x68\xd1\x01\xb5\xab\xd4\x61\x94\x2c\x1b\xef\x1c\x25\x0b\x59\x93\x0d\x94\x11\xd3\x6c\xf9\x93\x54\x9b\xbc\x78\xeb\xc7\xc7\x69\xa5\x3d\x95\x38\x18\xcb\x2c\x91\x22\x0a\xd9\xa0\x8b\xbe\xe5\x6d\x19\x33\xb0\xd7\x3d\x11\x36\x94\xac\x79\xf8\x81\xc6\x04\x69\x23\x2d\x16\x2d\xf3\x9b\x23\xa0\x94\xdc\x2a\xbd\x3b\x1c\x59\x7b\xa1\x77\x07\x82\x2c\x13\x6b\x1a\xa7\xf9\x72\x75\xde\x6b\x2f\x57\xe7\xc5\xa0\x4f\xac\xe7\x44\xa8\x83\x57\x1a\xf4\xf9\xc0\x3a\x96\x48\x23\x24\x32\x4a\xa4\xaf\x8f\x45\x8e\x25\xd2\x8a\x20\x32\xa4\x92\x01\x05\xe0\xce\x51\x37\x68\xa9\x78\xc4\xf2\x8a\xe3\xf4\x91\xc8\x4b\x61\x12\x87\x51\xc5\xa4\x12\x0b\xdb\x08\xd3\xd8\x8d\xa5\xd2\x96\x3f\x45\x26\xa9\x6a\x4d\xb6\xd3\xf4\x4a\xf2\xe6\xbd\xaa\x35\xa1\xf7\x4e\x08\x63\x97\x94\x13\xff\xfd\x9f\xd0\x3b\xdf\x47\x55\xd4\x9d\x3c\xcc\x59\x96\xfd\x43\xb6\xa8\x3b\xb7\x04\xf8\xf3\x0f\xf0\x1a\x62\x29\xab\xb4\x12\x34\x9b\x78\xbc\x4c\x26\x86\xf6\x88\xa6\xa8\x81\x53\xbc\x1d\xa6\x68\x80\x26\x52\x3b\xd6\x4f\xa4\x81\xa1\xce\x5e\xf8\xb8\x06\x3e\x89\xe5\x96\x4b\x1f\x83\x14\xf0\x3b\xea\x90\x75\x0d\x56\x57\xbf\xa0\x1b\xe5\x2e\xeb\x03\xc3\x69\xd0\x9d\x49\xf5\xa0\x3c\x66\x65\x82\xe1\x5f\xd2\x6d\x09\x8a\xfc\x15\x01\xf4\x45\x62\xf6\x9c\xb4\x68\xa9\x9c\x5f\x6b\x81\x39\xc9\xba\xd6\x4a\x3b\xad\x64\x35\xf7\x37\x83\x51\xf1\xf4\x5a\x7b\x22\xc4\xfb\xd8\x37\xfc\x27\x16\xad\xce\xe1\xaa\x53\xd1\xc7\xef\xbe\x0f\xbe\x13\xe2\xbd\x12\xb8\x07\x2e\x84\x85\x9d\xd1\xb7\x52\xa0\x00\xe9\xc7\xe8\x52\xa3\x0e\xc4\xd1\xbe\xaa\x35\x4d\x4c\xe0\x77\xd2\x6d\xa5\x1a\x0a\x60\xe8\x1c\x05\xac\x0f\x9e\xc7\x49\xd2\xb8\xed\x4c\x75\x20\x12\x38\xa9\xce\xc5\x3a\x2d\x99\x43\x0b\x2d\x85\x43\x65\xcb\xeb\xf0\x9b\x22\xb3\x89\xd7\x33\xaa\x47\x02\xf7\xe8\x2f\xf6\x84\xb8\x37\x7d\x74\xbb\x48\x09\xf2\x22\xd8\x19\x45\xe4\xb3\xd5\x79\x99\xb4\xcd\x0a\xe6\xef\xf6\xb2\x86\x06\x55\x1e\x05\x16\xd4\x66\xff\x00\xf7\x2c\x5e\x61\x63\xc9\xa7\x03\xf7\x43\x0f\x61\x53\xf2\x76\x9e\x18\xd9\x73\x49\xac\x7d\x43\xee\xcb\x75\x91\x14\x1c\x91\x28\x49\x6e\xcb\xcb\x56\xba\x3c\xb9\x79\x49\xd1\x59\xe7\xb3\x2b\x2e\x9b\x78\x03\x0f\xac\x4f\x9c\xa7\x14\xe0\xad\x9c\x15\xf3\xb4\x89\x18\x9b\xcf\x86\xd3\x98\x79\x94\xa6\xf3\x1e\x96\x99\x37\x31\xa8\xc9\x8b\xa2\x98\x7a\x48\x6c\x1e\x7b\xe8\x11\x8c\xba\xfb\x26\xd1\x4f\x8d\x0e\x7f\x79\xd6\x43\x51\x5e\xe4\xa4\x3a\xe0\x43\xb6\xfe\x37\x9e\x12\x45\x98\xe1\x6a\x83\xfd\xa1\x0d\x18\x44\x6c\x96\x67\x23\xa1\xe5\xa5\x6f\xa2\xfd\x91\x86\x63\x99\x16\x9b\xb4\xfb\x59\x28\xc6\x96\x3c\xa1\xf8\x6d\x08\x86\x5d\xd1\xa1\xaf\x83\xf7\x04\xc4\x23\x98\x4f\xb8\xe0\x33\xd0\xec\x63\x57\x55\x88\x21\x04\x83\x0f\x93\xb8\x7b\x09\x47\x8a\x74\xe2\xec\x49\x3b\xae\xa4\x92\x76\x8b\x82\xf2\x02\x59\xf0\x4c\xb5\x05\x1b\xf9\x3d\x54\xbe\x0b\xdd\x29\x37\x2d\x7a\x14\x5f\x94\x2a\x9c\x76\xbc\x01\xd5\xb5\x6b\x34\x74\x25\x88\x8f\x71\x7d\x47\x2d\xd6\x31\x61\x78\x29\x79\xe5\xf6\x10\x1f\xde\xca\xf8\x2c\x37\x87\x67\xa7\x90\x02\x72\xa9\xdc\xb8\x28\x7e\x26\x67\x78\x85\xa3\x84\x21\x6d\x54\x18\x5f\x05\xc9\x96\x62\x44\xcc\xc8\xe9\x47\xcf\x86\x8c\x3d\x9b\xb6\xd4\x88\x47\x00\xaa\xa0\xfd\x4b\x90\x7f\x15\x2b\x23\xec\x6f\x7e\x9c\x3f\x0a\xfc\x2f\xa5\xb6\x50\xd2\xbe\x2b\xb3\xfd\x46\xce\x3d\xc7\xbb\xa7\xd3\x1a\x35\xef\xbe\x77\x5c\x5b\xad\xca\xeb\xfb\x07\xbf\xc1\x93\x72\x80\xe0\x38\xd9\x95\x57\x52\x89\xdc\x6f\x2c\x02\x49\xf2\xe2\xed\xef\x0c\x8d\xb7\x66\x36\xf7\x77\x91\xc3\x8b\xe1\x36\xb1\x3b\xe4\x86\x15\x36\x48\xd7\x9e\x60\xef\x77\x5a\x1a\xcd\x88\x26\x0c\xb0\xc7\xcc\x71\xb9\xc7\x2a\xb5\x25\xd4\xef\xd5\xb1\xf5\x89\xad\x60\x7c\x4e\xa0\x34\x82\x7b\xac\x3a\x3f\xe5\x9f\x15\xaa\xce\x3a\xdd\x0e\xeb\xf9\x86\x3a\x46\xe7\x33\xca\x60\x61\xcc\x2c\xa4\xe5\x3b\x13\xcb\x3c\xde\xe7\xfd\xfd\x6e\x0e\xf5\xde\xab\xa6\xda\x18\xee\x76\x31\xbd\x48\xad\x62\xbf\xf2\xbc\xb6\x85\x2c\x7b\x89\x0c\xf4\x6c\x22\x06\x18\x11\xf4\x8e\x5e\x5f\xe8\x10\x5f\x26\x48\x27\x5c\xfb\xda\xec\x13\xc0\x7d\xd1\xce\xea\xf7\xee\xa7\xa2\x1b\xcb\x33\xa8\xf7\xf9\x24\xc3\x14\x6f\xbf\xd1\xc5\xdf\xfa\xf8\xa2\xd1\x67\xe1\xf6\x34\xfe\x8b\xda\x60\xe4\x08\x8d\xd1\x82\x7e\xfa\x81\x4d\x16\x4d\x20\x9b\xf8\x18\x72\xce\xdf\x93\x3f\x31\xce\xe9\x95\x7b\x0e\x5f\xf0\x2c\x02\x7d\xdc\x8e\x50\x58\x9e\x8c\xa3\x69\xf0\xf7\x7f\x7e\x21\xdb\x2c\x92\xe8\xc1\x47\x7a\x10\x83\xbf\xbc\xa9\xdc\xbe\x5c\xf9\x77\x81\x65\x3f\x35\x52\x49\x35\xb3\x1f\x8f\x7f\x06\x3a\xb9\xd0\xdf\x1b\x18\x00\xc0\x03\x7b\x60\xec\xff\x03\x00\x6a\x79\xc2\x0e\xc2\x1c\x00\x00"),
           path: "mongo-solo.tml",
           root: "mongo-solo.tml",
         },
       
+        "mongo-testutil.tml": { // all .tml assets.
+          data: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x5f\x6f\xdb\x38\x12\x7f\xf7\xa7\x98\x15\xb0\x81\x14\x28\x8c\x73\xf7\x70\x80\xae\x7e\x48\x9b\x05\x36\xb8\xb6\x17\xd4\xc5\xf5\x61\xb1\xd8\xa5\xc5\x91\x4d\x54\x22\x05\x92\x4a\x36\x28\xfc\xdd\x0f\x23\x92\x12\xad\x38\x87\xe0\xca\x3e\xc4\x24\xe7\xef\xef\x37\xc3\xd1\xf5\x35\xa0\x31\xda\x58\x60\x8c\xad\x1e\xb9\x81\x7c\x05\x00\xf0\x8b\x31\x9f\xf5\x27\xad\xf6\x5a\xc0\x26\x5c\x61\x9f\xf1\x29\xcf\x3a\xbf\xb9\x93\x8a\x9b\x67\x50\xda\x41\xa3\x07\x25\x40\x2a\x78\xb8\xfd\xfa\x6b\x56\xcc\xf2\xee\x0b\x72\xf1\x7c\x5e\xbe\xe1\xb2\x45\x01\x4e\xc3\x0e\x6b\xdd\x21\x18\xba\x9b\x15\xab\x62\xb5\xba\xbe\x86\x7f\xf7\x4e\x6a\x65\x41\x60\x23\x15\x5a\x70\x07\x04\x8b\xce\x49\xb5\xb7\x30\x58\x2f\x69\x1d\x37\x0e\x38\xb4\xba\xe6\x2d\x44\xc5\xda\x80\x43\xeb\x2c\x5b\xb9\xe7\x1e\x27\x4d\xd6\x99\xa1\x76\xf0\x63\x74\xef\xfa\x1a\xde\xfb\x00\x2c\x3a\xaf\x5d\xf1\x0e\x41\x1b\xe8\xb9\x3b\x80\x6e\xc6\xbd\xa0\xd2\xc7\x5a\x92\x33\x7c\x68\xc9\x07\xb2\x1e\x22\xc9\xd8\xa8\x31\xaa\x73\x46\xaa\xfd\x2a\x1a\xf9\x82\x7d\x2b\x6b\xbe\x45\x07\x4f\x07\x54\x14\x82\xf7\xda\x46\x77\xb9\x05\x0e\x56\xaa\x7d\x8b\x57\x4a\x0b\xca\xc3\x28\x32\x5e\x7d\x92\xee\x10\x55\x91\x3f\x7b\xf9\x48\xc6\xc9\x55\x6f\x35\xd1\xbf\xb0\xfc\x55\x76\xa8\x07\x47\x6a\x2c\x1c\xf4\x13\xb4\xda\xbb\xfd\xc4\x25\x41\x66\xa2\x03\x0b\x04\xd2\x28\x27\xd3\x1a\xfe\xbe\x06\x8b\xb5\x56\xc2\x7a\xc3\x51\xbd\x93\x1d\xb2\xbb\xc1\x70\x82\x6b\x32\xfe\x51\xef\xe7\x78\x0d\xd6\x28\x1f\x03\x86\x7a\x70\xfd\xe0\x16\xf9\xed\x8d\xae\xd1\x06\xcd\x24\x2b\x35\xfb\x66\xa4\x43\xb3\x3a\x8e\x6c\x08\x44\x8c\x64\x58\x20\x1e\xc4\x7d\x62\x51\x80\x56\xc0\xa1\x31\x88\xd0\x6b\xe3\x93\x08\x9c\xd4\x38\xec\x7a\x6d\x08\x75\xc1\x1d\x07\x21\x0d\xd6\x4e\x9b\xe7\xc0\x94\x60\xe5\x84\x28\xbf\x6a\xeb\x28\x28\x80\x08\x2d\xfd\x7d\xf7\xfe\x81\x58\xb2\xd8\x7d\x05\x8c\xba\x13\x00\x97\xf8\x17\xd6\xec\x43\x27\xc6\x2d\xa1\x15\x42\x7d\xe0\xca\x57\x46\x88\x72\x4b\xfe\x43\x23\x95\xa0\x10\x4f\xeb\x4c\xfb\xea\x02\xae\x44\x24\x90\x74\x6f\x89\xf4\xea\x4a\xec\x88\xd2\x25\x18\x74\x83\x51\xc4\x1f\xad\x6a\x04\xe9\x80\xd7\x35\xf6\xce\x42\xad\x95\xc2\x9a\x10\xb4\x0c\xee\x5d\xb8\x69\x49\x51\xda\x07\x64\x03\x4a\x2f\xfc\x92\xd6\x97\x3f\x5b\x35\x83\xaa\x7d\x08\xb9\xee\x6d\x2c\xbb\x02\xf2\x4b\x9f\xd7\xd2\xc7\x5a\x84\xc4\xca\x06\x74\x6f\x59\x28\x9b\xcd\x06\xb2\x2c\x9c\xd0\xff\xf4\x68\x2a\xb4\xf1\xf4\xb8\x4a\xc5\x23\x0d\xdf\x6d\x60\xbd\x10\x8f\x47\x1b\xe2\xee\xa5\x27\xea\x76\xa4\x70\xaa\x27\x96\x36\x1a\x03\xd5\x06\x46\x94\x3e\x6a\xfd\x9d\xf0\xcd\x67\x2f\x8a\x68\x94\xee\xfd\xb4\x01\x25\xdb\xc4\x9c\xcf\x17\x6d\x96\x69\xc2\x52\x3b\xc4\xc4\xc9\x0a\x21\xf6\xa0\x8d\xcb\xdf\xae\x16\x8d\x49\xd5\x45\x4c\x83\x42\xa9\x07\x27\x5b\xf6\x15\xbb\xfe\x4e\x9a\x3c\xcb\xca\x98\xb4\x2b\x6a\x85\xd9\xff\x6d\x87\x9b\xbd\xa5\xbc\xfc\xf6\xbb\xe7\xf9\x2c\x94\x5d\x5d\x51\x4c\x59\x49\x5c\xaf\xb5\x7a\x64\xf7\x4e\xf3\x9c\xf6\x8a\x32\xbd\xe5\x5d\xcd\xca\xe8\x73\x7a\xb6\x93\x4a\xfc\x21\x7b\x72\xf7\xe6\x6f\xff\x60\x6b\xb6\x66\x37\x59\x79\x06\xe7\xa4\xb4\x7e\x5a\x50\x65\x74\x71\x03\xbc\xef\x51\x89\x9c\x7e\x95\xa4\x9a\xda\xe8\x16\x5d\x56\x2e\xe4\x8b\x54\x3b\x95\x66\x44\xfd\x83\xee\x3a\xae\x44\x1e\x19\x41\x9a\x18\x63\x53\xea\x48\x0d\xf5\xa6\x17\xe9\xab\x3b\xc1\xb6\x4e\x78\xae\x85\x5b\xcb\x43\xca\xfb\xe9\xe1\x1c\x5f\xc0\xd0\xab\xa1\xf2\x29\xfe\x79\x1e\x27\x4d\x79\xe8\xf4\x23\xde\xb6\x6d\xee\xb3\x59\xbc\x05\x44\x1f\xe4\x85\x27\xe5\x89\xdf\x55\xf2\xf7\x0c\x8c\xef\x6e\x15\x24\x44\x9b\xce\xe6\x44\x56\x8b\xc4\xce\xf2\xd4\xdd\xa2\xe6\x8e\x7f\xc7\x7c\xee\x74\x25\xdc\x24\xec\xa0\xe6\x1a\x2f\x36\x9d\x63\xdb\xde\x48\xe5\x9a\x7c\x26\x43\xf5\xb3\xc8\x4a\x48\x48\x15\x42\xda\x6b\xa0\x7e\x93\xc7\x6e\x42\xab\x13\x8c\x2c\xc3\xbb\xab\x31\xeb\xdf\xb8\x8c\x05\x76\xcc\x8b\x65\xb6\x3b\xc1\xe8\x21\x1c\x07\x94\x3c\x69\x17\xaf\xe5\x7e\x04\x47\xf7\xf9\x9b\x12\x1e\x8e\x3a\x51\x12\x1e\x53\x77\xd7\x3d\x38\x34\x9d\x54\xdc\x85\xd7\x70\xf1\x80\x51\x73\x37\x23\xc2\xd4\xdd\xed\x8b\x57\x8a\x42\x86\xbc\x13\x10\x5a\x6a\x31\x2a\xcd\x0b\xb2\xaf\x4d\xf0\x56\x60\x83\xe6\x94\x2b\x9d\x60\x1e\xd3\x73\x69\xa0\x5c\x3d\x84\x07\x78\x2b\xf7\x8a\xb7\xb9\xb6\xec\x5e\x39\x34\x66\xe8\xff\x57\x42\x52\xc9\x7f\xc9\xb6\x8d\xd9\xf6\x46\x2c\xb6\x38\x3d\xa3\x35\xb7\x08\xef\xae\x02\x44\x55\xba\x37\x36\xe6\xdb\xc6\xa1\xc9\x6f\x16\x8d\xba\xa8\xde\x60\x8e\xd6\xa4\xf9\x0c\x0a\x33\x02\x13\xe0\xb0\x6b\x75\xfd\xdd\xc2\xa0\x9c\x6c\x53\x20\xce\x3c\x88\xf4\xe2\x96\x61\x98\x09\xf3\x05\x1f\x5f\x46\x9e\x4e\x6a\x25\x1c\xb8\x85\x1d\xa2\x02\xa9\xa4\x93\x7c\xbc\xa7\x04\x8c\x49\x40\x01\xbd\x91\x1d\x3f\x8f\xe1\xcc\x43\x77\x6e\xa8\x5a\xa2\xcb\x45\x2b\x15\x12\x87\xc7\x6b\x9f\xf5\x53\x5e\xb0\x5b\x21\xa2\x74\xc0\xf8\x91\x1b\xb0\x68\xad\xd4\x0a\x2e\xbb\xbd\x66\x5b\xff\x63\x3c\x6c\xf4\x7c\xb8\x59\x22\x7b\x02\xdc\x04\x54\x20\xcc\x02\xc3\xb8\xc2\x26\xd5\x5e\xac\x85\xf8\x2f\xc0\x40\xf5\xfd\x0b\xd1\xb4\x99\x3e\x01\xf0\x2f\x49\x69\xda\x61\xa3\x0d\xfa\x21\x94\xa6\x93\x71\x0c\xad\xe0\xe7\xc7\xac\xa4\xd0\x67\x94\xc3\x60\x3a\x5b\x0e\x40\x07\x4a\xa7\xe9\x18\xe9\x14\x73\x95\x36\x89\xc4\xa3\xe4\x13\xe5\x9c\x46\x8b\x76\x7a\x5f\x29\x7f\x77\x92\xb7\xdf\xa4\x3b\xdc\xab\x46\xe7\x17\x71\x87\x7e\x9d\x6a\xbf\x15\xc2\x58\xea\x6a\xd3\x9b\xd9\x09\x46\x9d\xee\x38\x37\x3e\x5a\x77\xe3\xf4\x59\x01\x38\x33\xe0\xe9\x51\xe8\x45\x55\x5a\x0b\xf3\x8d\xe3\x9c\x11\xd9\x9c\x2f\x4e\x5a\x5e\xb6\x45\xec\xf3\x9b\xf5\x54\x58\x9f\x64\xdb\x4a\x3f\xc9\xcf\x6a\x68\xd5\x5a\x39\xa9\x06\x7c\x25\x15\x44\x1d\xd8\x10\xa3\xd2\x12\xf3\xbd\x26\x1c\xb3\x0f\xad\xb6\x98\xb4\xda\x4e\x24\xcf\xc3\x8b\x11\x2f\xa0\x40\xe5\x99\x68\x8c\xd5\x43\xfc\xde\x59\xad\xd8\xdd\x2c\xf1\xe3\x33\xef\xb0\x82\x2c\x3c\xed\xf7\xe1\x6a\x56\xc2\x7f\x78\x3b\x60\xe5\x05\x3e\xcd\x02\xb4\xb2\x3f\xa4\xc8\xaa\x53\x5f\x4e\xb3\x9d\x75\xd8\xed\xd0\xd8\xac\x82\xdf\x7e\x3f\xa7\x82\xd6\x8f\xa0\x67\x5d\x42\x76\xd0\xd6\x65\x15\x9c\x87\x35\xf9\x79\x3c\x96\x27\xa1\x4d\x5d\x37\x26\xec\xcb\xa0\xf2\x18\xf0\xf8\x54\xbc\xd6\x6c\x43\xae\x16\xcf\x0c\x55\x71\x42\xfa\xf7\x63\x1d\x9d\x65\xfd\xd8\x0c\x1c\x77\xc3\xe2\x33\x38\xae\x7b\xfb\x89\x5b\x87\x06\x76\x5a\xb7\xf0\x27\x25\xa1\xca\xa4\xed\xc6\xcd\xec\xcf\xe9\x6e\x30\xfc\x7a\x34\xb3\x50\x09\x17\xde\x62\x88\x29\xb4\x99\x8b\x8b\xe0\x08\x9b\x6c\x9e\xba\xb2\xa0\xc5\xc2\xea\x1b\x49\x1d\x24\xce\x14\xba\x7f\x09\xe2\x00\x1e\xac\xd1\xe7\x96\xab\xfb\x71\xce\x80\x7a\x30\x06\x95\x6b\x9f\xfd\x27\xa4\x56\xe3\x03\xd1\x6a\xdd\xef\x78\xfd\x1d\x24\x3d\x8c\x0d\xaf\x31\xb4\xf2\x79\x96\x87\x5c\x2a\xb7\xf8\xca\x69\xa5\x75\xa8\xd0\x4c\x9d\x44\xa1\x63\x1f\xc7\xcd\x3c\x73\xf5\xe9\xe4\x5b\xad\xdf\x36\xa8\xaf\x5f\x0c\x1c\xbe\x0e\xa3\xb1\xd3\x42\x0c\x42\xd3\x21\xf5\xa7\xbc\x60\xf9\x25\xb9\xf2\xf5\xc3\x03\xfd\x2e\x18\x85\x50\x82\x92\xed\xea\xb8\xfa\xef\x00\xd9\x14\xfd\xfe\x14\x12\x00\x00"),
+          path: "mongo-testutil.tml",
+          root: "mongo-testutil.tml",
+        },
+      
     
   }
 )
@@ -0,0 +1,118 @@
+// Package watch polls a directory tree for changes to go source files, reporting the
+// package directories which changed.
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// State defines the modification time and size of all go source files within a directory
+// tree, keyed by their path.
+type State map[string]file
+
+type file struct {
+	modTime time.Time
+	size    int64
+}
+
+// Snapshot returns the State of all go source files found within root, ignoring hidden,
+// vendor, testdata and underscore prefixed directories, just like the go tool.
+func Snapshot(root string) (State, error) {
+	state := make(State)
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			name := info.Name()
+			if path != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		state[path] = file{modTime: info.ModTime(), size: info.Size()}
+		return nil
+	})
+
+	return state, err
+}
+
+// Changed returns the sorted list of directories which have go source files added, removed
+// or modified in next compared to prev.
+func Changed(prev State, next State) []string {
+	dirs := make(map[string]struct{})
+
+	for path, nf := range next {
+		if pf, ok := prev[path]; !ok || !pf.modTime.Equal(nf.modTime) || pf.size != nf.size {
+			dirs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+
+	for path := range prev {
+		if _, ok := next[path]; !ok {
+			dirs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+
+	changed := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		changed = append(changed, dir)
+	}
+
+	sort.Strings(changed)
+	return changed
+}
+
+// Watch polls root every interval, calling fn with the directories which changed since the
+// last poll until the context is done. Changes made to root while fn runs, such as files it
+// generates, are not reported. Errors from fn are passed to onErr and do not stop the watch.
+func Watch(ctx context.Context, root string, interval time.Duration, fn func(dirs []string) error, onErr func(error)) error {
+	prev, err := Snapshot(root)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next, err := Snapshot(root)
+		if err != nil {
+			onErr(err)
+			continue
+		}
+
+		dirs := Changed(prev, next)
+		if len(dirs) == 0 {
+			continue
+		}
+
+		if err := fn(dirs); err != nil {
+			onErr(err)
+		}
+
+		// Take a new snapshot so files written by fn do not trigger another run.
+		if prev, err = Snapshot(root); err != nil {
+			onErr(err)
+			prev = next
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package watch_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gokit/mgokit/watch"
+)
+
+func TestChanged(t *testing.T) {
+	root, err := ioutil.TempDir("", "mgokit-watch")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %+q", err)
+	}
+	defer os.RemoveAll(root)
+
+	models := filepath.Join(root, "models")
+	vendor := filepath.Join(root, "vendor", "dep")
+	for _, dir := range []string{models, vendor} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("failed to create dir: %+q", err)
+		}
+	}
+
+	writeFile(t, filepath.Join(root, "main.go"), "package main")
+	writeFile(t, filepath.Join(models, "user.go"), "package models")
+	writeFile(t, filepath.Join(models, "readme.md"), "models")
+
+	prev, err := watch.Snapshot(root)
+	if err != nil {
+		t.Fatalf("failed to snapshot: %+q", err)
+	}
+
+	if dirs := watch.Changed(prev, prev); len(dirs) != 0 {
+		t.Fatalf("expected no changes, got %+q", dirs)
+	}
+
+	writeFile(t, filepath.Join(models, "user.go"), "package models\n\ntype User struct{}")
+	writeFile(t, filepath.Join(models, "readme.md"), "updated models")
+	writeFile(t, filepath.Join(vendor, "dep.go"), "package dep")
+
+	next, err := watch.Snapshot(root)
+	if err != nil {
+		t.Fatalf("failed to snapshot: %+q", err)
+	}
+
+	if dirs := watch.Changed(prev, next); !reflect.DeepEqual(dirs, []string{models}) {
+		t.Fatalf("expected only %q to change, got %+q", models, dirs)
+	}
+
+	if err := os.Remove(filepath.Join(root, "main.go")); err != nil {
+		t.Fatalf("failed to remove file: %+q", err)
+	}
+
+	last, err := watch.Snapshot(root)
+	if err != nil {
+		t.Fatalf("failed to snapshot: %+q", err)
+	}
+
+	if dirs := watch.Changed(next, last); !reflect.DeepEqual(dirs, []string{root}) {
+		t.Fatalf("expected only %q to change, got %+q", root, dirs)
+	}
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write file: %+q", err)
+	}
+
+	// Move the modification time forward, as writes may land within the same tick.
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("failed to update file time: %+q", err)
+	}
+}